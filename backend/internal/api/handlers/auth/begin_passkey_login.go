@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func BeginPasskeyLoginRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/login/options", beginPasskeyLoginHandler(s))
+}
+
+func beginPasskeyLoginHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "beginPasskeyLoginHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("beginPasskeyLoginHandler started")
+
+		res, err := s.Auth.BeginPasskeyLogin(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("beginPasskeyLoginHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
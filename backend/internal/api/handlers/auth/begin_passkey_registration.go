@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func BeginPasskeyRegistrationRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/registration", beginPasskeyRegistrationHandler(s))
+}
+
+func beginPasskeyRegistrationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "beginPasskeyRegistrationHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("beginPasskeyRegistrationHandler started")
+
+		res, err := s.Auth.BeginPasskeyRegistration(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("beginPasskeyRegistrationHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
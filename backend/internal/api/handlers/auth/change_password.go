@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ChangePasswordRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/password", changePasswordHandler(s))
+}
+
+func changePasswordHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "changePasswordHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("changePasswordHandler started")
+
+		var body types.ChangePasswordRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.ChangePassword(ctx, dto.ChangePasswordRequest{
+			CurrentPassword: body.CurrentPassword,
+			NewPassword:     body.NewPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("changePasswordHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
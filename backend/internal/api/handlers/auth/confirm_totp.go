@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ConfirmTOTPRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/mfa/totp/confirm", confirmTOTPHandler(s))
+}
+
+func confirmTOTPHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "confirmTOTPHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("confirmTOTPHandler started")
+
+		var body types.ConfirmTotpRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.ConfirmTOTP(ctx, dto.ConfirmTOTPRequest{
+			Code: body.Code,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("confirmTOTPHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
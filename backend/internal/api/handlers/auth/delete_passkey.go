@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeletePasskeyRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/passkeys/:id", deletePasskeyHandler(s))
+}
+
+func deletePasskeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "deletePasskeyHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("deletePasskeyHandler started")
+
+		param := c.Param("id")
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || id <= 0 {
+			return httperrors.ErrInvalidID
+		}
+
+		res, err := s.Auth.DeletePasskey(ctx, dto.DeletePasskeyRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("deletePasskeyHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DisableTOTPRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/mfa/totp/disable", disableTOTPHandler(s))
+}
+
+func disableTOTPHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "disableTOTPHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("disableTOTPHandler started")
+
+		var body types.DisableTotpRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.DisableTOTP(ctx, dto.DisableTOTPRequest{
+			Password:     body.Password,
+			Code:         body.Code,
+			RecoveryCode: body.RecoveryCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("disableTOTPHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
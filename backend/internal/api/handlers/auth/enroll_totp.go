@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func EnrollTOTPRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/mfa/totp", enrollTOTPHandler(s))
+}
+
+func enrollTOTPHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "enrollTOTPHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("enrollTOTPHandler started")
+
+		res, err := s.Auth.EnrollTOTP(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("enrollTOTPHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
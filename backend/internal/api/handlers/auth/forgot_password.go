@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ForgotPasswordRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/password/forgot", forgotPasswordHandler(s))
+}
+
+func forgotPasswordHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "forgotPasswordHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("forgotPasswordHandler started")
+
+		var body types.ForgotPasswordRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.ForgotPassword(ctx, dto.ForgotPasswordRequest{
+			Email: string(body.Email),
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("forgotPasswordHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
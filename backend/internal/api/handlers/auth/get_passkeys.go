@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPasskeysRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/passkeys", getPasskeysHandler(s))
+}
+
+func getPasskeysHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getPasskeysHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getPasskeysHandler started")
+
+		passkeys, err := s.Auth.GetPasskeys(ctx)
+		if err != nil {
+			return err
+		}
+
+		passkeyResponse := make([]types.PasskeyResponse, len(passkeys))
+		for i, passkey := range passkeys {
+			passkeyResponse[i] = *passkey.ToTypes()
+		}
+
+		log.Debug().Msg("getPasskeysHandler successfully executed")
+
+		return c.JSON(http.StatusOK, passkeyResponse)
+	}
+}
@@ -28,8 +28,9 @@ func loginHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		res, err := s.Auth.Login(ctx, dto.LoginRequest{
-			Email:    string(body.Email),
-			Password: body.Password,
+			Email:     string(body.Email),
+			Password:  body.Password,
+			IPAddress: c.RealIP(),
 		})
 		if err != nil {
 			return err
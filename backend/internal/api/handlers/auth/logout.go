@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func LogoutRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/logout", logoutHandler(s))
+}
+
+func logoutHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "logoutHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("logoutHandler started")
+
+		var body types.LogoutRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.Logout(ctx, dto.LogoutRequest{
+			RefreshToken: body.RefreshToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("logoutHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func OIDCCallbackRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sso/oidc/callback", oidcCallbackHandler(s))
+}
+
+func oidcCallbackHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "oidcCallbackHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("oidcCallbackHandler started")
+
+		var body types.OidcCallbackRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.OIDCCallback(ctx, dto.OIDCCallbackRequest{
+			State:        body.State,
+			Code:         body.Code,
+			BrowserState: takeSSOStateCookie(c, s, oidcStateCookie),
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("oidcCallbackHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
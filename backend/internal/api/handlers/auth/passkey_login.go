@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PasskeyLoginRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys/login", passkeyLoginHandler(s))
+}
+
+func passkeyLoginHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "passkeyLoginHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("passkeyLoginHandler started")
+
+		var body types.PasskeyLoginRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.PasskeyLogin(ctx, dto.PasskeyLoginRequest{
+			CeremonyID: body.CeremonyId,
+			Credential: body.Credential,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("passkeyLoginHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
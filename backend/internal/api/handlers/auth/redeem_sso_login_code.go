@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func RedeemSSOLoginCodeRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sso/saml/token", redeemSSOLoginCodeHandler(s))
+}
+
+func redeemSSOLoginCodeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "redeemSSOLoginCodeHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("redeemSSOLoginCodeHandler started")
+
+		var body types.SsoLoginCodeRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.RedeemSSOLoginCode(ctx, dto.SSOLoginCodeRequest{
+			Code: body.Code,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("redeemSSOLoginCodeHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
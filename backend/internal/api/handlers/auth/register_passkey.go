@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterPasskeyRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/passkeys", registerPasskeyHandler(s))
+}
+
+func registerPasskeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "registerPasskeyHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("registerPasskeyHandler started")
+
+		var body types.RegisterPasskeyRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.RegisterPasskey(ctx, dto.RegisterPasskeyRequest{
+			CeremonyID: body.CeremonyId,
+			Name:       body.Name,
+			Credential: body.Credential,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("registerPasskeyHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
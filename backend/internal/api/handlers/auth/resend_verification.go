@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ResendVerificationRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/email/verification", resendVerificationHandler(s))
+}
+
+func resendVerificationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "resendVerificationHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("resendVerificationHandler started")
+
+		res, err := s.Auth.ResendVerification(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("resendVerificationHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ResetPasswordRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/password/reset", resetPasswordHandler(s))
+}
+
+func resetPasswordHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "resetPasswordHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("resetPasswordHandler started")
+
+		var body types.ResetPasswordRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.ResetPassword(ctx, dto.ResetPasswordRequest{
+			Token:       body.Token,
+			NewPassword: body.NewPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("resetPasswordHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SAMLAssertionRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sso/saml/acs", samlAssertionHandler(s))
+}
+
+// samlAssertionHandler takes the response the identity provider posts with
+// the browser of the user and sends the user on to the frontend, with the
+// login code or the type of the error.
+func samlAssertionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "samlAssertionHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("samlAssertionHandler started")
+
+		callback := strings.TrimSuffix(s.Config.Frontend.BaseURL, "/") + s.Config.Frontend.SAMLCallbackEndpoint
+
+		res, err := s.Auth.SAMLAssertion(ctx, dto.SAMLAssertionRequest{
+			SAMLResponse: c.FormValue("SAMLResponse"),
+			RelayState:   c.FormValue("RelayState"),
+			BrowserState: takeSSOStateCookie(c, s, samlStateCookie),
+		})
+		if err != nil {
+			var httpErr *httperrors.HTTPError
+			if !errors.As(err, &httpErr) {
+				return err
+			}
+
+			return c.Redirect(http.StatusSeeOther, callback+"?error="+url.QueryEscape(httpErr.Type))
+		}
+
+		log.Debug().Msg("samlAssertionHandler successfully executed")
+
+		return c.Redirect(http.StatusSeeOther, callback+"?code="+url.QueryEscape(res.Code))
+	}
+}
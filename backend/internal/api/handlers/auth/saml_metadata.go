@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SAMLMetadataRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/sso/saml/metadata", samlMetadataHandler(s))
+}
+
+func samlMetadataHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "samlMetadataHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("samlMetadataHandler started")
+
+		param := c.QueryParam("tenantId")
+		tenantID, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || tenantID <= 0 {
+			return httperrors.ErrInvalidID
+		}
+
+		res, err := s.Auth.SAMLMetadata(ctx, dto.GetSAMLMetadataRequest{
+			TenantID: tenantID,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("samlMetadataHandler successfully executed")
+
+		return c.Blob(http.StatusOK, "application/samlmetadata+xml", res)
+	}
+}
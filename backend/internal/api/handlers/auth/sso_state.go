@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api"
+	"github.com/labstack/echo/v4"
+)
+
+// Cookies binding a single sign-on login to the browser that started it.
+const (
+	oidcStateCookie = "oidc_state"
+	samlStateCookie = "saml_relay_state"
+	ssoCookiePath   = "/api/v1/auth/sso"
+)
+
+// setSSOStateCookie keeps the state of a login in the browser until the
+// provider sends the user back. Identity providers post back cross site, so
+// on https the cookie is sent with cross site requests as well. Browsers
+// only take SameSite=None on secure cookies; on http the attribute is left
+// out, which browsers still send with a top level post right after login.
+func setSSOStateCookie(c echo.Context, s *api.Server, name string, state string, ttl time.Duration) {
+	secure := strings.HasPrefix(s.Config.Echo.BaseURL, "https://")
+
+	var sameSite http.SameSite
+	if secure {
+		sameSite = http.SameSiteNoneMode
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     name,
+		Value:    state,
+		Path:     ssoCookiePath,
+		MaxAge:   int(ttl.Seconds()),
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: sameSite,
+	})
+}
+
+// takeSSOStateCookie returns the state kept in the browser and clears it, as
+// each state is used once. It returns "" when the browser has none.
+func takeSSOStateCookie(c echo.Context, s *api.Server, name string) string {
+	cookie, err := c.Cookie(name)
+	if err != nil {
+		return ""
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     name,
+		Path:     ssoCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(s.Config.Echo.BaseURL, "https://"),
+	})
+
+	return cookie.Value
+}
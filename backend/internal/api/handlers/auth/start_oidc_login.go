@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func StartOIDCLoginRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sso/oidc/authorize", startOIDCLoginHandler(s))
+}
+
+func startOIDCLoginHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "startOIDCLoginHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("startOIDCLoginHandler started")
+
+		var body types.StartSsoLoginRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.StartOIDCLogin(ctx, dto.StartSSOLoginRequest{
+			TenantID: body.TenantId,
+		})
+		if err != nil {
+			return err
+		}
+
+		setSSOStateCookie(c, s, oidcStateCookie, res.State, s.Config.Auth.OIDCStateTTL)
+
+		log.Debug().Msg("startOIDCLoginHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
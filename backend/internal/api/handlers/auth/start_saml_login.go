@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func StartSAMLLoginRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sso/saml/authorize", startSAMLLoginHandler(s))
+}
+
+func startSAMLLoginHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "startSAMLLoginHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("startSAMLLoginHandler started")
+
+		var body types.StartSsoLoginRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.StartSAMLLogin(ctx, dto.StartSSOLoginRequest{
+			TenantID: body.TenantId,
+		})
+		if err != nil {
+			return err
+		}
+
+		setSSOStateCookie(c, s, samlStateCookie, res.State, s.Config.Auth.SAMLRequestTTL)
+
+		log.Debug().Msg("startSAMLLoginHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
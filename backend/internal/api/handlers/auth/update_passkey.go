@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func UpdatePasskeyRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.PATCH("/passkeys/:id", updatePasskeyHandler(s))
+}
+
+func updatePasskeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "updatePasskeyHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("updatePasskeyHandler started")
+
+		param := c.Param("id")
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || id <= 0 {
+			return httperrors.ErrInvalidID
+		}
+
+		var body types.UpdatePasskeyRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.UpdatePasskey(ctx, dto.UpdatePasskeyRequest{
+			ID:   id,
+			Name: body.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("updatePasskeyHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func VerifyEmailRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/email/verify", verifyEmailHandler(s))
+}
+
+func verifyEmailHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "verifyEmailHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("verifyEmailHandler started")
+
+		var body types.VerifyEmailRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.VerifyEmail(ctx, dto.VerifyEmailRequest{
+			Token: body.Token,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("verifyEmailHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func VerifyMFARouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/mfa/verify", verifyMFAHandler(s))
+}
+
+func verifyMFAHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "verifyMFAHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("verifyMFAHandler started")
+
+		var body types.VerifyMfaRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Auth.VerifyMFA(ctx, dto.VerifyMFARequest{
+			MFAToken:     body.MfaToken,
+			Code:         body.Code,
+			RecoveryCode: body.RecoveryCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("verifyMFAHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
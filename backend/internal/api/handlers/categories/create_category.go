@@ -0,0 +1,41 @@
+package categories
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func CreateCategoryRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.POST("", createCategoryHandler(s))
+}
+
+func createCategoryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "createCategoryHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("createCategoryHandler started")
+
+		var body types.CreateCategoryRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Category.Create(ctx, dto.CreateCategoryRequest{
+			ParentID: body.ParentId,
+			Name:     body.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("createCategoryHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
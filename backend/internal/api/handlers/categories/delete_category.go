@@ -0,0 +1,42 @@
+package categories
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteCategoryRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.DELETE("/:id", deleteCategoryHandler(s))
+}
+
+func deleteCategoryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "deleteCategoryHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("deleteCategoryHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse category id")
+			return err
+		}
+
+		res, err := s.Category.Delete(ctx, dto.DeleteCategoryRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("deleteCategoryHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,48 @@
+package categories
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetCategoryDescendantsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.GET("/:id/descendants", getCategoryDescendantsHandler(s))
+}
+
+func getCategoryDescendantsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getCategoryDescendantsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getCategoryDescendantsHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse category id")
+			return err
+		}
+
+		res, err := s.Category.GetDescendants(ctx, dto.GetCategoryRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		categoryResponses := make([]*types.CategoryResponse, len(res))
+		for i, category := range res {
+			categoryResponses[i] = category.ToTypes()
+		}
+
+		log.Debug().Msg("getCategoryDescendantsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, categoryResponses)
+	}
+}
@@ -0,0 +1,37 @@
+package categories
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetCategoryTreeRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.GET("", getCategoryTreeHandler(s))
+}
+
+func getCategoryTreeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getCategoryTreeHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getCategoryTreeHandler started")
+
+		res, err := s.Category.GetTree(ctx)
+		if err != nil {
+			return err
+		}
+
+		categoryResponses := make([]*types.CategoryResponse, len(res))
+		for i, category := range res {
+			categoryResponses[i] = category.ToTypes()
+		}
+
+		log.Debug().Msg("getCategoryTreeHandler successfully executed")
+
+		return c.JSON(http.StatusOK, categoryResponses)
+	}
+}
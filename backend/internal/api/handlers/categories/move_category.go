@@ -0,0 +1,50 @@
+package categories
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func MoveCategoryRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.POST("/:id/move", moveCategoryHandler(s))
+}
+
+func moveCategoryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "moveCategoryHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("moveCategoryHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse category id")
+			return err
+		}
+
+		var body types.MoveCategoryRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Category.Move(ctx, dto.MoveCategoryRequest{
+			ID:       id,
+			ParentID: body.ParentId,
+			Position: body.Position,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("moveCategoryHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
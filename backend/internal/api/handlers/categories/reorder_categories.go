@@ -0,0 +1,41 @@
+package categories
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ReorderCategoriesRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.POST("/reorder", reorderCategoriesHandler(s))
+}
+
+func reorderCategoriesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "reorderCategoriesHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("reorderCategoriesHandler started")
+
+		var body types.ReorderCategoriesRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Category.Reorder(ctx, dto.ReorderCategoriesRequest{
+			ParentID: body.ParentId,
+			IDs:      body.Ids,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("reorderCategoriesHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,49 @@
+package categories
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func UpdateCategoryRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Categories.PATCH("/:id", updateCategoryHandler(s))
+}
+
+func updateCategoryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "updateCategoryHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("updateCategoryHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse category id")
+			return err
+		}
+
+		var body types.UpdateCategoryRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Category.Update(ctx, dto.UpdateCategoryRequest{
+			ID:   id,
+			Name: body.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("updateCategoryHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
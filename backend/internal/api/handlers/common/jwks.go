@@ -0,0 +1,37 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func JWKSRouter(s *api.Server) *echo.Route {
+	return s.Router.Root.GET("/.well-known/jwks.json", jwksHandler(s))
+}
+
+// jwksHandler publishes the public keys access tokens are verified with, so
+// other services can verify them without a shared secret. Clients may cache
+// the keys for the sync interval and should refetch them on an unknown kid.
+func jwksHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "jwksHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("jwksHandler started")
+
+		res, err := s.Keys.JWKS(ctx)
+		if err != nil {
+			log.Err(err).Msg("Failed to load token keys")
+			return err
+		}
+
+		log.Debug().Msg("jwksHandler successfully executed")
+
+		c.Response().Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.Config.Auth.JWTKeySyncInterval.Seconds())))
+		return c.JSON(http.StatusOK, res)
+	}
+}
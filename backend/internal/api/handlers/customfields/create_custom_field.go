@@ -0,0 +1,55 @@
+package customfields
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func CreateCustomFieldRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1CustomFields.POST("", createCustomFieldHandler(s))
+}
+
+func createCustomFieldHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "createCustomFieldHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("createCustomFieldHandler started")
+
+		var body types.CreateCustomFieldRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		var required bool
+		if body.Required != nil {
+			required = *body.Required
+		}
+
+		var options []string
+		if body.Options != nil {
+			options = *body.Options
+		}
+
+		res, err := s.CustomField.Create(ctx, dto.CreateCustomFieldRequest{
+			Name:     body.Name,
+			Label:    body.Label,
+			Type:     body.Type,
+			Required: required,
+			Options:  options,
+			Position: body.Position,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("createCustomFieldHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,42 @@
+package customfields
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteCustomFieldRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1CustomFields.DELETE("/:id", deleteCustomFieldHandler(s))
+}
+
+func deleteCustomFieldHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "deleteCustomFieldHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("deleteCustomFieldHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse custom field id")
+			return err
+		}
+
+		res, err := s.CustomField.Delete(ctx, dto.DeleteCustomFieldRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("deleteCustomFieldHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
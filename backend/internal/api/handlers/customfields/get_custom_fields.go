@@ -0,0 +1,37 @@
+package customfields
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetCustomFieldsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1CustomFields.GET("", getCustomFieldsHandler(s))
+}
+
+func getCustomFieldsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getCustomFieldsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getCustomFieldsHandler started")
+
+		res, err := s.CustomField.GetAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		customFieldResponses := make([]*types.CustomFieldResponse, len(res))
+		for i, customField := range res {
+			customFieldResponses[i] = customField.ToTypes()
+		}
+
+		log.Debug().Msg("getCustomFieldsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, customFieldResponses)
+	}
+}
@@ -0,0 +1,52 @@
+package customfields
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func UpdateCustomFieldRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1CustomFields.PATCH("/:id", updateCustomFieldHandler(s))
+}
+
+func updateCustomFieldHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "updateCustomFieldHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("updateCustomFieldHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse custom field id")
+			return err
+		}
+
+		var body types.UpdateCustomFieldRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.CustomField.Update(ctx, dto.UpdateCustomFieldRequest{
+			ID:       id,
+			Label:    body.Label,
+			Required: body.Required,
+			Options:  body.Options,
+			Position: body.Position,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("updateCustomFieldHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
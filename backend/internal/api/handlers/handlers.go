@@ -3,8 +3,12 @@ package handlers
 import (
 	"cuhara.qua.go/internal/api"
 	"cuhara.qua.go/internal/api/handlers/auth"
+	"cuhara.qua.go/internal/api/handlers/categories"
 	"cuhara.qua.go/internal/api/handlers/claims"
 	"cuhara.qua.go/internal/api/handlers/common"
+	"cuhara.qua.go/internal/api/handlers/customfields"
+	"cuhara.qua.go/internal/api/handlers/notifications"
+	"cuhara.qua.go/internal/api/handlers/posts"
 	"cuhara.qua.go/internal/api/handlers/roles"
 	"cuhara.qua.go/internal/api/handlers/tenants"
 	"cuhara.qua.go/internal/api/handlers/topics"
@@ -16,8 +20,33 @@ func AttachAllRoutes(s *api.Server) {
 	s.Router.Routes = []*echo.Route{
 		common.SwaggerRouter(s),
 		common.DocsRouter(s),
+		common.JWKSRouter(s),
 		auth.LoginRouter(s),
 		auth.RegisterRouter(s),
+		auth.RefreshRouter(s),
+		auth.LogoutRouter(s),
+		auth.ChangePasswordRouter(s),
+		auth.ForgotPasswordRouter(s),
+		auth.ResetPasswordRouter(s),
+		auth.VerifyEmailRouter(s),
+		auth.ResendVerificationRouter(s),
+		auth.VerifyMFARouter(s),
+		auth.EnrollTOTPRouter(s),
+		auth.ConfirmTOTPRouter(s),
+		auth.DisableTOTPRouter(s),
+		auth.BeginPasskeyRegistrationRouter(s),
+		auth.RegisterPasskeyRouter(s),
+		auth.GetPasskeysRouter(s),
+		auth.UpdatePasskeyRouter(s),
+		auth.DeletePasskeyRouter(s),
+		auth.BeginPasskeyLoginRouter(s),
+		auth.PasskeyLoginRouter(s),
+		auth.StartOIDCLoginRouter(s),
+		auth.OIDCCallbackRouter(s),
+		auth.SAMLMetadataRouter(s),
+		auth.StartSAMLLoginRouter(s),
+		auth.SAMLAssertionRouter(s),
+		auth.RedeemSSOLoginCodeRouter(s),
 		roles.GetAllRouter(s),
 		roles.CreateRoleRouter(s),
 		roles.UpdateRoleRouter(s),
@@ -25,21 +54,89 @@ func AttachAllRoutes(s *api.Server) {
 		users.GetUsersRouter(s),
 		users.UpdateUserRoute(s),
 		users.DeleteUserRoute(s),
+		users.GetUserExpertiseRouter(s),
+		users.GetModeratedScopesRouter(s),
+		users.RevokeSessionsRouter(s),
+		users.UnlockUserRouter(s),
 		tenants.GetAllRouter(s),
 		tenants.CreateTenantRouter(s),
 		tenants.UpdateTenantRouter(s),
 		tenants.DeleteTenantRouter(s),
+		tenants.GetOIDCProviderRouter(s),
+		tenants.SetOIDCProviderRouter(s),
+		tenants.GetSAMLProviderRouter(s),
+		tenants.SetSAMLProviderRouter(s),
 		topics.GetAllTopicRouter(s),
 		topics.CreateTopicRouter(s),
 		topics.UpdateTopicRouter(s),
 		topics.DeleteTopicRouter(s),
+		topics.ReorderTopicsRouter(s),
+		topics.GetTopicAccessRouter(s),
+		topics.SetTopicAccessRouter(s),
+		topics.GetTopicModeratorsRouter(s),
+		topics.SetTopicModeratorsRouter(s),
 		topics.GetAllSubTopicRouter(s),
 		topics.CreateSubTopicRouter(s),
 		topics.DeleteSubTopicRouter(s),
 		topics.UpdateSubTopicRouter(s),
+		topics.ReorderSubTopicsRouter(s),
+		topics.GetSubTopicRespondersRouter(s),
+		topics.SetSubTopicRespondersRouter(s),
+		topics.GetSubTopicAccessRouter(s),
+		topics.SetSubTopicAccessRouter(s),
+		topics.GetSubTopicModeratorsRouter(s),
+		topics.SetSubTopicModeratorsRouter(s),
+		topics.GetQuestionTemplateRouter(s),
+		topics.SetQuestionTemplateRouter(s),
 		claims.GetAllRouter(s),
 		claims.CreateClaimRouter(s),
 		claims.UpdateClaimRouter(s),
 		claims.DeleteClaimRouter(s),
+		posts.GetUnansweredPostsRouter(s),
+		posts.GetPostsRouter(s),
+		posts.ExportPostsRouter(s),
+		posts.SearchPostsRouter(s),
+		posts.CreatePostRouter(s),
+		posts.GetPostExpertsRouter(s),
+		posts.AssignPostRouter(s),
+		posts.GetAssignedPostsRouter(s),
+		posts.GetPostRouter(s),
+		posts.GetPostAuthorRouter(s),
+		posts.MergePostRouter(s),
+		posts.GetPostHistoryRouter(s),
+		posts.MovePostRouter(s),
+		posts.MovePostsRouter(s),
+		posts.UpdatePostRouter(s),
+		posts.DeletePostRouter(s),
+		posts.ClosePostRouter(s),
+		posts.LockPostRouter(s),
+		posts.ReactPostRouter(s),
+		posts.ReactAnswerRouter(s),
+		posts.ReactCommentRouter(s),
+		posts.SetPostWikiRouter(s),
+		posts.GetAnswersRouter(s),
+		posts.UpdateAnswerRouter(s),
+		posts.SetAnswerWikiRouter(s),
+		posts.SuggestPostEditRouter(s),
+		posts.SuggestAnswerEditRouter(s),
+		posts.GetSuggestedEditsRouter(s),
+		posts.GetSuggestedEditDiffRouter(s),
+		posts.ReviewSuggestedEditRouter(s),
+		posts.GetPollRouter(s),
+		posts.VotePollRouter(s),
+		notifications.GetAllRouter(s),
+		notifications.ReadNotificationRouter(s),
+		categories.GetCategoryTreeRouter(s),
+		categories.GetCategoryBreadcrumbRouter(s),
+		categories.GetCategoryDescendantsRouter(s),
+		categories.CreateCategoryRouter(s),
+		categories.UpdateCategoryRouter(s),
+		categories.DeleteCategoryRouter(s),
+		categories.MoveCategoryRouter(s),
+		categories.ReorderCategoriesRouter(s),
+		customfields.GetCustomFieldsRouter(s),
+		customfields.CreateCustomFieldRouter(s),
+		customfields.UpdateCustomFieldRouter(s),
+		customfields.DeleteCustomFieldRouter(s),
 	}
 }
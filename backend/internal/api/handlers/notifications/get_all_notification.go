@@ -0,0 +1,37 @@
+package notifications
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAllRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Notifications.GET("", getAllHandler(s))
+}
+
+func getAllHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getAllHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getAllHandler started")
+
+		res, err := s.Notification.GetAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		notificationResponses := make([]*types.NotificationResponse, len(res))
+		for i, notification := range res {
+			notificationResponses[i] = notification.ToTypes()
+		}
+
+		log.Debug().Msg("getAllHandler successfully executed")
+
+		return c.JSON(http.StatusOK, notificationResponses)
+	}
+}
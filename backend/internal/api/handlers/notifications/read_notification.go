@@ -0,0 +1,42 @@
+package notifications
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ReadNotificationRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Notifications.POST("/:id/read", readNotificationHandler(s))
+}
+
+func readNotificationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "readNotificationHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("readNotificationHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse notification id")
+			return err
+		}
+
+		res, err := s.Notification.Read(ctx, dto.ReadNotificationRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("readNotificationHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
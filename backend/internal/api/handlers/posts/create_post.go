@@ -0,0 +1,73 @@
+package posts
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func CreatePostRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("", createPostHandler(s))
+}
+
+func createPostHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "createPostHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("createPostHandler started")
+
+		var body types.CreatePostRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		var tags []string
+		if body.Tags != nil {
+			tags = *body.Tags
+		}
+
+		var fields map[string]any
+		if body.Fields != nil {
+			fields = *body.Fields
+		}
+
+		var customFields map[string]any
+		if body.CustomFields != nil {
+			customFields = *body.CustomFields
+		}
+
+		var poll *dto.CreatePollRequest
+		if body.Poll != nil {
+			poll = &dto.CreatePollRequest{
+				Question:          body.Poll.Question,
+				Options:           body.Poll.Options,
+				MultipleChoice:    body.Poll.MultipleChoice != nil && *body.Poll.MultipleChoice,
+				ResultsVisibility: util.PtrToString(body.Poll.ResultsVisibility),
+				ClosesAt:          body.Poll.ClosesAt,
+			}
+		}
+
+		res, err := s.Post.Create(ctx, dto.CreatePostRequest{
+			SubTopicID:   body.SubTopicId,
+			Title:        body.Title,
+			Body:         body.Body,
+			Tags:         tags,
+			Fields:       fields,
+			CustomFields: customFields,
+			Poll:         poll,
+			Anonymous:    body.Anonymous != nil && *body.Anonymous,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("createPostHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
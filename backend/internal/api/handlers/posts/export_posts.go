@@ -0,0 +1,50 @@
+package posts
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ExportPostsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/export", exportPostsHandler(s))
+}
+
+func exportPostsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "exportPostsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("exportPostsHandler started")
+
+		request, err := parseGetPostsRequest(c)
+		if err != nil {
+			return err
+		}
+
+		res, err := s.Post.Export(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(res.Header); err != nil {
+			log.Error().Err(err).Msg("Failed to write export header")
+			return err
+		}
+		if err := w.WriteAll(res.Rows); err != nil {
+			log.Error().Err(err).Msg("Failed to write export rows")
+			return err
+		}
+
+		log.Debug().Msg("exportPostsHandler successfully executed")
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="posts.csv"`)
+		return c.Blob(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+	}
+}
@@ -0,0 +1,46 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAnswersRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/:id/answers", getAnswersHandler(s))
+}
+
+func getAnswersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getAnswersHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getAnswersHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		res, err := s.Post.GetAnswers(ctx, dto.GetAnswersRequest{PostID: id})
+		if err != nil {
+			return err
+		}
+
+		answerResponses := make([]*types.AnswerResponse, len(res))
+		for i, answer := range res {
+			answerResponses[i] = answer.ToTypes()
+		}
+
+		log.Debug().Msg("getAnswersHandler successfully executed")
+
+		return c.JSON(http.StatusOK, answerResponses)
+	}
+}
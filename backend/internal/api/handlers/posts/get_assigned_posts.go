@@ -0,0 +1,37 @@
+package posts
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAssignedPostsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/assigned", getAssignedPostsHandler(s))
+}
+
+func getAssignedPostsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getAssignedPostsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getAssignedPostsHandler started")
+
+		res, err := s.Post.GetAssigned(ctx)
+		if err != nil {
+			return err
+		}
+
+		postResponses := make([]*types.PostResponse, len(res))
+		for i, post := range res {
+			postResponses[i] = post.ToTypes()
+		}
+
+		log.Debug().Msg("getAssignedPostsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, postResponses)
+	}
+}
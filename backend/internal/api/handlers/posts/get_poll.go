@@ -0,0 +1,40 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPollRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/:id/poll", getPollHandler(s))
+}
+
+func getPollHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getPollHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getPollHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		res, err := s.Post.GetPoll(ctx, dto.GetPollRequest{PostID: id})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("getPollHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,58 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPostExpertsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/:id/experts", getPostExpertsHandler(s))
+}
+
+func getPostExpertsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getPostExpertsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getPostExpertsHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var limit int
+		if limitStr := c.QueryParam("limit"); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to parse limit")
+				return err
+			}
+		}
+
+		res, err := s.Post.GetExperts(ctx, dto.GetPostExpertsRequest{
+			ID:    id,
+			Limit: limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		expertResponses := make([]*types.ExpertResponse, len(res))
+		for i, expert := range res {
+			expertResponses[i] = expert.ToTypes()
+		}
+
+		log.Debug().Msg("getPostExpertsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, expertResponses)
+	}
+}
@@ -0,0 +1,48 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPostHistoryRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/:id/history", getPostHistoryHandler(s))
+}
+
+func getPostHistoryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getPostHistoryHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getPostHistoryHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		res, err := s.Post.GetHistory(ctx, dto.GetPostHistoryRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		historyResponses := make([]*types.PostHistoryResponse, len(res))
+		for i, history := range res {
+			historyResponses[i] = history.ToTypes()
+		}
+
+		log.Debug().Msg("getPostHistoryHandler successfully executed")
+
+		return c.JSON(http.StatusOK, historyResponses)
+	}
+}
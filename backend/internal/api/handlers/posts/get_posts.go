@@ -0,0 +1,91 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPostsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("", getPostsHandler(s))
+}
+
+func getPostsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getPostsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getPostsHandler started")
+
+		request, err := parseGetPostsRequest(c)
+		if err != nil {
+			return err
+		}
+
+		res, err := s.Post.GetAll(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		postResponses := make([]*types.PostResponse, len(res))
+		for i, post := range res {
+			postResponses[i] = post.ToTypes()
+		}
+
+		log.Debug().Msg("getPostsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, postResponses)
+	}
+}
+
+// parseGetPostsRequest reads the filters, sort and limit shared by the post
+// list and its export.
+func parseGetPostsRequest(c echo.Context) (dto.GetPostsRequest, error) {
+	log := util.LogFromEchoContext(c)
+
+	var subTopicID *int64
+	if subTopicIDStr := c.QueryParam("subTopicId"); subTopicIDStr != "" {
+		parsed, err := strconv.ParseInt(subTopicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse sub topic id")
+			return dto.GetPostsRequest{}, err
+		}
+		subTopicID = &parsed
+	}
+
+	var limit int
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse limit")
+			return dto.GetPostsRequest{}, err
+		}
+		limit = parsed
+	}
+
+	return dto.GetPostsRequest{
+		SubTopicID:   subTopicID,
+		Fields:       parseFieldFilters(c.QueryParams()["field"]),
+		CustomFields: parseFieldFilters(c.QueryParams()["customField"]),
+		Sort:         c.QueryParam("sort"),
+		Limit:        limit,
+	}, nil
+}
+
+// parseFieldFilters turns name:value query parameters into a map. The request
+// validator already rejected values without a name.
+func parseFieldFilters(values []string) map[string]string {
+	fields := make(map[string]string, len(values))
+	for _, value := range values {
+		name, fieldValue, _ := strings.Cut(value, ":")
+		fields[name] = fieldValue
+	}
+
+	return fields
+}
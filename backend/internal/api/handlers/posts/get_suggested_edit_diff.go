@@ -0,0 +1,40 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetSuggestedEditDiffRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/suggested-edits/:id/diff", getSuggestedEditDiffHandler(s))
+}
+
+func getSuggestedEditDiffHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getSuggestedEditDiffHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getSuggestedEditDiffHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse suggested edit id")
+			return err
+		}
+
+		res, err := s.Post.GetSuggestedEditDiff(ctx, dto.GetSuggestedEditDiffRequest{ID: id})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("getSuggestedEditDiffHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,49 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetSuggestedEditsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/suggested-edits", getSuggestedEditsHandler(s))
+}
+
+func getSuggestedEditsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getSuggestedEditsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getSuggestedEditsHandler started")
+
+		var request dto.GetSuggestedEditsRequest
+		if postIDStr := c.QueryParam("postId"); postIDStr != "" {
+			postID, err := strconv.ParseInt(postIDStr, 10, 64)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to parse post id")
+				return err
+			}
+			request.PostID = &postID
+		}
+
+		res, err := s.Post.GetSuggestedEdits(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		editResponses := make([]*types.SuggestedEditResponse, len(res))
+		for i, edit := range res {
+			editResponses[i] = edit.ToTypes()
+		}
+
+		log.Debug().Msg("getSuggestedEditsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, editResponses)
+	}
+}
@@ -0,0 +1,53 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultAcceptanceDays = 7
+
+func GetUnansweredPostsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/unanswered", getUnansweredPostsHandler(s))
+}
+
+func getUnansweredPostsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getUnansweredPostsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getUnansweredPostsHandler started")
+
+		acceptanceDays := defaultAcceptanceDays
+		if acceptanceDaysStr := c.QueryParam("acceptanceDays"); acceptanceDaysStr != "" {
+			parsed, err := strconv.Atoi(acceptanceDaysStr)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to parse acceptance days")
+				return err
+			}
+			acceptanceDays = parsed
+		}
+
+		res, err := s.Post.GetUnanswered(ctx, dto.GetUnansweredPostsRequest{
+			AcceptanceDays: acceptanceDays,
+		})
+		if err != nil {
+			return err
+		}
+
+		postResponses := make([]*types.UnansweredPostResponse, len(res))
+		for i, post := range res {
+			postResponses[i] = post.ToTypes()
+		}
+
+		log.Debug().Msg("getUnansweredPostsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, postResponses)
+	}
+}
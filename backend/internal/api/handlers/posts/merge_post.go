@@ -0,0 +1,49 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func MergePostRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/:id/merge", mergePostHandler(s))
+}
+
+func mergePostHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "mergePostHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("mergePostHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var body types.MergePostRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.Merge(ctx, dto.MergePostRequest{
+			ID:           id,
+			TargetPostID: body.TargetPostId,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("mergePostHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
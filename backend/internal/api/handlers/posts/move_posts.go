@@ -0,0 +1,49 @@
+package posts
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func MovePostsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/move", movePostsHandler(s))
+}
+
+func movePostsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "movePostsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("movePostsHandler started")
+
+		var body types.MovePostsRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		var postIDs []int64
+		if body.PostIds != nil {
+			postIDs = *body.PostIds
+		}
+
+		res, err := s.Post.MoveMany(ctx, dto.MovePostsRequest{
+			SubTopicID:     body.SubTopicId,
+			PostIDs:        postIDs,
+			FromSubTopicID: body.FromSubTopicId,
+			FromTopicID:    body.FromTopicId,
+			Tag:            body.Tag,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("movePostsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,55 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ReactPostRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/:id/reactions", reactPostHandler(s))
+}
+
+func reactPostHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "reactPostHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("reactPostHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var body types.ReactRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.React(ctx, dto.ReactRequest{
+			PostID:  id,
+			Emoji:   body.Emoji,
+			Reacted: body.Reacted,
+		})
+		if err != nil {
+			return err
+		}
+
+		reactionResponses := make([]*types.ReactionResponse, len(res))
+		for i, reaction := range res {
+			reactionResponses[i] = reaction.ToTypes()
+		}
+
+		log.Debug().Msg("reactPostHandler successfully executed")
+
+		return c.JSON(http.StatusOK, reactionResponses)
+	}
+}
@@ -0,0 +1,52 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ReviewSuggestedEditRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/suggested-edits/:id/review", reviewSuggestedEditHandler(s))
+}
+
+func reviewSuggestedEditHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "reviewSuggestedEditHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("reviewSuggestedEditHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse suggested edit id")
+			return err
+		}
+
+		var body types.ReviewSuggestedEditRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.ReviewSuggestedEdit(ctx, dto.ReviewSuggestedEditRequest{
+			ID:       id,
+			Approved: body.Approved,
+			Title:    body.Title,
+			Body:     body.Body,
+			Comment:  body.Comment,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("reviewSuggestedEditHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,53 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SearchPostsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.GET("/search", searchPostsHandler(s))
+}
+
+func searchPostsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "searchPostsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("searchPostsHandler started")
+
+		var limit int
+		if limitStr := c.QueryParam("limit"); limitStr != "" {
+			parsed, err := strconv.Atoi(limitStr)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to parse limit")
+				return err
+			}
+			limit = parsed
+		}
+
+		res, err := s.Post.Search(ctx, dto.SearchPostsRequest{
+			Query:  c.QueryParam("q"),
+			Fields: parseFieldFilters(c.QueryParams()["field"]),
+			Limit:  limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		postResponses := make([]*types.PostResponse, len(res))
+		for i, post := range res {
+			postResponses[i] = post.ToTypes()
+		}
+
+		log.Debug().Msg("searchPostsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, postResponses)
+	}
+}
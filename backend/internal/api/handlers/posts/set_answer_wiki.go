@@ -0,0 +1,57 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetAnswerWikiRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/:id/answers/:answerID/wiki", setAnswerWikiHandler(s))
+}
+
+func setAnswerWikiHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setAnswerWikiHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setAnswerWikiHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var answerIDStr = c.Param("answerID")
+		answerID, err := strconv.ParseInt(answerIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse answer id")
+			return err
+		}
+
+		var body types.SetWikiRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.SetWiki(ctx, dto.SetWikiRequest{
+			PostID:   id,
+			AnswerID: &answerID,
+			Wiki:     body.Wiki,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("setAnswerWikiHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
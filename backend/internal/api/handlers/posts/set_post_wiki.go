@@ -0,0 +1,49 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetPostWikiRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/:id/wiki", setPostWikiHandler(s))
+}
+
+func setPostWikiHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setPostWikiHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setPostWikiHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var body types.SetWikiRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.SetWiki(ctx, dto.SetWikiRequest{
+			PostID: id,
+			Wiki:   body.Wiki,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("setPostWikiHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
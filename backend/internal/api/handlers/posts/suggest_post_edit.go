@@ -0,0 +1,51 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SuggestPostEditRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.POST("/:id/suggested-edits", suggestPostEditHandler(s))
+}
+
+func suggestPostEditHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "suggestPostEditHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("suggestPostEditHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var body types.SuggestEditRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.SuggestEdit(ctx, dto.SuggestEditRequest{
+			PostID:  id,
+			Title:   body.Title,
+			Body:    body.Body,
+			Comment: body.Comment,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("suggestPostEditHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,58 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func UpdateAnswerRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.PATCH("/:id/answers/:answerID", updateAnswerHandler(s))
+}
+
+func updateAnswerHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "updateAnswerHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("updateAnswerHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var answerIDStr = c.Param("answerID")
+		answerID, err := strconv.ParseInt(answerIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse answer id")
+			return err
+		}
+
+		var body types.UpdateAnswerRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.UpdateAnswer(ctx, dto.UpdateAnswerRequest{
+			PostID:  id,
+			ID:      answerID,
+			Body:    body.Body,
+			Version: body.Version,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("updateAnswerHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
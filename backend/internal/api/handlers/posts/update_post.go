@@ -0,0 +1,54 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func UpdatePostRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.PATCH("/:id", updatePostHandler(s))
+}
+
+func updatePostHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "updatePostHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("updatePostHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var body types.UpdatePostRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.Update(ctx, dto.UpdatePostRequest{
+			ID:           id,
+			Title:        body.Title,
+			Body:         body.Body,
+			Tags:         body.Tags,
+			Fields:       body.Fields,
+			CustomFields: body.CustomFields,
+			Version:      body.Version,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("updatePostHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
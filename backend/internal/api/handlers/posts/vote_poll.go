@@ -0,0 +1,49 @@
+package posts
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func VotePollRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Posts.PUT("/:id/poll/vote", votePollHandler(s))
+}
+
+func votePollHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "votePollHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("votePollHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse post id")
+			return err
+		}
+
+		var body types.VotePollRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Post.Vote(ctx, dto.VotePollRequest{
+			PostID:    id,
+			OptionIDs: body.OptionIds,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("votePollHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
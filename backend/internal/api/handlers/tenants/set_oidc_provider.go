@@ -0,0 +1,69 @@
+package tenants
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetOIDCProviderRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Tennants.PUT("/:id/oidc", setOIDCProviderHandler(s))
+}
+
+func setOIDCProviderHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setOIDCProviderHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setOIDCProviderHandler started")
+
+		param := c.Param("id")
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || id <= 0 {
+			return httperrors.ErrInvalidID
+		}
+
+		var body types.SetOidcProviderRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		request := dto.SetOIDCProviderRequest{
+			TenantID:      id,
+			Enabled:       body.Enabled,
+			Issuer:        body.Issuer,
+			ClientID:      body.ClientId,
+			ClientSecret:  body.ClientSecret,
+			DefaultRoleID: body.DefaultRoleId,
+		}
+		if body.Scopes != nil {
+			request.Scopes = *body.Scopes
+		}
+		if body.RoleClaim != nil {
+			request.RoleClaim = *body.RoleClaim
+		}
+		if body.RoleMappings != nil {
+			for _, mapping := range *body.RoleMappings {
+				request.RoleMappings = append(request.RoleMappings, dto.SSORoleMapping{
+					Value:  mapping.Value,
+					RoleID: mapping.RoleId,
+				})
+			}
+		}
+
+		res, err := s.Tennant.SetOIDCProvider(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("setOIDCProviderHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
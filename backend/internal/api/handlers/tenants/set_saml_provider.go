@@ -0,0 +1,78 @@
+package tenants
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetSAMLProviderRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Tennants.PUT("/:id/saml", setSAMLProviderHandler(s))
+}
+
+func setSAMLProviderHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setSAMLProviderHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setSAMLProviderHandler started")
+
+		param := c.Param("id")
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || id <= 0 {
+			return httperrors.ErrInvalidID
+		}
+
+		var body types.SetSamlProviderRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		request := dto.SetSAMLProviderRequest{
+			TenantID:       id,
+			Enabled:        body.Enabled,
+			IDPEntityID:    body.IdpEntityId,
+			IDPSSOURL:      body.IdpSsoUrl,
+			IDPCertificate: body.IdpCertificate,
+			DefaultRoleID:  body.DefaultRoleId,
+		}
+		if body.EmailAttribute != nil {
+			request.EmailAttribute = *body.EmailAttribute
+		}
+		if body.NameAttribute != nil {
+			request.NameAttribute = *body.NameAttribute
+		}
+		if body.UsernameAttribute != nil {
+			request.UsernameAttribute = *body.UsernameAttribute
+		}
+		if body.RoleAttribute != nil {
+			request.RoleAttribute = *body.RoleAttribute
+		}
+		if body.LinkExistingUsers != nil {
+			request.LinkExistingUsers = *body.LinkExistingUsers
+		}
+		if body.RoleMappings != nil {
+			for _, mapping := range *body.RoleMappings {
+				request.RoleMappings = append(request.RoleMappings, dto.SSORoleMapping{
+					Value:  mapping.Value,
+					RoleID: mapping.RoleId,
+				})
+			}
+		}
+
+		res, err := s.Tennant.SetSAMLProvider(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("setSAMLProviderHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -35,8 +35,13 @@ func updateTenantHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		res, err := s.Tennant.Update(ctx, dto.UpdateTenantRequest{
-			ID:   id,
-			Name: body.Name,
+			ID:                    id,
+			Name:                  body.Name,
+			AllowAnonymousPosts:   body.AllowAnonymousPosts,
+			ReactionEmojis:        body.ReactionEmojis,
+			WikiEditMinReputation: body.WikiEditMinReputation,
+			UnverifiedUserAccess:  body.UnverifiedUserAccess,
+			MFARequiredRoleIDs:    body.MfaRequiredRoleIds,
 		})
 		if err != nil {
 			return err
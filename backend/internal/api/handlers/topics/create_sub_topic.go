@@ -34,8 +34,12 @@ func createSubTopicHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		res, err := s.Topic.CreateSubTopic(ctx, dto.CreateSubTopicRequest{
-			TopicID: topicID,
-			Name:    body.Name,
+			TopicID:                 topicID,
+			Name:                    body.Name,
+			Description:             body.Description,
+			Icon:                    body.Icon,
+			Color:                   body.Color,
+			FirstReplyTargetMinutes: body.FirstReplyTargetMinutes,
 		})
 		if err != nil {
 			return err
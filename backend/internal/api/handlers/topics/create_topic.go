@@ -27,7 +27,10 @@ func createTopicHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		res, err := s.Topic.Create(ctx, dto.CreateTopicRequest{
-			Name: body.Name,
+			Name:        body.Name,
+			Description: body.Description,
+			Icon:        body.Icon,
+			Color:       body.Color,
 		})
 		if err != nil {
 			return err
@@ -35,9 +35,20 @@ func deleteSubTopicHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
+		var reassignTo *int64
+		if reassignToStr := c.QueryParam("reassignTo"); reassignToStr != "" {
+			parsed, err := strconv.ParseInt(reassignToStr, 10, 64)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to parse reassign to sub topic id")
+				return err
+			}
+			reassignTo = &parsed
+		}
+
 		res, err := s.Topic.DeleteSubTopic(ctx, dto.DeleteSubTopicRequest{
-			ID:      subTopicID,
-			TopicID: topicID,
+			ID:         subTopicID,
+			TopicID:    topicID,
+			ReassignTo: reassignTo,
 		})
 		if err != nil {
 			return err
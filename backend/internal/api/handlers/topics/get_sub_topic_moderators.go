@@ -0,0 +1,56 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetSubTopicModeratorsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1SubTopics.GET("/:subTopicID/moderators", getSubTopicModeratorsHandler(s))
+}
+
+func getSubTopicModeratorsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getSubTopicModeratorsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getSubTopicModeratorsHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		var subTopicIDStr = c.Param("subTopicID")
+		subTopicID, err := strconv.ParseInt(subTopicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse sub topic id")
+			return err
+		}
+
+		res, err := s.Topic.GetSubTopicModerators(ctx, dto.GetSubTopicModeratorsRequest{
+			ID:      subTopicID,
+			TopicID: topicID,
+		})
+		if err != nil {
+			return err
+		}
+
+		moderators := make([]*types.UserResponse, len(res))
+		for i, user := range res {
+			moderators[i] = user.ToTypes()
+		}
+
+		log.Debug().Msg("getSubTopicModeratorsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, moderators)
+	}
+}
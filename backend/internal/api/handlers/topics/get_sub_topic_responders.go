@@ -0,0 +1,56 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetSubTopicRespondersRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1SubTopics.GET("/:subTopicID/responders", getSubTopicRespondersHandler(s))
+}
+
+func getSubTopicRespondersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getSubTopicRespondersHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getSubTopicRespondersHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		var subTopicIDStr = c.Param("subTopicID")
+		subTopicID, err := strconv.ParseInt(subTopicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse sub topic id")
+			return err
+		}
+
+		res, err := s.Topic.GetSubTopicResponders(ctx, dto.GetSubTopicRespondersRequest{
+			ID:      subTopicID,
+			TopicID: topicID,
+		})
+		if err != nil {
+			return err
+		}
+
+		responders := make([]*types.UserResponse, len(res))
+		for i, user := range res {
+			responders[i] = user.ToTypes()
+		}
+
+		log.Debug().Msg("getSubTopicRespondersHandler successfully executed")
+
+		return c.JSON(http.StatusOK, responders)
+	}
+}
@@ -0,0 +1,42 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetTopicAccessRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Topics.GET("/:id/access", getTopicAccessHandler(s))
+}
+
+func getTopicAccessHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getTopicAccessHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getTopicAccessHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		res, err := s.Topic.GetAccess(ctx, dto.GetTopicAccessRequest{
+			ID: topicID,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("getTopicAccessHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
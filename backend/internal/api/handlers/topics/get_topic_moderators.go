@@ -0,0 +1,48 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetTopicModeratorsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Topics.GET("/:id/moderators", getTopicModeratorsHandler(s))
+}
+
+func getTopicModeratorsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getTopicModeratorsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getTopicModeratorsHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		res, err := s.Topic.GetModerators(ctx, dto.GetTopicModeratorsRequest{
+			ID: topicID,
+		})
+		if err != nil {
+			return err
+		}
+
+		moderators := make([]*types.UserResponse, len(res))
+		for i, user := range res {
+			moderators[i] = user.ToTypes()
+		}
+
+		log.Debug().Msg("getTopicModeratorsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, moderators)
+	}
+}
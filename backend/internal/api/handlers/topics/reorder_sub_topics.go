@@ -0,0 +1,49 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ReorderSubTopicsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1SubTopics.POST("/reorder", reorderSubTopicsHandler(s))
+}
+
+func reorderSubTopicsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "reorderSubTopicsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("reorderSubTopicsHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		var body types.ReorderSubTopicsRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Topic.ReorderSubTopics(ctx, dto.ReorderSubTopicsRequest{
+			TopicID: topicID,
+			IDs:     body.Ids,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("reorderSubTopicsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
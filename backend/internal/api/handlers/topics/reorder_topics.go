@@ -0,0 +1,40 @@
+package topics
+
+import (
+	"net/http"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func ReorderTopicsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Topics.POST("/reorder", reorderTopicsHandler(s))
+}
+
+func reorderTopicsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "reorderTopicsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("reorderTopicsHandler started")
+
+		var body types.ReorderTopicsRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Topic.ReorderTopics(ctx, dto.ReorderTopicsRequest{
+			IDs: body.Ids,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("reorderTopicsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,79 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetQuestionTemplateRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1SubTopics.PUT("/:subTopicID/template", setQuestionTemplateHandler(s))
+}
+
+func setQuestionTemplateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setQuestionTemplateHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setQuestionTemplateHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		var subTopicIDStr = c.Param("subTopicID")
+		subTopicID, err := strconv.ParseInt(subTopicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse sub topic id")
+			return err
+		}
+
+		var body types.SetQuestionTemplateRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		var templateBody string
+		if body.Body != nil {
+			templateBody = *body.Body
+		}
+
+		fields := make([]dto.QuestionTemplateFieldDTO, len(body.Fields))
+		for i, field := range body.Fields {
+			fields[i] = dto.QuestionTemplateFieldDTO{
+				Name:     field.Name,
+				Label:    field.Label,
+				Type:     field.Type,
+				Required: field.Required != nil && *field.Required,
+				Pattern:  field.Pattern,
+				Min:      field.Min,
+				Max:      field.Max,
+			}
+			if field.Options != nil {
+				fields[i].Options = *field.Options
+			}
+		}
+
+		res, err := s.Topic.SetTemplate(ctx, dto.SetQuestionTemplateRequest{
+			ID:      subTopicID,
+			TopicID: topicID,
+			Body:    templateBody,
+			Fields:  fields,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("setQuestionTemplateHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,56 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetSubTopicRespondersRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1SubTopics.PUT("/:subTopicID/responders", setSubTopicRespondersHandler(s))
+}
+
+func setSubTopicRespondersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setSubTopicRespondersHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setSubTopicRespondersHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		var subTopicIDStr = c.Param("subTopicID")
+		subTopicID, err := strconv.ParseInt(subTopicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse sub topic id")
+			return err
+		}
+
+		var body dto.SetSubTopicRespondersRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Topic.SetSubTopicResponders(ctx, dto.SetSubTopicRespondersRequest{
+			ID:      subTopicID,
+			TopicID: topicID,
+			UserIDs: body.UserIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msg("setSubTopicRespondersHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -0,0 +1,54 @@
+package topics
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func SetTopicModeratorsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Topics.PUT("/:id/moderators", setTopicModeratorsHandler(s))
+}
+
+func setTopicModeratorsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "setTopicModeratorsHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("setTopicModeratorsHandler started")
+
+		var topicIDStr = c.Param("id")
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse topic id")
+			return err
+		}
+
+		var body types.SetModeratorsRequest
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		res, err := s.Topic.SetModerators(ctx, dto.SetTopicModeratorsRequest{
+			ID:      topicID,
+			UserIDs: body.UserIds,
+		})
+		if err != nil {
+			return err
+		}
+
+		moderators := make([]*types.UserResponse, len(res))
+		for i, user := range res {
+			moderators[i] = user.ToTypes()
+		}
+
+		log.Debug().Msg("setTopicModeratorsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, moderators)
+	}
+}
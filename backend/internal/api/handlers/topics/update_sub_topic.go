@@ -41,9 +41,14 @@ func updateSubTopicHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		res, err := s.Topic.UpdateSubTopic(ctx, dto.UpdateSubTopicRequest{
-			ID:      subTopicID,
-			TopicID: topicID,
-			Name:    body.Name,
+			ID:                      subTopicID,
+			TopicID:                 topicID,
+			Name:                    body.Name,
+			Description:             body.Description,
+			Icon:                    body.Icon,
+			Color:                   body.Color,
+			Archived:                body.Archived,
+			FirstReplyTargetMinutes: body.FirstReplyTargetMinutes,
 		})
 		if err != nil {
 			return err
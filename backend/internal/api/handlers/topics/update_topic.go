@@ -35,8 +35,12 @@ func updateTopicHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		res, err := s.Topic.Update(ctx, dto.UpdateTopicRequest{
-			ID:   id,
-			Name: body.Name,
+			ID:          id,
+			Name:        body.Name,
+			Description: body.Description,
+			Icon:        body.Icon,
+			Color:       body.Color,
+			Archived:    body.Archived,
 		})
 		if err != nil {
 			return err
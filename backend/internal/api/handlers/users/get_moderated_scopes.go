@@ -0,0 +1,48 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetModeratedScopesRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Users.GET("/:id/moderated-scopes", getModeratedScopesHandler(s))
+}
+
+func getModeratedScopesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getModeratedScopesHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getModeratedScopesHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse user id")
+			return err
+		}
+
+		res, err := s.User.GetModeratedScopes(ctx, dto.GetModeratedScopesRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		scopeResponses := make([]*types.ModeratedScopeResponse, len(res))
+		for i, scope := range res {
+			scopeResponses[i] = scope.ToTypes()
+		}
+
+		log.Debug().Msg("getModeratedScopesHandler successfully executed")
+
+		return c.JSON(http.StatusOK, scopeResponses)
+	}
+}
@@ -0,0 +1,48 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetUserExpertiseRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Users.GET("/:id/expertise", getUserExpertiseHandler(s))
+}
+
+func getUserExpertiseHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "getUserExpertiseHandler").Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("getUserExpertiseHandler started")
+
+		var idStr = c.Param("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse user id")
+			return err
+		}
+
+		res, err := s.User.GetExpertise(ctx, dto.GetUserExpertiseRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		expertiseResponses := make([]*types.TagExpertiseResponse, len(res))
+		for i, tagExpertise := range res {
+			expertiseResponses[i] = tagExpertise.ToTypes()
+		}
+
+		log.Debug().Msg("getUserExpertiseHandler successfully executed")
+
+		return c.JSON(http.StatusOK, expertiseResponses)
+	}
+}
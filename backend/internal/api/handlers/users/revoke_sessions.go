@@ -0,0 +1,42 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func RevokeSessionsRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Users.POST("/:id/revoke-sessions", revokeSessionsHandler(s))
+}
+
+func revokeSessionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "revokeSessionsHandler").Str("id", c.Param("id")).Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("revokeSessionsHandler started")
+
+		param := c.Param("id")
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		res, err := s.User.RevokeSessions(ctx, dto.RevokeSessionsRequest{
+			ID: id,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to revoke sessions")
+			return err
+		}
+
+		log.Debug().Msg("revokeSessionsHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
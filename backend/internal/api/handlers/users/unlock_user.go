@@ -0,0 +1,43 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+
+	"cuhara.qua.go/internal/api"
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func UnlockUserRouter(s *api.Server) *echo.Route {
+	return s.Router.APIV1Users.POST("/:id/unlock", unlockUserHandler(s))
+}
+
+func unlockUserHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		log := util.LogFromEchoContext(c).With().Str("function", "unlockUserHandler").Str("id", c.Param("id")).Logger()
+		ctx := c.Request().Context()
+
+		log.Debug().Msg("unlockUserHandler started")
+
+		param := c.Param("id")
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || id <= 0 {
+			return httperrors.ErrInvalidID
+		}
+
+		res, err := s.User.UnlockUser(ctx, dto.UnlockUserRequest{
+			ID: id,
+		})
+		if err != nil {
+			log.Err(err).Msg("Failed to unlock user")
+			return err
+		}
+
+		log.Debug().Msg("unlockUserHandler successfully executed")
+
+		return c.JSON(http.StatusOK, res.ToTypes())
+	}
+}
@@ -3,12 +3,40 @@ package httperrors
 import "net/http"
 
 var (
-	ErrConflictUserAlreadyExists = NewHTTPError(http.StatusConflict, "USER_ALREADY_EXISTS", "User with given email already exists")
-	ErrUnauthorized              = NewHTTPError(http.StatusUnauthorized, "unauthorized", "unauthorized")
-	ErrForbidden                 = NewHTTPError(http.StatusForbidden, "forbidden", "forbidden")
-	ErrNotFound                  = NewHTTPError(http.StatusNotFound, "not_found", "not_found")
-	ErrInvalidToken              = NewHTTPError(http.StatusUnauthorized, "invalid_token", "invalid_token")
-	ErrInvalidSubjcet            = NewHTTPError(http.StatusUnauthorized, "invalid_subject", "invalid_subject")
-	ErrInvalidIssuer             = NewHTTPError(http.StatusUnauthorized, "invalid_issuer", "invalid_issuer")
-	ErrInvalidSigningMethod      = NewHTTPError(http.StatusUnauthorized, "invalid_signing_method", "invalid_signing_method")
+	ErrConflictUserAlreadyExists    = NewHTTPError(http.StatusConflict, "USER_ALREADY_EXISTS", "User with given email already exists")
+	ErrUnauthorized                 = NewHTTPError(http.StatusUnauthorized, "unauthorized", "unauthorized")
+	ErrInvalidCredentials           = NewHTTPError(http.StatusUnauthorized, "invalid_credentials", "invalid_credentials")
+	ErrLoginLocked                  = NewHTTPError(http.StatusTooManyRequests, "login_locked", "login_locked")
+	ErrForbidden                    = NewHTTPError(http.StatusForbidden, "forbidden", "forbidden")
+	ErrNotFound                     = NewHTTPError(http.StatusNotFound, "not_found", "not_found")
+	ErrInvalidToken                 = NewHTTPError(http.StatusUnauthorized, "invalid_token", "invalid_token")
+	ErrInvalidSubjcet               = NewHTTPError(http.StatusUnauthorized, "invalid_subject", "invalid_subject")
+	ErrInvalidIssuer                = NewHTTPError(http.StatusUnauthorized, "invalid_issuer", "invalid_issuer")
+	ErrInvalidSigningMethod         = NewHTTPError(http.StatusUnauthorized, "invalid_signing_method", "invalid_signing_method")
+	ErrInvalidRefreshToken          = NewHTTPError(http.StatusUnauthorized, "invalid_refresh_token", "invalid_refresh_token")
+	ErrRefreshTokenReused           = NewHTTPError(http.StatusUnauthorized, "refresh_token_reused", "refresh_token_reused")
+	ErrTokenRevoked                 = NewHTTPError(http.StatusUnauthorized, "token_revoked", "token_revoked")
+	ErrInvalidPasswordResetToken    = NewHTTPError(http.StatusBadRequest, "invalid_password_reset_token", "invalid_password_reset_token")
+	ErrEmailNotVerified             = NewHTTPError(http.StatusForbidden, "email_not_verified", "email_not_verified")
+	ErrEmailAlreadyVerified         = NewHTTPError(http.StatusConflict, "email_already_verified", "email_already_verified")
+	ErrInvalidVerificationToken     = NewHTTPError(http.StatusBadRequest, "invalid_verification_token", "invalid_verification_token")
+	ErrVerificationMailThrottled    = NewHTTPError(http.StatusTooManyRequests, "verification_mail_throttled", "verification_mail_throttled")
+	ErrInvalidMFAToken              = NewHTTPError(http.StatusUnauthorized, "invalid_mfa_token", "invalid_mfa_token")
+	ErrInvalidMFACode               = NewHTTPError(http.StatusUnauthorized, "invalid_mfa_code", "invalid_mfa_code")
+	ErrMFALocked                    = NewHTTPError(http.StatusTooManyRequests, "mfa_locked", "mfa_locked")
+	ErrMFANotEnabled                = NewHTTPError(http.StatusConflict, "mfa_not_enabled", "mfa_not_enabled")
+	ErrMFANotEnrolled               = NewHTTPError(http.StatusConflict, "mfa_not_enrolled", "mfa_not_enrolled")
+	ErrMFAAlreadyEnabled            = NewHTTPError(http.StatusConflict, "mfa_already_enabled", "mfa_already_enabled")
+	ErrMFARequired                  = NewHTTPError(http.StatusForbidden, "mfa_required", "mfa_required")
+	ErrMFAEnrollmentRequired        = NewHTTPError(http.StatusForbidden, "mfa_enrollment_required", "mfa_enrollment_required")
+	ErrInvalidPasskey               = NewHTTPError(http.StatusUnauthorized, "invalid_passkey", "invalid_passkey")
+	ErrInvalidPasskeyCeremony       = NewHTTPError(http.StatusBadRequest, "invalid_passkey_ceremony", "invalid_passkey_ceremony")
+	ErrPasskeyNotFound              = NewHTTPError(http.StatusNotFound, "PASSKEY_NOT_FOUND", "Passkey not found")
+	ErrSSONotConfigured             = NewHTTPError(http.StatusNotFound, "sso_not_configured", "sso_not_configured")
+	ErrSSOProviderUnavailable       = NewHTTPError(http.StatusBadGateway, "sso_provider_unavailable", "sso_provider_unavailable")
+	ErrInvalidSSOState              = NewHTTPError(http.StatusBadRequest, "invalid_sso_state", "invalid_sso_state")
+	ErrSSOLoginFailed               = NewHTTPError(http.StatusUnauthorized, "sso_login_failed", "sso_login_failed")
+	ErrSSOEmailNotVerified          = NewHTTPError(http.StatusForbidden, "sso_email_not_verified", "sso_email_not_verified")
+	ErrSSOUserNotAllowed            = NewHTTPError(http.StatusForbidden, "sso_user_not_allowed", "sso_user_not_allowed")
+	ErrTooManyPasswordResetRequests = NewHTTPError(http.StatusTooManyRequests, "too_many_password_reset_requests", "too_many_password_reset_requests")
 )
@@ -0,0 +1,11 @@
+package httperrors
+
+import "net/http"
+
+var (
+	ErrCategoryNotFound              = NewHTTPError(http.StatusNotFound, "CATEGORY_NOT_FOUND", "Category not found")
+	ErrConflictCategoryAlreadyExists = NewHTTPError(http.StatusConflict, "CATEGORY_ALREADY_EXISTS", "Category with given name already exists under the same parent")
+	ErrCategoryHasChildren           = NewHTTPError(http.StatusConflict, "CATEGORY_HAS_CHILDREN", "Category still has child categories")
+	ErrInvalidCategoryMove           = NewHTTPError(http.StatusBadRequest, "INVALID_CATEGORY_MOVE", "Root categories cannot be moved and categories cannot be moved below themselves")
+	ErrInvalidCategoryOrder          = NewHTTPError(http.StatusBadRequest, "INVALID_CATEGORY_ORDER", "Ordered ids must contain every child of the parent exactly once")
+)
\ No newline at end of file
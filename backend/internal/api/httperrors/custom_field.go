@@ -0,0 +1,8 @@
+package httperrors
+
+import "net/http"
+
+var (
+	ErrCustomFieldNotFound              = NewHTTPError(http.StatusNotFound, "CUSTOM_FIELD_NOT_FOUND", "Custom field not found")
+	ErrConflictCustomFieldAlreadyExists = NewHTTPError(http.StatusConflict, "CUSTOM_FIELD_ALREADY_EXISTS", "Custom field with given name already exists")
+)
\ No newline at end of file
@@ -57,7 +57,7 @@ func (e *HTTPError) Error() string {
 	fmt.Fprintf(&b, "HTTPError %d (%s): %s", e.Code, e.Type, e.Title)
 
 	if e.Detail != nil {
-		fmt.Fprintf(&b, " - %s", e.Detail)
+		fmt.Fprintf(&b, " - %s", *e.Detail)
 	}
 	if e.Internal != nil {
 		fmt.Fprintf(&b, "- %v", e.Internal)
@@ -110,7 +110,7 @@ func (e *HTTPValidationError) Error() string {
 	fmt.Fprintf(&b, "HTTPValidationError %d (%s): %s", e.Code, e.Type, e.Title)
 
 	if e.Detail != nil {
-		fmt.Fprintf(&b, " - %s", e.Detail)
+		fmt.Fprintf(&b, " - %s", *e.Detail)
 	}
 	if e.Internal != nil {
 		fmt.Fprintf(&b, ", %v", e.Internal)
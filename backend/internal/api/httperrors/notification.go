@@ -0,0 +1,7 @@
+package httperrors
+
+import "net/http"
+
+var (
+	ErrNotificationNotFound = NewHTTPError(http.StatusNotFound, "NOTIFICATION_NOT_FOUND", "Notification not found")
+)
\ No newline at end of file
@@ -0,0 +1,8 @@
+package httperrors
+
+import "net/http"
+
+var (
+	ErrPollNotFound = NewHTTPError(http.StatusNotFound, "POLL_NOT_FOUND", "Post has no poll")
+	ErrPollClosed   = NewHTTPError(http.StatusConflict, "POLL_CLOSED", "Poll is closed")
+)
\ No newline at end of file
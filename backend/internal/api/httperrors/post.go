@@ -0,0 +1,23 @@
+package httperrors
+
+import "net/http"
+
+var (
+	ErrPostNotFound           = NewHTTPError(http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+	ErrInvalidPostAssignment  = NewHTTPError(http.StatusBadRequest, "INVALID_POST_ASSIGNMENT", "Post must be assigned to either a user or a role")
+	ErrAssigneeCannotSeePost  = NewHTTPError(http.StatusBadRequest, "ASSIGNEE_CANNOT_SEE_POST", "Post cannot be assigned to users who cannot see it")
+	ErrInvalidPostMerge       = NewHTTPError(http.StatusBadRequest, "INVALID_POST_MERGE", "Post cannot be merged into itself")
+	ErrPostAlreadyMerged      = NewHTTPError(http.StatusConflict, "POST_ALREADY_MERGED", "Post has already been merged into another post")
+	ErrInvalidPostMoveFilter  = NewHTTPError(http.StatusBadRequest, "INVALID_POST_MOVE_FILTER", "Post ids or at least one filter must be given")
+	ErrPostLocked             = NewHTTPError(http.StatusConflict, "POST_LOCKED", "Post is locked and can only be edited by moderators")
+	ErrAnonymousPostsDisabled = NewHTTPError(http.StatusBadRequest, "ANONYMOUS_POSTS_DISABLED", "Anonymous posts are disabled for this tenant")
+	ErrInvalidPostSort        = NewHTTPError(http.StatusBadRequest, "INVALID_POST_SORT", "Posts can only be sorted by createdAt or by a custom field of the tenant")
+	ErrReactionNotAllowed     = NewHTTPError(http.StatusBadRequest, "REACTION_NOT_ALLOWED", "Emoji is not part of the reaction set of the tenant")
+	ErrAnswerNotFound         = NewHTTPError(http.StatusNotFound, "ANSWER_NOT_FOUND", "Answer not found")
+	ErrCommentNotFound        = NewHTTPError(http.StatusNotFound, "COMMENT_NOT_FOUND", "Comment not found")
+	ErrEditConflict           = NewHTTPError(http.StatusConflict, "EDIT_CONFLICT", "Content was changed by someone else since the given version")
+	ErrSuggestedEditNotFound  = NewHTTPError(http.StatusNotFound, "SUGGESTED_EDIT_NOT_FOUND", "Suggested edit not found")
+	ErrSuggestedEditNotNeeded = NewHTTPError(http.StatusBadRequest, "SUGGESTED_EDIT_NOT_NEEDED", "Content can be edited directly")
+	ErrSuggestedEditPending   = NewHTTPError(http.StatusConflict, "SUGGESTED_EDIT_PENDING", "A suggested edit of this content is already waiting for review")
+	ErrSuggestedEditReviewed  = NewHTTPError(http.StatusConflict, "SUGGESTED_EDIT_REVIEWED", "Suggested edit has already been reviewed")
+)
\ No newline at end of file
@@ -7,4 +7,10 @@ var (
 	ErrConflictTopicAlreadyExists = NewHTTPError(http.StatusConflict, "TOPIC_ALREADY_EXISTS", "Topic with given name already exists")
 	ErrConflictSubTopicAlreadyExists = NewHTTPError(http.StatusConflict, "SUB_TOPIC_ALREADY_EXISTS", "Sub topic with given name already exists")
 	ErrSubTopicNotFound = NewHTTPError(http.StatusNotFound, "SUB_TOPIC_NOT_FOUND", "Sub topic not found")
+	ErrSubTopicHasPosts = NewHTTPError(http.StatusConflict, "SUB_TOPIC_HAS_POSTS", "Sub topic still has posts, reassign them to another sub topic")
+	ErrInvalidSubTopicReassign = NewHTTPError(http.StatusBadRequest, "INVALID_SUB_TOPIC_REASSIGN", "Posts cannot be reassigned to the deleted sub topic")
+	ErrTopicArchived = NewHTTPError(http.StatusConflict, "TOPIC_ARCHIVED", "Topic is archived, no new content can be added to it")
+	ErrSubTopicArchived = NewHTTPError(http.StatusConflict, "SUB_TOPIC_ARCHIVED", "Sub topic is archived, no new posts can be added to it")
+	ErrInvalidTopicOrder = NewHTTPError(http.StatusBadRequest, "INVALID_TOPIC_ORDER", "Ordered ids must contain every topic exactly once")
+	ErrInvalidSubTopicOrder = NewHTTPError(http.StatusBadRequest, "INVALID_SUB_TOPIC_ORDER", "Ordered ids must contain every sub topic of the topic exactly once")
 )
\ No newline at end of file
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// job is work the server repeats in the background while it runs.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(context.Context) error
+}
+
+func (s *Server) jobs() []job {
+	return []job{
+		{name: "notify_overdue_posts", interval: s.Config.Jobs.OverduePostsInterval, run: s.Post.NotifyOverdue},
+		{name: "delete_stale_login_throttles", interval: s.Config.Jobs.LoginThrottleCleanupInterval, run: s.Auth.DeleteStaleLoginThrottles},
+	}
+}
+
+// StartJobs runs the background jobs until the server shuts down. Failed runs
+// are logged and retried on the next tick.
+func (s *Server) StartJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopJobs = cancel
+
+	for _, j := range s.jobs() {
+		if j.interval <= 0 {
+			log.Warn().Str("job", j.name).Msg("Disabling job due to environment config")
+			continue
+		}
+
+		go s.runJob(ctx, j)
+	}
+}
+
+func (s *Server) runJob(ctx context.Context, j job) {
+	logger := log.With().Str("job", j.name).Logger()
+	ctx = logger.WithContext(ctx)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.run(ctx); err != nil {
+				logger.Error().Err(err).Msg("Job failed")
+			}
+		}
+	}
+}
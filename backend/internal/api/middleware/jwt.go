@@ -1,16 +1,29 @@
 package middleware
 
 import (
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"cuhara.qua.go/internal/api"
 	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/modules/jwtkeys"
 	"cuhara.qua.go/internal/util"
 	"github.com/labstack/echo/v4"
 )
 
 var (
-	skipJWTAuthPaths = []string{"/api/v1/auth/login", "/", "/swagger", "/docs"}
+	skipJWTAuthPaths = []string{"/api/v1/auth/login", "/api/v1/auth/refresh", "/api/v1/auth/password/forgot", "/api/v1/auth/password/reset", "/api/v1/auth/email/verify", "/api/v1/auth/mfa/verify", "/api/v1/auth/passkeys/login/options", "/api/v1/auth/passkeys/login", "/api/v1/auth/sso/oidc/authorize", "/api/v1/auth/sso/oidc/callback", "/api/v1/auth/sso/saml/metadata", "/api/v1/auth/sso/saml/authorize", "/api/v1/auth/sso/saml/acs", "/api/v1/auth/sso/saml/token", "/.well-known/jwks.json", "/", "/swagger", "/docs"}
+	// readOnlyWritePaths can be written to with a read only token, so
+	// unverified users can still manage their session and verification.
+	readOnlyWritePaths = []string{"/api/v1/auth/logout", "/api/v1/auth/password", "/api/v1/auth/email/verification"}
+	// mfaEnrollmentPaths are the only ones a token of a user who must enable
+	// two factor authentication first can be used for.
+	mfaEnrollmentPaths = []string{"/api/v1/auth/logout", "/api/v1/auth/mfa/totp", "/api/v1/auth/mfa/totp/confirm"}
 )
 
 const AuthModeKey = "auth_mode"
@@ -50,7 +63,12 @@ func JWTAuthWithConfig(cfg JWTConfig) echo.MiddlewareFunc {
 				return err
 			}
 
-			claims, err := util.Verify(tokenStr)
+			ctx := c.Request().Context()
+			claims, err := cfg.S.Keys.Verify(ctx, tokenStr)
+			if err != nil && !errors.Is(err, jwtkeys.ErrInvalidToken) {
+				log.Error().Err(err).Msg("failed to load token keys")
+				return err
+			}
 			if err != nil {
 				log.Info().Err(err).Any("claims", claims).Msg("invalid jwt token")
 				return httperrors.ErrInvalidToken
@@ -64,9 +82,44 @@ func JWTAuthWithConfig(cfg JWTConfig) echo.MiddlewareFunc {
 				return httperrors.ErrInvalidIssuer
 			}
 
-			ctx := c.Request().Context()
-			ctx = util.SaveContextValue(ctx, util.CTXKeyUser, claims["sub"])
+			userID, ok := numericClaim(claims["sub"])
+			if !ok {
+				log.Info().Any("claims", claims).Msg("invalid subject")
+				return httperrors.ErrInvalidSubjcet
+			}
+
+			jti, _ := claims["jti"].(string)
+			if cfg.S.Revocations != nil {
+				issuedAt, _ := timeClaim(claims["iat"])
+				revoked, err := cfg.S.Revocations.Revoked(ctx, jti, userID, issuedAt)
+				if err != nil {
+					log.Error().Err(err).Msg("failed to check token revocation")
+					return err
+				}
+				if revoked {
+					log.Info().Int64("userId", userID).Str("jti", jti).Msg("token revoked")
+					return httperrors.ErrTokenRevoked
+				}
+			}
+
+			if readOnly, _ := claims[util.ClaimReadOnly].(bool); readOnly && !readOnlyAllowed(c) {
+				log.Info().Int64("userId", userID).Msg("read only token used to write")
+				return httperrors.ErrEmailNotVerified
+			}
+
+			if enroll, _ := claims[util.ClaimMFAEnrollment].(bool); enroll && !slices.Contains(mfaEnrollmentPaths, c.Request().URL.Path) {
+				log.Info().Int64("userId", userID).Msg("two factor authentication must be enabled first")
+				return httperrors.ErrMFAEnrollmentRequired
+			}
+
+			ctx = util.SaveContextValue(ctx, util.CTXKeyUser, userID)
 			ctx = util.SaveContextValue(ctx, util.CTXKeyAuthToken, tokenStr)
+			if jti != "" {
+				ctx = util.SaveContextValue(ctx, util.CTXKeyTokenID, jti)
+			}
+			if expiresAt, ok := numericClaim(claims["exp"]); ok {
+				ctx = util.SaveContextValue(ctx, util.CTXKeyTokenExpiry, expiresAt)
+			}
 			c.SetRequest(c.Request().WithContext(ctx))
 
 			log.Debug().Msg("token validation successful")
@@ -100,6 +153,42 @@ func bearerTokenFromHeader(authz string) (string, error) {
 	return token, nil
 }
 
+// numericClaim returns a claim holding a number. JSON numbers are decoded as
+// float64, which must not be formatted directly since large ids would end up
+// in exponent notation.
+func numericClaim(claim any) (int64, bool) {
+	switch v := claim.(type) {
+	case float64:
+		return int64(v), true
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// timeClaim reads a NumericDate claim, which may hold fractions of a second,
+// to the microsecond.
+func timeClaim(claim any) (time.Time, bool) {
+	seconds, ok := claim.(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMicro(int64(math.Round(seconds * 1e6))), true
+}
+
+// readOnlyAllowed reports whether a read only token may be used for the
+// request.
+func readOnlyAllowed(c echo.Context) bool {
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return slices.Contains(readOnlyWritePaths, c.Request().URL.Path)
+}
+
 func skipJWTAuth(c echo.Context) bool {
 	for _, path := range skipJWTAuthPaths {
 		if c.Request().URL.Path == path {
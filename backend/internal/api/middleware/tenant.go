@@ -14,7 +14,7 @@ import (
 )
 
 var (
-	skipTenantAuthPaths = []string{"/api/v1/auth/login", "/", "/swagger", "/docs"}
+	skipTenantAuthPaths = []string{"/api/v1/auth/login", "/api/v1/auth/refresh", "/api/v1/auth/password/forgot", "/api/v1/auth/password/reset", "/api/v1/auth/email/verify", "/api/v1/auth/logout", "/api/v1/auth/email/verification", "/api/v1/auth/mfa/verify", "/api/v1/auth/mfa/totp", "/api/v1/auth/mfa/totp/confirm", "/api/v1/auth/mfa/totp/disable", "/api/v1/auth/passkeys/login/options", "/api/v1/auth/passkeys/login", "/api/v1/auth/sso/oidc/authorize", "/api/v1/auth/sso/oidc/callback", "/api/v1/auth/sso/saml/metadata", "/api/v1/auth/sso/saml/authorize", "/api/v1/auth/sso/saml/acs", "/api/v1/auth/sso/saml/token", "/.well-known/jwks.json", "/", "/swagger", "/docs"}
 )
 
 const (
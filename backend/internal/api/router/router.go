@@ -1,6 +1,11 @@
 package router
 
 import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
 	"cuhara.qua.go/internal/api"
 	"cuhara.qua.go/internal/api/handlers"
 	"cuhara.qua.go/internal/api/middleware"
@@ -21,6 +26,12 @@ func Init(s *api.Server) error {
 		HideInternalServerErrorDetails: s.Config.Echo.HideInternalServerErrorDetails,
 	})
 
+	ipExtractor, err := IPExtractor(s.Config.Echo.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	s.Echo.IPExtractor = ipExtractor
+
 	// -
 	// General middleware
 	if s.Config.Echo.EnableTrailingSlashMiddleware {
@@ -61,7 +72,11 @@ func Init(s *api.Server) error {
 	}
 
 	if s.Config.Echo.EnableCORSMiddleware {
-		s.Echo.Use(echoMiddleware.CORS())
+		s.Echo.Use(echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
+			AllowOrigins: s.Config.Echo.CORSAllowOrigins,
+			// Cookies are only allowed for listed origins, never for any.
+			AllowCredentials: !slices.Contains(s.Config.Echo.CORSAllowOrigins, "*"),
+		}))
 	} else {
 		log.Warn().Msg("Disabling cors middleware due to environment config")
 	}
@@ -85,18 +100,47 @@ func Init(s *api.Server) error {
 	}
 
 	s.Router = &api.Router{
-		Routes:         nil,
-		Root:           s.Echo.Group(""),
-		APIV1Auth:      s.Echo.Group("/api/v1/auth"),
-		APIV1Users:     s.Echo.Group("/api/v1/users"),
-		APIV1Roles:     s.Echo.Group("/api/v1/roles"),
-		APIV1Tennants:  s.Echo.Group("/api/v1/tenants"),
-		APIV1Topics:    s.Echo.Group("/api/v1/topics"),
-		APIV1Claims:    s.Echo.Group("/api/v1/claims"),
-		APIV1SubTopics: s.Echo.Group("/api/v1/topics/:id/sub-topics"),
+		Routes:             nil,
+		Root:               s.Echo.Group(""),
+		APIV1Auth:          s.Echo.Group("/api/v1/auth"),
+		APIV1Users:         s.Echo.Group("/api/v1/users"),
+		APIV1Roles:         s.Echo.Group("/api/v1/roles"),
+		APIV1Tennants:      s.Echo.Group("/api/v1/tenants"),
+		APIV1Topics:        s.Echo.Group("/api/v1/topics"),
+		APIV1Claims:        s.Echo.Group("/api/v1/claims"),
+		APIV1SubTopics:     s.Echo.Group("/api/v1/topics/:id/sub-topics"),
+		APIV1Posts:         s.Echo.Group("/api/v1/posts"),
+		APIV1Notifications: s.Echo.Group("/api/v1/notifications"),
+		APIV1Categories:    s.Echo.Group("/api/v1/categories"),
+		APIV1CustomFields:  s.Echo.Group("/api/v1/custom-fields"),
 	}
 
 	handlers.AttachAllRoutes(s)
 
 	return nil
 }
+
+// IPExtractor returns how the client IP of a request is found. Behind the
+// trusted proxies it is read from X-Forwarded-For, skipping the proxies;
+// nothing else, not even private networks, is trusted to set the header.
+// Without trusted proxies the header is ignored.
+func IPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(proxy))
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
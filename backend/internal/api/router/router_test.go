@@ -0,0 +1,46 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIPExtractor(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   string
+		want           string
+	}{
+		{"no proxies ignores header", nil, "203.0.113.7:4000", "198.51.100.1", "203.0.113.7"},
+		{"private peer is not trusted", []string{"10.1.0.0/16"}, "192.168.0.5:4000", "198.51.100.1", "192.168.0.5"},
+		{"untrusted peer ignores header", []string{"10.1.0.0/16"}, "203.0.113.7:4000", "198.51.100.1", "203.0.113.7"},
+		{"trusted proxy forwards client", []string{"10.1.0.0/16"}, "10.1.2.3:4000", "198.51.100.1", "198.51.100.1"},
+		{"spoofed hop before client is skipped", []string{"10.1.0.0/16"}, "10.1.2.3:4000", "1.2.3.4, 198.51.100.1", "198.51.100.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extract, err := IPExtractor(tt.trustedProxies)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+			request.RemoteAddr = tt.remoteAddr
+			request.Header.Set("X-Forwarded-For", tt.forwardedFor)
+
+			if got := extract(request); got != tt.want {
+				t.Errorf("got IP %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPExtractorRejectsInvalidProxy(t *testing.T) {
+	if _, err := IPExtractor([]string{"10.1.0.0"}); err == nil {
+		t.Error("expected a proxy without prefix length to be rejected")
+	}
+}
@@ -10,50 +10,113 @@ import (
 	"cuhara.qua.go/internal/config"
 	"cuhara.qua.go/internal/data/dto"
 	"cuhara.qua.go/internal/modules/auth"
+	"cuhara.qua.go/internal/modules/category"
 	"cuhara.qua.go/internal/modules/claim"
+	"cuhara.qua.go/internal/modules/customfield"
+	"cuhara.qua.go/internal/modules/jwtkeys"
+	"cuhara.qua.go/internal/modules/mailer"
+	"cuhara.qua.go/internal/modules/oidc"
+	"cuhara.qua.go/internal/modules/passkey"
+	"cuhara.qua.go/internal/modules/notification"
+	"cuhara.qua.go/internal/modules/post"
+	"cuhara.qua.go/internal/modules/revocation"
 	"cuhara.qua.go/internal/modules/role"
 	tenant "cuhara.qua.go/internal/modules/tennant"
 	"cuhara.qua.go/internal/modules/topic"
 	"cuhara.qua.go/internal/modules/user"
+	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog/log"
 )
 
 type Router struct {
-	Routes        []*echo.Route
-	Root          *echo.Group
-	APIV1Auth     *echo.Group
-	APIV1Users    *echo.Group
-	APIV1Roles    *echo.Group
-	APIV1Tennants *echo.Group
-	APIV1Topics   *echo.Group
-	APIV1Claims   *echo.Group
-	APIV1SubTopics *echo.Group
+	Routes             []*echo.Route
+	Root               *echo.Group
+	APIV1Auth          *echo.Group
+	APIV1Users         *echo.Group
+	APIV1Roles         *echo.Group
+	APIV1Tennants      *echo.Group
+	APIV1Topics        *echo.Group
+	APIV1Claims        *echo.Group
+	APIV1SubTopics     *echo.Group
+	APIV1Posts         *echo.Group
+	APIV1Notifications *echo.Group
+	APIV1Categories    *echo.Group
+	APIV1CustomFields  *echo.Group
 }
 
 type Server struct {
-	Config  config.Server
-	DB      *sql.DB
-	Echo    *echo.Echo
-	Router  *Router
-	Auth    AuthService
-	User    UserService
-	Role    RoleService
-	Tennant TennantService
-	Topic   TopicService
-	Claim   ClaimService
+	Config       config.Server
+	DB           *sql.DB
+	Echo         *echo.Echo
+	Router       *Router
+	Revocations  RevocationStore
+	Keys         TokenKeys
+	Auth         AuthService
+	User         UserService
+	Role         RoleService
+	Tennant      TennantService
+	Topic        TopicService
+	Claim        ClaimService
+	Post         PostService
+	Notification NotificationService
+	Category     CategoryService
+	CustomField  CustomFieldService
+	stopJobs     context.CancelFunc
+}
+
+// RevocationStore answers whether an access token was revoked, either on its
+// own or together with every token of the user.
+type RevocationStore interface {
+	Revoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error)
+}
+
+// TokenKeys verifies access tokens by the key named in their header and
+// publishes the public keys for other services.
+type TokenKeys interface {
+	Verify(ctx context.Context, token string) (jwt.MapClaims, error)
+	JWKS(ctx context.Context) (jwtkeys.JWKS, error)
 }
 
 type AuthService interface {
 	Login(context.Context, dto.LoginRequest) (dto.LoginResponse, error)
 	Register(context.Context, dto.RegisterRequest) (dto.LoginResponse, error)
+	Refresh(context.Context, dto.RefreshRequest) (dto.LoginResponse, error)
+	Logout(context.Context, dto.LogoutRequest) (dto.LogoutResponse, error)
+	ChangePassword(context.Context, dto.ChangePasswordRequest) (dto.LoginResponse, error)
+	ForgotPassword(context.Context, dto.ForgotPasswordRequest) (dto.ForgotPasswordResponse, error)
+	ResetPassword(context.Context, dto.ResetPasswordRequest) (dto.ResetPasswordResponse, error)
+	VerifyEmail(context.Context, dto.VerifyEmailRequest) (dto.VerifyEmailResponse, error)
+	ResendVerification(context.Context) (dto.ResendVerificationResponse, error)
+	VerifyMFA(context.Context, dto.VerifyMFARequest) (dto.LoginResponse, error)
+	EnrollTOTP(context.Context) (dto.TOTPEnrollmentDTO, error)
+	ConfirmTOTP(context.Context, dto.ConfirmTOTPRequest) (dto.RecoveryCodesDTO, error)
+	DisableTOTP(context.Context, dto.DisableTOTPRequest) (dto.DisableTOTPResponse, error)
+	BeginPasskeyRegistration(context.Context) (dto.PasskeyOptionsDTO, error)
+	RegisterPasskey(context.Context, dto.RegisterPasskeyRequest) (dto.PasskeyDTO, error)
+	GetPasskeys(context.Context) ([]dto.PasskeyDTO, error)
+	UpdatePasskey(context.Context, dto.UpdatePasskeyRequest) (dto.PasskeyDTO, error)
+	DeletePasskey(context.Context, dto.DeletePasskeyRequest) (dto.DeletePasskeyResponse, error)
+	BeginPasskeyLogin(context.Context) (dto.PasskeyOptionsDTO, error)
+	PasskeyLogin(context.Context, dto.PasskeyLoginRequest) (dto.LoginResponse, error)
+	StartOIDCLogin(context.Context, dto.StartSSOLoginRequest) (dto.SSOAuthorizationDTO, error)
+	OIDCCallback(context.Context, dto.OIDCCallbackRequest) (dto.LoginResponse, error)
+	SAMLMetadata(context.Context, dto.GetSAMLMetadataRequest) ([]byte, error)
+	StartSAMLLogin(context.Context, dto.StartSSOLoginRequest) (dto.SSOAuthorizationDTO, error)
+	SAMLAssertion(context.Context, dto.SAMLAssertionRequest) (dto.SSOLoginCodeDTO, error)
+	RedeemSSOLoginCode(context.Context, dto.SSOLoginCodeRequest) (dto.LoginResponse, error)
+	DeleteStaleLoginThrottles(context.Context) error
 }
 
 type UserService interface {
 	GetAll(context.Context) ([]dto.UserDTO, error)
 	Update(context.Context, dto.UpdateUserRequest) (dto.UpdateUserResponse, error)
 	Delete(context.Context, dto.DeleteUserRequest) (dto.DeleteUserResponse, error)
+	GetExpertise(context.Context, dto.GetUserExpertiseRequest) ([]dto.TagExpertiseDTO, error)
+	GetModeratedScopes(context.Context, dto.GetModeratedScopesRequest) ([]dto.ModeratedScopeDTO, error)
+	RevokeSessions(context.Context, dto.RevokeSessionsRequest) (dto.RevokeSessionsResponse, error)
+	UnlockUser(context.Context, dto.UnlockUserRequest) (dto.UnlockUserResponse, error)
 }
 
 type RoleService interface {
@@ -68,6 +131,10 @@ type TennantService interface {
 	Update(context.Context, dto.UpdateTenantRequest) (dto.UpdateTenantResponse, error)
 	Delete(context.Context, dto.DeleteTenantRequest) (dto.DeleteTenantResponse, error)
 	GetAll(context.Context) ([]dto.TenantDTO, error)
+	GetOIDCProvider(context.Context, dto.GetOIDCProviderRequest) (dto.OIDCProviderDTO, error)
+	SetOIDCProvider(context.Context, dto.SetOIDCProviderRequest) (dto.OIDCProviderDTO, error)
+	GetSAMLProvider(context.Context, dto.GetSAMLProviderRequest) (dto.SAMLProviderDTO, error)
+	SetSAMLProvider(context.Context, dto.SetSAMLProviderRequest) (dto.SAMLProviderDTO, error)
 }
 
 type TopicService interface {
@@ -75,10 +142,24 @@ type TopicService interface {
 	Create(context.Context, dto.CreateTopicRequest) (dto.CreateTopicResponse, error)
 	Update(context.Context, dto.UpdateTopicRequest) (dto.UpdateTopicResponse, error)
 	Delete(context.Context, dto.DeleteTopicRequest) (dto.DeleteTopicResponse, error)
+	ReorderTopics(context.Context, dto.ReorderTopicsRequest) (dto.ReorderTopicsResponse, error)
+	GetAccess(context.Context, dto.GetTopicAccessRequest) (dto.TopicAccessDTO, error)
+	SetAccess(context.Context, dto.SetTopicAccessRequest) (dto.TopicAccessDTO, error)
+	GetModerators(context.Context, dto.GetTopicModeratorsRequest) ([]dto.UserDTO, error)
+	SetModerators(context.Context, dto.SetTopicModeratorsRequest) ([]dto.UserDTO, error)
 	GetSubTopics(context.Context, dto.GetSubTopicsRequest) ([]dto.SubTopicDTO, error)
 	CreateSubTopic(context.Context, dto.CreateSubTopicRequest) (dto.CreateSubTopicResponse, error)
 	UpdateSubTopic(context.Context, dto.UpdateSubTopicRequest) (dto.UpdateSubTopicResponse, error)
 	DeleteSubTopic(context.Context, dto.DeleteSubTopicRequest) (dto.DeleteSubTopicResponse, error)
+	ReorderSubTopics(context.Context, dto.ReorderSubTopicsRequest) (dto.ReorderSubTopicsResponse, error)
+	GetSubTopicResponders(context.Context, dto.GetSubTopicRespondersRequest) ([]dto.UserDTO, error)
+	SetSubTopicResponders(context.Context, dto.SetSubTopicRespondersRequest) (dto.UpdateSubTopicResponse, error)
+	GetSubTopicAccess(context.Context, dto.GetSubTopicAccessRequest) (dto.TopicAccessDTO, error)
+	SetSubTopicAccess(context.Context, dto.SetSubTopicAccessRequest) (dto.TopicAccessDTO, error)
+	GetSubTopicModerators(context.Context, dto.GetSubTopicModeratorsRequest) ([]dto.UserDTO, error)
+	SetSubTopicModerators(context.Context, dto.SetSubTopicModeratorsRequest) ([]dto.UserDTO, error)
+	GetTemplate(context.Context, dto.GetQuestionTemplateRequest) (dto.QuestionTemplateDTO, error)
+	SetTemplate(context.Context, dto.SetQuestionTemplateRequest) (dto.QuestionTemplateDTO, error)
 }
 
 type ClaimService interface {
@@ -88,19 +169,78 @@ type ClaimService interface {
 	Delete(context.Context, dto.DeleteClaimRequest) (dto.DeleteClaimResponse, error)
 }
 
+type CategoryService interface {
+	GetTree(context.Context) ([]dto.CategoryDTO, error)
+	GetBreadcrumb(context.Context, dto.GetCategoryRequest) ([]dto.CategoryDTO, error)
+	GetDescendants(context.Context, dto.GetCategoryRequest) ([]dto.CategoryDTO, error)
+	Create(context.Context, dto.CreateCategoryRequest) (dto.CreateCategoryResponse, error)
+	Update(context.Context, dto.UpdateCategoryRequest) (dto.UpdateCategoryResponse, error)
+	Delete(context.Context, dto.DeleteCategoryRequest) (dto.DeleteCategoryResponse, error)
+	Move(context.Context, dto.MoveCategoryRequest) (dto.MoveCategoryResponse, error)
+	Reorder(context.Context, dto.ReorderCategoriesRequest) (dto.ReorderCategoriesResponse, error)
+}
+
+type CustomFieldService interface {
+	GetAll(context.Context) ([]dto.CustomFieldDTO, error)
+	Create(context.Context, dto.CreateCustomFieldRequest) (dto.CreateCustomFieldResponse, error)
+	Update(context.Context, dto.UpdateCustomFieldRequest) (dto.UpdateCustomFieldResponse, error)
+	Delete(context.Context, dto.DeleteCustomFieldRequest) (dto.DeleteCustomFieldResponse, error)
+}
+
+type PostService interface {
+	GetUnanswered(context.Context, dto.GetUnansweredPostsRequest) ([]dto.UnansweredPostDTO, error)
+	GetAll(context.Context, dto.GetPostsRequest) ([]dto.PostDTO, error)
+	Export(context.Context, dto.GetPostsRequest) (dto.PostExportDTO, error)
+	Search(context.Context, dto.SearchPostsRequest) ([]dto.PostDTO, error)
+	Create(context.Context, dto.CreatePostRequest) (dto.CreatePostResponse, error)
+	GetExperts(context.Context, dto.GetPostExpertsRequest) ([]dto.ExpertDTO, error)
+	Assign(context.Context, dto.AssignPostRequest) (dto.AssignPostResponse, error)
+	GetAssigned(context.Context) ([]dto.PostDTO, error)
+	GetByID(context.Context, dto.GetPostRequest) (dto.PostDTO, error)
+	GetAuthor(context.Context, dto.GetPostRequest) (dto.UserDTO, error)
+	Merge(context.Context, dto.MergePostRequest) (dto.MergePostResponse, error)
+	GetHistory(context.Context, dto.GetPostHistoryRequest) ([]dto.PostHistoryDTO, error)
+	Move(context.Context, dto.MovePostRequest) (dto.MovePostResponse, error)
+	MoveMany(context.Context, dto.MovePostsRequest) (dto.MovePostsResponse, error)
+	Update(context.Context, dto.UpdatePostRequest) (dto.UpdatePostResponse, error)
+	Delete(context.Context, dto.DeletePostRequest) (dto.DeletePostResponse, error)
+	Close(context.Context, dto.ClosePostRequest) (dto.ClosePostResponse, error)
+	Lock(context.Context, dto.LockPostRequest) (dto.LockPostResponse, error)
+	React(context.Context, dto.ReactRequest) ([]dto.ReactionDTO, error)
+	SetWiki(context.Context, dto.SetWikiRequest) (dto.SetWikiResponse, error)
+	GetAnswers(context.Context, dto.GetAnswersRequest) ([]dto.AnswerDTO, error)
+	UpdateAnswer(context.Context, dto.UpdateAnswerRequest) (dto.UpdateAnswerResponse, error)
+	SuggestEdit(context.Context, dto.SuggestEditRequest) (dto.SuggestedEditDTO, error)
+	GetSuggestedEdits(context.Context, dto.GetSuggestedEditsRequest) ([]dto.SuggestedEditDTO, error)
+	GetSuggestedEditDiff(context.Context, dto.GetSuggestedEditDiffRequest) (dto.SuggestedEditDiffDTO, error)
+	ReviewSuggestedEdit(context.Context, dto.ReviewSuggestedEditRequest) (dto.SuggestedEditDTO, error)
+	GetPoll(context.Context, dto.GetPollRequest) (dto.PollDTO, error)
+	Vote(context.Context, dto.VotePollRequest) (dto.PollDTO, error)
+	NotifyOverdue(context.Context) error
+}
+
+type NotificationService interface {
+	GetAll(context.Context) ([]dto.NotificationDTO, error)
+	Read(context.Context, dto.ReadNotificationRequest) (dto.ReadNotificationResponse, error)
+}
 
 func NewServer(config config.Server) *Server {
 	s := &Server{
-		Config:  config,
-		DB:      nil,
-		Echo:    nil,
-		Router:  nil,
-		Auth:    nil,
-		User:    nil,
-		Role:    nil,
-		Tennant: nil,
-		Topic:   nil,
-		Claim:   nil,
+		Config:       config,
+		DB:           nil,
+		Echo:         nil,
+		Router:       nil,
+		Revocations:  nil,
+		Auth:         nil,
+		User:         nil,
+		Role:         nil,
+		Tennant:      nil,
+		Topic:        nil,
+		Claim:        nil,
+		Post:         nil,
+		Notification: nil,
+		Category:     nil,
+		CustomField:  nil,
 	}
 
 	return s
@@ -110,12 +250,18 @@ func (s *Server) Ready() bool {
 	return s.DB != nil &&
 		s.Echo != nil &&
 		s.Router != nil &&
+		s.Revocations != nil &&
+		s.Keys != nil &&
 		s.Auth != nil &&
 		s.User != nil &&
 		s.Role != nil &&
 		s.Tennant != nil &&
 		s.Topic != nil &&
-		s.Claim != nil
+		s.Claim != nil &&
+		s.Post != nil &&
+		s.Notification != nil &&
+		s.Category != nil &&
+		s.CustomField != nil
 }
 
 func (s *Server) InitCmd() *Server {
@@ -127,11 +273,20 @@ func (s *Server) InitCmd() *Server {
 	}
 	cancel()
 
-	if err := s.InitAuthService(); err != nil {
+	revocations := s.InitRevocationStore()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	keys, err := s.InitTokenKeys(ctx)
+	cancel()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize token keys")
+	}
+
+	if err := s.InitAuthService(revocations, keys); err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize auth service")
 	}
 
-	if err := s.InitUserService(); err != nil {
+	if err := s.InitUserService(revocations); err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize user service")
 	}
 
@@ -151,17 +306,58 @@ func (s *Server) InitCmd() *Server {
 		log.Fatal().Err(err).Msg("Failed to initialize claim service")
 	}
 
+	if err := s.InitPostService(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize post service")
+	}
+
+	if err := s.InitNotificationService(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize notification service")
+	}
+
+	if err := s.InitCategoryService(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize category service")
+	}
+
+	if err := s.InitCustomFieldService(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize custom field service")
+	}
+
 	return s
 }
 
-func (s *Server) InitAuthService() error {
-	s.Auth = auth.NewService(s.Config, s.DB)
+// InitRevocationStore creates the store shared by the jwt middleware and the
+// services that revoke tokens.
+func (s *Server) InitRevocationStore() *revocation.Store {
+	revocations := revocation.NewStore(s.Config, s.DB)
+	s.Revocations = revocations
+
+	return revocations
+}
+
+// InitTokenKeys loads the keys access tokens are signed and verified with.
+func (s *Server) InitTokenKeys(ctx context.Context) (*jwtkeys.Store, error) {
+	keys, err := jwtkeys.NewStore(ctx, s.Config, s.DB)
+	if err != nil {
+		return nil, err
+	}
+	s.Keys = keys
+
+	return keys, nil
+}
+
+func (s *Server) InitAuthService(revocations *revocation.Store, keys *jwtkeys.Store) error {
+	passkeys, err := passkey.New(s.Config)
+	if err != nil {
+		return err
+	}
+
+	s.Auth = auth.NewService(s.Config, s.DB, revocations, mailer.New(s.Config), passkeys, oidc.New(s.Config.Auth.OIDCHTTPTimeout), keys)
 
 	return nil
 }
 
-func (s *Server) InitUserService() error {
-	s.User = user.NewService(s.Config, s.DB)
+func (s *Server) InitUserService(revocations *revocation.Store) error {
+	s.User = user.NewService(s.Config, s.DB, revocations)
 
 	return nil
 }
@@ -190,6 +386,30 @@ func (s *Server) InitClaimService() error {
 	return nil
 }
 
+func (s *Server) InitPostService() error {
+	s.Post = post.NewService(s.Config, s.DB)
+
+	return nil
+}
+
+func (s *Server) InitNotificationService() error {
+	s.Notification = notification.NewService(s.Config, s.DB)
+
+	return nil
+}
+
+func (s *Server) InitCategoryService() error {
+	s.Category = category.NewService(s.Config, s.DB)
+
+	return nil
+}
+
+func (s *Server) InitCustomFieldService() error {
+	s.CustomField = customfield.NewService(s.Config, s.DB)
+
+	return nil
+}
+
 func (s *Server) InitDB(ctx context.Context) error {
 	connStr := s.Config.Database.ConnectionString()
 
@@ -218,6 +438,8 @@ func (s *Server) Start() error {
 		return errors.New("server is not ready")
 	}
 
+	s.StartJobs()
+
 	return s.Echo.Start(s.Config.Echo.ListenAddress)
 }
 
@@ -226,6 +448,11 @@ func (s *Server) Shutdown(ctx context.Context) []error {
 
 	var errs []error
 
+	if s.stopJobs != nil {
+		log.Debug().Msg("Stopping background jobs")
+		s.stopJobs()
+	}
+
 	if s.DB != nil {
 		log.Debug().Msg("Closing database connection")
 
@@ -2,6 +2,7 @@ package config
 
 import (
 	"runtime"
+	"strings"
 	"time"
 
 	"cuhara.qua.go/internal/util"
@@ -24,6 +25,15 @@ type EchoServer struct {
 	EnableValidationMiddleware     bool
 	EnableTenantAuthMiddleware     bool
 	SecureMiddleware               EchoServerSecureMiddleware
+	// CORSAllowOrigins are the origins allowed to call the API from a
+	// browser. Listed origins may send cookies, which single sign-on needs
+	// when the frontend is served from another origin.
+	CORSAllowOrigins []string
+	// TrustedProxies are the CIDR ranges of the proxies in front of the
+	// server. The client IP is only read from X-Forwarded-For when the
+	// request comes through them, without any it is the peer of the
+	// connection.
+	TrustedProxies []string
 }
 
 type EchoServerSecureMiddleware struct {
@@ -39,9 +49,67 @@ type EchoServerSecureMiddleware struct {
 }
 
 type AuthServer struct {
-	JWTSecret     string
-	JWTIssuer     string
-	JWTTTLMinutes time.Duration
+	// JWTSecret signs the mailed links and login challenges; access tokens
+	// are signed with the keys below.
+	JWTSecret       string
+	JWTIssuer       string
+	JWTTTLMinutes   time.Duration
+	RefreshTokenTTL time.Duration
+	// JWTKeySource is where the signing keys of access tokens come from,
+	// "database" or "file". JWTKeysDir holds the PEM files of the keys,
+	// named by their kid, and JWTSigningKeyID names the one that signs;
+	// files with only a public key keep verifying during rotation.
+	JWTKeySource    string
+	JWTKeysDir      string
+	JWTSigningKeyID string
+	// JWTAlgorithm is the algorithm of keys generated in the database, RS256
+	// or EdDSA. A new key is generated every JWTKeyRotationInterval, none
+	// when it is zero, and JWTKeySyncInterval is how often the keys are
+	// reloaded from the database.
+	JWTAlgorithm           string
+	JWTKeyRotationInterval time.Duration
+	JWTKeySyncInterval     time.Duration
+	// RevocationSyncInterval is how often the revoked tokens are reloaded
+	// from the database into memory.
+	RevocationSyncInterval time.Duration
+	// PasswordResetTokenTTL is how long a mailed password reset link works.
+	PasswordResetTokenTTL time.Duration
+	// At most PasswordResetMaxRequests resets can be requested per email
+	// within PasswordResetWindow.
+	PasswordResetMaxRequests int
+	PasswordResetWindow      time.Duration
+	// EmailVerificationTTL is how long a mailed verification link works.
+	EmailVerificationTTL time.Duration
+	// EmailVerificationResendInterval is the least time between two
+	// verification mails to the same user.
+	EmailVerificationResendInterval time.Duration
+	// WebAuthnRPID is the domain passkeys are bound to, and WebAuthnOrigins
+	// are the origins of the frontend allowed to use them.
+	WebAuthnRPID          string
+	WebAuthnRPDisplayName string
+	WebAuthnOrigins       []string
+	// WebAuthnCeremonyTTL is how long a passkey registration or login may
+	// take from its options to its response.
+	WebAuthnCeremonyTTL time.Duration
+	// OIDCStateTTL is how long a user may take to log in at the identity
+	// provider of the tenant.
+	OIDCStateTTL time.Duration
+	// OIDCHTTPTimeout bounds the requests to identity providers.
+	OIDCHTTPTimeout time.Duration
+	// SAMLRequestTTL is how long a user may take to log in at the SAML
+	// identity provider of the tenant.
+	SAMLRequestTTL time.Duration
+	// SSOLoginCodeTTL is how long the frontend has to trade the code of a
+	// SAML login for tokens.
+	SSOLoginCodeTTL time.Duration
+	// LoginFreeAttempts failed logins in a row are let through; each one
+	// after locks the account or client IP for twice as long as the last,
+	// starting at a second. After LoginMaxAttempts failures of an account or
+	// LoginMaxIPAttempts of a client IP it is locked for LoginLockDuration.
+	LoginFreeAttempts  int
+	LoginMaxAttempts   int
+	LoginMaxIPAttempts int
+	LoginLockDuration  time.Duration
 }
 
 type LoggerServer struct {
@@ -57,8 +125,36 @@ type LoggerServer struct {
 }
 
 type FrontendServer struct {
-	BaseURL               string
-	PasswordResetEndpoint string
+	BaseURL                   string
+	PasswordResetEndpoint     string
+	EmailVerificationEndpoint string
+	// OIDCCallbackEndpoint is where identity providers send users back to
+	// after a single sign-on login; it has to be registered with them.
+	OIDCCallbackEndpoint string
+	// SAMLCallbackEndpoint is where users land with the login code or the
+	// error of a SAML login.
+	SAMLCallbackEndpoint string
+}
+
+// MailerServer is the SMTP server mails are sent through. Mails are only
+// logged when no host is set.
+type MailerServer struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// JobsServer sets how often the background jobs run. Jobs with a zero
+// interval do not run.
+type JobsServer struct {
+	// OverduePostsInterval is how often responders are told about posts that
+	// missed the first reply target of their sub topic.
+	OverduePostsInterval time.Duration
+	// LoginThrottleCleanupInterval is how often failed logins older than the
+	// login lock duration are deleted.
+	LoginThrottleCleanupInterval time.Duration
 }
 
 type Server struct {
@@ -67,6 +163,8 @@ type Server struct {
 	Logger   LoggerServer
 	Auth     AuthServer
 	Frontend FrontendServer
+	Mailer   MailerServer
+	Jobs     JobsServer
 }
 
 func DefaultServiceConfigFromEnv() Server {
@@ -99,6 +197,8 @@ func DefaultServiceConfigFromEnv() Server {
 			EnableValidationMiddleware:     util.GetEnvAsBool("SERVER_ECHO_ENABLE_VALIDATION_MIDDLEWARE", true),
 			EnableJWTMiddleware:            util.GetEnvAsBool("SERVER_ECHO_ENABLE_JWT_MIDDLEWARE", true),
 			EnableTenantAuthMiddleware:     util.GetEnvAsBool("SERVER_ECHO_ENABLE_TENANT_AUTH_MIDDLEWARE", true),
+			CORSAllowOrigins:               strings.Split(util.GetEnv("SERVER_ECHO_CORS_ALLOW_ORIGINS", "*"), ","),
+			TrustedProxies:                 strings.FieldsFunc(util.GetEnv("SERVER_ECHO_TRUSTED_PROXIES", ""), func(r rune) bool { return r == ',' }),
 			SecureMiddleware: EchoServerSecureMiddleware{
 				XSSProtection:         util.GetEnv("SERVER_ECHO_SECURE_MIDDLEWARE_XSS_PROTECTION", "1; mode=block"),
 				ContentTypeNosniff:    util.GetEnv("SERVER_ECHO_SECURE_MIDDLEWARE_CONTENT_TYPE_NOSNIFF", "nosniff"),
@@ -123,13 +223,52 @@ func DefaultServiceConfigFromEnv() Server {
 			PrettyPrintConsole: util.GetEnvAsBool("SERVER_LOGGER_PRETTY_PRINT_CONSOLE", false),
 		},
 		Auth: AuthServer{
-			JWTSecret:     util.GetEnv("AUTH_SERVER_JWT_SECRET", "development"),
-			JWTIssuer:     util.GetEnv("AUTH_SERVER_JWT_ISSUER", "devs"),
-			JWTTTLMinutes: time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_JWT_TTL_MINUTES", 60)),
+			JWTSecret:                       util.GetEnv("AUTH_SERVER_JWT_SECRET", "development"),
+			JWTIssuer:                       util.GetEnv("AUTH_SERVER_JWT_ISSUER", "devs"),
+			JWTTTLMinutes:                   time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_JWT_TTL_MINUTES", 15)),
+			RefreshTokenTTL:                 time.Hour * time.Duration(util.GetEnvAsInt("AUTH_SERVER_REFRESH_TOKEN_TTL_HOURS", 720)),
+			JWTKeySource:                    util.GetEnv("AUTH_SERVER_JWT_KEY_SOURCE", "database"),
+			JWTKeysDir:                      util.GetEnv("AUTH_SERVER_JWT_KEYS_DIR", ""),
+			JWTSigningKeyID:                 util.GetEnv("AUTH_SERVER_JWT_SIGNING_KEY_ID", ""),
+			JWTAlgorithm:                    util.GetEnv("AUTH_SERVER_JWT_ALGORITHM", "RS256"),
+			JWTKeyRotationInterval:          time.Hour * time.Duration(util.GetEnvAsInt("AUTH_SERVER_JWT_KEY_ROTATION_HOURS", 720)),
+			JWTKeySyncInterval:              time.Second * time.Duration(util.GetEnvAsInt("AUTH_SERVER_JWT_KEY_SYNC_SECONDS", 60)),
+			RevocationSyncInterval:          time.Second * time.Duration(util.GetEnvAsInt("AUTH_SERVER_REVOCATION_SYNC_SECONDS", 30)),
+			PasswordResetTokenTTL:           time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_PASSWORD_RESET_TOKEN_TTL_MINUTES", 60)),
+			PasswordResetMaxRequests:        util.GetEnvAsInt("AUTH_SERVER_PASSWORD_RESET_MAX_REQUESTS", 3),
+			PasswordResetWindow:             time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_PASSWORD_RESET_WINDOW_MINUTES", 60)),
+			EmailVerificationTTL:            time.Hour * time.Duration(util.GetEnvAsInt("AUTH_SERVER_EMAIL_VERIFICATION_TTL_HOURS", 72)),
+			EmailVerificationResendInterval: time.Second * time.Duration(util.GetEnvAsInt("AUTH_SERVER_EMAIL_VERIFICATION_RESEND_SECONDS", 60)),
+			WebAuthnRPID:                    util.GetEnv("AUTH_SERVER_WEBAUTHN_RP_ID", "localhost"),
+			WebAuthnRPDisplayName:           util.GetEnv("AUTH_SERVER_WEBAUTHN_RP_DISPLAY_NAME", "cuhara"),
+			WebAuthnOrigins:                 strings.Split(util.GetEnv("AUTH_SERVER_WEBAUTHN_ORIGINS", "http://localhost:3000"), ","),
+			WebAuthnCeremonyTTL:             time.Second * time.Duration(util.GetEnvAsInt("AUTH_SERVER_WEBAUTHN_CEREMONY_TTL_SECONDS", 300)),
+			OIDCStateTTL:                    time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_OIDC_STATE_TTL_MINUTES", 10)),
+			OIDCHTTPTimeout:                 time.Second * time.Duration(util.GetEnvAsInt("AUTH_SERVER_OIDC_HTTP_TIMEOUT_SECONDS", 10)),
+			SAMLRequestTTL:                  time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_SAML_REQUEST_TTL_MINUTES", 10)),
+			SSOLoginCodeTTL:                 time.Second * time.Duration(util.GetEnvAsInt("AUTH_SERVER_SSO_LOGIN_CODE_TTL_SECONDS", 60)),
+			LoginFreeAttempts:               util.GetEnvAsInt("AUTH_SERVER_LOGIN_FREE_ATTEMPTS", 3),
+			LoginMaxAttempts:                util.GetEnvAsInt("AUTH_SERVER_LOGIN_MAX_ATTEMPTS", 10),
+			LoginMaxIPAttempts:              util.GetEnvAsInt("AUTH_SERVER_LOGIN_MAX_IP_ATTEMPTS", 50),
+			LoginLockDuration:               time.Minute * time.Duration(util.GetEnvAsInt("AUTH_SERVER_LOGIN_LOCK_MINUTES", 15)),
 		},
 		Frontend: FrontendServer{
-			BaseURL:               util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
-			PasswordResetEndpoint: util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			BaseURL:                   util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
+			PasswordResetEndpoint:     util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			EmailVerificationEndpoint: util.GetEnv("SERVER_FRONTEND_EMAIL_VERIFICATION_ENDPOINT", "/verify-email"),
+			OIDCCallbackEndpoint:      util.GetEnv("SERVER_FRONTEND_OIDC_CALLBACK_ENDPOINT", "/sso/callback"),
+			SAMLCallbackEndpoint:      util.GetEnv("SERVER_FRONTEND_SAML_CALLBACK_ENDPOINT", "/sso/saml/callback"),
+		},
+		Mailer: MailerServer{
+			Host:     util.GetEnv("SERVER_MAILER_HOST", ""),
+			Port:     util.GetEnvAsInt("SERVER_MAILER_PORT", 587),
+			Username: util.GetEnv("SERVER_MAILER_USERNAME", ""),
+			Password: util.GetEnv("SERVER_MAILER_PASSWORD", ""),
+			From:     util.GetEnv("SERVER_MAILER_FROM", "no-reply@localhost"),
+		},
+		Jobs: JobsServer{
+			OverduePostsInterval:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_JOBS_OVERDUE_POSTS_SECONDS", 60)),
+			LoginThrottleCleanupInterval: time.Second * time.Duration(util.GetEnvAsInt("SERVER_JOBS_LOGIN_THROTTLE_CLEANUP_SECONDS", 300)),
 		},
 	}
 }
@@ -0,0 +1,25 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (a *AnswerDTO) ToTypes() *types.AnswerResponse {
+	return &types.AnswerResponse{
+		Id:        &a.ID,
+		PostId:    &a.PostID,
+		Body:      &a.Body,
+		CreatorId: &a.CreatorID,
+		Accepted:  &a.Accepted,
+		Wiki:      &a.Wiki,
+		Version:   &a.Version,
+		Reactions: reactionsToTypes(a.Reactions),
+		CreatedAt: &a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+func (u *UpdateAnswerResponse) ToTypes() *types.UpdateAnswerResponse {
+	return &types.UpdateAnswerResponse{
+		Id:      &u.ID,
+		Version: &u.Version,
+	}
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+type AnswerDTO struct {
+	ID        int64         `json:"id"`
+	PostID    int64         `json:"postId"`
+	Body      string        `json:"body"`
+	CreatorID int64         `json:"creatorId"`
+	Accepted  bool          `json:"accepted"`
+	Wiki      bool          `json:"wiki"`
+	Version   int           `json:"version"`
+	Reactions []ReactionDTO `json:"reactions"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt *time.Time    `json:"updatedAt"`
+}
+
+type GetAnswersRequest struct {
+	PostID int64 `json:"postId"`
+}
+
+// UpdateAnswerRequest edits the body of an answer. Version is the version the
+// edit is based on, required for community wiki answers.
+type UpdateAnswerRequest struct {
+	PostID  int64  `json:"postId"`
+	ID      int64  `json:"id"`
+	Body    string `json:"body"`
+	Version *int   `json:"version"`
+}
+
+type UpdateAnswerResponse struct {
+	ID      int64 `json:"id"`
+	Version int   `json:"version"`
+}
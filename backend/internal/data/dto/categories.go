@@ -0,0 +1,62 @@
+package dto
+
+type CategoryDTO struct {
+	ID         int64         `json:"id"`
+	ParentID   *int64        `json:"parentId"`
+	Name       string        `json:"name"`
+	Path       string        `json:"path"`
+	Depth      int           `json:"depth"`
+	Position   int           `json:"position"`
+	TopicID    *int64        `json:"topicId"`
+	SubTopicID *int64        `json:"subTopicId"`
+	Children   []CategoryDTO `json:"children"`
+}
+
+type GetCategoryRequest struct {
+	ID int64 `json:"id"`
+}
+
+type CreateCategoryRequest struct {
+	ParentID *int64 `json:"parentId"`
+	Name     string `json:"name"`
+}
+
+type CreateCategoryResponse struct {
+	ID int64 `json:"id"`
+}
+
+type UpdateCategoryRequest struct {
+	ID   int64   `json:"id"`
+	Name *string `json:"name"`
+}
+
+type UpdateCategoryResponse struct {
+	ID int64 `json:"id"`
+}
+
+type DeleteCategoryRequest struct {
+	ID int64 `json:"id"`
+}
+
+type DeleteCategoryResponse struct {
+	ID int64 `json:"id"`
+}
+
+type MoveCategoryRequest struct {
+	ID       int64 `json:"id"`
+	ParentID int64 `json:"parentId"`
+	Position *int  `json:"position"`
+}
+
+type MoveCategoryResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ReorderCategoriesRequest struct {
+	ParentID *int64  `json:"parentId"`
+	IDs      []int64 `json:"ids"`
+}
+
+type ReorderCategoriesResponse struct {
+	ParentID *int64 `json:"parentId"`
+}
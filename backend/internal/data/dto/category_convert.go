@@ -0,0 +1,52 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (c *CategoryDTO) ToTypes() *types.CategoryResponse {
+	children := make([]types.CategoryResponse, len(c.Children))
+	for i := range c.Children {
+		children[i] = *c.Children[i].ToTypes()
+	}
+
+	return &types.CategoryResponse{
+		Id:         &c.ID,
+		ParentId:   c.ParentID,
+		Name:       &c.Name,
+		Path:       &c.Path,
+		Depth:      &c.Depth,
+		Position:   &c.Position,
+		TopicId:    c.TopicID,
+		SubTopicId: c.SubTopicID,
+		Children:   &children,
+	}
+}
+
+func (c *CreateCategoryResponse) ToTypes() *types.CreateCategoryResponse {
+	return &types.CreateCategoryResponse{
+		Id: &c.ID,
+	}
+}
+
+func (u *UpdateCategoryResponse) ToTypes() *types.UpdateCategoryResponse {
+	return &types.UpdateCategoryResponse{
+		Id: &u.ID,
+	}
+}
+
+func (d *DeleteCategoryResponse) ToTypes() *types.DeleteCategoryResponse {
+	return &types.DeleteCategoryResponse{
+		Id: &d.ID,
+	}
+}
+
+func (m *MoveCategoryResponse) ToTypes() *types.MoveCategoryResponse {
+	return &types.MoveCategoryResponse{
+		Id: &m.ID,
+	}
+}
+
+func (r *ReorderCategoriesResponse) ToTypes() *types.ReorderCategoriesResponse {
+	return &types.ReorderCategoriesResponse{
+		ParentId: r.ParentID,
+	}
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (c *CustomFieldDTO) ToTypes() *types.CustomFieldResponse {
+	return &types.CustomFieldResponse{
+		Id:       &c.ID,
+		Name:     &c.Name,
+		Label:    &c.Label,
+		Type:     &c.Type,
+		Required: &c.Required,
+		Options:  &c.Options,
+		Position: &c.Position,
+	}
+}
+
+func (c *CreateCustomFieldResponse) ToTypes() *types.CreateCustomFieldResponse {
+	return &types.CreateCustomFieldResponse{
+		Id: &c.ID,
+	}
+}
+
+func (u *UpdateCustomFieldResponse) ToTypes() *types.UpdateCustomFieldResponse {
+	return &types.UpdateCustomFieldResponse{
+		Id: &u.ID,
+	}
+}
+
+func (d *DeleteCustomFieldResponse) ToTypes() *types.DeleteCustomFieldResponse {
+	return &types.DeleteCustomFieldResponse{
+		Id: &d.ID,
+	}
+}
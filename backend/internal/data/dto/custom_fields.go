@@ -0,0 +1,44 @@
+package dto
+
+type CustomFieldDTO struct {
+	ID       int64    `json:"id"`
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options"`
+	Position int      `json:"position"`
+}
+
+type CreateCustomFieldRequest struct {
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options"`
+	Position *int     `json:"position"`
+}
+
+type CreateCustomFieldResponse struct {
+	ID int64 `json:"id"`
+}
+
+type UpdateCustomFieldRequest struct {
+	ID       int64     `json:"id"`
+	Label    *string   `json:"label"`
+	Required *bool     `json:"required"`
+	Options  *[]string `json:"options"`
+	Position *int      `json:"position"`
+}
+
+type UpdateCustomFieldResponse struct {
+	ID int64 `json:"id"`
+}
+
+type DeleteCustomFieldRequest struct {
+	ID int64 `json:"id"`
+}
+
+type DeleteCustomFieldResponse struct {
+	ID int64 `json:"id"`
+}
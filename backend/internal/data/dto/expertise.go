@@ -0,0 +1,19 @@
+package dto
+
+type ExpertDTO struct {
+	UserID int64  `json:"userId"`
+	Name   string `json:"name"`
+	Score  int64  `json:"score"`
+}
+
+type TagExpertiseDTO struct {
+	TagID           int64  `json:"tagId"`
+	TagName         string `json:"tagName"`
+	AcceptedAnswers int64  `json:"acceptedAnswers"`
+	Upvotes         int64  `json:"upvotes"`
+	Score           int64  `json:"score"`
+}
+
+type GetUserExpertiseRequest struct {
+	ID int64 `json:"id"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (e *ExpertDTO) ToTypes() *types.ExpertResponse {
+	return &types.ExpertResponse{
+		UserId: &e.UserID,
+		Name:   &e.Name,
+		Score:  &e.Score,
+	}
+}
+
+func (t *TagExpertiseDTO) ToTypes() *types.TagExpertiseResponse {
+	return &types.TagExpertiseResponse{
+		TagId:           &t.TagID,
+		TagName:         &t.TagName,
+		AcceptedAnswers: &t.AcceptedAnswers,
+		Upvotes:         &t.Upvotes,
+		Score:           &t.Score,
+	}
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (n *NotificationDTO) ToTypes() *types.NotificationResponse {
+	return &types.NotificationResponse{
+		Id:        &n.ID,
+		Type:      &n.Type,
+		Message:   &n.Message,
+		PostId:    n.PostID,
+		ReadAt:    n.ReadAt,
+		CreatedAt: &n.CreatedAt,
+	}
+}
+
+func (r *ReadNotificationResponse) ToTypes() *types.ReadNotificationResponse {
+	return &types.ReadNotificationResponse{
+		Id: &r.ID,
+	}
+}
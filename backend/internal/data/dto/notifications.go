@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type NotificationDTO struct {
+	ID        int64      `json:"id"`
+	Type      string     `json:"type"`
+	Message   string     `json:"message"`
+	PostID    *int64     `json:"postId"`
+	ReadAt    *time.Time `json:"readAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type ReadNotificationRequest struct {
+	ID int64 `json:"id"`
+}
+
+type ReadNotificationResponse struct {
+	ID int64 `json:"id"`
+}
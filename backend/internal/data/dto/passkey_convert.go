@@ -0,0 +1,25 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (p PasskeyDTO) ToTypes() *types.PasskeyResponse {
+	return &types.PasskeyResponse{
+		Id:         &p.ID,
+		Name:       &p.Name,
+		CreatedAt:  &p.CreatedAt,
+		LastUsedAt: p.LastUsedAt,
+	}
+}
+
+func (p PasskeyOptionsDTO) ToTypes() *types.PasskeyOptionsResponse {
+	return &types.PasskeyOptionsResponse{
+		CeremonyId: &p.CeremonyID,
+		Options:    &p.Options,
+	}
+}
+
+func (d DeletePasskeyResponse) ToTypes() *types.DeletePasskeyResponse {
+	return &types.DeletePasskeyResponse{
+		Id: &d.ID,
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+// PasskeyDTO is a passkey of a user. LastUsedAt is nil until the passkey is
+// used to log in.
+type PasskeyDTO struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// PasskeyOptionsDTO holds the options of a WebAuthn ceremony for the browser
+// and the id to finish the ceremony with.
+type PasskeyOptionsDTO struct {
+	CeremonyID string         `json:"ceremonyId"`
+	Options    map[string]any `json:"options"`
+}
+
+// RegisterPasskeyRequest finishes a registration with the credential the
+// browser returned, serialized as JSON object.
+type RegisterPasskeyRequest struct {
+	CeremonyID string         `json:"ceremonyId"`
+	Name       string         `json:"name"`
+	Credential map[string]any `json:"credential"`
+}
+
+type PasskeyLoginRequest struct {
+	CeremonyID string         `json:"ceremonyId"`
+	Credential map[string]any `json:"credential"`
+}
+
+type UpdatePasskeyRequest struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type DeletePasskeyRequest struct {
+	ID int64 `json:"id"`
+}
+
+type DeletePasskeyResponse struct {
+	ID int64 `json:"id"`
+}
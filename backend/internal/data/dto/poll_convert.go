@@ -0,0 +1,32 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (p *PollDTO) ToTypes() *types.PollResponse {
+	if p == nil {
+		return nil
+	}
+
+	options := make([]types.PollOptionResponse, len(p.Options))
+	for i := range p.Options {
+		options[i] = types.PollOptionResponse{
+			Id:    &p.Options[i].ID,
+			Label: &p.Options[i].Label,
+			Votes: p.Options[i].Votes,
+			Voted: &p.Options[i].Voted,
+		}
+	}
+
+	return &types.PollResponse{
+		Id:                &p.ID,
+		Question:          &p.Question,
+		MultipleChoice:    &p.MultipleChoice,
+		ResultsVisibility: &p.ResultsVisibility,
+		ClosesAt:          p.ClosesAt,
+		Closed:            &p.Closed,
+		Voted:             &p.Voted,
+		ResultsVisible:    &p.ResultsVisible,
+		VoterCount:        p.VoterCount,
+		Options:           &options,
+	}
+}
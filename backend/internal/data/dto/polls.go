@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+// CreatePollRequest adds a poll to a new post. ResultsVisibility is ALWAYS,
+// AFTER_VOTE or AFTER_CLOSE.
+type CreatePollRequest struct {
+	Question          string     `json:"question"`
+	Options           []string   `json:"options"`
+	MultipleChoice    bool       `json:"multipleChoice"`
+	ResultsVisibility string     `json:"resultsVisibility"`
+	ClosesAt          *time.Time `json:"closesAt"`
+}
+
+// PollDTO is a poll as seen by the current user. Vote counts are only set
+// when the results are visible to the user.
+type PollDTO struct {
+	ID                int64           `json:"id"`
+	Question          string          `json:"question"`
+	MultipleChoice    bool            `json:"multipleChoice"`
+	ResultsVisibility string          `json:"resultsVisibility"`
+	ClosesAt          *time.Time      `json:"closesAt"`
+	Closed            bool            `json:"closed"`
+	Voted             bool            `json:"voted"`
+	ResultsVisible    bool            `json:"resultsVisible"`
+	VoterCount        *int            `json:"voterCount"`
+	Options           []PollOptionDTO `json:"options"`
+}
+
+type PollOptionDTO struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+	Votes *int   `json:"votes"`
+	Voted bool   `json:"voted"`
+}
+
+type GetPollRequest struct {
+	PostID int64 `json:"postId"`
+}
+
+// VotePollRequest replaces the vote of the user on the poll of a post. An
+// empty OptionIDs withdraws the vote.
+type VotePollRequest struct {
+	PostID    int64   `json:"postId"`
+	OptionIDs []int64 `json:"optionIds"`
+}
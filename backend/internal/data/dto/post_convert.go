@@ -0,0 +1,139 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (u *UnansweredPostDTO) ToTypes() *types.UnansweredPostResponse {
+	return &types.UnansweredPostResponse{
+		Id:              &u.ID,
+		CreatorId:       u.CreatorID,
+		Anonymous:       &u.Anonymous,
+		SubTopic:        u.SubTopic.ToTypes(),
+		AnswerCount:     &u.AnswerCount,
+		CreatedAt:       &u.CreatedAt,
+		FirstReplyAt:    u.FirstReplyAt,
+		ResponseMinutes: u.ResponseMinutes,
+		TargetMinutes:   u.TargetMinutes,
+		Overdue:         &u.Overdue,
+	}
+}
+
+func (p *PostDTO) ToTypes() *types.PostResponse {
+	return &types.PostResponse{
+		Id:             &p.ID,
+		Title:          &p.Title,
+		Body:           &p.Body,
+		CreatorId:      p.CreatorID,
+		Anonymous:      &p.Anonymous,
+		SubTopic:       p.SubTopic.ToTypes(),
+		Tags:           &p.Tags,
+		Fields:         &p.Fields,
+		CustomFields:   &p.CustomFields,
+		Reactions:      reactionsToTypes(p.Reactions),
+		Poll:           p.Poll.ToTypes(),
+		Wiki:           &p.Wiki,
+		Version:        &p.Version,
+		AssigneeUserId: p.AssigneeUserID,
+		AssigneeRoleId: p.AssigneeRoleID,
+		AssignedAt:     p.AssignedAt,
+		MergedIntoId:   p.MergedIntoID,
+		ClosedAt:       p.ClosedAt,
+		LockedAt:       p.LockedAt,
+		CreatedAt:      &p.CreatedAt,
+	}
+}
+
+func (c *CreatePostResponse) ToTypes() *types.CreatePostResponse {
+	suggestedExperts := make([]types.ExpertResponse, len(c.SuggestedExperts))
+	for i := range c.SuggestedExperts {
+		suggestedExperts[i] = *c.SuggestedExperts[i].ToTypes()
+	}
+
+	return &types.CreatePostResponse{
+		Id:               &c.ID,
+		SuggestedExperts: &suggestedExperts,
+	}
+}
+
+func (a *AssignPostResponse) ToTypes() *types.AssignPostResponse {
+	return &types.AssignPostResponse{
+		Id: &a.ID,
+	}
+}
+
+func (m *MergePostResponse) ToTypes() *types.MergePostResponse {
+	return &types.MergePostResponse{
+		Id: &m.ID,
+	}
+}
+
+func (p *PostHistoryDTO) ToTypes() *types.PostHistoryResponse {
+	return &types.PostHistoryResponse{
+		Id:        &p.ID,
+		PostId:    &p.PostID,
+		ActorId:   p.ActorID,
+		Action:    &p.Action,
+		Data:      &p.Data,
+		CreatedAt: &p.CreatedAt,
+	}
+}
+
+func (m *MovePostResponse) ToTypes() *types.MovePostResponse {
+	return &types.MovePostResponse{
+		Id: &m.ID,
+	}
+}
+
+func (m *MovePostsResponse) ToTypes() *types.MovePostsResponse {
+	return &types.MovePostsResponse{
+		MovedCount: &m.MovedCount,
+	}
+}
+
+func (u *UpdatePostResponse) ToTypes() *types.UpdatePostResponse {
+	return &types.UpdatePostResponse{
+		Id:      &u.ID,
+		Version: &u.Version,
+	}
+}
+
+func (d *DeletePostResponse) ToTypes() *types.DeletePostResponse {
+	return &types.DeletePostResponse{
+		Id: &d.ID,
+	}
+}
+
+func (c *ClosePostResponse) ToTypes() *types.ClosePostResponse {
+	return &types.ClosePostResponse{
+		Id: &c.ID,
+	}
+}
+
+func (l *LockPostResponse) ToTypes() *types.LockPostResponse {
+	return &types.LockPostResponse{
+		Id: &l.ID,
+	}
+}
+
+func (r *ReactionDTO) ToTypes() *types.ReactionResponse {
+	return &types.ReactionResponse{
+		Emoji:   &r.Emoji,
+		Count:   &r.Count,
+		Reacted: &r.Reacted,
+	}
+}
+
+func reactionsToTypes(reactions []ReactionDTO) *[]types.ReactionResponse {
+	responses := make([]types.ReactionResponse, len(reactions))
+	for i := range reactions {
+		responses[i] = *reactions[i].ToTypes()
+	}
+
+	return &responses
+}
+
+func (w *SetWikiResponse) ToTypes() *types.SetWikiResponse {
+	return &types.SetWikiResponse{
+		Id:   &w.ID,
+		Wiki: &w.Wiki,
+	}
+}
@@ -0,0 +1,217 @@
+package dto
+
+import "time"
+
+type UnansweredPostDTO struct {
+	ID              int64       `json:"id"`
+	CreatorID       *int64      `json:"creatorId"`
+	Anonymous       bool        `json:"anonymous"`
+	SubTopic        SubTopicDTO `json:"subTopic"`
+	AnswerCount     int         `json:"answerCount"`
+	CreatedAt       time.Time   `json:"createdAt"`
+	FirstReplyAt    *time.Time  `json:"firstReplyAt"`
+	ResponseMinutes *int        `json:"responseMinutes"`
+	TargetMinutes   *int        `json:"targetMinutes"`
+	Overdue         bool        `json:"overdue"`
+}
+
+type GetUnansweredPostsRequest struct {
+	AcceptanceDays int `json:"acceptanceDays"`
+}
+
+type SearchPostsRequest struct {
+	Query  string            `json:"q"`
+	Fields map[string]string `json:"fields"`
+	Limit  int               `json:"limit"`
+}
+
+// GetPostsRequest lists posts, optionally only those of a sub topic and with
+// the given question template and custom field values. Sort is createdAt or
+// custom.<name>, descending when prefixed with a minus.
+type GetPostsRequest struct {
+	SubTopicID   *int64            `json:"subTopicId"`
+	Fields       map[string]string `json:"fields"`
+	CustomFields map[string]string `json:"customFields"`
+	Sort         string            `json:"sort"`
+	Limit        int               `json:"limit"`
+}
+
+// PostExportDTO is a table of posts with one column per custom field.
+type PostExportDTO struct {
+	Header []string   `json:"header"`
+	Rows   [][]string `json:"rows"`
+}
+
+type PostDTO struct {
+	ID             int64          `json:"id"`
+	Title          string         `json:"title"`
+	Body           string         `json:"body"`
+	CreatorID      *int64         `json:"creatorId"`
+	Anonymous      bool           `json:"anonymous"`
+	SubTopic       SubTopicDTO    `json:"subTopic"`
+	Tags           []string       `json:"tags"`
+	Fields         map[string]any `json:"fields"`
+	CustomFields   map[string]any `json:"customFields"`
+	Reactions      []ReactionDTO  `json:"reactions"`
+	Poll           *PollDTO       `json:"poll"`
+	Wiki           bool           `json:"wiki"`
+	Version        int            `json:"version"`
+	AssigneeUserID *int64         `json:"assigneeUserId"`
+	AssigneeRoleID *int64         `json:"assigneeRoleId"`
+	AssignedAt     *time.Time     `json:"assignedAt"`
+	MergedIntoID   *int64         `json:"mergedIntoId"`
+	ClosedAt       *time.Time     `json:"closedAt"`
+	LockedAt       *time.Time     `json:"lockedAt"`
+	CreatedAt      time.Time      `json:"createdAt"`
+}
+
+type CreatePostRequest struct {
+	SubTopicID   int64              `json:"subTopicId"`
+	Title        string             `json:"title"`
+	Body         string             `json:"body"`
+	Tags         []string           `json:"tags"`
+	Fields       map[string]any     `json:"fields"`
+	CustomFields map[string]any     `json:"customFields"`
+	Poll         *CreatePollRequest `json:"poll"`
+	Anonymous    bool               `json:"anonymous"`
+}
+
+type CreatePostResponse struct {
+	ID               int64       `json:"id"`
+	SuggestedExperts []ExpertDTO `json:"suggestedExperts"`
+}
+
+type GetPostExpertsRequest struct {
+	ID    int64 `json:"id"`
+	Limit int   `json:"limit"`
+}
+
+type AssignPostRequest struct {
+	ID     int64  `json:"id"`
+	UserID *int64 `json:"userId"`
+	RoleID *int64 `json:"roleId"`
+}
+
+type AssignPostResponse struct {
+	ID int64 `json:"id"`
+}
+
+type GetPostRequest struct {
+	ID int64 `json:"id"`
+}
+
+type MergePostRequest struct {
+	ID           int64 `json:"id"`
+	TargetPostID int64 `json:"targetPostId"`
+}
+
+type MergePostResponse struct {
+	ID int64 `json:"id"`
+}
+
+type PostHistoryDTO struct {
+	ID        int64          `json:"id"`
+	PostID    int64          `json:"postId"`
+	ActorID   *int64         `json:"actorId"`
+	Action    string         `json:"action"`
+	Data      map[string]any `json:"data"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+type GetPostHistoryRequest struct {
+	ID int64 `json:"id"`
+}
+
+type MovePostRequest struct {
+	ID         int64 `json:"id"`
+	SubTopicID int64 `json:"subTopicId"`
+}
+
+type MovePostResponse struct {
+	ID int64 `json:"id"`
+}
+
+type MovePostsRequest struct {
+	SubTopicID     int64   `json:"subTopicId"`
+	PostIDs        []int64 `json:"postIds"`
+	FromSubTopicID *int64  `json:"fromSubTopicId"`
+	FromTopicID    *int64  `json:"fromTopicId"`
+	Tag            *string `json:"tag"`
+}
+
+type MovePostsResponse struct {
+	MovedCount int64 `json:"movedCount"`
+}
+
+type UpdatePostRequest struct {
+	ID           int64           `json:"id"`
+	Title        *string         `json:"title"`
+	Body         *string         `json:"body"`
+	Tags         *[]string       `json:"tags"`
+	Fields       *map[string]any `json:"fields"`
+	CustomFields *map[string]any `json:"customFields"`
+	Version      *int            `json:"version"`
+}
+
+type UpdatePostResponse struct {
+	ID      int64 `json:"id"`
+	Version int   `json:"version"`
+}
+
+type DeletePostRequest struct {
+	ID int64 `json:"id"`
+}
+
+type DeletePostResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ClosePostRequest struct {
+	ID     int64   `json:"id"`
+	Closed bool    `json:"closed"`
+	Reason *string `json:"reason"`
+}
+
+type ClosePostResponse struct {
+	ID int64 `json:"id"`
+}
+
+type LockPostRequest struct {
+	ID     int64 `json:"id"`
+	Locked bool  `json:"locked"`
+}
+
+type LockPostResponse struct {
+	ID int64 `json:"id"`
+}
+
+// ReactionDTO is the number of reactions with one emoji and whether the
+// current user is among them.
+type ReactionDTO struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"`
+}
+
+// ReactRequest adds or removes a reaction to a post, or to an answer or
+// comment of the post when its id is set.
+type ReactRequest struct {
+	PostID    int64  `json:"postId"`
+	AnswerID  *int64 `json:"answerId"`
+	CommentID *int64 `json:"commentId"`
+	Emoji     string `json:"emoji"`
+	Reacted   bool   `json:"reacted"`
+}
+
+// SetWikiRequest turns a post, or one of its answers when AnswerID is set,
+// into a community wiki or back.
+type SetWikiRequest struct {
+	PostID   int64  `json:"postId"`
+	AnswerID *int64 `json:"answerId"`
+	Wiki     bool   `json:"wiki"`
+}
+
+type SetWikiResponse struct {
+	ID   int64 `json:"id"`
+	Wiki bool  `json:"wiki"`
+}
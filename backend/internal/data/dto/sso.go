@@ -0,0 +1,127 @@
+package dto
+
+// OIDCProviderDTO is the OpenID Connect identity provider of a tenant. The
+// client secret is never returned, ClientSecretSet tells whether one is
+// stored.
+type OIDCProviderDTO struct {
+	TenantID        int64            `json:"tenantId"`
+	Enabled         bool             `json:"enabled"`
+	Issuer          string           `json:"issuer"`
+	ClientID        string           `json:"clientId"`
+	ClientSecretSet bool             `json:"clientSecretSet"`
+	Scopes          []string         `json:"scopes"`
+	RoleClaim       string           `json:"roleClaim"`
+	RoleMappings    []SSORoleMapping `json:"roleMappings"`
+	DefaultRoleID   *int64           `json:"defaultRoleId"`
+}
+
+// SSORoleMapping gives the role to users whose role claim or attribute
+// contains the value.
+type SSORoleMapping struct {
+	Value  string `json:"value"`
+	RoleID int64  `json:"roleId"`
+}
+
+type GetOIDCProviderRequest struct {
+	TenantID int64 `json:"tenantId"`
+}
+
+// SetOIDCProviderRequest creates or replaces the provider of the tenant. The
+// stored client secret is kept when ClientSecret is nil.
+type SetOIDCProviderRequest struct {
+	TenantID      int64            `json:"tenantId"`
+	Enabled       bool             `json:"enabled"`
+	Issuer        string           `json:"issuer"`
+	ClientID      string           `json:"clientId"`
+	ClientSecret  *string          `json:"clientSecret"`
+	Scopes        []string         `json:"scopes"`
+	RoleClaim     string           `json:"roleClaim"`
+	RoleMappings  []SSORoleMapping `json:"roleMappings"`
+	DefaultRoleID *int64           `json:"defaultRoleId"`
+}
+
+type StartSSOLoginRequest struct {
+	TenantID int64 `json:"tenantId"`
+}
+
+// SSOAuthorizationDTO is the URL of the identity provider to send the user
+// to. State binds the login to the browser that started it; it goes into a
+// cookie, never into the body.
+type SSOAuthorizationDTO struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+	State            string `json:"-"`
+}
+
+// OIDCCallbackRequest completes a login with the state and code the identity
+// provider redirected back with. BrowserState is the state kept in the
+// cookie of the browser.
+type OIDCCallbackRequest struct {
+	State        string `json:"state"`
+	Code         string `json:"code"`
+	BrowserState string `json:"-"`
+}
+
+// SAMLProviderDTO is the SAML identity provider of a tenant, with the entity
+// ID and assertion consumer service URL of the service provider to register
+// with it.
+type SAMLProviderDTO struct {
+	TenantID          int64            `json:"tenantId"`
+	Enabled           bool             `json:"enabled"`
+	IDPEntityID       string           `json:"idpEntityId"`
+	IDPSSOURL         string           `json:"idpSsoUrl"`
+	IDPCertificate    string           `json:"idpCertificate"`
+	EmailAttribute    string           `json:"emailAttribute"`
+	NameAttribute     string           `json:"nameAttribute"`
+	UsernameAttribute string           `json:"usernameAttribute"`
+	RoleAttribute     string           `json:"roleAttribute"`
+	RoleMappings      []SSORoleMapping `json:"roleMappings"`
+	DefaultRoleID     *int64           `json:"defaultRoleId"`
+	LinkExistingUsers bool             `json:"linkExistingUsers"`
+	EntityID          string           `json:"entityId"`
+	ACSURL            string           `json:"acsUrl"`
+}
+
+type GetSAMLProviderRequest struct {
+	TenantID int64 `json:"tenantId"`
+}
+
+// SetSAMLProviderRequest creates or replaces the provider of the tenant.
+// LinkExistingUsers lets the provider log in users of the tenant that exist
+// already, found by the email it asserts.
+type SetSAMLProviderRequest struct {
+	TenantID          int64            `json:"tenantId"`
+	Enabled           bool             `json:"enabled"`
+	IDPEntityID       string           `json:"idpEntityId"`
+	IDPSSOURL         string           `json:"idpSsoUrl"`
+	IDPCertificate    string           `json:"idpCertificate"`
+	EmailAttribute    string           `json:"emailAttribute"`
+	NameAttribute     string           `json:"nameAttribute"`
+	UsernameAttribute string           `json:"usernameAttribute"`
+	RoleAttribute     string           `json:"roleAttribute"`
+	RoleMappings      []SSORoleMapping `json:"roleMappings"`
+	DefaultRoleID     *int64           `json:"defaultRoleId"`
+	LinkExistingUsers bool             `json:"linkExistingUsers"`
+}
+
+type GetSAMLMetadataRequest struct {
+	TenantID int64 `json:"tenantId"`
+}
+
+// SAMLAssertionRequest is the response of the identity provider as posted
+// to the assertion consumer service. BrowserState is the relay state kept in
+// the cookie of the browser.
+type SAMLAssertionRequest struct {
+	SAMLResponse string `json:"SAMLResponse"`
+	RelayState   string `json:"RelayState"`
+	BrowserState string `json:"-"`
+}
+
+// SSOLoginCodeDTO hands a finished login over to the frontend, which trades
+// the code for tokens.
+type SSOLoginCodeDTO struct {
+	Code string `json:"code"`
+}
+
+type SSOLoginCodeRequest struct {
+	Code string `json:"code"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (p OIDCProviderDTO) ToTypes() *types.OidcProviderResponse {
+	roleMappings := make([]types.SsoRoleMapping, len(p.RoleMappings))
+	for i, mapping := range p.RoleMappings {
+		roleMappings[i] = types.SsoRoleMapping{
+			Value:  mapping.Value,
+			RoleId: mapping.RoleID,
+		}
+	}
+
+	return &types.OidcProviderResponse{
+		TenantId:        &p.TenantID,
+		Enabled:         &p.Enabled,
+		Issuer:          &p.Issuer,
+		ClientId:        &p.ClientID,
+		ClientSecretSet: &p.ClientSecretSet,
+		Scopes:          &p.Scopes,
+		RoleClaim:       &p.RoleClaim,
+		RoleMappings:    &roleMappings,
+		DefaultRoleId:   p.DefaultRoleID,
+	}
+}
+
+func (a SSOAuthorizationDTO) ToTypes() *types.SsoAuthorizationResponse {
+	return &types.SsoAuthorizationResponse{
+		AuthorizationUrl: &a.AuthorizationURL,
+	}
+}
+
+func (p SAMLProviderDTO) ToTypes() *types.SamlProviderResponse {
+	roleMappings := make([]types.SsoRoleMapping, len(p.RoleMappings))
+	for i, mapping := range p.RoleMappings {
+		roleMappings[i] = types.SsoRoleMapping{
+			Value:  mapping.Value,
+			RoleId: mapping.RoleID,
+		}
+	}
+
+	return &types.SamlProviderResponse{
+		TenantId:          &p.TenantID,
+		Enabled:           &p.Enabled,
+		IdpEntityId:       &p.IDPEntityID,
+		IdpSsoUrl:         &p.IDPSSOURL,
+		IdpCertificate:    &p.IDPCertificate,
+		EmailAttribute:    &p.EmailAttribute,
+		NameAttribute:     &p.NameAttribute,
+		UsernameAttribute: &p.UsernameAttribute,
+		RoleAttribute:     &p.RoleAttribute,
+		RoleMappings:      &roleMappings,
+		DefaultRoleId:     p.DefaultRoleID,
+		LinkExistingUsers: &p.LinkExistingUsers,
+		EntityId:          &p.EntityID,
+		AcsUrl:            &p.ACSURL,
+	}
+}
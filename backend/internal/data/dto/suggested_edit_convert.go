@@ -0,0 +1,44 @@
+package dto
+
+import "cuhara.qua.go/internal/types"
+
+func (e *SuggestedEditDTO) ToTypes() *types.SuggestedEditResponse {
+	return &types.SuggestedEditResponse{
+		Id:            &e.ID,
+		PostId:        &e.PostID,
+		AnswerId:      e.AnswerID,
+		SuggesterId:   &e.SuggesterID,
+		Title:         e.Title,
+		Body:          &e.Body,
+		Comment:       e.Comment,
+		BaseVersion:   &e.BaseVersion,
+		Status:        &e.Status,
+		ReviewerId:    e.ReviewerID,
+		ReviewComment: e.ReviewComment,
+		ReviewedAt:    e.ReviewedAt,
+		CreatedAt:     &e.CreatedAt,
+	}
+}
+
+func (d *SuggestedEditDiffDTO) ToTypes() *types.SuggestedEditDiffResponse {
+	return &types.SuggestedEditDiffResponse{
+		Id:             &d.ID,
+		BaseVersion:    &d.BaseVersion,
+		CurrentVersion: &d.CurrentVersion,
+		Outdated:       &d.Outdated,
+		Title:          diffLinesToTypes(d.Title),
+		Body:           diffLinesToTypes(d.Body),
+	}
+}
+
+func diffLinesToTypes(lines []DiffLineDTO) *[]types.DiffLineResponse {
+	responses := make([]types.DiffLineResponse, len(lines))
+	for i := range lines {
+		responses[i] = types.DiffLineResponse{
+			Op:   &lines[i].Op,
+			Text: &lines[i].Text,
+		}
+	}
+
+	return &responses
+}
@@ -0,0 +1,62 @@
+package dto
+
+import "time"
+
+type SuggestedEditDTO struct {
+	ID            int64      `json:"id"`
+	PostID        int64      `json:"postId"`
+	AnswerID      *int64     `json:"answerId"`
+	SuggesterID   int64      `json:"suggesterId"`
+	Title         *string    `json:"title"`
+	Body          string     `json:"body"`
+	Comment       *string    `json:"comment"`
+	BaseVersion   int        `json:"baseVersion"`
+	Status        string     `json:"status"`
+	ReviewerID    *int64     `json:"reviewerId"`
+	ReviewComment *string    `json:"reviewComment"`
+	ReviewedAt    *time.Time `json:"reviewedAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+// SuggestEditRequest proposes an edit of a post, or of one of its answers
+// when AnswerID is set. Titles can only be suggested for posts.
+type SuggestEditRequest struct {
+	PostID   int64   `json:"postId"`
+	AnswerID *int64  `json:"answerId"`
+	Title    *string `json:"title"`
+	Body     string  `json:"body"`
+	Comment  *string `json:"comment"`
+}
+
+type GetSuggestedEditsRequest struct {
+	PostID *int64 `json:"postId"`
+}
+
+type GetSuggestedEditDiffRequest struct {
+	ID int64 `json:"id"`
+}
+
+// DiffLineDTO is a line kept, inserted or deleted by a suggested edit.
+type DiffLineDTO struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type SuggestedEditDiffDTO struct {
+	ID             int64         `json:"id"`
+	BaseVersion    int           `json:"baseVersion"`
+	CurrentVersion int           `json:"currentVersion"`
+	Outdated       bool          `json:"outdated"`
+	Title          []DiffLineDTO `json:"title"`
+	Body           []DiffLineDTO `json:"body"`
+}
+
+// ReviewSuggestedEditRequest approves or rejects a suggested edit. Title and
+// Body improve the suggestion before it is applied.
+type ReviewSuggestedEditRequest struct {
+	ID       int64   `json:"id"`
+	Approved bool    `json:"approved"`
+	Title    *string `json:"title"`
+	Body     *string `json:"body"`
+	Comment  *string `json:"comment"`
+}
@@ -6,6 +6,11 @@ func (t *TenantDTO) ToTypes() *types.TenantResponse {
 	return &types.TenantResponse{
 		Id: &t.ID,
 		Name: &t.Name,
+		AllowAnonymousPosts: &t.AllowAnonymousPosts,
+		ReactionEmojis: &t.ReactionEmojis,
+		WikiEditMinReputation: &t.WikiEditMinReputation,
+		UnverifiedUserAccess: &t.UnverifiedUserAccess,
+		MfaRequiredRoleIds: &t.MFARequiredRoleIDs,
 	}
 }
 
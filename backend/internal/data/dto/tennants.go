@@ -1,8 +1,13 @@
 package dto
 
 type TenantDTO struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID                    int64    `json:"id"`
+	Name                  string   `json:"name"`
+	AllowAnonymousPosts   bool     `json:"allowAnonymousPosts"`
+	ReactionEmojis        []string `json:"reactionEmojis"`
+	WikiEditMinReputation int      `json:"wikiEditMinReputation"`
+	UnverifiedUserAccess  string   `json:"unverifiedUserAccess"`
+	MFARequiredRoleIDs    []int64  `json:"mfaRequiredRoleIds"`
 }
 
 type CreateTenantRequest struct {
@@ -14,8 +19,13 @@ type CreateTenantResponse struct {
 }
 
 type UpdateTenantRequest struct {
-	ID   int64   `json:"id"`
-	Name *string `json:"name"`
+	ID                    int64     `json:"id"`
+	Name                  *string   `json:"name"`
+	AllowAnonymousPosts   *bool     `json:"allowAnonymousPosts"`
+	ReactionEmojis        *[]string `json:"reactionEmojis"`
+	WikiEditMinReputation *int      `json:"wikiEditMinReputation"`
+	UnverifiedUserAccess  *string   `json:"unverifiedUserAccess"`
+	MFARequiredRoleIDs    *[]int64  `json:"mfaRequiredRoleIds"`
 }
 
 type UpdateTenantResponse struct {
@@ -4,8 +4,26 @@ import "cuhara.qua.go/internal/types"
 
 func (t *TopicDTO) ToTypes() *types.TopicResponse {
 	return &types.TopicResponse{
-		Id:   &t.ID,
-		Name: &t.Name,
+		Id:          &t.ID,
+		Name:        &t.Name,
+		Description: &t.Description,
+		Icon:        t.Icon,
+		Color:       t.Color,
+		Archived:    &t.Archived,
+		Position:    &t.Position,
+		Stats:       t.Stats.ToTypes(),
+	}
+}
+
+func (s *TopicStatsDTO) ToTypes() *types.TopicStatsResponse {
+	if s == nil {
+		return nil
+	}
+
+	return &types.TopicStatsResponse{
+		PostCount:        &s.PostCount,
+		UnansweredCount:  &s.UnansweredCount,
+		LatestActivityAt: s.LatestActivityAt,
 	}
 }
 
@@ -27,11 +45,24 @@ func (d *DeleteTopicResponse) ToTypes() *types.DeleteTopicResponse {
 	}
 }
 
+func (r *ReorderTopicsResponse) ToTypes() *types.ReorderTopicsResponse {
+	return &types.ReorderTopicsResponse{
+		Ids: &r.IDs,
+	}
+}
+
 func (s *SubTopicDTO) ToTypes() *types.SubTopicResponse {
 	return &types.SubTopicResponse{
-		Id:   &s.ID,
-		Name: &s.Name,
-		Topic: s.Topic.ToTypes(),
+		Id:                      &s.ID,
+		Name:                    &s.Name,
+		Description:             &s.Description,
+		Icon:                    s.Icon,
+		Color:                   s.Color,
+		Archived:                &s.Archived,
+		Position:                &s.Position,
+		FirstReplyTargetMinutes: s.FirstReplyTargetMinutes,
+		Stats:                   s.Stats.ToTypes(),
+		Topic:                   s.Topic.ToTypes(),
 	}
 }
 
@@ -51,4 +82,42 @@ func (d *DeleteSubTopicResponse) ToTypes() *types.DeleteSubTopicResponse {
 	return &types.DeleteSubTopicResponse{
 		Id: &d.ID,
 	}
-}
\ No newline at end of file
+}
+
+func (r *ReorderSubTopicsResponse) ToTypes() *types.ReorderSubTopicsResponse {
+	return &types.ReorderSubTopicsResponse{
+		TopicId: &r.TopicID,
+		Ids:     &r.IDs,
+	}
+}
+
+func (a *TopicAccessDTO) ToTypes() *types.TopicAccessResponse {
+	return &types.TopicAccessResponse{
+		RoleIds:  &a.RoleIDs,
+		ClaimIds: &a.ClaimIDs,
+	}
+}
+func (q *QuestionTemplateDTO) ToTypes() *types.QuestionTemplateResponse {
+	fields := make([]types.QuestionTemplateField, len(q.Fields))
+	for i := range q.Fields {
+		fields[i] = *q.Fields[i].ToTypes()
+	}
+
+	return &types.QuestionTemplateResponse{
+		Body:   &q.Body,
+		Fields: &fields,
+	}
+}
+
+func (f *QuestionTemplateFieldDTO) ToTypes() *types.QuestionTemplateField {
+	return &types.QuestionTemplateField{
+		Name:     f.Name,
+		Label:    f.Label,
+		Type:     f.Type,
+		Required: &f.Required,
+		Pattern:  f.Pattern,
+		Min:      f.Min,
+		Max:      f.Max,
+		Options:  &f.Options,
+	}
+}
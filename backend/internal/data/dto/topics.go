@@ -1,12 +1,31 @@
 package dto
 
+import "time"
+
 type TopicDTO struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID          int64          `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Icon        *string        `json:"icon"`
+	Color       *string        `json:"color"`
+	Archived    bool           `json:"archived"`
+	Position    int            `json:"position"`
+	Stats       *TopicStatsDTO `json:"stats"`
+}
+
+// TopicStatsDTO summarizes the posts of a topic or sub topic. Merged posts
+// are not counted.
+type TopicStatsDTO struct {
+	PostCount        int        `json:"postCount"`
+	UnansweredCount  int        `json:"unansweredCount"`
+	LatestActivityAt *time.Time `json:"latestActivityAt"`
 }
 
 type CreateTopicRequest struct {
-	Name string `json:"name"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Icon        *string `json:"icon"`
+	Color       *string `json:"color"`
 }
 
 type CreateTopicResponse struct {
@@ -14,8 +33,12 @@ type CreateTopicResponse struct {
 }
 
 type UpdateTopicRequest struct {
-	ID   int64   `json:"id"`
-	Name *string `json:"name"`
+	ID          int64   `json:"id"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Icon        *string `json:"icon"`
+	Color       *string `json:"color"`
+	Archived    *bool   `json:"archived"`
 }
 
 type UpdateTopicResponse struct {
@@ -30,10 +53,25 @@ type DeleteTopicResponse struct {
 	ID int64 `json:"id"`
 }
 
+type ReorderTopicsRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+type ReorderTopicsResponse struct {
+	IDs []int64 `json:"ids"`
+}
+
 type SubTopicDTO struct {
-	ID    int64    `json:"id"`
-	Name  string   `json:"name"`
-	Topic TopicDTO `json:"topic"`
+	ID                      int64          `json:"id"`
+	Name                    string         `json:"name"`
+	Description             string         `json:"description"`
+	Icon                    *string        `json:"icon"`
+	Color                   *string        `json:"color"`
+	Archived                bool           `json:"archived"`
+	Position                int            `json:"position"`
+	FirstReplyTargetMinutes *int           `json:"firstReplyTargetMinutes"`
+	Stats                   *TopicStatsDTO `json:"stats"`
+	Topic                   TopicDTO       `json:"topic"`
 }
 
 type GetSubTopicsRequest struct {
@@ -41,8 +79,12 @@ type GetSubTopicsRequest struct {
 }
 
 type CreateSubTopicRequest struct {
-	TopicID int64  `json:"topicId"`
-	Name    string `json:"name"`
+	TopicID                 int64   `json:"topicId"`
+	Name                    string  `json:"name"`
+	Description             *string `json:"description"`
+	Icon                    *string `json:"icon"`
+	Color                   *string `json:"color"`
+	FirstReplyTargetMinutes *int    `json:"firstReplyTargetMinutes"`
 }
 
 type CreateSubTopicResponse struct {
@@ -50,9 +92,14 @@ type CreateSubTopicResponse struct {
 }
 
 type UpdateSubTopicRequest struct {
-	ID      int64   `json:"id"`
-	TopicID int64   `json:"topicId"`
-	Name    *string `json:"name"`
+	ID                      int64   `json:"id"`
+	TopicID                 int64   `json:"topicId"`
+	Name                    *string `json:"name"`
+	Description             *string `json:"description"`
+	Icon                    *string `json:"icon"`
+	Color                   *string `json:"color"`
+	Archived                *bool   `json:"archived"`
+	FirstReplyTargetMinutes *int    `json:"firstReplyTargetMinutes"`
 }
 
 type UpdateSubTopicResponse struct {
@@ -60,10 +107,111 @@ type UpdateSubTopicResponse struct {
 }
 
 type DeleteSubTopicRequest struct {
+	ID         int64  `json:"id"`
+	TopicID    int64  `json:"topicId"`
+	ReassignTo *int64 `json:"reassignTo"`
+}
+
+type DeleteSubTopicResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ReorderSubTopicsRequest struct {
+	TopicID int64   `json:"topicId"`
+	IDs     []int64 `json:"ids"`
+}
+
+type ReorderSubTopicsResponse struct {
+	TopicID int64   `json:"topicId"`
+	IDs     []int64 `json:"ids"`
+}
+
+type GetSubTopicRespondersRequest struct {
 	ID      int64 `json:"id"`
 	TopicID int64 `json:"topicId"`
 }
 
-type DeleteSubTopicResponse struct {
+type SetSubTopicRespondersRequest struct {
+	ID      int64   `json:"id"`
+	TopicID int64   `json:"topicId"`
+	UserIDs []int64 `json:"userIds"`
+}
+
+// TopicAccessDTO is the access list of a topic or sub topic. Empty lists
+// make it public to the tenant.
+type TopicAccessDTO struct {
+	RoleIDs  []int64 `json:"roleIds"`
+	ClaimIDs []int64 `json:"claimIds"`
+}
+
+type GetTopicAccessRequest struct {
+	ID int64 `json:"id"`
+}
+
+type SetTopicAccessRequest struct {
+	ID       int64   `json:"id"`
+	RoleIDs  []int64 `json:"roleIds"`
+	ClaimIDs []int64 `json:"claimIds"`
+}
+
+type GetSubTopicAccessRequest struct {
+	ID      int64 `json:"id"`
+	TopicID int64 `json:"topicId"`
+}
+
+type SetSubTopicAccessRequest struct {
+	ID       int64   `json:"id"`
+	TopicID  int64   `json:"topicId"`
+	RoleIDs  []int64 `json:"roleIds"`
+	ClaimIDs []int64 `json:"claimIds"`
+}
+
+type GetTopicModeratorsRequest struct {
 	ID int64 `json:"id"`
 }
+
+type SetTopicModeratorsRequest struct {
+	ID      int64   `json:"id"`
+	UserIDs []int64 `json:"userIds"`
+}
+
+type GetSubTopicModeratorsRequest struct {
+	ID      int64 `json:"id"`
+	TopicID int64 `json:"topicId"`
+}
+
+type SetSubTopicModeratorsRequest struct {
+	ID      int64   `json:"id"`
+	TopicID int64   `json:"topicId"`
+	UserIDs []int64 `json:"userIds"`
+}
+
+// QuestionTemplateDTO is the question template of a sub topic: the Markdown
+// new posts are prefilled with and the structured fields they fill in.
+type QuestionTemplateDTO struct {
+	Body   string                     `json:"body"`
+	Fields []QuestionTemplateFieldDTO `json:"fields"`
+}
+
+type QuestionTemplateFieldDTO struct {
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Pattern  *string  `json:"pattern"`
+	Min      *float64 `json:"min"`
+	Max      *float64 `json:"max"`
+	Options  []string `json:"options"`
+}
+
+type GetQuestionTemplateRequest struct {
+	ID      int64 `json:"id"`
+	TopicID int64 `json:"topicId"`
+}
+
+type SetQuestionTemplateRequest struct {
+	ID      int64                      `json:"id"`
+	TopicID int64                      `json:"topicId"`
+	Body    string                     `json:"body"`
+	Fields  []QuestionTemplateFieldDTO `json:"fields"`
+}
@@ -1,22 +1,117 @@
 package dto
 
+import "time"
+
 type UserDTO struct {
-	ID         int64   `json:"id"`
-	Name       string  `json:"name"`
-	Email      string  `json:"email"`
-	VscAccount string  `json:"vscAccount"`
-	RoleDTO    RoleDTO `json:"role"`
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	VscAccount      string     `json:"vscAccount"`
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt"`
+	RoleDTO         RoleDTO    `json:"role"`
 }
 
+// LoginRequest is a password login. IPAddress is the client IP failed logins
+// are also counted by.
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	IPAddress string `json:"ipAddress"`
 }
 
+// LoginResponse carries a short lived access token and the refresh token to
+// get the next one. ExpiresIn is the lifetime of the access token in seconds.
+// Users with two factor authentication get no tokens at first but an
+// MFAToken to complete the login with.
 type LoginResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+	ExpiresIn    int64  `json:"expiresIn"`
+	MFARequired  bool   `json:"mfaRequired"`
+	MFAToken     string `json:"mfaToken"`
+}
+
+// VerifyMFARequest completes a login with either a TOTP code or a recovery
+// code.
+type VerifyMFARequest struct {
+	MFAToken     string  `json:"mfaToken"`
+	Code         *string `json:"code"`
+	RecoveryCode *string `json:"recoveryCode"`
+}
+
+// TOTPEnrollmentDTO is a new TOTP secret and the otpauth URI to show as QR
+// code.
+type TOTPEnrollmentDTO struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningUri"`
+}
+
+type ConfirmTOTPRequest struct {
+	Code string `json:"code"`
+}
+
+type RecoveryCodesDTO struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type DisableTOTPRequest struct {
+	Password     string  `json:"password"`
+	Code         *string `json:"code"`
+	RecoveryCode *string `json:"recoveryCode"`
+}
+
+type DisableTOTPResponse struct {
+	ID int64 `json:"id"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// LogoutRequest revokes the access token of the request and, when given, the
+// refresh token together with every token rotated from the same login.
+type LogoutRequest struct {
+	RefreshToken *string `json:"refreshToken"`
+}
+
+type LogoutResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ForgotPasswordResponse struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
+
+type ResetPasswordResponse struct {
+	ID int64 `json:"id"`
+}
+
+type VerifyEmailRequest struct {
 	Token string `json:"token"`
 }
 
+type VerifyEmailResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ResendVerificationResponse struct {
+	ID int64 `json:"id"`
+}
+
 type RegisterRequest struct {
 	Name       string `json:"name"`
 	Email      string `json:"email"`
@@ -48,3 +143,32 @@ type DeleteUserRequest struct {
 type DeleteUserResponse struct {
 	ID int64 `json:"id"`
 }
+
+type RevokeSessionsRequest struct {
+	ID int64 `json:"id"`
+}
+
+type RevokeSessionsResponse struct {
+	ID int64 `json:"id"`
+}
+
+type UnlockUserRequest struct {
+	ID int64 `json:"id"`
+}
+
+type UnlockUserResponse struct {
+	ID int64 `json:"id"`
+}
+
+type GetModeratedScopesRequest struct {
+	ID int64 `json:"id"`
+}
+
+// ModeratedScopeDTO is a topic or sub topic a user moderates. Exactly one of
+// TopicID and SubTopicID is set.
+type ModeratedScopeDTO struct {
+	ID         int64  `json:"id"`
+	TopicID    *int64 `json:"topicId"`
+	SubTopicID *int64 `json:"subTopicId"`
+	Name       string `json:"name"`
+}
@@ -4,10 +4,11 @@ import "cuhara.qua.go/internal/types"
 
 func (u UserDTO) ToTypes() *types.UserResponse {
 	return &types.UserResponse{
-		Id:         &u.ID,
-		Name:       &u.Name,
-		Email:      &u.Email,
-		VscAccount: &u.VscAccount,
+		Id:              &u.ID,
+		Name:            &u.Name,
+		Email:           &u.Email,
+		VscAccount:      &u.VscAccount,
+		EmailVerifiedAt: u.EmailVerifiedAt,
 		Role: &types.RoleResponse{
 			Id:   &u.RoleDTO.ID,
 			Name: &u.RoleDTO.Name,
@@ -17,7 +18,60 @@ func (u UserDTO) ToTypes() *types.UserResponse {
 
 func (l LoginResponse) ToTypes() *types.LoginResponse {
 	return &types.LoginResponse{
-		Token: &l.Token,
+		Token:        &l.Token,
+		RefreshToken: &l.RefreshToken,
+		ExpiresIn:    &l.ExpiresIn,
+		MfaRequired:  &l.MFARequired,
+		MfaToken:     &l.MFAToken,
+	}
+}
+
+func (t TOTPEnrollmentDTO) ToTypes() *types.TotpEnrollmentResponse {
+	return &types.TotpEnrollmentResponse{
+		Secret:          &t.Secret,
+		ProvisioningUri: &t.ProvisioningURI,
+	}
+}
+
+func (r RecoveryCodesDTO) ToTypes() *types.RecoveryCodesResponse {
+	return &types.RecoveryCodesResponse{
+		RecoveryCodes: &r.RecoveryCodes,
+	}
+}
+
+func (d DisableTOTPResponse) ToTypes() *types.DisableTotpResponse {
+	return &types.DisableTotpResponse{
+		Id: &d.ID,
+	}
+}
+
+func (l LogoutResponse) ToTypes() *types.LogoutResponse {
+	return &types.LogoutResponse{
+		Id: &l.ID,
+	}
+}
+
+func (f ForgotPasswordResponse) ToTypes() *types.ForgotPasswordResponse {
+	return &types.ForgotPasswordResponse{
+		Email: &f.Email,
+	}
+}
+
+func (r ResetPasswordResponse) ToTypes() *types.ResetPasswordResponse {
+	return &types.ResetPasswordResponse{
+		Id: &r.ID,
+	}
+}
+
+func (v VerifyEmailResponse) ToTypes() *types.VerifyEmailResponse {
+	return &types.VerifyEmailResponse{
+		Id: &v.ID,
+	}
+}
+
+func (r ResendVerificationResponse) ToTypes() *types.ResendVerificationResponse {
+	return &types.ResendVerificationResponse{
+		Id: &r.ID,
 	}
 }
 
@@ -38,3 +92,24 @@ func (d DeleteUserResponse) ToTypes() *types.DeleteUserResponse {
 		Id: &d.ID,
 	}
 }
+
+func (r RevokeSessionsResponse) ToTypes() *types.RevokeSessionsResponse {
+	return &types.RevokeSessionsResponse{
+		Id: &r.ID,
+	}
+}
+
+func (r UnlockUserResponse) ToTypes() *types.UnlockUserResponse {
+	return &types.UnlockUserResponse{
+		Id: &r.ID,
+	}
+}
+
+func (m ModeratedScopeDTO) ToTypes() *types.ModeratedScopeResponse {
+	return &types.ModeratedScopeResponse{
+		Id:         &m.ID,
+		TopicId:    m.TopicID,
+		SubTopicId: m.SubTopicID,
+		Name:       &m.Name,
+	}
+}
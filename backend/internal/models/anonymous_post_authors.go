@@ -0,0 +1,1604 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AnonymousPostAuthor is an object representing the database table.
+type AnonymousPostAuthor struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PostID    int64     `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TenantID  int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *anonymousPostAuthorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L anonymousPostAuthorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AnonymousPostAuthorColumns = struct {
+	ID        string
+	PostID    string
+	UserID    string
+	TenantID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	PostID:    "post_id",
+	UserID:    "user_id",
+	TenantID:  "tenant_id",
+	CreatedAt: "created_at",
+}
+
+var AnonymousPostAuthorTableColumns = struct {
+	ID        string
+	PostID    string
+	UserID    string
+	TenantID  string
+	CreatedAt string
+}{
+	ID:        "anonymous_post_authors.id",
+	PostID:    "anonymous_post_authors.post_id",
+	UserID:    "anonymous_post_authors.user_id",
+	TenantID:  "anonymous_post_authors.tenant_id",
+	CreatedAt: "anonymous_post_authors.created_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AnonymousPostAuthorWhere = struct {
+	ID        whereHelperint64
+	PostID    whereHelperint64
+	UserID    whereHelperint64
+	TenantID  whereHelperint64
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"anonymous_post_authors\".\"id\""},
+	PostID:    whereHelperint64{field: "\"anonymous_post_authors\".\"post_id\""},
+	UserID:    whereHelperint64{field: "\"anonymous_post_authors\".\"user_id\""},
+	TenantID:  whereHelperint64{field: "\"anonymous_post_authors\".\"tenant_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"anonymous_post_authors\".\"created_at\""},
+}
+
+// AnonymousPostAuthorRels is where relationship names are stored.
+var AnonymousPostAuthorRels = struct {
+	Post   string
+	Tenant string
+	User   string
+}{
+	Post:   "Post",
+	Tenant: "Tenant",
+	User:   "User",
+}
+
+// anonymousPostAuthorR is where relationships are stored.
+type anonymousPostAuthorR struct {
+	Post   *Post   `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	User   *User   `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*anonymousPostAuthorR) NewStruct() *anonymousPostAuthorR {
+	return &anonymousPostAuthorR{}
+}
+
+func (o *AnonymousPostAuthor) GetPost() *Post {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPost()
+}
+
+func (r *anonymousPostAuthorR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+
+	return r.Post
+}
+
+func (o *AnonymousPostAuthor) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *anonymousPostAuthorR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *AnonymousPostAuthor) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *anonymousPostAuthorR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// anonymousPostAuthorL is where Load methods for each relationship are stored.
+type anonymousPostAuthorL struct{}
+
+var (
+	anonymousPostAuthorAllColumns            = []string{"id", "post_id", "user_id", "tenant_id", "created_at"}
+	anonymousPostAuthorColumnsWithoutDefault = []string{"post_id", "user_id", "tenant_id"}
+	anonymousPostAuthorColumnsWithDefault    = []string{"id", "created_at"}
+	anonymousPostAuthorPrimaryKeyColumns     = []string{"id"}
+	anonymousPostAuthorGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// AnonymousPostAuthorSlice is an alias for a slice of pointers to AnonymousPostAuthor.
+	// This should almost always be used instead of []AnonymousPostAuthor.
+	AnonymousPostAuthorSlice []*AnonymousPostAuthor
+	// AnonymousPostAuthorHook is the signature for custom AnonymousPostAuthor hook methods
+	AnonymousPostAuthorHook func(context.Context, boil.ContextExecutor, *AnonymousPostAuthor) error
+
+	anonymousPostAuthorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	anonymousPostAuthorType                 = reflect.TypeOf(&AnonymousPostAuthor{})
+	anonymousPostAuthorMapping              = queries.MakeStructMapping(anonymousPostAuthorType)
+	anonymousPostAuthorPrimaryKeyMapping, _ = queries.BindMapping(anonymousPostAuthorType, anonymousPostAuthorMapping, anonymousPostAuthorPrimaryKeyColumns)
+	anonymousPostAuthorInsertCacheMut       sync.RWMutex
+	anonymousPostAuthorInsertCache          = make(map[string]insertCache)
+	anonymousPostAuthorUpdateCacheMut       sync.RWMutex
+	anonymousPostAuthorUpdateCache          = make(map[string]updateCache)
+	anonymousPostAuthorUpsertCacheMut       sync.RWMutex
+	anonymousPostAuthorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var anonymousPostAuthorAfterSelectMu sync.Mutex
+var anonymousPostAuthorAfterSelectHooks []AnonymousPostAuthorHook
+
+var anonymousPostAuthorBeforeInsertMu sync.Mutex
+var anonymousPostAuthorBeforeInsertHooks []AnonymousPostAuthorHook
+var anonymousPostAuthorAfterInsertMu sync.Mutex
+var anonymousPostAuthorAfterInsertHooks []AnonymousPostAuthorHook
+
+var anonymousPostAuthorBeforeUpdateMu sync.Mutex
+var anonymousPostAuthorBeforeUpdateHooks []AnonymousPostAuthorHook
+var anonymousPostAuthorAfterUpdateMu sync.Mutex
+var anonymousPostAuthorAfterUpdateHooks []AnonymousPostAuthorHook
+
+var anonymousPostAuthorBeforeDeleteMu sync.Mutex
+var anonymousPostAuthorBeforeDeleteHooks []AnonymousPostAuthorHook
+var anonymousPostAuthorAfterDeleteMu sync.Mutex
+var anonymousPostAuthorAfterDeleteHooks []AnonymousPostAuthorHook
+
+var anonymousPostAuthorBeforeUpsertMu sync.Mutex
+var anonymousPostAuthorBeforeUpsertHooks []AnonymousPostAuthorHook
+var anonymousPostAuthorAfterUpsertMu sync.Mutex
+var anonymousPostAuthorAfterUpsertHooks []AnonymousPostAuthorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AnonymousPostAuthor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AnonymousPostAuthor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AnonymousPostAuthor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AnonymousPostAuthor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AnonymousPostAuthor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AnonymousPostAuthor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AnonymousPostAuthor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AnonymousPostAuthor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AnonymousPostAuthor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range anonymousPostAuthorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAnonymousPostAuthorHook registers your hook function for all future operations.
+func AddAnonymousPostAuthorHook(hookPoint boil.HookPoint, anonymousPostAuthorHook AnonymousPostAuthorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		anonymousPostAuthorAfterSelectMu.Lock()
+		anonymousPostAuthorAfterSelectHooks = append(anonymousPostAuthorAfterSelectHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		anonymousPostAuthorBeforeInsertMu.Lock()
+		anonymousPostAuthorBeforeInsertHooks = append(anonymousPostAuthorBeforeInsertHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		anonymousPostAuthorAfterInsertMu.Lock()
+		anonymousPostAuthorAfterInsertHooks = append(anonymousPostAuthorAfterInsertHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		anonymousPostAuthorBeforeUpdateMu.Lock()
+		anonymousPostAuthorBeforeUpdateHooks = append(anonymousPostAuthorBeforeUpdateHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		anonymousPostAuthorAfterUpdateMu.Lock()
+		anonymousPostAuthorAfterUpdateHooks = append(anonymousPostAuthorAfterUpdateHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		anonymousPostAuthorBeforeDeleteMu.Lock()
+		anonymousPostAuthorBeforeDeleteHooks = append(anonymousPostAuthorBeforeDeleteHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		anonymousPostAuthorAfterDeleteMu.Lock()
+		anonymousPostAuthorAfterDeleteHooks = append(anonymousPostAuthorAfterDeleteHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		anonymousPostAuthorBeforeUpsertMu.Lock()
+		anonymousPostAuthorBeforeUpsertHooks = append(anonymousPostAuthorBeforeUpsertHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		anonymousPostAuthorAfterUpsertMu.Lock()
+		anonymousPostAuthorAfterUpsertHooks = append(anonymousPostAuthorAfterUpsertHooks, anonymousPostAuthorHook)
+		anonymousPostAuthorAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single anonymousPostAuthor record from the query.
+func (q anonymousPostAuthorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AnonymousPostAuthor, error) {
+	o := &AnonymousPostAuthor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for anonymous_post_authors")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AnonymousPostAuthor records from the query.
+func (q anonymousPostAuthorQuery) All(ctx context.Context, exec boil.ContextExecutor) (AnonymousPostAuthorSlice, error) {
+	var o []*AnonymousPostAuthor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AnonymousPostAuthor slice")
+	}
+
+	if len(anonymousPostAuthorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AnonymousPostAuthor records in the query.
+func (q anonymousPostAuthorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count anonymous_post_authors rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q anonymousPostAuthorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if anonymous_post_authors exists")
+	}
+
+	return count > 0, nil
+}
+
+// Post pointed to by the foreign key.
+func (o *AnonymousPostAuthor) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *AnonymousPostAuthor) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *AnonymousPostAuthor) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (anonymousPostAuthorL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnonymousPostAuthor interface{}, mods queries.Applicator) error {
+	var slice []*AnonymousPostAuthor
+	var object *AnonymousPostAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeAnonymousPostAuthor.(*AnonymousPostAuthor)
+		if !ok {
+			object = new(AnonymousPostAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAnonymousPostAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAnonymousPostAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeAnonymousPostAuthor.(*[]*AnonymousPostAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAnonymousPostAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAnonymousPostAuthor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &anonymousPostAuthorR{}
+		}
+		args[object.PostID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &anonymousPostAuthorR{}
+			}
+
+			args[obj.PostID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.AnonymousPostAuthor = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.AnonymousPostAuthor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (anonymousPostAuthorL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnonymousPostAuthor interface{}, mods queries.Applicator) error {
+	var slice []*AnonymousPostAuthor
+	var object *AnonymousPostAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeAnonymousPostAuthor.(*AnonymousPostAuthor)
+		if !ok {
+			object = new(AnonymousPostAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAnonymousPostAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAnonymousPostAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeAnonymousPostAuthor.(*[]*AnonymousPostAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAnonymousPostAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAnonymousPostAuthor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &anonymousPostAuthorR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &anonymousPostAuthorR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.AnonymousPostAuthors = append(foreign.R.AnonymousPostAuthors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.AnonymousPostAuthors = append(foreign.R.AnonymousPostAuthors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (anonymousPostAuthorL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnonymousPostAuthor interface{}, mods queries.Applicator) error {
+	var slice []*AnonymousPostAuthor
+	var object *AnonymousPostAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeAnonymousPostAuthor.(*AnonymousPostAuthor)
+		if !ok {
+			object = new(AnonymousPostAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAnonymousPostAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAnonymousPostAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeAnonymousPostAuthor.(*[]*AnonymousPostAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAnonymousPostAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAnonymousPostAuthor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &anonymousPostAuthorR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &anonymousPostAuthorR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AnonymousPostAuthors = append(foreign.R.AnonymousPostAuthors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AnonymousPostAuthors = append(foreign.R.AnonymousPostAuthors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPost of the anonymousPostAuthor to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.AnonymousPostAuthor.
+func (o *AnonymousPostAuthor) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, anonymousPostAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &anonymousPostAuthorR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			AnonymousPostAuthor: o,
+		}
+	} else {
+		related.R.AnonymousPostAuthor = o
+	}
+
+	return nil
+}
+
+// SetTenant of the anonymousPostAuthor to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.AnonymousPostAuthors.
+func (o *AnonymousPostAuthor) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, anonymousPostAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &anonymousPostAuthorR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			AnonymousPostAuthors: AnonymousPostAuthorSlice{o},
+		}
+	} else {
+		related.R.AnonymousPostAuthors = append(related.R.AnonymousPostAuthors, o)
+	}
+
+	return nil
+}
+
+// SetUser of the anonymousPostAuthor to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.AnonymousPostAuthors.
+func (o *AnonymousPostAuthor) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, anonymousPostAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &anonymousPostAuthorR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AnonymousPostAuthors: AnonymousPostAuthorSlice{o},
+		}
+	} else {
+		related.R.AnonymousPostAuthors = append(related.R.AnonymousPostAuthors, o)
+	}
+
+	return nil
+}
+
+// AnonymousPostAuthors retrieves all the records using an executor.
+func AnonymousPostAuthors(mods ...qm.QueryMod) anonymousPostAuthorQuery {
+	mods = append(mods, qm.From("\"anonymous_post_authors\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"anonymous_post_authors\".*"})
+	}
+
+	return anonymousPostAuthorQuery{q}
+}
+
+// FindAnonymousPostAuthor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAnonymousPostAuthor(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*AnonymousPostAuthor, error) {
+	anonymousPostAuthorObj := &AnonymousPostAuthor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"anonymous_post_authors\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, anonymousPostAuthorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from anonymous_post_authors")
+	}
+
+	if err = anonymousPostAuthorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return anonymousPostAuthorObj, err
+	}
+
+	return anonymousPostAuthorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AnonymousPostAuthor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no anonymous_post_authors provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(anonymousPostAuthorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	anonymousPostAuthorInsertCacheMut.RLock()
+	cache, cached := anonymousPostAuthorInsertCache[key]
+	anonymousPostAuthorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			anonymousPostAuthorAllColumns,
+			anonymousPostAuthorColumnsWithDefault,
+			anonymousPostAuthorColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, anonymousPostAuthorGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(anonymousPostAuthorType, anonymousPostAuthorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(anonymousPostAuthorType, anonymousPostAuthorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"anonymous_post_authors\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"anonymous_post_authors\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into anonymous_post_authors")
+	}
+
+	if !cached {
+		anonymousPostAuthorInsertCacheMut.Lock()
+		anonymousPostAuthorInsertCache[key] = cache
+		anonymousPostAuthorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AnonymousPostAuthor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AnonymousPostAuthor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	anonymousPostAuthorUpdateCacheMut.RLock()
+	cache, cached := anonymousPostAuthorUpdateCache[key]
+	anonymousPostAuthorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			anonymousPostAuthorAllColumns,
+			anonymousPostAuthorPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, anonymousPostAuthorGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update anonymous_post_authors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, anonymousPostAuthorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(anonymousPostAuthorType, anonymousPostAuthorMapping, append(wl, anonymousPostAuthorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update anonymous_post_authors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for anonymous_post_authors")
+	}
+
+	if !cached {
+		anonymousPostAuthorUpdateCacheMut.Lock()
+		anonymousPostAuthorUpdateCache[key] = cache
+		anonymousPostAuthorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q anonymousPostAuthorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for anonymous_post_authors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for anonymous_post_authors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AnonymousPostAuthorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), anonymousPostAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, anonymousPostAuthorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in anonymousPostAuthor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all anonymousPostAuthor")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AnonymousPostAuthor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no anonymous_post_authors provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(anonymousPostAuthorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	anonymousPostAuthorUpsertCacheMut.RLock()
+	cache, cached := anonymousPostAuthorUpsertCache[key]
+	anonymousPostAuthorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			anonymousPostAuthorAllColumns,
+			anonymousPostAuthorColumnsWithDefault,
+			anonymousPostAuthorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			anonymousPostAuthorAllColumns,
+			anonymousPostAuthorPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, anonymousPostAuthorGeneratedColumns)
+		update = strmangle.SetComplement(update, anonymousPostAuthorGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert anonymous_post_authors, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(anonymousPostAuthorAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(anonymousPostAuthorPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert anonymous_post_authors, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(anonymousPostAuthorPrimaryKeyColumns))
+			copy(conflict, anonymousPostAuthorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"anonymous_post_authors\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(anonymousPostAuthorType, anonymousPostAuthorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(anonymousPostAuthorType, anonymousPostAuthorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert anonymous_post_authors")
+	}
+
+	if !cached {
+		anonymousPostAuthorUpsertCacheMut.Lock()
+		anonymousPostAuthorUpsertCache[key] = cache
+		anonymousPostAuthorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AnonymousPostAuthor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AnonymousPostAuthor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AnonymousPostAuthor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), anonymousPostAuthorPrimaryKeyMapping)
+	sql := "DELETE FROM \"anonymous_post_authors\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from anonymous_post_authors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for anonymous_post_authors")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q anonymousPostAuthorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no anonymousPostAuthorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from anonymous_post_authors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for anonymous_post_authors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AnonymousPostAuthorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(anonymousPostAuthorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), anonymousPostAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"anonymous_post_authors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, anonymousPostAuthorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from anonymousPostAuthor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for anonymous_post_authors")
+	}
+
+	if len(anonymousPostAuthorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AnonymousPostAuthor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAnonymousPostAuthor(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AnonymousPostAuthorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AnonymousPostAuthorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), anonymousPostAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"anonymous_post_authors\".* FROM \"anonymous_post_authors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, anonymousPostAuthorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AnonymousPostAuthorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AnonymousPostAuthorExists checks if the AnonymousPostAuthor row exists.
+func AnonymousPostAuthorExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"anonymous_post_authors\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if anonymous_post_authors exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AnonymousPostAuthor row exists.
+func (o *AnonymousPostAuthor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AnonymousPostAuthorExists(ctx, exec, o.ID)
+}
@@ -33,6 +33,8 @@ type Answer struct {
 	TenantID     int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
 	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt    null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Wiki         bool      `boil:"wiki" json:"wiki" toml:"wiki" yaml:"wiki"`
+	Version      int       `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *answerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L answerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +50,8 @@ var AnswerColumns = struct {
 	TenantID     string
 	CreatedAt    string
 	UpdatedAt    string
+	Wiki         string
+	Version      string
 }{
 	ID:           "id",
 	Body:         "body",
@@ -58,6 +62,8 @@ var AnswerColumns = struct {
 	TenantID:     "tenant_id",
 	CreatedAt:    "created_at",
 	UpdatedAt:    "updated_at",
+	Wiki:         "wiki",
+	Version:      "version",
 }
 
 var AnswerTableColumns = struct {
@@ -70,6 +76,8 @@ var AnswerTableColumns = struct {
 	TenantID     string
 	CreatedAt    string
 	UpdatedAt    string
+	Wiki         string
+	Version      string
 }{
 	ID:           "answers.id",
 	Body:         "answers.body",
@@ -80,33 +88,12 @@ var AnswerTableColumns = struct {
 	TenantID:     "answers.tenant_id",
 	CreatedAt:    "answers.created_at",
 	UpdatedAt:    "answers.updated_at",
+	Wiki:         "answers.wiki",
+	Version:      "answers.version",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperstring struct{ field string }
 
 func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -162,27 +149,6 @@ func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
 func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Time struct{ field string }
 
 func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
@@ -207,6 +173,38 @@ func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
 func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var AnswerWhere = struct {
 	ID           whereHelperint64
 	Body         whereHelperstring
@@ -217,6 +215,8 @@ var AnswerWhere = struct {
 	TenantID     whereHelperint64
 	CreatedAt    whereHelpertime_Time
 	UpdatedAt    whereHelpernull_Time
+	Wiki         whereHelperbool
+	Version      whereHelperint
 }{
 	ID:           whereHelperint64{field: "\"answers\".\"id\""},
 	Body:         whereHelperstring{field: "\"answers\".\"body\""},
@@ -227,30 +227,38 @@ var AnswerWhere = struct {
 	TenantID:     whereHelperint64{field: "\"answers\".\"tenant_id\""},
 	CreatedAt:    whereHelpertime_Time{field: "\"answers\".\"created_at\""},
 	UpdatedAt:    whereHelpernull_Time{field: "\"answers\".\"updated_at\""},
+	Wiki:         whereHelperbool{field: "\"answers\".\"wiki\""},
+	Version:      whereHelperint{field: "\"answers\".\"version\""},
 }
 
 // AnswerRels is where relationship names are stored.
 var AnswerRels = struct {
-	Creator  string
-	Post     string
-	Tenant   string
-	Comments string
-	Votes    string
+	Creator        string
+	Post           string
+	Tenant         string
+	Comments       string
+	Reactions      string
+	SuggestedEdits string
+	Votes          string
 }{
-	Creator:  "Creator",
-	Post:     "Post",
-	Tenant:   "Tenant",
-	Comments: "Comments",
-	Votes:    "Votes",
+	Creator:        "Creator",
+	Post:           "Post",
+	Tenant:         "Tenant",
+	Comments:       "Comments",
+	Reactions:      "Reactions",
+	SuggestedEdits: "SuggestedEdits",
+	Votes:          "Votes",
 }
 
 // answerR is where relationships are stored.
 type answerR struct {
-	Creator  *User        `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
-	Post     *Post        `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
-	Tenant   *Tenant      `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	Comments CommentSlice `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
-	Votes    VoteSlice    `boil:"Votes" json:"Votes" toml:"Votes" yaml:"Votes"`
+	Creator        *User              `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
+	Post           *Post              `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Tenant         *Tenant            `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Comments       CommentSlice       `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	Reactions      ReactionSlice      `boil:"Reactions" json:"Reactions" toml:"Reactions" yaml:"Reactions"`
+	SuggestedEdits SuggestedEditSlice `boil:"SuggestedEdits" json:"SuggestedEdits" toml:"SuggestedEdits" yaml:"SuggestedEdits"`
+	Votes          VoteSlice          `boil:"Votes" json:"Votes" toml:"Votes" yaml:"Votes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -322,6 +330,38 @@ func (r *answerR) GetComments() CommentSlice {
 	return r.Comments
 }
 
+func (o *Answer) GetReactions() ReactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReactions()
+}
+
+func (r *answerR) GetReactions() ReactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reactions
+}
+
+func (o *Answer) GetSuggestedEdits() SuggestedEditSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSuggestedEdits()
+}
+
+func (r *answerR) GetSuggestedEdits() SuggestedEditSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SuggestedEdits
+}
+
 func (o *Answer) GetVotes() VoteSlice {
 	if o == nil {
 		return nil
@@ -342,9 +382,9 @@ func (r *answerR) GetVotes() VoteSlice {
 type answerL struct{}
 
 var (
-	answerAllColumns            = []string{"id", "body", "is_accepted", "is_first_reply", "creator_id", "post_id", "tenant_id", "created_at", "updated_at"}
+	answerAllColumns            = []string{"id", "body", "is_accepted", "is_first_reply", "creator_id", "post_id", "tenant_id", "created_at", "updated_at", "wiki", "version"}
 	answerColumnsWithoutDefault = []string{"body", "creator_id", "post_id", "tenant_id"}
-	answerColumnsWithDefault    = []string{"id", "is_accepted", "is_first_reply", "created_at", "updated_at"}
+	answerColumnsWithDefault    = []string{"id", "is_accepted", "is_first_reply", "created_at", "updated_at", "wiki", "version"}
 	answerPrimaryKeyColumns     = []string{"id"}
 	answerGeneratedColumns      = []string{"id"}
 )
@@ -701,6 +741,34 @@ func (o *Answer) Comments(mods ...qm.QueryMod) commentQuery {
 	return Comments(queryMods...)
 }
 
+// Reactions retrieves all the reaction's Reactions with an executor.
+func (o *Answer) Reactions(mods ...qm.QueryMod) reactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reactions\".\"answer_id\"=?", o.ID),
+	)
+
+	return Reactions(queryMods...)
+}
+
+// SuggestedEdits retrieves all the suggested_edit's SuggestedEdits with an executor.
+func (o *Answer) SuggestedEdits(mods ...qm.QueryMod) suggestedEditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"suggested_edits\".\"answer_id\"=?", o.ID),
+	)
+
+	return SuggestedEdits(queryMods...)
+}
+
 // Votes retrieves all the vote's Votes with an executor.
 func (o *Answer) Votes(mods ...qm.QueryMod) voteQuery {
 	var queryMods []qm.QueryMod
@@ -1188,6 +1256,232 @@ func (answerL) LoadComments(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (answerL) LoadReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnswer interface{}, mods queries.Applicator) error {
+	var slice []*Answer
+	var object *Answer
+
+	if singular {
+		var ok bool
+		object, ok = maybeAnswer.(*Answer)
+		if !ok {
+			object = new(Answer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAnswer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAnswer))
+			}
+		}
+	} else {
+		s, ok := maybeAnswer.(*[]*Answer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAnswer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAnswer))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &answerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &answerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reactions`),
+		qm.WhereIn(`reactions.answer_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reactions")
+	}
+
+	var resultSlice []*Reaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reactions")
+	}
+
+	if len(reactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reactionR{}
+			}
+			foreign.R.Answer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AnswerID) {
+				local.R.Reactions = append(local.R.Reactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &reactionR{}
+				}
+				foreign.R.Answer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSuggestedEdits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (answerL) LoadSuggestedEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnswer interface{}, mods queries.Applicator) error {
+	var slice []*Answer
+	var object *Answer
+
+	if singular {
+		var ok bool
+		object, ok = maybeAnswer.(*Answer)
+		if !ok {
+			object = new(Answer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAnswer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAnswer))
+			}
+		}
+	} else {
+		s, ok := maybeAnswer.(*[]*Answer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAnswer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAnswer))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &answerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &answerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`suggested_edits`),
+		qm.WhereIn(`suggested_edits.answer_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load suggested_edits")
+	}
+
+	var resultSlice []*SuggestedEdit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice suggested_edits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on suggested_edits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for suggested_edits")
+	}
+
+	if len(suggestedEditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SuggestedEdits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &suggestedEditR{}
+			}
+			foreign.R.Answer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AnswerID) {
+				local.R.SuggestedEdits = append(local.R.SuggestedEdits, foreign)
+				if foreign.R == nil {
+					foreign.R = &suggestedEditR{}
+				}
+				foreign.R.Answer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadVotes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (answerL) LoadVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnswer interface{}, mods queries.Applicator) error {
@@ -1495,6 +1789,260 @@ func (o *Answer) AddComments(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddReactions adds the given related objects to the existing relationships
+// of the answer, optionally inserting them as new records.
+// Appends related to o.R.Reactions.
+// Sets related.R.Answer appropriately.
+func (o *Answer) AddReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AnswerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"answer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AnswerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &answerR{
+			Reactions: related,
+		}
+	} else {
+		o.R.Reactions = append(o.R.Reactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reactionR{
+				Answer: o,
+			}
+		} else {
+			rel.R.Answer = o
+		}
+	}
+	return nil
+}
+
+// SetReactions removes all previously related items of the
+// answer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Answer's Reactions accordingly.
+// Replaces o.R.Reactions with related.
+// Sets related.R.Answer's Reactions accordingly.
+func (o *Answer) SetReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	query := "update \"reactions\" set \"answer_id\" = null where \"answer_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Reactions {
+			queries.SetScanner(&rel.AnswerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Answer = nil
+		}
+		o.R.Reactions = nil
+	}
+
+	return o.AddReactions(ctx, exec, insert, related...)
+}
+
+// RemoveReactions relationships from objects passed in.
+// Removes related items from R.Reactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Answer.
+func (o *Answer) RemoveReactions(ctx context.Context, exec boil.ContextExecutor, related ...*Reaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AnswerID, nil)
+		if rel.R != nil {
+			rel.R.Answer = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("answer_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Reactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Reactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Reactions[i] = o.R.Reactions[ln-1]
+			}
+			o.R.Reactions = o.R.Reactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSuggestedEdits adds the given related objects to the existing relationships
+// of the answer, optionally inserting them as new records.
+// Appends related to o.R.SuggestedEdits.
+// Sets related.R.Answer appropriately.
+func (o *Answer) AddSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AnswerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"suggested_edits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"answer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AnswerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &answerR{
+			SuggestedEdits: related,
+		}
+	} else {
+		o.R.SuggestedEdits = append(o.R.SuggestedEdits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &suggestedEditR{
+				Answer: o,
+			}
+		} else {
+			rel.R.Answer = o
+		}
+	}
+	return nil
+}
+
+// SetSuggestedEdits removes all previously related items of the
+// answer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Answer's SuggestedEdits accordingly.
+// Replaces o.R.SuggestedEdits with related.
+// Sets related.R.Answer's SuggestedEdits accordingly.
+func (o *Answer) SetSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
+	query := "update \"suggested_edits\" set \"answer_id\" = null where \"answer_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SuggestedEdits {
+			queries.SetScanner(&rel.AnswerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Answer = nil
+		}
+		o.R.SuggestedEdits = nil
+	}
+
+	return o.AddSuggestedEdits(ctx, exec, insert, related...)
+}
+
+// RemoveSuggestedEdits relationships from objects passed in.
+// Removes related items from R.SuggestedEdits (uses pointer comparison, removal does not keep order)
+// Sets related.R.Answer.
+func (o *Answer) RemoveSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, related ...*SuggestedEdit) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AnswerID, nil)
+		if rel.R != nil {
+			rel.R.Answer = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("answer_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SuggestedEdits {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SuggestedEdits)
+			if ln > 1 && i < ln-1 {
+				o.R.SuggestedEdits[i] = o.R.SuggestedEdits[ln-1]
+			}
+			o.R.SuggestedEdits = o.R.SuggestedEdits[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddVotes adds the given related objects to the existing relationships
 // of the answer, optionally inserting them as new records.
 // Appends related to o.R.Votes.
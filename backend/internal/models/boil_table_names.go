@@ -4,33 +4,101 @@
 package models
 
 var TableNames = struct {
-	Answers    string
-	Claims     string
-	Comments   string
-	PostTags   string
-	Posts      string
-	RoleClaims string
-	Roles      string
-	SubTopics  string
-	Tags       string
-	Tenants    string
-	Topics     string
-	UserClaims string
-	Users      string
-	Votes      string
+	AnonymousPostAuthors   string
+	Answers                string
+	Categories             string
+	Claims                 string
+	Comments               string
+	CustomFields           string
+	JWTSigningKeys         string
+	LoginThrottles         string
+	Notifications          string
+	OidcLoginStates        string
+	PasswordResetRequests  string
+	PasswordResetTokens    string
+	PollOptions            string
+	PollVotes              string
+	Polls                  string
+	PostHistories          string
+	PostTags               string
+	Posts                  string
+	QuestionTemplateFields string
+	Reactions              string
+	RecoveryCodes          string
+	RefreshTokens          string
+	RevokedTokens          string
+	RoleClaims             string
+	Roles                  string
+	SamlLoginRequests      string
+	SsoLoginCodes          string
+	SubTopicClaims         string
+	SubTopicResponders     string
+	SubTopicRoles          string
+	SubTopics              string
+	SuggestedEdits         string
+	Tags                   string
+	TenantOidcProviders    string
+	TenantSamlProviders    string
+	Tenants                string
+	TopicClaims            string
+	TopicModerators        string
+	TopicRoles             string
+	Topics                 string
+	UserClaims             string
+	UserIdentities         string
+	UserTokenRevocations   string
+	UserTotps              string
+	Users                  string
+	Votes                  string
+	WebauthnCeremonies     string
+	WebauthnCredentials    string
 }{
-	Answers:    "answers",
-	Claims:     "claims",
-	Comments:   "comments",
-	PostTags:   "post_tags",
-	Posts:      "posts",
-	RoleClaims: "role_claims",
-	Roles:      "roles",
-	SubTopics:  "sub_topics",
-	Tags:       "tags",
-	Tenants:    "tenants",
-	Topics:     "topics",
-	UserClaims: "user_claims",
-	Users:      "users",
-	Votes:      "votes",
+	AnonymousPostAuthors:   "anonymous_post_authors",
+	Answers:                "answers",
+	Categories:             "categories",
+	Claims:                 "claims",
+	Comments:               "comments",
+	CustomFields:           "custom_fields",
+	JWTSigningKeys:         "jwt_signing_keys",
+	LoginThrottles:         "login_throttles",
+	Notifications:          "notifications",
+	OidcLoginStates:        "oidc_login_states",
+	PasswordResetRequests:  "password_reset_requests",
+	PasswordResetTokens:    "password_reset_tokens",
+	PollOptions:            "poll_options",
+	PollVotes:              "poll_votes",
+	Polls:                  "polls",
+	PostHistories:          "post_histories",
+	PostTags:               "post_tags",
+	Posts:                  "posts",
+	QuestionTemplateFields: "question_template_fields",
+	Reactions:              "reactions",
+	RecoveryCodes:          "recovery_codes",
+	RefreshTokens:          "refresh_tokens",
+	RevokedTokens:          "revoked_tokens",
+	RoleClaims:             "role_claims",
+	Roles:                  "roles",
+	SamlLoginRequests:      "saml_login_requests",
+	SsoLoginCodes:          "sso_login_codes",
+	SubTopicClaims:         "sub_topic_claims",
+	SubTopicResponders:     "sub_topic_responders",
+	SubTopicRoles:          "sub_topic_roles",
+	SubTopics:              "sub_topics",
+	SuggestedEdits:         "suggested_edits",
+	Tags:                   "tags",
+	TenantOidcProviders:    "tenant_oidc_providers",
+	TenantSamlProviders:    "tenant_saml_providers",
+	Tenants:                "tenants",
+	TopicClaims:            "topic_claims",
+	TopicModerators:        "topic_moderators",
+	TopicRoles:             "topic_roles",
+	Topics:                 "topics",
+	UserClaims:             "user_claims",
+	UserIdentities:         "user_identities",
+	UserTokenRevocations:   "user_token_revocations",
+	UserTotps:              "user_totps",
+	Users:                  "users",
+	Votes:                  "votes",
+	WebauthnCeremonies:     "webauthn_ceremonies",
+	WebauthnCredentials:    "webauthn_credentials",
 }
@@ -143,20 +143,26 @@ var ClaimWhere = struct {
 
 // ClaimRels is where relationship names are stored.
 var ClaimRels = struct {
-	Tenant string
-	Roles  string
-	Users  string
+	Tenant    string
+	Roles     string
+	SubTopics string
+	Topics    string
+	Users     string
 }{
-	Tenant: "Tenant",
-	Roles:  "Roles",
-	Users:  "Users",
+	Tenant:    "Tenant",
+	Roles:     "Roles",
+	SubTopics: "SubTopics",
+	Topics:    "Topics",
+	Users:     "Users",
 }
 
 // claimR is where relationships are stored.
 type claimR struct {
-	Tenant *Tenant   `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	Roles  RoleSlice `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
-	Users  UserSlice `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Tenant    *Tenant       `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Roles     RoleSlice     `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
+	SubTopics SubTopicSlice `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
+	Topics    TopicSlice    `boil:"Topics" json:"Topics" toml:"Topics" yaml:"Topics"`
+	Users     UserSlice     `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
 }
 
 // NewStruct creates a new relationship struct
@@ -196,6 +202,38 @@ func (r *claimR) GetRoles() RoleSlice {
 	return r.Roles
 }
 
+func (o *Claim) GetSubTopics() SubTopicSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubTopics()
+}
+
+func (r *claimR) GetSubTopics() SubTopicSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubTopics
+}
+
+func (o *Claim) GetTopics() TopicSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopics()
+}
+
+func (r *claimR) GetTopics() TopicSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Topics
+}
+
 func (o *Claim) GetUsers() UserSlice {
 	if o == nil {
 		return nil
@@ -554,6 +592,36 @@ func (o *Claim) Roles(mods ...qm.QueryMod) roleQuery {
 	return Roles(queryMods...)
 }
 
+// SubTopics retrieves all the sub_topic's SubTopics with an executor.
+func (o *Claim) SubTopics(mods ...qm.QueryMod) subTopicQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"sub_topic_claims\" on \"sub_topics\".\"id\" = \"sub_topic_claims\".\"sub_topic_id\""),
+		qm.Where("\"sub_topic_claims\".\"claim_id\"=?", o.ID),
+	)
+
+	return SubTopics(queryMods...)
+}
+
+// Topics retrieves all the topic's Topics with an executor.
+func (o *Claim) Topics(mods ...qm.QueryMod) topicQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"topic_claims\" on \"topics\".\"id\" = \"topic_claims\".\"topic_id\""),
+		qm.Where("\"topic_claims\".\"claim_id\"=?", o.ID),
+	)
+
+	return Topics(queryMods...)
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Claim) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -819,6 +887,266 @@ func (claimL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// LoadSubTopics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (claimL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClaim interface{}, mods queries.Applicator) error {
+	var slice []*Claim
+	var object *Claim
+
+	if singular {
+		var ok bool
+		object, ok = maybeClaim.(*Claim)
+		if !ok {
+			object = new(Claim)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeClaim)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeClaim))
+			}
+		}
+	} else {
+		s, ok := maybeClaim.(*[]*Claim)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeClaim)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeClaim))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &claimR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &claimR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"sub_topics\".\"id\", \"sub_topics\".\"name\", \"sub_topics\".\"topic_id\", \"sub_topics\".\"tenant_id\", \"sub_topics\".\"created_at\", \"sub_topics\".\"updated_at\", \"sub_topics\".\"first_reply_target_minutes\", \"sub_topics\".\"description\", \"sub_topics\".\"icon\", \"sub_topics\".\"color\", \"sub_topics\".\"archived\", \"sub_topics\".\"template_body\", \"a\".\"claim_id\""),
+		qm.From("\"sub_topics\""),
+		qm.InnerJoin("\"sub_topic_claims\" as \"a\" on \"sub_topics\".\"id\" = \"a\".\"sub_topic_id\""),
+		qm.WhereIn("\"a\".\"claim_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sub_topics")
+	}
+
+	var resultSlice []*SubTopic
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(SubTopic)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TopicID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.FirstReplyTargetMinutes, &one.Description, &one.Icon, &one.Color, &one.Archived, &one.TemplateBody, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for sub_topics")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice sub_topics")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sub_topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+	}
+
+	if len(subTopicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubTopics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &subTopicR{}
+			}
+			foreign.R.Claims = append(foreign.R.Claims, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.SubTopics = append(local.R.SubTopics, foreign)
+				if foreign.R == nil {
+					foreign.R = &subTopicR{}
+				}
+				foreign.R.Claims = append(foreign.R.Claims, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (claimL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClaim interface{}, mods queries.Applicator) error {
+	var slice []*Claim
+	var object *Claim
+
+	if singular {
+		var ok bool
+		object, ok = maybeClaim.(*Claim)
+		if !ok {
+			object = new(Claim)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeClaim)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeClaim))
+			}
+		}
+	} else {
+		s, ok := maybeClaim.(*[]*Claim)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeClaim)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeClaim))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &claimR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &claimR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"topics\".\"id\", \"topics\".\"name\", \"topics\".\"tenant_id\", \"topics\".\"created_at\", \"topics\".\"updated_at\", \"topics\".\"description\", \"topics\".\"icon\", \"topics\".\"color\", \"topics\".\"archived\", \"a\".\"claim_id\""),
+		qm.From("\"topics\""),
+		qm.InnerJoin("\"topic_claims\" as \"a\" on \"topics\".\"id\" = \"a\".\"topic_id\""),
+		qm.WhereIn("\"a\".\"claim_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topics")
+	}
+
+	var resultSlice []*Topic
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Topic)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.Description, &one.Icon, &one.Color, &one.Archived, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for topics")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice topics")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topics")
+	}
+
+	if len(topicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Topics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicR{}
+			}
+			foreign.R.Claims = append(foreign.R.Claims, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Topics = append(local.R.Topics, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicR{}
+				}
+				foreign.R.Claims = append(foreign.R.Claims, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUsers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (claimL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClaim interface{}, mods queries.Applicator) error {
@@ -874,7 +1202,7 @@ func (claimL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.Select("\"users\".\"id\", \"users\".\"name\", \"users\".\"email\", \"users\".\"vsc_account\", \"users\".\"role_id\", \"users\".\"tenant_id\", \"users\".\"created_at\", \"users\".\"updated_at\", \"users\".\"password\", \"a\".\"claim_id\""),
+		qm.Select("\"users\".\"id\", \"users\".\"name\", \"users\".\"email\", \"users\".\"vsc_account\", \"users\".\"role_id\", \"users\".\"tenant_id\", \"users\".\"created_at\", \"users\".\"updated_at\", \"users\".\"password\", \"users\".\"email_verified_at\", \"users\".\"email_verification_sent_at\", \"users\".\"webauthn_handle\", \"a\".\"claim_id\""),
 		qm.From("\"users\""),
 		qm.InnerJoin("\"user_claims\" as \"a\" on \"users\".\"id\" = \"a\".\"user_id\""),
 		qm.WhereIn("\"a\".\"claim_id\" in ?", argsSlice...),
@@ -895,7 +1223,7 @@ func (claimL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bo
 		one := new(User)
 		var localJoinCol int64
 
-		err = results.Scan(&one.ID, &one.Name, &one.Email, &one.VSCAccount, &one.RoleID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.Password, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.Email, &one.VSCAccount, &one.RoleID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.Password, &one.EmailVerifiedAt, &one.EmailVerificationSentAt, &one.WebauthnHandle, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for users")
 		}
@@ -1141,6 +1469,296 @@ func removeRolesFromClaimsSlice(o *Claim, related []*Role) {
 	}
 }
 
+// AddSubTopics adds the given related objects to the existing relationships
+// of the claim, optionally inserting them as new records.
+// Appends related to o.R.SubTopics.
+// Sets related.R.Claims appropriately.
+func (o *Claim) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"sub_topic_claims\" (\"claim_id\", \"sub_topic_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &claimR{
+			SubTopics: related,
+		}
+	} else {
+		o.R.SubTopics = append(o.R.SubTopics, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &subTopicR{
+				Claims: ClaimSlice{o},
+			}
+		} else {
+			rel.R.Claims = append(rel.R.Claims, o)
+		}
+	}
+	return nil
+}
+
+// SetSubTopics removes all previously related items of the
+// claim replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Claims's SubTopics accordingly.
+// Replaces o.R.SubTopics with related.
+// Sets related.R.Claims's SubTopics accordingly.
+func (o *Claim) SetSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+	query := "delete from \"sub_topic_claims\" where \"claim_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeSubTopicsFromClaimsSlice(o, related)
+	if o.R != nil {
+		o.R.SubTopics = nil
+	}
+
+	return o.AddSubTopics(ctx, exec, insert, related...)
+}
+
+// RemoveSubTopics relationships from objects passed in.
+// Removes related items from R.SubTopics (uses pointer comparison, removal does not keep order)
+// Sets related.R.Claims.
+func (o *Claim) RemoveSubTopics(ctx context.Context, exec boil.ContextExecutor, related ...*SubTopic) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"sub_topic_claims\" where \"claim_id\" = $1 and \"sub_topic_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeSubTopicsFromClaimsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubTopics {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubTopics)
+			if ln > 1 && i < ln-1 {
+				o.R.SubTopics[i] = o.R.SubTopics[ln-1]
+			}
+			o.R.SubTopics = o.R.SubTopics[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeSubTopicsFromClaimsSlice(o *Claim, related []*SubTopic) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Claims {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Claims)
+			if ln > 1 && i < ln-1 {
+				rel.R.Claims[i] = rel.R.Claims[ln-1]
+			}
+			rel.R.Claims = rel.R.Claims[:ln-1]
+			break
+		}
+	}
+}
+
+// AddTopics adds the given related objects to the existing relationships
+// of the claim, optionally inserting them as new records.
+// Appends related to o.R.Topics.
+// Sets related.R.Claims appropriately.
+func (o *Claim) AddTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Topic) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"topic_claims\" (\"claim_id\", \"topic_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &claimR{
+			Topics: related,
+		}
+	} else {
+		o.R.Topics = append(o.R.Topics, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &topicR{
+				Claims: ClaimSlice{o},
+			}
+		} else {
+			rel.R.Claims = append(rel.R.Claims, o)
+		}
+	}
+	return nil
+}
+
+// SetTopics removes all previously related items of the
+// claim replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Claims's Topics accordingly.
+// Replaces o.R.Topics with related.
+// Sets related.R.Claims's Topics accordingly.
+func (o *Claim) SetTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Topic) error {
+	query := "delete from \"topic_claims\" where \"claim_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeTopicsFromClaimsSlice(o, related)
+	if o.R != nil {
+		o.R.Topics = nil
+	}
+
+	return o.AddTopics(ctx, exec, insert, related...)
+}
+
+// RemoveTopics relationships from objects passed in.
+// Removes related items from R.Topics (uses pointer comparison, removal does not keep order)
+// Sets related.R.Claims.
+func (o *Claim) RemoveTopics(ctx context.Context, exec boil.ContextExecutor, related ...*Topic) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"topic_claims\" where \"claim_id\" = $1 and \"topic_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeTopicsFromClaimsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Topics {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Topics)
+			if ln > 1 && i < ln-1 {
+				o.R.Topics[i] = o.R.Topics[ln-1]
+			}
+			o.R.Topics = o.R.Topics[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeTopicsFromClaimsSlice(o *Claim, related []*Topic) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Claims {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Claims)
+			if ln > 1 && i < ln-1 {
+				rel.R.Claims[i] = rel.R.Claims[ln-1]
+			}
+			rel.R.Claims = rel.R.Claims[:ln-1]
+			break
+		}
+	}
+}
+
 // AddUsers adds the given related objects to the existing relationships
 // of the claim, optionally inserting them as new records.
 // Appends related to o.R.Users.
@@ -94,20 +94,23 @@ var CommentWhere = struct {
 
 // CommentRels is where relationship names are stored.
 var CommentRels = struct {
-	Answer string
-	Sender string
-	Tenant string
+	Answer    string
+	Sender    string
+	Tenant    string
+	Reactions string
 }{
-	Answer: "Answer",
-	Sender: "Sender",
-	Tenant: "Tenant",
+	Answer:    "Answer",
+	Sender:    "Sender",
+	Tenant:    "Tenant",
+	Reactions: "Reactions",
 }
 
 // commentR is where relationships are stored.
 type commentR struct {
-	Answer *Answer `boil:"Answer" json:"Answer" toml:"Answer" yaml:"Answer"`
-	Sender *User   `boil:"Sender" json:"Sender" toml:"Sender" yaml:"Sender"`
-	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Answer    *Answer       `boil:"Answer" json:"Answer" toml:"Answer" yaml:"Answer"`
+	Sender    *User         `boil:"Sender" json:"Sender" toml:"Sender" yaml:"Sender"`
+	Tenant    *Tenant       `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Reactions ReactionSlice `boil:"Reactions" json:"Reactions" toml:"Reactions" yaml:"Reactions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -163,6 +166,22 @@ func (r *commentR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *Comment) GetReactions() ReactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReactions()
+}
+
+func (r *commentR) GetReactions() ReactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reactions
+}
+
 // commentL is where Load methods for each relationship are stored.
 type commentL struct{}
 
@@ -512,6 +531,20 @@ func (o *Comment) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// Reactions retrieves all the reaction's Reactions with an executor.
+func (o *Comment) Reactions(mods ...qm.QueryMod) reactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reactions\".\"comment_id\"=?", o.ID),
+	)
+
+	return Reactions(queryMods...)
+}
+
 // LoadAnswer allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (commentL) LoadAnswer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
@@ -872,6 +905,119 @@ func (commentL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (commentL) LoadReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
+	var slice []*Comment
+	var object *Comment
+
+	if singular {
+		var ok bool
+		object, ok = maybeComment.(*Comment)
+		if !ok {
+			object = new(Comment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeComment))
+			}
+		}
+	} else {
+		s, ok := maybeComment.(*[]*Comment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &commentR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &commentR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reactions`),
+		qm.WhereIn(`reactions.comment_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reactions")
+	}
+
+	var resultSlice []*Reaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reactions")
+	}
+
+	if len(reactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reactionR{}
+			}
+			foreign.R.Comment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CommentID) {
+				local.R.Reactions = append(local.R.Reactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &reactionR{}
+				}
+				foreign.R.Comment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetAnswer of the comment to the related item.
 // Sets o.R.Answer to related.
 // Adds o to related.R.Comments.
@@ -1013,6 +1159,133 @@ func (o *Comment) SetTenant(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddReactions adds the given related objects to the existing relationships
+// of the comment, optionally inserting them as new records.
+// Appends related to o.R.Reactions.
+// Sets related.R.Comment appropriately.
+func (o *Comment) AddReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CommentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"comment_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CommentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &commentR{
+			Reactions: related,
+		}
+	} else {
+		o.R.Reactions = append(o.R.Reactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reactionR{
+				Comment: o,
+			}
+		} else {
+			rel.R.Comment = o
+		}
+	}
+	return nil
+}
+
+// SetReactions removes all previously related items of the
+// comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Comment's Reactions accordingly.
+// Replaces o.R.Reactions with related.
+// Sets related.R.Comment's Reactions accordingly.
+func (o *Comment) SetReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	query := "update \"reactions\" set \"comment_id\" = null where \"comment_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Reactions {
+			queries.SetScanner(&rel.CommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Comment = nil
+		}
+		o.R.Reactions = nil
+	}
+
+	return o.AddReactions(ctx, exec, insert, related...)
+}
+
+// RemoveReactions relationships from objects passed in.
+// Removes related items from R.Reactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Comment.
+func (o *Comment) RemoveReactions(ctx context.Context, exec boil.ContextExecutor, related ...*Reaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CommentID, nil)
+		if rel.R != nil {
+			rel.R.Comment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Reactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Reactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Reactions[i] = o.R.Reactions[ln-1]
+			}
+			o.R.Reactions = o.R.Reactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Comments retrieves all the records using an executor.
 func Comments(mods ...qm.QueryMod) commentQuery {
 	mods = append(mods, qm.From("\"comments\""))
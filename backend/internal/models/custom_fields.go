@@ -0,0 +1,1239 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// CustomField is an object representing the database table.
+type CustomField struct {
+	ID        int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name      string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Label     string            `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Type      string            `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Required  bool              `boil:"required" json:"required" toml:"required" yaml:"required"`
+	Options   types.StringArray `boil:"options" json:"options,omitempty" toml:"options" yaml:"options,omitempty"`
+	Position  int               `boil:"position" json:"position" toml:"position" yaml:"position"`
+	TenantID  int64             `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *customFieldR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L customFieldL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CustomFieldColumns = struct {
+	ID        string
+	Name      string
+	Label     string
+	Type      string
+	Required  string
+	Options   string
+	Position  string
+	TenantID  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	Name:      "name",
+	Label:     "label",
+	Type:      "type",
+	Required:  "required",
+	Options:   "options",
+	Position:  "position",
+	TenantID:  "tenant_id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var CustomFieldTableColumns = struct {
+	ID        string
+	Name      string
+	Label     string
+	Type      string
+	Required  string
+	Options   string
+	Position  string
+	TenantID  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "custom_fields.id",
+	Name:      "custom_fields.name",
+	Label:     "custom_fields.label",
+	Type:      "custom_fields.type",
+	Required:  "custom_fields.required",
+	Options:   "custom_fields.options",
+	Position:  "custom_fields.position",
+	TenantID:  "custom_fields.tenant_id",
+	CreatedAt: "custom_fields.created_at",
+	UpdatedAt: "custom_fields.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpertypes_StringArray) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
+var CustomFieldWhere = struct {
+	ID        whereHelperint64
+	Name      whereHelperstring
+	Label     whereHelperstring
+	Type      whereHelperstring
+	Required  whereHelperbool
+	Options   whereHelpertypes_StringArray
+	Position  whereHelperint
+	TenantID  whereHelperint64
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperint64{field: "\"custom_fields\".\"id\""},
+	Name:      whereHelperstring{field: "\"custom_fields\".\"name\""},
+	Label:     whereHelperstring{field: "\"custom_fields\".\"label\""},
+	Type:      whereHelperstring{field: "\"custom_fields\".\"type\""},
+	Required:  whereHelperbool{field: "\"custom_fields\".\"required\""},
+	Options:   whereHelpertypes_StringArray{field: "\"custom_fields\".\"options\""},
+	Position:  whereHelperint{field: "\"custom_fields\".\"position\""},
+	TenantID:  whereHelperint64{field: "\"custom_fields\".\"tenant_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"custom_fields\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"custom_fields\".\"updated_at\""},
+}
+
+// CustomFieldRels is where relationship names are stored.
+var CustomFieldRels = struct {
+	Tenant string
+}{
+	Tenant: "Tenant",
+}
+
+// customFieldR is where relationships are stored.
+type customFieldR struct {
+	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*customFieldR) NewStruct() *customFieldR {
+	return &customFieldR{}
+}
+
+func (o *CustomField) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *customFieldR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// customFieldL is where Load methods for each relationship are stored.
+type customFieldL struct{}
+
+var (
+	customFieldAllColumns            = []string{"id", "name", "label", "type", "required", "options", "position", "tenant_id", "created_at", "updated_at"}
+	customFieldColumnsWithoutDefault = []string{"name", "label", "type", "tenant_id"}
+	customFieldColumnsWithDefault    = []string{"id", "required", "options", "position", "created_at", "updated_at"}
+	customFieldPrimaryKeyColumns     = []string{"id"}
+	customFieldGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// CustomFieldSlice is an alias for a slice of pointers to CustomField.
+	// This should almost always be used instead of []CustomField.
+	CustomFieldSlice []*CustomField
+	// CustomFieldHook is the signature for custom CustomField hook methods
+	CustomFieldHook func(context.Context, boil.ContextExecutor, *CustomField) error
+
+	customFieldQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	customFieldType                 = reflect.TypeOf(&CustomField{})
+	customFieldMapping              = queries.MakeStructMapping(customFieldType)
+	customFieldPrimaryKeyMapping, _ = queries.BindMapping(customFieldType, customFieldMapping, customFieldPrimaryKeyColumns)
+	customFieldInsertCacheMut       sync.RWMutex
+	customFieldInsertCache          = make(map[string]insertCache)
+	customFieldUpdateCacheMut       sync.RWMutex
+	customFieldUpdateCache          = make(map[string]updateCache)
+	customFieldUpsertCacheMut       sync.RWMutex
+	customFieldUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var customFieldAfterSelectMu sync.Mutex
+var customFieldAfterSelectHooks []CustomFieldHook
+
+var customFieldBeforeInsertMu sync.Mutex
+var customFieldBeforeInsertHooks []CustomFieldHook
+var customFieldAfterInsertMu sync.Mutex
+var customFieldAfterInsertHooks []CustomFieldHook
+
+var customFieldBeforeUpdateMu sync.Mutex
+var customFieldBeforeUpdateHooks []CustomFieldHook
+var customFieldAfterUpdateMu sync.Mutex
+var customFieldAfterUpdateHooks []CustomFieldHook
+
+var customFieldBeforeDeleteMu sync.Mutex
+var customFieldBeforeDeleteHooks []CustomFieldHook
+var customFieldAfterDeleteMu sync.Mutex
+var customFieldAfterDeleteHooks []CustomFieldHook
+
+var customFieldBeforeUpsertMu sync.Mutex
+var customFieldBeforeUpsertHooks []CustomFieldHook
+var customFieldAfterUpsertMu sync.Mutex
+var customFieldAfterUpsertHooks []CustomFieldHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CustomField) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CustomField) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CustomField) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CustomField) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CustomField) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CustomField) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CustomField) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CustomField) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CustomField) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customFieldAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCustomFieldHook registers your hook function for all future operations.
+func AddCustomFieldHook(hookPoint boil.HookPoint, customFieldHook CustomFieldHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		customFieldAfterSelectMu.Lock()
+		customFieldAfterSelectHooks = append(customFieldAfterSelectHooks, customFieldHook)
+		customFieldAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		customFieldBeforeInsertMu.Lock()
+		customFieldBeforeInsertHooks = append(customFieldBeforeInsertHooks, customFieldHook)
+		customFieldBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		customFieldAfterInsertMu.Lock()
+		customFieldAfterInsertHooks = append(customFieldAfterInsertHooks, customFieldHook)
+		customFieldAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		customFieldBeforeUpdateMu.Lock()
+		customFieldBeforeUpdateHooks = append(customFieldBeforeUpdateHooks, customFieldHook)
+		customFieldBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		customFieldAfterUpdateMu.Lock()
+		customFieldAfterUpdateHooks = append(customFieldAfterUpdateHooks, customFieldHook)
+		customFieldAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		customFieldBeforeDeleteMu.Lock()
+		customFieldBeforeDeleteHooks = append(customFieldBeforeDeleteHooks, customFieldHook)
+		customFieldBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		customFieldAfterDeleteMu.Lock()
+		customFieldAfterDeleteHooks = append(customFieldAfterDeleteHooks, customFieldHook)
+		customFieldAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		customFieldBeforeUpsertMu.Lock()
+		customFieldBeforeUpsertHooks = append(customFieldBeforeUpsertHooks, customFieldHook)
+		customFieldBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		customFieldAfterUpsertMu.Lock()
+		customFieldAfterUpsertHooks = append(customFieldAfterUpsertHooks, customFieldHook)
+		customFieldAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single customField record from the query.
+func (q customFieldQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CustomField, error) {
+	o := &CustomField{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for custom_fields")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CustomField records from the query.
+func (q customFieldQuery) All(ctx context.Context, exec boil.ContextExecutor) (CustomFieldSlice, error) {
+	var o []*CustomField
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CustomField slice")
+	}
+
+	if len(customFieldAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CustomField records in the query.
+func (q customFieldQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count custom_fields rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q customFieldQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if custom_fields exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tenant pointed to by the foreign key.
+func (o *CustomField) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (customFieldL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomField interface{}, mods queries.Applicator) error {
+	var slice []*CustomField
+	var object *CustomField
+
+	if singular {
+		var ok bool
+		object, ok = maybeCustomField.(*CustomField)
+		if !ok {
+			object = new(CustomField)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCustomField)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCustomField))
+			}
+		}
+	} else {
+		s, ok := maybeCustomField.(*[]*CustomField)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCustomField)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCustomField))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &customFieldR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customFieldR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.CustomFields = append(foreign.R.CustomFields, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.CustomFields = append(foreign.R.CustomFields, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the customField to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.CustomFields.
+func (o *CustomField) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"custom_fields\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, customFieldPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &customFieldR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			CustomFields: CustomFieldSlice{o},
+		}
+	} else {
+		related.R.CustomFields = append(related.R.CustomFields, o)
+	}
+
+	return nil
+}
+
+// CustomFields retrieves all the records using an executor.
+func CustomFields(mods ...qm.QueryMod) customFieldQuery {
+	mods = append(mods, qm.From("\"custom_fields\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"custom_fields\".*"})
+	}
+
+	return customFieldQuery{q}
+}
+
+// FindCustomField retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCustomField(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*CustomField, error) {
+	customFieldObj := &CustomField{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"custom_fields\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, customFieldObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from custom_fields")
+	}
+
+	if err = customFieldObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return customFieldObj, err
+	}
+
+	return customFieldObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CustomField) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no custom_fields provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(customFieldColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	customFieldInsertCacheMut.RLock()
+	cache, cached := customFieldInsertCache[key]
+	customFieldInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			customFieldAllColumns,
+			customFieldColumnsWithDefault,
+			customFieldColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, customFieldGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(customFieldType, customFieldMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(customFieldType, customFieldMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"custom_fields\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"custom_fields\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into custom_fields")
+	}
+
+	if !cached {
+		customFieldInsertCacheMut.Lock()
+		customFieldInsertCache[key] = cache
+		customFieldInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CustomField.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CustomField) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	customFieldUpdateCacheMut.RLock()
+	cache, cached := customFieldUpdateCache[key]
+	customFieldUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			customFieldAllColumns,
+			customFieldPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, customFieldGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update custom_fields, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"custom_fields\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, customFieldPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(customFieldType, customFieldMapping, append(wl, customFieldPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update custom_fields row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for custom_fields")
+	}
+
+	if !cached {
+		customFieldUpdateCacheMut.Lock()
+		customFieldUpdateCache[key] = cache
+		customFieldUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q customFieldQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for custom_fields")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for custom_fields")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CustomFieldSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), customFieldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"custom_fields\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, customFieldPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in customField slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all customField")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CustomField) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no custom_fields provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(customFieldColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	customFieldUpsertCacheMut.RLock()
+	cache, cached := customFieldUpsertCache[key]
+	customFieldUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			customFieldAllColumns,
+			customFieldColumnsWithDefault,
+			customFieldColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			customFieldAllColumns,
+			customFieldPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, customFieldGeneratedColumns)
+		update = strmangle.SetComplement(update, customFieldGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert custom_fields, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(customFieldAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(customFieldPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert custom_fields, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(customFieldPrimaryKeyColumns))
+			copy(conflict, customFieldPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"custom_fields\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(customFieldType, customFieldMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(customFieldType, customFieldMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert custom_fields")
+	}
+
+	if !cached {
+		customFieldUpsertCacheMut.Lock()
+		customFieldUpsertCache[key] = cache
+		customFieldUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CustomField record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CustomField) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CustomField provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), customFieldPrimaryKeyMapping)
+	sql := "DELETE FROM \"custom_fields\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from custom_fields")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for custom_fields")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q customFieldQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no customFieldQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from custom_fields")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for custom_fields")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CustomFieldSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(customFieldBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), customFieldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"custom_fields\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, customFieldPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from customField slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for custom_fields")
+	}
+
+	if len(customFieldAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CustomField) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCustomField(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CustomFieldSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CustomFieldSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), customFieldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"custom_fields\".* FROM \"custom_fields\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, customFieldPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CustomFieldSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CustomFieldExists checks if the CustomField row exists.
+func CustomFieldExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"custom_fields\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if custom_fields exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CustomField row exists.
+func (o *CustomField) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CustomFieldExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,964 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// JWTSigningKey is an object representing the database table.
+type JWTSigningKey struct {
+	Kid        string    `boil:"kid" json:"kid" toml:"kid" yaml:"kid"`
+	Algorithm  string    `boil:"algorithm" json:"algorithm" toml:"algorithm" yaml:"algorithm"`
+	PrivateKey string    `boil:"private_key" json:"private_key" toml:"private_key" yaml:"private_key"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RetiredAt  null.Time `boil:"retired_at" json:"retired_at,omitempty" toml:"retired_at" yaml:"retired_at,omitempty"`
+
+	R *jwtSigningKeyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L jwtSigningKeyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var JWTSigningKeyColumns = struct {
+	Kid        string
+	Algorithm  string
+	PrivateKey string
+	CreatedAt  string
+	RetiredAt  string
+}{
+	Kid:        "kid",
+	Algorithm:  "algorithm",
+	PrivateKey: "private_key",
+	CreatedAt:  "created_at",
+	RetiredAt:  "retired_at",
+}
+
+var JWTSigningKeyTableColumns = struct {
+	Kid        string
+	Algorithm  string
+	PrivateKey string
+	CreatedAt  string
+	RetiredAt  string
+}{
+	Kid:        "jwt_signing_keys.kid",
+	Algorithm:  "jwt_signing_keys.algorithm",
+	PrivateKey: "jwt_signing_keys.private_key",
+	CreatedAt:  "jwt_signing_keys.created_at",
+	RetiredAt:  "jwt_signing_keys.retired_at",
+}
+
+// Generated where
+
+var JWTSigningKeyWhere = struct {
+	Kid        whereHelperstring
+	Algorithm  whereHelperstring
+	PrivateKey whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	RetiredAt  whereHelpernull_Time
+}{
+	Kid:        whereHelperstring{field: "\"jwt_signing_keys\".\"kid\""},
+	Algorithm:  whereHelperstring{field: "\"jwt_signing_keys\".\"algorithm\""},
+	PrivateKey: whereHelperstring{field: "\"jwt_signing_keys\".\"private_key\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"jwt_signing_keys\".\"created_at\""},
+	RetiredAt:  whereHelpernull_Time{field: "\"jwt_signing_keys\".\"retired_at\""},
+}
+
+// JWTSigningKeyRels is where relationship names are stored.
+var JWTSigningKeyRels = struct {
+}{}
+
+// jwtSigningKeyR is where relationships are stored.
+type jwtSigningKeyR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*jwtSigningKeyR) NewStruct() *jwtSigningKeyR {
+	return &jwtSigningKeyR{}
+}
+
+// jwtSigningKeyL is where Load methods for each relationship are stored.
+type jwtSigningKeyL struct{}
+
+var (
+	jwtSigningKeyAllColumns            = []string{"kid", "algorithm", "private_key", "created_at", "retired_at"}
+	jwtSigningKeyColumnsWithoutDefault = []string{"kid", "algorithm", "private_key"}
+	jwtSigningKeyColumnsWithDefault    = []string{"created_at", "retired_at"}
+	jwtSigningKeyPrimaryKeyColumns     = []string{"kid"}
+	jwtSigningKeyGeneratedColumns      = []string{}
+)
+
+type (
+	// JWTSigningKeySlice is an alias for a slice of pointers to JWTSigningKey.
+	// This should almost always be used instead of []JWTSigningKey.
+	JWTSigningKeySlice []*JWTSigningKey
+	// JWTSigningKeyHook is the signature for custom JWTSigningKey hook methods
+	JWTSigningKeyHook func(context.Context, boil.ContextExecutor, *JWTSigningKey) error
+
+	jwtSigningKeyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	jwtSigningKeyType                 = reflect.TypeOf(&JWTSigningKey{})
+	jwtSigningKeyMapping              = queries.MakeStructMapping(jwtSigningKeyType)
+	jwtSigningKeyPrimaryKeyMapping, _ = queries.BindMapping(jwtSigningKeyType, jwtSigningKeyMapping, jwtSigningKeyPrimaryKeyColumns)
+	jwtSigningKeyInsertCacheMut       sync.RWMutex
+	jwtSigningKeyInsertCache          = make(map[string]insertCache)
+	jwtSigningKeyUpdateCacheMut       sync.RWMutex
+	jwtSigningKeyUpdateCache          = make(map[string]updateCache)
+	jwtSigningKeyUpsertCacheMut       sync.RWMutex
+	jwtSigningKeyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var jwtSigningKeyAfterSelectMu sync.Mutex
+var jwtSigningKeyAfterSelectHooks []JWTSigningKeyHook
+
+var jwtSigningKeyBeforeInsertMu sync.Mutex
+var jwtSigningKeyBeforeInsertHooks []JWTSigningKeyHook
+var jwtSigningKeyAfterInsertMu sync.Mutex
+var jwtSigningKeyAfterInsertHooks []JWTSigningKeyHook
+
+var jwtSigningKeyBeforeUpdateMu sync.Mutex
+var jwtSigningKeyBeforeUpdateHooks []JWTSigningKeyHook
+var jwtSigningKeyAfterUpdateMu sync.Mutex
+var jwtSigningKeyAfterUpdateHooks []JWTSigningKeyHook
+
+var jwtSigningKeyBeforeDeleteMu sync.Mutex
+var jwtSigningKeyBeforeDeleteHooks []JWTSigningKeyHook
+var jwtSigningKeyAfterDeleteMu sync.Mutex
+var jwtSigningKeyAfterDeleteHooks []JWTSigningKeyHook
+
+var jwtSigningKeyBeforeUpsertMu sync.Mutex
+var jwtSigningKeyBeforeUpsertHooks []JWTSigningKeyHook
+var jwtSigningKeyAfterUpsertMu sync.Mutex
+var jwtSigningKeyAfterUpsertHooks []JWTSigningKeyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *JWTSigningKey) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *JWTSigningKey) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *JWTSigningKey) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *JWTSigningKey) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *JWTSigningKey) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *JWTSigningKey) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *JWTSigningKey) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *JWTSigningKey) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *JWTSigningKey) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jwtSigningKeyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddJWTSigningKeyHook registers your hook function for all future operations.
+func AddJWTSigningKeyHook(hookPoint boil.HookPoint, jwtSigningKeyHook JWTSigningKeyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		jwtSigningKeyAfterSelectMu.Lock()
+		jwtSigningKeyAfterSelectHooks = append(jwtSigningKeyAfterSelectHooks, jwtSigningKeyHook)
+		jwtSigningKeyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		jwtSigningKeyBeforeInsertMu.Lock()
+		jwtSigningKeyBeforeInsertHooks = append(jwtSigningKeyBeforeInsertHooks, jwtSigningKeyHook)
+		jwtSigningKeyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		jwtSigningKeyAfterInsertMu.Lock()
+		jwtSigningKeyAfterInsertHooks = append(jwtSigningKeyAfterInsertHooks, jwtSigningKeyHook)
+		jwtSigningKeyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		jwtSigningKeyBeforeUpdateMu.Lock()
+		jwtSigningKeyBeforeUpdateHooks = append(jwtSigningKeyBeforeUpdateHooks, jwtSigningKeyHook)
+		jwtSigningKeyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		jwtSigningKeyAfterUpdateMu.Lock()
+		jwtSigningKeyAfterUpdateHooks = append(jwtSigningKeyAfterUpdateHooks, jwtSigningKeyHook)
+		jwtSigningKeyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		jwtSigningKeyBeforeDeleteMu.Lock()
+		jwtSigningKeyBeforeDeleteHooks = append(jwtSigningKeyBeforeDeleteHooks, jwtSigningKeyHook)
+		jwtSigningKeyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		jwtSigningKeyAfterDeleteMu.Lock()
+		jwtSigningKeyAfterDeleteHooks = append(jwtSigningKeyAfterDeleteHooks, jwtSigningKeyHook)
+		jwtSigningKeyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		jwtSigningKeyBeforeUpsertMu.Lock()
+		jwtSigningKeyBeforeUpsertHooks = append(jwtSigningKeyBeforeUpsertHooks, jwtSigningKeyHook)
+		jwtSigningKeyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		jwtSigningKeyAfterUpsertMu.Lock()
+		jwtSigningKeyAfterUpsertHooks = append(jwtSigningKeyAfterUpsertHooks, jwtSigningKeyHook)
+		jwtSigningKeyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single jwtSigningKey record from the query.
+func (q jwtSigningKeyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*JWTSigningKey, error) {
+	o := &JWTSigningKey{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for jwt_signing_keys")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all JWTSigningKey records from the query.
+func (q jwtSigningKeyQuery) All(ctx context.Context, exec boil.ContextExecutor) (JWTSigningKeySlice, error) {
+	var o []*JWTSigningKey
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to JWTSigningKey slice")
+	}
+
+	if len(jwtSigningKeyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all JWTSigningKey records in the query.
+func (q jwtSigningKeyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count jwt_signing_keys rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q jwtSigningKeyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if jwt_signing_keys exists")
+	}
+
+	return count > 0, nil
+}
+
+// JWTSigningKeys retrieves all the records using an executor.
+func JWTSigningKeys(mods ...qm.QueryMod) jwtSigningKeyQuery {
+	mods = append(mods, qm.From("\"jwt_signing_keys\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"jwt_signing_keys\".*"})
+	}
+
+	return jwtSigningKeyQuery{q}
+}
+
+// FindJWTSigningKey retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindJWTSigningKey(ctx context.Context, exec boil.ContextExecutor, kid string, selectCols ...string) (*JWTSigningKey, error) {
+	jwtSigningKeyObj := &JWTSigningKey{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"jwt_signing_keys\" where \"kid\"=$1", sel,
+	)
+
+	q := queries.Raw(query, kid)
+
+	err := q.Bind(ctx, exec, jwtSigningKeyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from jwt_signing_keys")
+	}
+
+	if err = jwtSigningKeyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return jwtSigningKeyObj, err
+	}
+
+	return jwtSigningKeyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *JWTSigningKey) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no jwt_signing_keys provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(jwtSigningKeyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	jwtSigningKeyInsertCacheMut.RLock()
+	cache, cached := jwtSigningKeyInsertCache[key]
+	jwtSigningKeyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			jwtSigningKeyAllColumns,
+			jwtSigningKeyColumnsWithDefault,
+			jwtSigningKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(jwtSigningKeyType, jwtSigningKeyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(jwtSigningKeyType, jwtSigningKeyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"jwt_signing_keys\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"jwt_signing_keys\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into jwt_signing_keys")
+	}
+
+	if !cached {
+		jwtSigningKeyInsertCacheMut.Lock()
+		jwtSigningKeyInsertCache[key] = cache
+		jwtSigningKeyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the JWTSigningKey.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *JWTSigningKey) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	jwtSigningKeyUpdateCacheMut.RLock()
+	cache, cached := jwtSigningKeyUpdateCache[key]
+	jwtSigningKeyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			jwtSigningKeyAllColumns,
+			jwtSigningKeyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update jwt_signing_keys, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"jwt_signing_keys\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, jwtSigningKeyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(jwtSigningKeyType, jwtSigningKeyMapping, append(wl, jwtSigningKeyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update jwt_signing_keys row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for jwt_signing_keys")
+	}
+
+	if !cached {
+		jwtSigningKeyUpdateCacheMut.Lock()
+		jwtSigningKeyUpdateCache[key] = cache
+		jwtSigningKeyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q jwtSigningKeyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for jwt_signing_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for jwt_signing_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o JWTSigningKeySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), jwtSigningKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"jwt_signing_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, jwtSigningKeyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in jwtSigningKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all jwtSigningKey")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *JWTSigningKey) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no jwt_signing_keys provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(jwtSigningKeyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	jwtSigningKeyUpsertCacheMut.RLock()
+	cache, cached := jwtSigningKeyUpsertCache[key]
+	jwtSigningKeyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			jwtSigningKeyAllColumns,
+			jwtSigningKeyColumnsWithDefault,
+			jwtSigningKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			jwtSigningKeyAllColumns,
+			jwtSigningKeyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert jwt_signing_keys, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(jwtSigningKeyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(jwtSigningKeyPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert jwt_signing_keys, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(jwtSigningKeyPrimaryKeyColumns))
+			copy(conflict, jwtSigningKeyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"jwt_signing_keys\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(jwtSigningKeyType, jwtSigningKeyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(jwtSigningKeyType, jwtSigningKeyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert jwt_signing_keys")
+	}
+
+	if !cached {
+		jwtSigningKeyUpsertCacheMut.Lock()
+		jwtSigningKeyUpsertCache[key] = cache
+		jwtSigningKeyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single JWTSigningKey record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *JWTSigningKey) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no JWTSigningKey provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), jwtSigningKeyPrimaryKeyMapping)
+	sql := "DELETE FROM \"jwt_signing_keys\" WHERE \"kid\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from jwt_signing_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for jwt_signing_keys")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q jwtSigningKeyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no jwtSigningKeyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from jwt_signing_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for jwt_signing_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o JWTSigningKeySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(jwtSigningKeyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), jwtSigningKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"jwt_signing_keys\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, jwtSigningKeyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from jwtSigningKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for jwt_signing_keys")
+	}
+
+	if len(jwtSigningKeyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *JWTSigningKey) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindJWTSigningKey(ctx, exec, o.Kid)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *JWTSigningKeySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := JWTSigningKeySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), jwtSigningKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"jwt_signing_keys\".* FROM \"jwt_signing_keys\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, jwtSigningKeyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in JWTSigningKeySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// JWTSigningKeyExists checks if the JWTSigningKey row exists.
+func JWTSigningKeyExists(ctx context.Context, exec boil.ContextExecutor, kid string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"jwt_signing_keys\" where \"kid\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, kid)
+	}
+	row := exec.QueryRowContext(ctx, sql, kid)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if jwt_signing_keys exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the JWTSigningKey row exists.
+func (o *JWTSigningKey) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return JWTSigningKeyExists(ctx, exec, o.Kid)
+}
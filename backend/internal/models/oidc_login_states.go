@@ -0,0 +1,1147 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OidcLoginState is an object representing the database table.
+type OidcLoginState struct {
+	State        string    `boil:"state" json:"state" toml:"state" yaml:"state"`
+	TenantID     int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	Nonce        string    `boil:"nonce" json:"nonce" toml:"nonce" yaml:"nonce"`
+	CodeVerifier string    `boil:"code_verifier" json:"code_verifier" toml:"code_verifier" yaml:"code_verifier"`
+	ExpiresAt    time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *oidcLoginStateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcLoginStateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcLoginStateColumns = struct {
+	State        string
+	TenantID     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    string
+}{
+	State:        "state",
+	TenantID:     "tenant_id",
+	Nonce:        "nonce",
+	CodeVerifier: "code_verifier",
+	ExpiresAt:    "expires_at",
+}
+
+var OidcLoginStateTableColumns = struct {
+	State        string
+	TenantID     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    string
+}{
+	State:        "oidc_login_states.state",
+	TenantID:     "oidc_login_states.tenant_id",
+	Nonce:        "oidc_login_states.nonce",
+	CodeVerifier: "oidc_login_states.code_verifier",
+	ExpiresAt:    "oidc_login_states.expires_at",
+}
+
+// Generated where
+
+var OidcLoginStateWhere = struct {
+	State        whereHelperstring
+	TenantID     whereHelperint64
+	Nonce        whereHelperstring
+	CodeVerifier whereHelperstring
+	ExpiresAt    whereHelpertime_Time
+}{
+	State:        whereHelperstring{field: "\"oidc_login_states\".\"state\""},
+	TenantID:     whereHelperint64{field: "\"oidc_login_states\".\"tenant_id\""},
+	Nonce:        whereHelperstring{field: "\"oidc_login_states\".\"nonce\""},
+	CodeVerifier: whereHelperstring{field: "\"oidc_login_states\".\"code_verifier\""},
+	ExpiresAt:    whereHelpertime_Time{field: "\"oidc_login_states\".\"expires_at\""},
+}
+
+// OidcLoginStateRels is where relationship names are stored.
+var OidcLoginStateRels = struct {
+	Tenant string
+}{
+	Tenant: "Tenant",
+}
+
+// oidcLoginStateR is where relationships are stored.
+type oidcLoginStateR struct {
+	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcLoginStateR) NewStruct() *oidcLoginStateR {
+	return &oidcLoginStateR{}
+}
+
+func (o *OidcLoginState) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *oidcLoginStateR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// oidcLoginStateL is where Load methods for each relationship are stored.
+type oidcLoginStateL struct{}
+
+var (
+	oidcLoginStateAllColumns            = []string{"state", "tenant_id", "nonce", "code_verifier", "expires_at"}
+	oidcLoginStateColumnsWithoutDefault = []string{"state", "tenant_id", "nonce", "code_verifier", "expires_at"}
+	oidcLoginStateColumnsWithDefault    = []string{}
+	oidcLoginStatePrimaryKeyColumns     = []string{"state"}
+	oidcLoginStateGeneratedColumns      = []string{}
+)
+
+type (
+	// OidcLoginStateSlice is an alias for a slice of pointers to OidcLoginState.
+	// This should almost always be used instead of []OidcLoginState.
+	OidcLoginStateSlice []*OidcLoginState
+	// OidcLoginStateHook is the signature for custom OidcLoginState hook methods
+	OidcLoginStateHook func(context.Context, boil.ContextExecutor, *OidcLoginState) error
+
+	oidcLoginStateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcLoginStateType                 = reflect.TypeOf(&OidcLoginState{})
+	oidcLoginStateMapping              = queries.MakeStructMapping(oidcLoginStateType)
+	oidcLoginStatePrimaryKeyMapping, _ = queries.BindMapping(oidcLoginStateType, oidcLoginStateMapping, oidcLoginStatePrimaryKeyColumns)
+	oidcLoginStateInsertCacheMut       sync.RWMutex
+	oidcLoginStateInsertCache          = make(map[string]insertCache)
+	oidcLoginStateUpdateCacheMut       sync.RWMutex
+	oidcLoginStateUpdateCache          = make(map[string]updateCache)
+	oidcLoginStateUpsertCacheMut       sync.RWMutex
+	oidcLoginStateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oidcLoginStateAfterSelectMu sync.Mutex
+var oidcLoginStateAfterSelectHooks []OidcLoginStateHook
+
+var oidcLoginStateBeforeInsertMu sync.Mutex
+var oidcLoginStateBeforeInsertHooks []OidcLoginStateHook
+var oidcLoginStateAfterInsertMu sync.Mutex
+var oidcLoginStateAfterInsertHooks []OidcLoginStateHook
+
+var oidcLoginStateBeforeUpdateMu sync.Mutex
+var oidcLoginStateBeforeUpdateHooks []OidcLoginStateHook
+var oidcLoginStateAfterUpdateMu sync.Mutex
+var oidcLoginStateAfterUpdateHooks []OidcLoginStateHook
+
+var oidcLoginStateBeforeDeleteMu sync.Mutex
+var oidcLoginStateBeforeDeleteHooks []OidcLoginStateHook
+var oidcLoginStateAfterDeleteMu sync.Mutex
+var oidcLoginStateAfterDeleteHooks []OidcLoginStateHook
+
+var oidcLoginStateBeforeUpsertMu sync.Mutex
+var oidcLoginStateBeforeUpsertHooks []OidcLoginStateHook
+var oidcLoginStateAfterUpsertMu sync.Mutex
+var oidcLoginStateAfterUpsertHooks []OidcLoginStateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OidcLoginState) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OidcLoginState) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OidcLoginState) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OidcLoginState) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OidcLoginState) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OidcLoginState) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OidcLoginState) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OidcLoginState) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OidcLoginState) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginStateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOidcLoginStateHook registers your hook function for all future operations.
+func AddOidcLoginStateHook(hookPoint boil.HookPoint, oidcLoginStateHook OidcLoginStateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oidcLoginStateAfterSelectMu.Lock()
+		oidcLoginStateAfterSelectHooks = append(oidcLoginStateAfterSelectHooks, oidcLoginStateHook)
+		oidcLoginStateAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		oidcLoginStateBeforeInsertMu.Lock()
+		oidcLoginStateBeforeInsertHooks = append(oidcLoginStateBeforeInsertHooks, oidcLoginStateHook)
+		oidcLoginStateBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		oidcLoginStateAfterInsertMu.Lock()
+		oidcLoginStateAfterInsertHooks = append(oidcLoginStateAfterInsertHooks, oidcLoginStateHook)
+		oidcLoginStateAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		oidcLoginStateBeforeUpdateMu.Lock()
+		oidcLoginStateBeforeUpdateHooks = append(oidcLoginStateBeforeUpdateHooks, oidcLoginStateHook)
+		oidcLoginStateBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		oidcLoginStateAfterUpdateMu.Lock()
+		oidcLoginStateAfterUpdateHooks = append(oidcLoginStateAfterUpdateHooks, oidcLoginStateHook)
+		oidcLoginStateAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		oidcLoginStateBeforeDeleteMu.Lock()
+		oidcLoginStateBeforeDeleteHooks = append(oidcLoginStateBeforeDeleteHooks, oidcLoginStateHook)
+		oidcLoginStateBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		oidcLoginStateAfterDeleteMu.Lock()
+		oidcLoginStateAfterDeleteHooks = append(oidcLoginStateAfterDeleteHooks, oidcLoginStateHook)
+		oidcLoginStateAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		oidcLoginStateBeforeUpsertMu.Lock()
+		oidcLoginStateBeforeUpsertHooks = append(oidcLoginStateBeforeUpsertHooks, oidcLoginStateHook)
+		oidcLoginStateBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		oidcLoginStateAfterUpsertMu.Lock()
+		oidcLoginStateAfterUpsertHooks = append(oidcLoginStateAfterUpsertHooks, oidcLoginStateHook)
+		oidcLoginStateAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single oidcLoginState record from the query.
+func (q oidcLoginStateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcLoginState, error) {
+	o := &OidcLoginState{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_login_states")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OidcLoginState records from the query.
+func (q oidcLoginStateQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcLoginStateSlice, error) {
+	var o []*OidcLoginState
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcLoginState slice")
+	}
+
+	if len(oidcLoginStateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OidcLoginState records in the query.
+func (q oidcLoginStateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_login_states rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcLoginStateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_login_states exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tenant pointed to by the foreign key.
+func (o *OidcLoginState) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oidcLoginStateL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOidcLoginState interface{}, mods queries.Applicator) error {
+	var slice []*OidcLoginState
+	var object *OidcLoginState
+
+	if singular {
+		var ok bool
+		object, ok = maybeOidcLoginState.(*OidcLoginState)
+		if !ok {
+			object = new(OidcLoginState)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOidcLoginState)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOidcLoginState))
+			}
+		}
+	} else {
+		s, ok := maybeOidcLoginState.(*[]*OidcLoginState)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOidcLoginState)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOidcLoginState))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &oidcLoginStateR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oidcLoginStateR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.OidcLoginStates = append(foreign.R.OidcLoginStates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.OidcLoginStates = append(foreign.R.OidcLoginStates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the oidcLoginState to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.OidcLoginStates.
+func (o *OidcLoginState) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oidc_login_states\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oidcLoginStatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.State}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &oidcLoginStateR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			OidcLoginStates: OidcLoginStateSlice{o},
+		}
+	} else {
+		related.R.OidcLoginStates = append(related.R.OidcLoginStates, o)
+	}
+
+	return nil
+}
+
+// OidcLoginStates retrieves all the records using an executor.
+func OidcLoginStates(mods ...qm.QueryMod) oidcLoginStateQuery {
+	mods = append(mods, qm.From("\"oidc_login_states\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oidc_login_states\".*"})
+	}
+
+	return oidcLoginStateQuery{q}
+}
+
+// FindOidcLoginState retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcLoginState(ctx context.Context, exec boil.ContextExecutor, state string, selectCols ...string) (*OidcLoginState, error) {
+	oidcLoginStateObj := &OidcLoginState{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oidc_login_states\" where \"state\"=$1", sel,
+	)
+
+	q := queries.Raw(query, state)
+
+	err := q.Bind(ctx, exec, oidcLoginStateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_login_states")
+	}
+
+	if err = oidcLoginStateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oidcLoginStateObj, err
+	}
+
+	return oidcLoginStateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcLoginState) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_login_states provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcLoginStateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcLoginStateInsertCacheMut.RLock()
+	cache, cached := oidcLoginStateInsertCache[key]
+	oidcLoginStateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcLoginStateAllColumns,
+			oidcLoginStateColumnsWithDefault,
+			oidcLoginStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oidcLoginStateType, oidcLoginStateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcLoginStateType, oidcLoginStateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oidc_login_states\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oidc_login_states\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_login_states")
+	}
+
+	if !cached {
+		oidcLoginStateInsertCacheMut.Lock()
+		oidcLoginStateInsertCache[key] = cache
+		oidcLoginStateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OidcLoginState.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcLoginState) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oidcLoginStateUpdateCacheMut.RLock()
+	cache, cached := oidcLoginStateUpdateCache[key]
+	oidcLoginStateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcLoginStateAllColumns,
+			oidcLoginStatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_login_states, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oidc_login_states\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oidcLoginStatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcLoginStateType, oidcLoginStateMapping, append(wl, oidcLoginStatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_login_states row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_login_states")
+	}
+
+	if !cached {
+		oidcLoginStateUpdateCacheMut.Lock()
+		oidcLoginStateUpdateCache[key] = cache
+		oidcLoginStateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcLoginStateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_login_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_login_states")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcLoginStateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oidc_login_states\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oidcLoginStatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcLoginState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcLoginState")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcLoginState) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no oidc_login_states provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcLoginStateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcLoginStateUpsertCacheMut.RLock()
+	cache, cached := oidcLoginStateUpsertCache[key]
+	oidcLoginStateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			oidcLoginStateAllColumns,
+			oidcLoginStateColumnsWithDefault,
+			oidcLoginStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oidcLoginStateAllColumns,
+			oidcLoginStatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_login_states, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(oidcLoginStateAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(oidcLoginStatePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert oidc_login_states, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(oidcLoginStatePrimaryKeyColumns))
+			copy(conflict, oidcLoginStatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oidc_login_states\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(oidcLoginStateType, oidcLoginStateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcLoginStateType, oidcLoginStateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_login_states")
+	}
+
+	if !cached {
+		oidcLoginStateUpsertCacheMut.Lock()
+		oidcLoginStateUpsertCache[key] = cache
+		oidcLoginStateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OidcLoginState record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcLoginState) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcLoginState provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcLoginStatePrimaryKeyMapping)
+	sql := "DELETE FROM \"oidc_login_states\" WHERE \"state\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_login_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_login_states")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcLoginStateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcLoginStateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_login_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_login_states")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcLoginStateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oidcLoginStateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oidc_login_states\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcLoginStatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcLoginState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_login_states")
+	}
+
+	if len(oidcLoginStateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcLoginState) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcLoginState(ctx, exec, o.State)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcLoginStateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcLoginStateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oidc_login_states\".* FROM \"oidc_login_states\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcLoginStatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcLoginStateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcLoginStateExists checks if the OidcLoginState row exists.
+func OidcLoginStateExists(ctx context.Context, exec boil.ContextExecutor, state string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oidc_login_states\" where \"state\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, state)
+	}
+	row := exec.QueryRowContext(ctx, sql, state)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_login_states exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OidcLoginState row exists.
+func (o *OidcLoginState) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OidcLoginStateExists(ctx, exec, o.State)
+}
@@ -0,0 +1,1548 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PollOption is an object representing the database table.
+type PollOption struct {
+	ID       int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PollID   int64  `boil:"poll_id" json:"poll_id" toml:"poll_id" yaml:"poll_id"`
+	Label    string `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Position int    `boil:"position" json:"position" toml:"position" yaml:"position"`
+	TenantID int64  `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+
+	R *pollOptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pollOptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PollOptionColumns = struct {
+	ID       string
+	PollID   string
+	Label    string
+	Position string
+	TenantID string
+}{
+	ID:       "id",
+	PollID:   "poll_id",
+	Label:    "label",
+	Position: "position",
+	TenantID: "tenant_id",
+}
+
+var PollOptionTableColumns = struct {
+	ID       string
+	PollID   string
+	Label    string
+	Position string
+	TenantID string
+}{
+	ID:       "poll_options.id",
+	PollID:   "poll_options.poll_id",
+	Label:    "poll_options.label",
+	Position: "poll_options.position",
+	TenantID: "poll_options.tenant_id",
+}
+
+// Generated where
+
+var PollOptionWhere = struct {
+	ID       whereHelperint64
+	PollID   whereHelperint64
+	Label    whereHelperstring
+	Position whereHelperint
+	TenantID whereHelperint64
+}{
+	ID:       whereHelperint64{field: "\"poll_options\".\"id\""},
+	PollID:   whereHelperint64{field: "\"poll_options\".\"poll_id\""},
+	Label:    whereHelperstring{field: "\"poll_options\".\"label\""},
+	Position: whereHelperint{field: "\"poll_options\".\"position\""},
+	TenantID: whereHelperint64{field: "\"poll_options\".\"tenant_id\""},
+}
+
+// PollOptionRels is where relationship names are stored.
+var PollOptionRels = struct {
+	Poll            string
+	Tenant          string
+	OptionPollVotes string
+}{
+	Poll:            "Poll",
+	Tenant:          "Tenant",
+	OptionPollVotes: "OptionPollVotes",
+}
+
+// pollOptionR is where relationships are stored.
+type pollOptionR struct {
+	Poll            *Poll         `boil:"Poll" json:"Poll" toml:"Poll" yaml:"Poll"`
+	Tenant          *Tenant       `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	OptionPollVotes PollVoteSlice `boil:"OptionPollVotes" json:"OptionPollVotes" toml:"OptionPollVotes" yaml:"OptionPollVotes"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pollOptionR) NewStruct() *pollOptionR {
+	return &pollOptionR{}
+}
+
+func (o *PollOption) GetPoll() *Poll {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPoll()
+}
+
+func (r *pollOptionR) GetPoll() *Poll {
+	if r == nil {
+		return nil
+	}
+
+	return r.Poll
+}
+
+func (o *PollOption) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *pollOptionR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *PollOption) GetOptionPollVotes() PollVoteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOptionPollVotes()
+}
+
+func (r *pollOptionR) GetOptionPollVotes() PollVoteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OptionPollVotes
+}
+
+// pollOptionL is where Load methods for each relationship are stored.
+type pollOptionL struct{}
+
+var (
+	pollOptionAllColumns            = []string{"id", "poll_id", "label", "position", "tenant_id"}
+	pollOptionColumnsWithoutDefault = []string{"poll_id", "label", "position", "tenant_id"}
+	pollOptionColumnsWithDefault    = []string{"id"}
+	pollOptionPrimaryKeyColumns     = []string{"id"}
+	pollOptionGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// PollOptionSlice is an alias for a slice of pointers to PollOption.
+	// This should almost always be used instead of []PollOption.
+	PollOptionSlice []*PollOption
+	// PollOptionHook is the signature for custom PollOption hook methods
+	PollOptionHook func(context.Context, boil.ContextExecutor, *PollOption) error
+
+	pollOptionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pollOptionType                 = reflect.TypeOf(&PollOption{})
+	pollOptionMapping              = queries.MakeStructMapping(pollOptionType)
+	pollOptionPrimaryKeyMapping, _ = queries.BindMapping(pollOptionType, pollOptionMapping, pollOptionPrimaryKeyColumns)
+	pollOptionInsertCacheMut       sync.RWMutex
+	pollOptionInsertCache          = make(map[string]insertCache)
+	pollOptionUpdateCacheMut       sync.RWMutex
+	pollOptionUpdateCache          = make(map[string]updateCache)
+	pollOptionUpsertCacheMut       sync.RWMutex
+	pollOptionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pollOptionAfterSelectMu sync.Mutex
+var pollOptionAfterSelectHooks []PollOptionHook
+
+var pollOptionBeforeInsertMu sync.Mutex
+var pollOptionBeforeInsertHooks []PollOptionHook
+var pollOptionAfterInsertMu sync.Mutex
+var pollOptionAfterInsertHooks []PollOptionHook
+
+var pollOptionBeforeUpdateMu sync.Mutex
+var pollOptionBeforeUpdateHooks []PollOptionHook
+var pollOptionAfterUpdateMu sync.Mutex
+var pollOptionAfterUpdateHooks []PollOptionHook
+
+var pollOptionBeforeDeleteMu sync.Mutex
+var pollOptionBeforeDeleteHooks []PollOptionHook
+var pollOptionAfterDeleteMu sync.Mutex
+var pollOptionAfterDeleteHooks []PollOptionHook
+
+var pollOptionBeforeUpsertMu sync.Mutex
+var pollOptionBeforeUpsertHooks []PollOptionHook
+var pollOptionAfterUpsertMu sync.Mutex
+var pollOptionAfterUpsertHooks []PollOptionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PollOption) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PollOption) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PollOption) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PollOption) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PollOption) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PollOption) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PollOption) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PollOption) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PollOption) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollOptionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPollOptionHook registers your hook function for all future operations.
+func AddPollOptionHook(hookPoint boil.HookPoint, pollOptionHook PollOptionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pollOptionAfterSelectMu.Lock()
+		pollOptionAfterSelectHooks = append(pollOptionAfterSelectHooks, pollOptionHook)
+		pollOptionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pollOptionBeforeInsertMu.Lock()
+		pollOptionBeforeInsertHooks = append(pollOptionBeforeInsertHooks, pollOptionHook)
+		pollOptionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pollOptionAfterInsertMu.Lock()
+		pollOptionAfterInsertHooks = append(pollOptionAfterInsertHooks, pollOptionHook)
+		pollOptionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pollOptionBeforeUpdateMu.Lock()
+		pollOptionBeforeUpdateHooks = append(pollOptionBeforeUpdateHooks, pollOptionHook)
+		pollOptionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pollOptionAfterUpdateMu.Lock()
+		pollOptionAfterUpdateHooks = append(pollOptionAfterUpdateHooks, pollOptionHook)
+		pollOptionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pollOptionBeforeDeleteMu.Lock()
+		pollOptionBeforeDeleteHooks = append(pollOptionBeforeDeleteHooks, pollOptionHook)
+		pollOptionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pollOptionAfterDeleteMu.Lock()
+		pollOptionAfterDeleteHooks = append(pollOptionAfterDeleteHooks, pollOptionHook)
+		pollOptionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pollOptionBeforeUpsertMu.Lock()
+		pollOptionBeforeUpsertHooks = append(pollOptionBeforeUpsertHooks, pollOptionHook)
+		pollOptionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pollOptionAfterUpsertMu.Lock()
+		pollOptionAfterUpsertHooks = append(pollOptionAfterUpsertHooks, pollOptionHook)
+		pollOptionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single pollOption record from the query.
+func (q pollOptionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PollOption, error) {
+	o := &PollOption{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for poll_options")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PollOption records from the query.
+func (q pollOptionQuery) All(ctx context.Context, exec boil.ContextExecutor) (PollOptionSlice, error) {
+	var o []*PollOption
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PollOption slice")
+	}
+
+	if len(pollOptionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PollOption records in the query.
+func (q pollOptionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count poll_options rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pollOptionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if poll_options exists")
+	}
+
+	return count > 0, nil
+}
+
+// Poll pointed to by the foreign key.
+func (o *PollOption) Poll(mods ...qm.QueryMod) pollQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PollID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Polls(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *PollOption) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// OptionPollVotes retrieves all the poll_vote's PollVotes with an executor via option_id column.
+func (o *PollOption) OptionPollVotes(mods ...qm.QueryMod) pollVoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"poll_votes\".\"option_id\"=?", o.ID),
+	)
+
+	return PollVotes(queryMods...)
+}
+
+// LoadPoll allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollOptionL) LoadPoll(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollOption interface{}, mods queries.Applicator) error {
+	var slice []*PollOption
+	var object *PollOption
+
+	if singular {
+		var ok bool
+		object, ok = maybePollOption.(*PollOption)
+		if !ok {
+			object = new(PollOption)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollOption)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollOption))
+			}
+		}
+	} else {
+		s, ok := maybePollOption.(*[]*PollOption)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollOption)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollOption))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollOptionR{}
+		}
+		args[object.PollID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollOptionR{}
+			}
+
+			args[obj.PollID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`polls`),
+		qm.WhereIn(`polls.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Poll")
+	}
+
+	var resultSlice []*Poll
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Poll")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for polls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for polls")
+	}
+
+	if len(pollAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Poll = foreign
+		if foreign.R == nil {
+			foreign.R = &pollR{}
+		}
+		foreign.R.PollOptions = append(foreign.R.PollOptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PollID == foreign.ID {
+				local.R.Poll = foreign
+				if foreign.R == nil {
+					foreign.R = &pollR{}
+				}
+				foreign.R.PollOptions = append(foreign.R.PollOptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollOptionL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollOption interface{}, mods queries.Applicator) error {
+	var slice []*PollOption
+	var object *PollOption
+
+	if singular {
+		var ok bool
+		object, ok = maybePollOption.(*PollOption)
+		if !ok {
+			object = new(PollOption)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollOption)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollOption))
+			}
+		}
+	} else {
+		s, ok := maybePollOption.(*[]*PollOption)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollOption)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollOption))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollOptionR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollOptionR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.PollOptions = append(foreign.R.PollOptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.PollOptions = append(foreign.R.PollOptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOptionPollVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pollOptionL) LoadOptionPollVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollOption interface{}, mods queries.Applicator) error {
+	var slice []*PollOption
+	var object *PollOption
+
+	if singular {
+		var ok bool
+		object, ok = maybePollOption.(*PollOption)
+		if !ok {
+			object = new(PollOption)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollOption)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollOption))
+			}
+		}
+	} else {
+		s, ok := maybePollOption.(*[]*PollOption)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollOption)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollOption))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollOptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollOptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`poll_votes`),
+		qm.WhereIn(`poll_votes.option_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load poll_votes")
+	}
+
+	var resultSlice []*PollVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice poll_votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on poll_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_votes")
+	}
+
+	if len(pollVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OptionPollVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pollVoteR{}
+			}
+			foreign.R.Option = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OptionID {
+				local.R.OptionPollVotes = append(local.R.OptionPollVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &pollVoteR{}
+				}
+				foreign.R.Option = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPoll of the pollOption to the related item.
+// Sets o.R.Poll to related.
+// Adds o to related.R.PollOptions.
+func (o *PollOption) SetPoll(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Poll) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"poll_options\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"poll_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollOptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PollID = related.ID
+	if o.R == nil {
+		o.R = &pollOptionR{
+			Poll: related,
+		}
+	} else {
+		o.R.Poll = related
+	}
+
+	if related.R == nil {
+		related.R = &pollR{
+			PollOptions: PollOptionSlice{o},
+		}
+	} else {
+		related.R.PollOptions = append(related.R.PollOptions, o)
+	}
+
+	return nil
+}
+
+// SetTenant of the pollOption to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.PollOptions.
+func (o *PollOption) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"poll_options\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollOptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &pollOptionR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			PollOptions: PollOptionSlice{o},
+		}
+	} else {
+		related.R.PollOptions = append(related.R.PollOptions, o)
+	}
+
+	return nil
+}
+
+// AddOptionPollVotes adds the given related objects to the existing relationships
+// of the poll_option, optionally inserting them as new records.
+// Appends related to o.R.OptionPollVotes.
+// Sets related.R.Option appropriately.
+func (o *PollOption) AddOptionPollVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PollVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OptionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"poll_votes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"option_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OptionID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OptionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pollOptionR{
+			OptionPollVotes: related,
+		}
+	} else {
+		o.R.OptionPollVotes = append(o.R.OptionPollVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollVoteR{
+				Option: o,
+			}
+		} else {
+			rel.R.Option = o
+		}
+	}
+	return nil
+}
+
+// PollOptions retrieves all the records using an executor.
+func PollOptions(mods ...qm.QueryMod) pollOptionQuery {
+	mods = append(mods, qm.From("\"poll_options\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"poll_options\".*"})
+	}
+
+	return pollOptionQuery{q}
+}
+
+// FindPollOption retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPollOption(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*PollOption, error) {
+	pollOptionObj := &PollOption{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"poll_options\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pollOptionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from poll_options")
+	}
+
+	if err = pollOptionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pollOptionObj, err
+	}
+
+	return pollOptionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PollOption) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no poll_options provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollOptionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pollOptionInsertCacheMut.RLock()
+	cache, cached := pollOptionInsertCache[key]
+	pollOptionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pollOptionAllColumns,
+			pollOptionColumnsWithDefault,
+			pollOptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, pollOptionGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(pollOptionType, pollOptionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pollOptionType, pollOptionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"poll_options\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"poll_options\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into poll_options")
+	}
+
+	if !cached {
+		pollOptionInsertCacheMut.Lock()
+		pollOptionInsertCache[key] = cache
+		pollOptionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PollOption.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PollOption) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pollOptionUpdateCacheMut.RLock()
+	cache, cached := pollOptionUpdateCache[key]
+	pollOptionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pollOptionAllColumns,
+			pollOptionPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, pollOptionGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update poll_options, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"poll_options\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pollOptionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pollOptionType, pollOptionMapping, append(wl, pollOptionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update poll_options row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for poll_options")
+	}
+
+	if !cached {
+		pollOptionUpdateCacheMut.Lock()
+		pollOptionUpdateCache[key] = cache
+		pollOptionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pollOptionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for poll_options")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for poll_options")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PollOptionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollOptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"poll_options\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pollOptionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pollOption slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pollOption")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PollOption) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no poll_options provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollOptionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pollOptionUpsertCacheMut.RLock()
+	cache, cached := pollOptionUpsertCache[key]
+	pollOptionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pollOptionAllColumns,
+			pollOptionColumnsWithDefault,
+			pollOptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pollOptionAllColumns,
+			pollOptionPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, pollOptionGeneratedColumns)
+		update = strmangle.SetComplement(update, pollOptionGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert poll_options, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pollOptionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pollOptionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert poll_options, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pollOptionPrimaryKeyColumns))
+			copy(conflict, pollOptionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"poll_options\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pollOptionType, pollOptionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pollOptionType, pollOptionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert poll_options")
+	}
+
+	if !cached {
+		pollOptionUpsertCacheMut.Lock()
+		pollOptionUpsertCache[key] = cache
+		pollOptionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PollOption record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PollOption) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PollOption provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pollOptionPrimaryKeyMapping)
+	sql := "DELETE FROM \"poll_options\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from poll_options")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for poll_options")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pollOptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pollOptionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from poll_options")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for poll_options")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PollOptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pollOptionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollOptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"poll_options\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollOptionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pollOption slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for poll_options")
+	}
+
+	if len(pollOptionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PollOption) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPollOption(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PollOptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PollOptionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollOptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"poll_options\".* FROM \"poll_options\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollOptionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PollOptionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PollOptionExists checks if the PollOption row exists.
+func PollOptionExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"poll_options\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if poll_options exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PollOption row exists.
+func (o *PollOption) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PollOptionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1752 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PollVote is an object representing the database table.
+type PollVote struct {
+	PollID    int64     `boil:"poll_id" json:"poll_id" toml:"poll_id" yaml:"poll_id"`
+	OptionID  int64     `boil:"option_id" json:"option_id" toml:"option_id" yaml:"option_id"`
+	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TenantID  int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *pollVoteR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pollVoteL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PollVoteColumns = struct {
+	PollID    string
+	OptionID  string
+	UserID    string
+	TenantID  string
+	CreatedAt string
+}{
+	PollID:    "poll_id",
+	OptionID:  "option_id",
+	UserID:    "user_id",
+	TenantID:  "tenant_id",
+	CreatedAt: "created_at",
+}
+
+var PollVoteTableColumns = struct {
+	PollID    string
+	OptionID  string
+	UserID    string
+	TenantID  string
+	CreatedAt string
+}{
+	PollID:    "poll_votes.poll_id",
+	OptionID:  "poll_votes.option_id",
+	UserID:    "poll_votes.user_id",
+	TenantID:  "poll_votes.tenant_id",
+	CreatedAt: "poll_votes.created_at",
+}
+
+// Generated where
+
+var PollVoteWhere = struct {
+	PollID    whereHelperint64
+	OptionID  whereHelperint64
+	UserID    whereHelperint64
+	TenantID  whereHelperint64
+	CreatedAt whereHelpertime_Time
+}{
+	PollID:    whereHelperint64{field: "\"poll_votes\".\"poll_id\""},
+	OptionID:  whereHelperint64{field: "\"poll_votes\".\"option_id\""},
+	UserID:    whereHelperint64{field: "\"poll_votes\".\"user_id\""},
+	TenantID:  whereHelperint64{field: "\"poll_votes\".\"tenant_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"poll_votes\".\"created_at\""},
+}
+
+// PollVoteRels is where relationship names are stored.
+var PollVoteRels = struct {
+	Option string
+	Poll   string
+	Tenant string
+	User   string
+}{
+	Option: "Option",
+	Poll:   "Poll",
+	Tenant: "Tenant",
+	User:   "User",
+}
+
+// pollVoteR is where relationships are stored.
+type pollVoteR struct {
+	Option *PollOption `boil:"Option" json:"Option" toml:"Option" yaml:"Option"`
+	Poll   *Poll       `boil:"Poll" json:"Poll" toml:"Poll" yaml:"Poll"`
+	Tenant *Tenant     `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	User   *User       `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pollVoteR) NewStruct() *pollVoteR {
+	return &pollVoteR{}
+}
+
+func (o *PollVote) GetOption() *PollOption {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOption()
+}
+
+func (r *pollVoteR) GetOption() *PollOption {
+	if r == nil {
+		return nil
+	}
+
+	return r.Option
+}
+
+func (o *PollVote) GetPoll() *Poll {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPoll()
+}
+
+func (r *pollVoteR) GetPoll() *Poll {
+	if r == nil {
+		return nil
+	}
+
+	return r.Poll
+}
+
+func (o *PollVote) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *pollVoteR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *PollVote) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *pollVoteR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// pollVoteL is where Load methods for each relationship are stored.
+type pollVoteL struct{}
+
+var (
+	pollVoteAllColumns            = []string{"poll_id", "option_id", "user_id", "tenant_id", "created_at"}
+	pollVoteColumnsWithoutDefault = []string{"poll_id", "option_id", "user_id", "tenant_id"}
+	pollVoteColumnsWithDefault    = []string{"created_at"}
+	pollVotePrimaryKeyColumns     = []string{"option_id", "user_id"}
+	pollVoteGeneratedColumns      = []string{}
+)
+
+type (
+	// PollVoteSlice is an alias for a slice of pointers to PollVote.
+	// This should almost always be used instead of []PollVote.
+	PollVoteSlice []*PollVote
+	// PollVoteHook is the signature for custom PollVote hook methods
+	PollVoteHook func(context.Context, boil.ContextExecutor, *PollVote) error
+
+	pollVoteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pollVoteType                 = reflect.TypeOf(&PollVote{})
+	pollVoteMapping              = queries.MakeStructMapping(pollVoteType)
+	pollVotePrimaryKeyMapping, _ = queries.BindMapping(pollVoteType, pollVoteMapping, pollVotePrimaryKeyColumns)
+	pollVoteInsertCacheMut       sync.RWMutex
+	pollVoteInsertCache          = make(map[string]insertCache)
+	pollVoteUpdateCacheMut       sync.RWMutex
+	pollVoteUpdateCache          = make(map[string]updateCache)
+	pollVoteUpsertCacheMut       sync.RWMutex
+	pollVoteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pollVoteAfterSelectMu sync.Mutex
+var pollVoteAfterSelectHooks []PollVoteHook
+
+var pollVoteBeforeInsertMu sync.Mutex
+var pollVoteBeforeInsertHooks []PollVoteHook
+var pollVoteAfterInsertMu sync.Mutex
+var pollVoteAfterInsertHooks []PollVoteHook
+
+var pollVoteBeforeUpdateMu sync.Mutex
+var pollVoteBeforeUpdateHooks []PollVoteHook
+var pollVoteAfterUpdateMu sync.Mutex
+var pollVoteAfterUpdateHooks []PollVoteHook
+
+var pollVoteBeforeDeleteMu sync.Mutex
+var pollVoteBeforeDeleteHooks []PollVoteHook
+var pollVoteAfterDeleteMu sync.Mutex
+var pollVoteAfterDeleteHooks []PollVoteHook
+
+var pollVoteBeforeUpsertMu sync.Mutex
+var pollVoteBeforeUpsertHooks []PollVoteHook
+var pollVoteAfterUpsertMu sync.Mutex
+var pollVoteAfterUpsertHooks []PollVoteHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PollVote) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PollVote) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PollVote) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PollVote) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PollVote) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PollVote) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PollVote) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PollVote) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PollVote) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollVoteAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPollVoteHook registers your hook function for all future operations.
+func AddPollVoteHook(hookPoint boil.HookPoint, pollVoteHook PollVoteHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pollVoteAfterSelectMu.Lock()
+		pollVoteAfterSelectHooks = append(pollVoteAfterSelectHooks, pollVoteHook)
+		pollVoteAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pollVoteBeforeInsertMu.Lock()
+		pollVoteBeforeInsertHooks = append(pollVoteBeforeInsertHooks, pollVoteHook)
+		pollVoteBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pollVoteAfterInsertMu.Lock()
+		pollVoteAfterInsertHooks = append(pollVoteAfterInsertHooks, pollVoteHook)
+		pollVoteAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pollVoteBeforeUpdateMu.Lock()
+		pollVoteBeforeUpdateHooks = append(pollVoteBeforeUpdateHooks, pollVoteHook)
+		pollVoteBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pollVoteAfterUpdateMu.Lock()
+		pollVoteAfterUpdateHooks = append(pollVoteAfterUpdateHooks, pollVoteHook)
+		pollVoteAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pollVoteBeforeDeleteMu.Lock()
+		pollVoteBeforeDeleteHooks = append(pollVoteBeforeDeleteHooks, pollVoteHook)
+		pollVoteBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pollVoteAfterDeleteMu.Lock()
+		pollVoteAfterDeleteHooks = append(pollVoteAfterDeleteHooks, pollVoteHook)
+		pollVoteAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pollVoteBeforeUpsertMu.Lock()
+		pollVoteBeforeUpsertHooks = append(pollVoteBeforeUpsertHooks, pollVoteHook)
+		pollVoteBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pollVoteAfterUpsertMu.Lock()
+		pollVoteAfterUpsertHooks = append(pollVoteAfterUpsertHooks, pollVoteHook)
+		pollVoteAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single pollVote record from the query.
+func (q pollVoteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PollVote, error) {
+	o := &PollVote{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for poll_votes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PollVote records from the query.
+func (q pollVoteQuery) All(ctx context.Context, exec boil.ContextExecutor) (PollVoteSlice, error) {
+	var o []*PollVote
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PollVote slice")
+	}
+
+	if len(pollVoteAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PollVote records in the query.
+func (q pollVoteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count poll_votes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pollVoteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if poll_votes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Option pointed to by the foreign key.
+func (o *PollVote) Option(mods ...qm.QueryMod) pollOptionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OptionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PollOptions(queryMods...)
+}
+
+// Poll pointed to by the foreign key.
+func (o *PollVote) Poll(mods ...qm.QueryMod) pollQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PollID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Polls(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *PollVote) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *PollVote) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadOption allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollVoteL) LoadOption(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollVote interface{}, mods queries.Applicator) error {
+	var slice []*PollVote
+	var object *PollVote
+
+	if singular {
+		var ok bool
+		object, ok = maybePollVote.(*PollVote)
+		if !ok {
+			object = new(PollVote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollVote))
+			}
+		}
+	} else {
+		s, ok := maybePollVote.(*[]*PollVote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollVote))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollVoteR{}
+		}
+		args[object.OptionID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollVoteR{}
+			}
+
+			args[obj.OptionID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`poll_options`),
+		qm.WhereIn(`poll_options.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PollOption")
+	}
+
+	var resultSlice []*PollOption
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PollOption")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for poll_options")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_options")
+	}
+
+	if len(pollOptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Option = foreign
+		if foreign.R == nil {
+			foreign.R = &pollOptionR{}
+		}
+		foreign.R.OptionPollVotes = append(foreign.R.OptionPollVotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OptionID == foreign.ID {
+				local.R.Option = foreign
+				if foreign.R == nil {
+					foreign.R = &pollOptionR{}
+				}
+				foreign.R.OptionPollVotes = append(foreign.R.OptionPollVotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPoll allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollVoteL) LoadPoll(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollVote interface{}, mods queries.Applicator) error {
+	var slice []*PollVote
+	var object *PollVote
+
+	if singular {
+		var ok bool
+		object, ok = maybePollVote.(*PollVote)
+		if !ok {
+			object = new(PollVote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollVote))
+			}
+		}
+	} else {
+		s, ok := maybePollVote.(*[]*PollVote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollVote))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollVoteR{}
+		}
+		args[object.PollID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollVoteR{}
+			}
+
+			args[obj.PollID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`polls`),
+		qm.WhereIn(`polls.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Poll")
+	}
+
+	var resultSlice []*Poll
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Poll")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for polls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for polls")
+	}
+
+	if len(pollAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Poll = foreign
+		if foreign.R == nil {
+			foreign.R = &pollR{}
+		}
+		foreign.R.PollVotes = append(foreign.R.PollVotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PollID == foreign.ID {
+				local.R.Poll = foreign
+				if foreign.R == nil {
+					foreign.R = &pollR{}
+				}
+				foreign.R.PollVotes = append(foreign.R.PollVotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollVoteL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollVote interface{}, mods queries.Applicator) error {
+	var slice []*PollVote
+	var object *PollVote
+
+	if singular {
+		var ok bool
+		object, ok = maybePollVote.(*PollVote)
+		if !ok {
+			object = new(PollVote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollVote))
+			}
+		}
+	} else {
+		s, ok := maybePollVote.(*[]*PollVote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollVote))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollVoteR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollVoteR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.PollVotes = append(foreign.R.PollVotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.PollVotes = append(foreign.R.PollVotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollVoteL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollVote interface{}, mods queries.Applicator) error {
+	var slice []*PollVote
+	var object *PollVote
+
+	if singular {
+		var ok bool
+		object, ok = maybePollVote.(*PollVote)
+		if !ok {
+			object = new(PollVote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollVote))
+			}
+		}
+	} else {
+		s, ok := maybePollVote.(*[]*PollVote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollVote))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollVoteR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollVoteR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PollVotes = append(foreign.R.PollVotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PollVotes = append(foreign.R.PollVotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOption of the pollVote to the related item.
+// Sets o.R.Option to related.
+// Adds o to related.R.OptionPollVotes.
+func (o *PollVote) SetOption(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PollOption) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"poll_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"option_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OptionID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OptionID = related.ID
+	if o.R == nil {
+		o.R = &pollVoteR{
+			Option: related,
+		}
+	} else {
+		o.R.Option = related
+	}
+
+	if related.R == nil {
+		related.R = &pollOptionR{
+			OptionPollVotes: PollVoteSlice{o},
+		}
+	} else {
+		related.R.OptionPollVotes = append(related.R.OptionPollVotes, o)
+	}
+
+	return nil
+}
+
+// SetPoll of the pollVote to the related item.
+// Sets o.R.Poll to related.
+// Adds o to related.R.PollVotes.
+func (o *PollVote) SetPoll(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Poll) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"poll_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"poll_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OptionID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PollID = related.ID
+	if o.R == nil {
+		o.R = &pollVoteR{
+			Poll: related,
+		}
+	} else {
+		o.R.Poll = related
+	}
+
+	if related.R == nil {
+		related.R = &pollR{
+			PollVotes: PollVoteSlice{o},
+		}
+	} else {
+		related.R.PollVotes = append(related.R.PollVotes, o)
+	}
+
+	return nil
+}
+
+// SetTenant of the pollVote to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.PollVotes.
+func (o *PollVote) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"poll_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OptionID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &pollVoteR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			PollVotes: PollVoteSlice{o},
+		}
+	} else {
+		related.R.PollVotes = append(related.R.PollVotes, o)
+	}
+
+	return nil
+}
+
+// SetUser of the pollVote to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PollVotes.
+func (o *PollVote) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"poll_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OptionID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &pollVoteR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PollVotes: PollVoteSlice{o},
+		}
+	} else {
+		related.R.PollVotes = append(related.R.PollVotes, o)
+	}
+
+	return nil
+}
+
+// PollVotes retrieves all the records using an executor.
+func PollVotes(mods ...qm.QueryMod) pollVoteQuery {
+	mods = append(mods, qm.From("\"poll_votes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"poll_votes\".*"})
+	}
+
+	return pollVoteQuery{q}
+}
+
+// FindPollVote retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPollVote(ctx context.Context, exec boil.ContextExecutor, optionID int64, userID int64, selectCols ...string) (*PollVote, error) {
+	pollVoteObj := &PollVote{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"poll_votes\" where \"option_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, optionID, userID)
+
+	err := q.Bind(ctx, exec, pollVoteObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from poll_votes")
+	}
+
+	if err = pollVoteObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pollVoteObj, err
+	}
+
+	return pollVoteObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PollVote) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no poll_votes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollVoteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pollVoteInsertCacheMut.RLock()
+	cache, cached := pollVoteInsertCache[key]
+	pollVoteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pollVoteAllColumns,
+			pollVoteColumnsWithDefault,
+			pollVoteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pollVoteType, pollVoteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pollVoteType, pollVoteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"poll_votes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"poll_votes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into poll_votes")
+	}
+
+	if !cached {
+		pollVoteInsertCacheMut.Lock()
+		pollVoteInsertCache[key] = cache
+		pollVoteInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PollVote.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PollVote) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pollVoteUpdateCacheMut.RLock()
+	cache, cached := pollVoteUpdateCache[key]
+	pollVoteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pollVoteAllColumns,
+			pollVotePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update poll_votes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"poll_votes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pollVotePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pollVoteType, pollVoteMapping, append(wl, pollVotePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update poll_votes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for poll_votes")
+	}
+
+	if !cached {
+		pollVoteUpdateCacheMut.Lock()
+		pollVoteUpdateCache[key] = cache
+		pollVoteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pollVoteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for poll_votes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for poll_votes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PollVoteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollVotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"poll_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pollVotePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pollVote slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pollVote")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PollVote) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no poll_votes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollVoteColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pollVoteUpsertCacheMut.RLock()
+	cache, cached := pollVoteUpsertCache[key]
+	pollVoteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pollVoteAllColumns,
+			pollVoteColumnsWithDefault,
+			pollVoteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pollVoteAllColumns,
+			pollVotePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert poll_votes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pollVoteAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pollVotePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert poll_votes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pollVotePrimaryKeyColumns))
+			copy(conflict, pollVotePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"poll_votes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pollVoteType, pollVoteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pollVoteType, pollVoteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert poll_votes")
+	}
+
+	if !cached {
+		pollVoteUpsertCacheMut.Lock()
+		pollVoteUpsertCache[key] = cache
+		pollVoteUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PollVote record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PollVote) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PollVote provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pollVotePrimaryKeyMapping)
+	sql := "DELETE FROM \"poll_votes\" WHERE \"option_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from poll_votes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for poll_votes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pollVoteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pollVoteQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from poll_votes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for poll_votes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PollVoteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pollVoteBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollVotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"poll_votes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollVotePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pollVote slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for poll_votes")
+	}
+
+	if len(pollVoteAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PollVote) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPollVote(ctx, exec, o.OptionID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PollVoteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PollVoteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollVotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"poll_votes\".* FROM \"poll_votes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollVotePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PollVoteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PollVoteExists checks if the PollVote row exists.
+func PollVoteExists(ctx context.Context, exec boil.ContextExecutor, optionID int64, userID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"poll_votes\" where \"option_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, optionID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, optionID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if poll_votes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PollVote row exists.
+func (o *PollVote) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PollVoteExists(ctx, exec, o.OptionID, o.UserID)
+}
@@ -0,0 +1,1783 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Poll is an object representing the database table.
+type Poll struct {
+	ID                int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PostID            int64     `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	Question          string    `boil:"question" json:"question" toml:"question" yaml:"question"`
+	MultipleChoice    bool      `boil:"multiple_choice" json:"multiple_choice" toml:"multiple_choice" yaml:"multiple_choice"`
+	ResultsVisibility string    `boil:"results_visibility" json:"results_visibility" toml:"results_visibility" yaml:"results_visibility"`
+	ClosesAt          null.Time `boil:"closes_at" json:"closes_at,omitempty" toml:"closes_at" yaml:"closes_at,omitempty"`
+	TenantID          int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *pollR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pollL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PollColumns = struct {
+	ID                string
+	PostID            string
+	Question          string
+	MultipleChoice    string
+	ResultsVisibility string
+	ClosesAt          string
+	TenantID          string
+	CreatedAt         string
+}{
+	ID:                "id",
+	PostID:            "post_id",
+	Question:          "question",
+	MultipleChoice:    "multiple_choice",
+	ResultsVisibility: "results_visibility",
+	ClosesAt:          "closes_at",
+	TenantID:          "tenant_id",
+	CreatedAt:         "created_at",
+}
+
+var PollTableColumns = struct {
+	ID                string
+	PostID            string
+	Question          string
+	MultipleChoice    string
+	ResultsVisibility string
+	ClosesAt          string
+	TenantID          string
+	CreatedAt         string
+}{
+	ID:                "polls.id",
+	PostID:            "polls.post_id",
+	Question:          "polls.question",
+	MultipleChoice:    "polls.multiple_choice",
+	ResultsVisibility: "polls.results_visibility",
+	ClosesAt:          "polls.closes_at",
+	TenantID:          "polls.tenant_id",
+	CreatedAt:         "polls.created_at",
+}
+
+// Generated where
+
+var PollWhere = struct {
+	ID                whereHelperint64
+	PostID            whereHelperint64
+	Question          whereHelperstring
+	MultipleChoice    whereHelperbool
+	ResultsVisibility whereHelperstring
+	ClosesAt          whereHelpernull_Time
+	TenantID          whereHelperint64
+	CreatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperint64{field: "\"polls\".\"id\""},
+	PostID:            whereHelperint64{field: "\"polls\".\"post_id\""},
+	Question:          whereHelperstring{field: "\"polls\".\"question\""},
+	MultipleChoice:    whereHelperbool{field: "\"polls\".\"multiple_choice\""},
+	ResultsVisibility: whereHelperstring{field: "\"polls\".\"results_visibility\""},
+	ClosesAt:          whereHelpernull_Time{field: "\"polls\".\"closes_at\""},
+	TenantID:          whereHelperint64{field: "\"polls\".\"tenant_id\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"polls\".\"created_at\""},
+}
+
+// PollRels is where relationship names are stored.
+var PollRels = struct {
+	Post        string
+	Tenant      string
+	PollOptions string
+	PollVotes   string
+}{
+	Post:        "Post",
+	Tenant:      "Tenant",
+	PollOptions: "PollOptions",
+	PollVotes:   "PollVotes",
+}
+
+// pollR is where relationships are stored.
+type pollR struct {
+	Post        *Post           `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Tenant      *Tenant         `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	PollOptions PollOptionSlice `boil:"PollOptions" json:"PollOptions" toml:"PollOptions" yaml:"PollOptions"`
+	PollVotes   PollVoteSlice   `boil:"PollVotes" json:"PollVotes" toml:"PollVotes" yaml:"PollVotes"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pollR) NewStruct() *pollR {
+	return &pollR{}
+}
+
+func (o *Poll) GetPost() *Post {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPost()
+}
+
+func (r *pollR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+
+	return r.Post
+}
+
+func (o *Poll) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *pollR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *Poll) GetPollOptions() PollOptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPollOptions()
+}
+
+func (r *pollR) GetPollOptions() PollOptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PollOptions
+}
+
+func (o *Poll) GetPollVotes() PollVoteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPollVotes()
+}
+
+func (r *pollR) GetPollVotes() PollVoteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PollVotes
+}
+
+// pollL is where Load methods for each relationship are stored.
+type pollL struct{}
+
+var (
+	pollAllColumns            = []string{"id", "post_id", "question", "multiple_choice", "results_visibility", "closes_at", "tenant_id", "created_at"}
+	pollColumnsWithoutDefault = []string{"post_id", "question", "tenant_id"}
+	pollColumnsWithDefault    = []string{"id", "multiple_choice", "results_visibility", "closes_at", "created_at"}
+	pollPrimaryKeyColumns     = []string{"id"}
+	pollGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// PollSlice is an alias for a slice of pointers to Poll.
+	// This should almost always be used instead of []Poll.
+	PollSlice []*Poll
+	// PollHook is the signature for custom Poll hook methods
+	PollHook func(context.Context, boil.ContextExecutor, *Poll) error
+
+	pollQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pollType                 = reflect.TypeOf(&Poll{})
+	pollMapping              = queries.MakeStructMapping(pollType)
+	pollPrimaryKeyMapping, _ = queries.BindMapping(pollType, pollMapping, pollPrimaryKeyColumns)
+	pollInsertCacheMut       sync.RWMutex
+	pollInsertCache          = make(map[string]insertCache)
+	pollUpdateCacheMut       sync.RWMutex
+	pollUpdateCache          = make(map[string]updateCache)
+	pollUpsertCacheMut       sync.RWMutex
+	pollUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pollAfterSelectMu sync.Mutex
+var pollAfterSelectHooks []PollHook
+
+var pollBeforeInsertMu sync.Mutex
+var pollBeforeInsertHooks []PollHook
+var pollAfterInsertMu sync.Mutex
+var pollAfterInsertHooks []PollHook
+
+var pollBeforeUpdateMu sync.Mutex
+var pollBeforeUpdateHooks []PollHook
+var pollAfterUpdateMu sync.Mutex
+var pollAfterUpdateHooks []PollHook
+
+var pollBeforeDeleteMu sync.Mutex
+var pollBeforeDeleteHooks []PollHook
+var pollAfterDeleteMu sync.Mutex
+var pollAfterDeleteHooks []PollHook
+
+var pollBeforeUpsertMu sync.Mutex
+var pollBeforeUpsertHooks []PollHook
+var pollAfterUpsertMu sync.Mutex
+var pollAfterUpsertHooks []PollHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Poll) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Poll) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Poll) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Poll) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Poll) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Poll) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Poll) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Poll) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Poll) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPollHook registers your hook function for all future operations.
+func AddPollHook(hookPoint boil.HookPoint, pollHook PollHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pollAfterSelectMu.Lock()
+		pollAfterSelectHooks = append(pollAfterSelectHooks, pollHook)
+		pollAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pollBeforeInsertMu.Lock()
+		pollBeforeInsertHooks = append(pollBeforeInsertHooks, pollHook)
+		pollBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pollAfterInsertMu.Lock()
+		pollAfterInsertHooks = append(pollAfterInsertHooks, pollHook)
+		pollAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pollBeforeUpdateMu.Lock()
+		pollBeforeUpdateHooks = append(pollBeforeUpdateHooks, pollHook)
+		pollBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pollAfterUpdateMu.Lock()
+		pollAfterUpdateHooks = append(pollAfterUpdateHooks, pollHook)
+		pollAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pollBeforeDeleteMu.Lock()
+		pollBeforeDeleteHooks = append(pollBeforeDeleteHooks, pollHook)
+		pollBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pollAfterDeleteMu.Lock()
+		pollAfterDeleteHooks = append(pollAfterDeleteHooks, pollHook)
+		pollAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pollBeforeUpsertMu.Lock()
+		pollBeforeUpsertHooks = append(pollBeforeUpsertHooks, pollHook)
+		pollBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pollAfterUpsertMu.Lock()
+		pollAfterUpsertHooks = append(pollAfterUpsertHooks, pollHook)
+		pollAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single poll record from the query.
+func (q pollQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Poll, error) {
+	o := &Poll{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for polls")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Poll records from the query.
+func (q pollQuery) All(ctx context.Context, exec boil.ContextExecutor) (PollSlice, error) {
+	var o []*Poll
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Poll slice")
+	}
+
+	if len(pollAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Poll records in the query.
+func (q pollQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count polls rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pollQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if polls exists")
+	}
+
+	return count > 0, nil
+}
+
+// Post pointed to by the foreign key.
+func (o *Poll) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *Poll) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// PollOptions retrieves all the poll_option's PollOptions with an executor.
+func (o *Poll) PollOptions(mods ...qm.QueryMod) pollOptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"poll_options\".\"poll_id\"=?", o.ID),
+	)
+
+	return PollOptions(queryMods...)
+}
+
+// PollVotes retrieves all the poll_vote's PollVotes with an executor.
+func (o *Poll) PollVotes(mods ...qm.QueryMod) pollVoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"poll_votes\".\"poll_id\"=?", o.ID),
+	)
+
+	return PollVotes(queryMods...)
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybePoll interface{}, mods queries.Applicator) error {
+	var slice []*Poll
+	var object *Poll
+
+	if singular {
+		var ok bool
+		object, ok = maybePoll.(*Poll)
+		if !ok {
+			object = new(Poll)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePoll))
+			}
+		}
+	} else {
+		s, ok := maybePoll.(*[]*Poll)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePoll))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollR{}
+		}
+		args[object.PostID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollR{}
+			}
+
+			args[obj.PostID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.Poll = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Poll = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybePoll interface{}, mods queries.Applicator) error {
+	var slice []*Poll
+	var object *Poll
+
+	if singular {
+		var ok bool
+		object, ok = maybePoll.(*Poll)
+		if !ok {
+			object = new(Poll)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePoll))
+			}
+		}
+	} else {
+		s, ok := maybePoll.(*[]*Poll)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePoll))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.Polls = append(foreign.R.Polls, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.Polls = append(foreign.R.Polls, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPollOptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pollL) LoadPollOptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePoll interface{}, mods queries.Applicator) error {
+	var slice []*Poll
+	var object *Poll
+
+	if singular {
+		var ok bool
+		object, ok = maybePoll.(*Poll)
+		if !ok {
+			object = new(Poll)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePoll))
+			}
+		}
+	} else {
+		s, ok := maybePoll.(*[]*Poll)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePoll))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`poll_options`),
+		qm.WhereIn(`poll_options.poll_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load poll_options")
+	}
+
+	var resultSlice []*PollOption
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice poll_options")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on poll_options")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_options")
+	}
+
+	if len(pollOptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PollOptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pollOptionR{}
+			}
+			foreign.R.Poll = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PollID {
+				local.R.PollOptions = append(local.R.PollOptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &pollOptionR{}
+				}
+				foreign.R.Poll = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPollVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pollL) LoadPollVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybePoll interface{}, mods queries.Applicator) error {
+	var slice []*Poll
+	var object *Poll
+
+	if singular {
+		var ok bool
+		object, ok = maybePoll.(*Poll)
+		if !ok {
+			object = new(Poll)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePoll))
+			}
+		}
+	} else {
+		s, ok := maybePoll.(*[]*Poll)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePoll)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePoll))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`poll_votes`),
+		qm.WhereIn(`poll_votes.poll_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load poll_votes")
+	}
+
+	var resultSlice []*PollVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice poll_votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on poll_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_votes")
+	}
+
+	if len(pollVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PollVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pollVoteR{}
+			}
+			foreign.R.Poll = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PollID {
+				local.R.PollVotes = append(local.R.PollVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &pollVoteR{}
+				}
+				foreign.R.Poll = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPost of the poll to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.Poll.
+func (o *Poll) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"polls\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &pollR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			Poll: o,
+		}
+	} else {
+		related.R.Poll = o
+	}
+
+	return nil
+}
+
+// SetTenant of the poll to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Polls.
+func (o *Poll) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"polls\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &pollR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Polls: PollSlice{o},
+		}
+	} else {
+		related.R.Polls = append(related.R.Polls, o)
+	}
+
+	return nil
+}
+
+// AddPollOptions adds the given related objects to the existing relationships
+// of the poll, optionally inserting them as new records.
+// Appends related to o.R.PollOptions.
+// Sets related.R.Poll appropriately.
+func (o *Poll) AddPollOptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PollOption) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PollID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"poll_options\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"poll_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollOptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PollID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pollR{
+			PollOptions: related,
+		}
+	} else {
+		o.R.PollOptions = append(o.R.PollOptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollOptionR{
+				Poll: o,
+			}
+		} else {
+			rel.R.Poll = o
+		}
+	}
+	return nil
+}
+
+// AddPollVotes adds the given related objects to the existing relationships
+// of the poll, optionally inserting them as new records.
+// Appends related to o.R.PollVotes.
+// Sets related.R.Poll appropriately.
+func (o *Poll) AddPollVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PollVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PollID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"poll_votes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"poll_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OptionID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PollID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pollR{
+			PollVotes: related,
+		}
+	} else {
+		o.R.PollVotes = append(o.R.PollVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollVoteR{
+				Poll: o,
+			}
+		} else {
+			rel.R.Poll = o
+		}
+	}
+	return nil
+}
+
+// Polls retrieves all the records using an executor.
+func Polls(mods ...qm.QueryMod) pollQuery {
+	mods = append(mods, qm.From("\"polls\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"polls\".*"})
+	}
+
+	return pollQuery{q}
+}
+
+// FindPoll retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPoll(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Poll, error) {
+	pollObj := &Poll{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"polls\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pollObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from polls")
+	}
+
+	if err = pollObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pollObj, err
+	}
+
+	return pollObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Poll) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no polls provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pollInsertCacheMut.RLock()
+	cache, cached := pollInsertCache[key]
+	pollInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pollAllColumns,
+			pollColumnsWithDefault,
+			pollColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, pollGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(pollType, pollMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pollType, pollMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"polls\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"polls\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into polls")
+	}
+
+	if !cached {
+		pollInsertCacheMut.Lock()
+		pollInsertCache[key] = cache
+		pollInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Poll.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Poll) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pollUpdateCacheMut.RLock()
+	cache, cached := pollUpdateCache[key]
+	pollUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pollAllColumns,
+			pollPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, pollGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update polls, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"polls\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pollPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pollType, pollMapping, append(wl, pollPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update polls row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for polls")
+	}
+
+	if !cached {
+		pollUpdateCacheMut.Lock()
+		pollUpdateCache[key] = cache
+		pollUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pollQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for polls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for polls")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PollSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"polls\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pollPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in poll slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all poll")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Poll) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no polls provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pollUpsertCacheMut.RLock()
+	cache, cached := pollUpsertCache[key]
+	pollUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pollAllColumns,
+			pollColumnsWithDefault,
+			pollColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pollAllColumns,
+			pollPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, pollGeneratedColumns)
+		update = strmangle.SetComplement(update, pollGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert polls, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pollAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pollPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert polls, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pollPrimaryKeyColumns))
+			copy(conflict, pollPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"polls\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pollType, pollMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pollType, pollMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert polls")
+	}
+
+	if !cached {
+		pollUpsertCacheMut.Lock()
+		pollUpsertCache[key] = cache
+		pollUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Poll record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Poll) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Poll provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pollPrimaryKeyMapping)
+	sql := "DELETE FROM \"polls\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from polls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for polls")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pollQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pollQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from polls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for polls")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PollSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pollBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"polls\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from poll slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for polls")
+	}
+
+	if len(pollAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Poll) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPoll(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PollSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PollSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"polls\".* FROM \"polls\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PollSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PollExists checks if the Poll row exists.
+func PollExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"polls\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if polls exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Poll row exists.
+func (o *Poll) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PollExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1596 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PostHistory is an object representing the database table.
+type PostHistory struct {
+	ID        int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PostID    int64      `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	ActorID   int64      `boil:"actor_id" json:"actor_id" toml:"actor_id" yaml:"actor_id"`
+	Action    string     `boil:"action" json:"action" toml:"action" yaml:"action"`
+	Data      types.JSON `boil:"data" json:"data" toml:"data" yaml:"data"`
+	TenantID  int64      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *postHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L postHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PostHistoryColumns = struct {
+	ID        string
+	PostID    string
+	ActorID   string
+	Action    string
+	Data      string
+	TenantID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	PostID:    "post_id",
+	ActorID:   "actor_id",
+	Action:    "action",
+	Data:      "data",
+	TenantID:  "tenant_id",
+	CreatedAt: "created_at",
+}
+
+var PostHistoryTableColumns = struct {
+	ID        string
+	PostID    string
+	ActorID   string
+	Action    string
+	Data      string
+	TenantID  string
+	CreatedAt string
+}{
+	ID:        "post_histories.id",
+	PostID:    "post_histories.post_id",
+	ActorID:   "post_histories.actor_id",
+	Action:    "post_histories.action",
+	Data:      "post_histories.data",
+	TenantID:  "post_histories.tenant_id",
+	CreatedAt: "post_histories.created_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var PostHistoryWhere = struct {
+	ID        whereHelperint64
+	PostID    whereHelperint64
+	ActorID   whereHelperint64
+	Action    whereHelperstring
+	Data      whereHelpertypes_JSON
+	TenantID  whereHelperint64
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"post_histories\".\"id\""},
+	PostID:    whereHelperint64{field: "\"post_histories\".\"post_id\""},
+	ActorID:   whereHelperint64{field: "\"post_histories\".\"actor_id\""},
+	Action:    whereHelperstring{field: "\"post_histories\".\"action\""},
+	Data:      whereHelpertypes_JSON{field: "\"post_histories\".\"data\""},
+	TenantID:  whereHelperint64{field: "\"post_histories\".\"tenant_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"post_histories\".\"created_at\""},
+}
+
+// PostHistoryRels is where relationship names are stored.
+var PostHistoryRels = struct {
+	Actor  string
+	Post   string
+	Tenant string
+}{
+	Actor:  "Actor",
+	Post:   "Post",
+	Tenant: "Tenant",
+}
+
+// postHistoryR is where relationships are stored.
+type postHistoryR struct {
+	Actor  *User   `boil:"Actor" json:"Actor" toml:"Actor" yaml:"Actor"`
+	Post   *Post   `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*postHistoryR) NewStruct() *postHistoryR {
+	return &postHistoryR{}
+}
+
+func (o *PostHistory) GetActor() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActor()
+}
+
+func (r *postHistoryR) GetActor() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Actor
+}
+
+func (o *PostHistory) GetPost() *Post {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPost()
+}
+
+func (r *postHistoryR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+
+	return r.Post
+}
+
+func (o *PostHistory) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *postHistoryR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// postHistoryL is where Load methods for each relationship are stored.
+type postHistoryL struct{}
+
+var (
+	postHistoryAllColumns            = []string{"id", "post_id", "actor_id", "action", "data", "tenant_id", "created_at"}
+	postHistoryColumnsWithoutDefault = []string{"post_id", "actor_id", "action", "tenant_id"}
+	postHistoryColumnsWithDefault    = []string{"id", "data", "created_at"}
+	postHistoryPrimaryKeyColumns     = []string{"id"}
+	postHistoryGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// PostHistorySlice is an alias for a slice of pointers to PostHistory.
+	// This should almost always be used instead of []PostHistory.
+	PostHistorySlice []*PostHistory
+	// PostHistoryHook is the signature for custom PostHistory hook methods
+	PostHistoryHook func(context.Context, boil.ContextExecutor, *PostHistory) error
+
+	postHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	postHistoryType                 = reflect.TypeOf(&PostHistory{})
+	postHistoryMapping              = queries.MakeStructMapping(postHistoryType)
+	postHistoryPrimaryKeyMapping, _ = queries.BindMapping(postHistoryType, postHistoryMapping, postHistoryPrimaryKeyColumns)
+	postHistoryInsertCacheMut       sync.RWMutex
+	postHistoryInsertCache          = make(map[string]insertCache)
+	postHistoryUpdateCacheMut       sync.RWMutex
+	postHistoryUpdateCache          = make(map[string]updateCache)
+	postHistoryUpsertCacheMut       sync.RWMutex
+	postHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var postHistoryAfterSelectMu sync.Mutex
+var postHistoryAfterSelectHooks []PostHistoryHook
+
+var postHistoryBeforeInsertMu sync.Mutex
+var postHistoryBeforeInsertHooks []PostHistoryHook
+var postHistoryAfterInsertMu sync.Mutex
+var postHistoryAfterInsertHooks []PostHistoryHook
+
+var postHistoryBeforeUpdateMu sync.Mutex
+var postHistoryBeforeUpdateHooks []PostHistoryHook
+var postHistoryAfterUpdateMu sync.Mutex
+var postHistoryAfterUpdateHooks []PostHistoryHook
+
+var postHistoryBeforeDeleteMu sync.Mutex
+var postHistoryBeforeDeleteHooks []PostHistoryHook
+var postHistoryAfterDeleteMu sync.Mutex
+var postHistoryAfterDeleteHooks []PostHistoryHook
+
+var postHistoryBeforeUpsertMu sync.Mutex
+var postHistoryBeforeUpsertHooks []PostHistoryHook
+var postHistoryAfterUpsertMu sync.Mutex
+var postHistoryAfterUpsertHooks []PostHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PostHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PostHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PostHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PostHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PostHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PostHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PostHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PostHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PostHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPostHistoryHook registers your hook function for all future operations.
+func AddPostHistoryHook(hookPoint boil.HookPoint, postHistoryHook PostHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		postHistoryAfterSelectMu.Lock()
+		postHistoryAfterSelectHooks = append(postHistoryAfterSelectHooks, postHistoryHook)
+		postHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		postHistoryBeforeInsertMu.Lock()
+		postHistoryBeforeInsertHooks = append(postHistoryBeforeInsertHooks, postHistoryHook)
+		postHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		postHistoryAfterInsertMu.Lock()
+		postHistoryAfterInsertHooks = append(postHistoryAfterInsertHooks, postHistoryHook)
+		postHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		postHistoryBeforeUpdateMu.Lock()
+		postHistoryBeforeUpdateHooks = append(postHistoryBeforeUpdateHooks, postHistoryHook)
+		postHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		postHistoryAfterUpdateMu.Lock()
+		postHistoryAfterUpdateHooks = append(postHistoryAfterUpdateHooks, postHistoryHook)
+		postHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		postHistoryBeforeDeleteMu.Lock()
+		postHistoryBeforeDeleteHooks = append(postHistoryBeforeDeleteHooks, postHistoryHook)
+		postHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		postHistoryAfterDeleteMu.Lock()
+		postHistoryAfterDeleteHooks = append(postHistoryAfterDeleteHooks, postHistoryHook)
+		postHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		postHistoryBeforeUpsertMu.Lock()
+		postHistoryBeforeUpsertHooks = append(postHistoryBeforeUpsertHooks, postHistoryHook)
+		postHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		postHistoryAfterUpsertMu.Lock()
+		postHistoryAfterUpsertHooks = append(postHistoryAfterUpsertHooks, postHistoryHook)
+		postHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single postHistory record from the query.
+func (q postHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PostHistory, error) {
+	o := &PostHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for post_histories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PostHistory records from the query.
+func (q postHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (PostHistorySlice, error) {
+	var o []*PostHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PostHistory slice")
+	}
+
+	if len(postHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PostHistory records in the query.
+func (q postHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count post_histories rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q postHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if post_histories exists")
+	}
+
+	return count > 0, nil
+}
+
+// Actor pointed to by the foreign key.
+func (o *PostHistory) Actor(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ActorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Post pointed to by the foreign key.
+func (o *PostHistory) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *PostHistory) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadActor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postHistoryL) LoadActor(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostHistory interface{}, mods queries.Applicator) error {
+	var slice []*PostHistory
+	var object *PostHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybePostHistory.(*PostHistory)
+		if !ok {
+			object = new(PostHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostHistory))
+			}
+		}
+	} else {
+		s, ok := maybePostHistory.(*[]*PostHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postHistoryR{}
+		}
+		args[object.ActorID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postHistoryR{}
+			}
+
+			args[obj.ActorID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Actor = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ActorPostHistories = append(foreign.R.ActorPostHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ActorID == foreign.ID {
+				local.R.Actor = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ActorPostHistories = append(foreign.R.ActorPostHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postHistoryL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostHistory interface{}, mods queries.Applicator) error {
+	var slice []*PostHistory
+	var object *PostHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybePostHistory.(*PostHistory)
+		if !ok {
+			object = new(PostHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostHistory))
+			}
+		}
+	} else {
+		s, ok := maybePostHistory.(*[]*PostHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postHistoryR{}
+		}
+		args[object.PostID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postHistoryR{}
+			}
+
+			args[obj.PostID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.PostHistories = append(foreign.R.PostHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.PostHistories = append(foreign.R.PostHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postHistoryL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostHistory interface{}, mods queries.Applicator) error {
+	var slice []*PostHistory
+	var object *PostHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybePostHistory.(*PostHistory)
+		if !ok {
+			object = new(PostHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostHistory))
+			}
+		}
+	} else {
+		s, ok := maybePostHistory.(*[]*PostHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postHistoryR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postHistoryR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.PostHistories = append(foreign.R.PostHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.PostHistories = append(foreign.R.PostHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetActor of the postHistory to the related item.
+// Sets o.R.Actor to related.
+// Adds o to related.R.ActorPostHistories.
+func (o *PostHistory) SetActor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ActorID = related.ID
+	if o.R == nil {
+		o.R = &postHistoryR{
+			Actor: related,
+		}
+	} else {
+		o.R.Actor = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ActorPostHistories: PostHistorySlice{o},
+		}
+	} else {
+		related.R.ActorPostHistories = append(related.R.ActorPostHistories, o)
+	}
+
+	return nil
+}
+
+// SetPost of the postHistory to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.PostHistories.
+func (o *PostHistory) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &postHistoryR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			PostHistories: PostHistorySlice{o},
+		}
+	} else {
+		related.R.PostHistories = append(related.R.PostHistories, o)
+	}
+
+	return nil
+}
+
+// SetTenant of the postHistory to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.PostHistories.
+func (o *PostHistory) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &postHistoryR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			PostHistories: PostHistorySlice{o},
+		}
+	} else {
+		related.R.PostHistories = append(related.R.PostHistories, o)
+	}
+
+	return nil
+}
+
+// PostHistories retrieves all the records using an executor.
+func PostHistories(mods ...qm.QueryMod) postHistoryQuery {
+	mods = append(mods, qm.From("\"post_histories\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"post_histories\".*"})
+	}
+
+	return postHistoryQuery{q}
+}
+
+// FindPostHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPostHistory(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*PostHistory, error) {
+	postHistoryObj := &PostHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"post_histories\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, postHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from post_histories")
+	}
+
+	if err = postHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return postHistoryObj, err
+	}
+
+	return postHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PostHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no post_histories provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	postHistoryInsertCacheMut.RLock()
+	cache, cached := postHistoryInsertCache[key]
+	postHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			postHistoryAllColumns,
+			postHistoryColumnsWithDefault,
+			postHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, postHistoryGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(postHistoryType, postHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(postHistoryType, postHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"post_histories\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"post_histories\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into post_histories")
+	}
+
+	if !cached {
+		postHistoryInsertCacheMut.Lock()
+		postHistoryInsertCache[key] = cache
+		postHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PostHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PostHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	postHistoryUpdateCacheMut.RLock()
+	cache, cached := postHistoryUpdateCache[key]
+	postHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			postHistoryAllColumns,
+			postHistoryPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, postHistoryGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update post_histories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"post_histories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, postHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(postHistoryType, postHistoryMapping, append(wl, postHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update post_histories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for post_histories")
+	}
+
+	if !cached {
+		postHistoryUpdateCacheMut.Lock()
+		postHistoryUpdateCache[key] = cache
+		postHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q postHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for post_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for post_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PostHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"post_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, postHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in postHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all postHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PostHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no post_histories provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	postHistoryUpsertCacheMut.RLock()
+	cache, cached := postHistoryUpsertCache[key]
+	postHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			postHistoryAllColumns,
+			postHistoryColumnsWithDefault,
+			postHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			postHistoryAllColumns,
+			postHistoryPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, postHistoryGeneratedColumns)
+		update = strmangle.SetComplement(update, postHistoryGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert post_histories, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(postHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(postHistoryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert post_histories, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(postHistoryPrimaryKeyColumns))
+			copy(conflict, postHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"post_histories\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(postHistoryType, postHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(postHistoryType, postHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert post_histories")
+	}
+
+	if !cached {
+		postHistoryUpsertCacheMut.Lock()
+		postHistoryUpsertCache[key] = cache
+		postHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PostHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PostHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PostHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), postHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"post_histories\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from post_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for post_histories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q postHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no postHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from post_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PostHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(postHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"post_histories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from postHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_histories")
+	}
+
+	if len(postHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PostHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPostHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PostHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PostHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"post_histories\".* FROM \"post_histories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PostHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PostHistoryExists checks if the PostHistory row exists.
+func PostHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"post_histories\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if post_histories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PostHistory row exists.
+func (o *PostHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PostHistoryExists(ctx, exec, o.ID)
+}
@@ -18,95 +18,224 @@ import (
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"github.com/aarondl/strmangle"
 	"github.com/friendsofgo/errors"
 )
 
 // Post is an object representing the database table.
 type Post struct {
-	ID         int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatorID  int64     `boil:"creator_id" json:"creator_id" toml:"creator_id" yaml:"creator_id"`
-	SubtopicID int64     `boil:"subtopic_id" json:"subtopic_id" toml:"subtopic_id" yaml:"subtopic_id"`
-	TenantID   int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID                int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatorID         null.Int64 `boil:"creator_id" json:"creator_id,omitempty" toml:"creator_id" yaml:"creator_id,omitempty"`
+	SubtopicID        int64      `boil:"subtopic_id" json:"subtopic_id" toml:"subtopic_id" yaml:"subtopic_id"`
+	TenantID          int64      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt         time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         null.Time  `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	OverdueNotifiedAt null.Time  `boil:"overdue_notified_at" json:"overdue_notified_at,omitempty" toml:"overdue_notified_at" yaml:"overdue_notified_at,omitempty"`
+	Title             string     `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Body              string     `boil:"body" json:"body" toml:"body" yaml:"body"`
+	AssigneeUserID    null.Int64 `boil:"assignee_user_id" json:"assignee_user_id,omitempty" toml:"assignee_user_id" yaml:"assignee_user_id,omitempty"`
+	AssigneeRoleID    null.Int64 `boil:"assignee_role_id" json:"assignee_role_id,omitempty" toml:"assignee_role_id" yaml:"assignee_role_id,omitempty"`
+	AssignedAt        null.Time  `boil:"assigned_at" json:"assigned_at,omitempty" toml:"assigned_at" yaml:"assigned_at,omitempty"`
+	MergedIntoID      null.Int64 `boil:"merged_into_id" json:"merged_into_id,omitempty" toml:"merged_into_id" yaml:"merged_into_id,omitempty"`
+	ClosedAt          null.Time  `boil:"closed_at" json:"closed_at,omitempty" toml:"closed_at" yaml:"closed_at,omitempty"`
+	LockedAt          null.Time  `boil:"locked_at" json:"locked_at,omitempty" toml:"locked_at" yaml:"locked_at,omitempty"`
+	Anonymous         bool       `boil:"anonymous" json:"anonymous" toml:"anonymous" yaml:"anonymous"`
+	Fields            types.JSON `boil:"fields" json:"fields" toml:"fields" yaml:"fields"`
+	CustomFields      types.JSON `boil:"custom_fields" json:"custom_fields" toml:"custom_fields" yaml:"custom_fields"`
+	Wiki              bool       `boil:"wiki" json:"wiki" toml:"wiki" yaml:"wiki"`
+	Version           int        `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *postR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L postL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PostColumns = struct {
-	ID         string
-	CreatorID  string
-	SubtopicID string
-	TenantID   string
-	CreatedAt  string
-	UpdatedAt  string
+	ID                string
+	CreatorID         string
+	SubtopicID        string
+	TenantID          string
+	CreatedAt         string
+	UpdatedAt         string
+	OverdueNotifiedAt string
+	Title             string
+	Body              string
+	AssigneeUserID    string
+	AssigneeRoleID    string
+	AssignedAt        string
+	MergedIntoID      string
+	ClosedAt          string
+	LockedAt          string
+	Anonymous         string
+	Fields            string
+	CustomFields      string
+	Wiki              string
+	Version           string
 }{
-	ID:         "id",
-	CreatorID:  "creator_id",
-	SubtopicID: "subtopic_id",
-	TenantID:   "tenant_id",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
+	ID:                "id",
+	CreatorID:         "creator_id",
+	SubtopicID:        "subtopic_id",
+	TenantID:          "tenant_id",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	OverdueNotifiedAt: "overdue_notified_at",
+	Title:             "title",
+	Body:              "body",
+	AssigneeUserID:    "assignee_user_id",
+	AssigneeRoleID:    "assignee_role_id",
+	AssignedAt:        "assigned_at",
+	MergedIntoID:      "merged_into_id",
+	ClosedAt:          "closed_at",
+	LockedAt:          "locked_at",
+	Anonymous:         "anonymous",
+	Fields:            "fields",
+	CustomFields:      "custom_fields",
+	Wiki:              "wiki",
+	Version:           "version",
 }
 
 var PostTableColumns = struct {
-	ID         string
-	CreatorID  string
-	SubtopicID string
-	TenantID   string
-	CreatedAt  string
-	UpdatedAt  string
+	ID                string
+	CreatorID         string
+	SubtopicID        string
+	TenantID          string
+	CreatedAt         string
+	UpdatedAt         string
+	OverdueNotifiedAt string
+	Title             string
+	Body              string
+	AssigneeUserID    string
+	AssigneeRoleID    string
+	AssignedAt        string
+	MergedIntoID      string
+	ClosedAt          string
+	LockedAt          string
+	Anonymous         string
+	Fields            string
+	CustomFields      string
+	Wiki              string
+	Version           string
 }{
-	ID:         "posts.id",
-	CreatorID:  "posts.creator_id",
-	SubtopicID: "posts.subtopic_id",
-	TenantID:   "posts.tenant_id",
-	CreatedAt:  "posts.created_at",
-	UpdatedAt:  "posts.updated_at",
+	ID:                "posts.id",
+	CreatorID:         "posts.creator_id",
+	SubtopicID:        "posts.subtopic_id",
+	TenantID:          "posts.tenant_id",
+	CreatedAt:         "posts.created_at",
+	UpdatedAt:         "posts.updated_at",
+	OverdueNotifiedAt: "posts.overdue_notified_at",
+	Title:             "posts.title",
+	Body:              "posts.body",
+	AssigneeUserID:    "posts.assignee_user_id",
+	AssigneeRoleID:    "posts.assignee_role_id",
+	AssignedAt:        "posts.assigned_at",
+	MergedIntoID:      "posts.merged_into_id",
+	ClosedAt:          "posts.closed_at",
+	LockedAt:          "posts.locked_at",
+	Anonymous:         "posts.anonymous",
+	Fields:            "posts.fields",
+	CustomFields:      "posts.custom_fields",
+	Wiki:              "posts.wiki",
+	Version:           "posts.version",
 }
 
 // Generated where
 
 var PostWhere = struct {
-	ID         whereHelperint64
-	CreatorID  whereHelperint64
-	SubtopicID whereHelperint64
-	TenantID   whereHelperint64
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpernull_Time
+	ID                whereHelperint64
+	CreatorID         whereHelpernull_Int64
+	SubtopicID        whereHelperint64
+	TenantID          whereHelperint64
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpernull_Time
+	OverdueNotifiedAt whereHelpernull_Time
+	Title             whereHelperstring
+	Body              whereHelperstring
+	AssigneeUserID    whereHelpernull_Int64
+	AssigneeRoleID    whereHelpernull_Int64
+	AssignedAt        whereHelpernull_Time
+	MergedIntoID      whereHelpernull_Int64
+	ClosedAt          whereHelpernull_Time
+	LockedAt          whereHelpernull_Time
+	Anonymous         whereHelperbool
+	Fields            whereHelpertypes_JSON
+	CustomFields      whereHelpertypes_JSON
+	Wiki              whereHelperbool
+	Version           whereHelperint
 }{
-	ID:         whereHelperint64{field: "\"posts\".\"id\""},
-	CreatorID:  whereHelperint64{field: "\"posts\".\"creator_id\""},
-	SubtopicID: whereHelperint64{field: "\"posts\".\"subtopic_id\""},
-	TenantID:   whereHelperint64{field: "\"posts\".\"tenant_id\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"posts\".\"created_at\""},
-	UpdatedAt:  whereHelpernull_Time{field: "\"posts\".\"updated_at\""},
+	ID:                whereHelperint64{field: "\"posts\".\"id\""},
+	CreatorID:         whereHelpernull_Int64{field: "\"posts\".\"creator_id\""},
+	SubtopicID:        whereHelperint64{field: "\"posts\".\"subtopic_id\""},
+	TenantID:          whereHelperint64{field: "\"posts\".\"tenant_id\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"posts\".\"created_at\""},
+	UpdatedAt:         whereHelpernull_Time{field: "\"posts\".\"updated_at\""},
+	OverdueNotifiedAt: whereHelpernull_Time{field: "\"posts\".\"overdue_notified_at\""},
+	Title:             whereHelperstring{field: "\"posts\".\"title\""},
+	Body:              whereHelperstring{field: "\"posts\".\"body\""},
+	AssigneeUserID:    whereHelpernull_Int64{field: "\"posts\".\"assignee_user_id\""},
+	AssigneeRoleID:    whereHelpernull_Int64{field: "\"posts\".\"assignee_role_id\""},
+	AssignedAt:        whereHelpernull_Time{field: "\"posts\".\"assigned_at\""},
+	MergedIntoID:      whereHelpernull_Int64{field: "\"posts\".\"merged_into_id\""},
+	ClosedAt:          whereHelpernull_Time{field: "\"posts\".\"closed_at\""},
+	LockedAt:          whereHelpernull_Time{field: "\"posts\".\"locked_at\""},
+	Anonymous:         whereHelperbool{field: "\"posts\".\"anonymous\""},
+	Fields:            whereHelpertypes_JSON{field: "\"posts\".\"fields\""},
+	CustomFields:      whereHelpertypes_JSON{field: "\"posts\".\"custom_fields\""},
+	Wiki:              whereHelperbool{field: "\"posts\".\"wiki\""},
+	Version:           whereHelperint{field: "\"posts\".\"version\""},
 }
 
 // PostRels is where relationship names are stored.
 var PostRels = struct {
-	Creator  string
-	Subtopic string
-	Tenant   string
-	Answers  string
-	Tags     string
+	AssigneeRole        string
+	AssigneeUser        string
+	Creator             string
+	MergedInto          string
+	Subtopic            string
+	Tenant              string
+	AnonymousPostAuthor string
+	Poll                string
+	Answers             string
+	Notifications       string
+	PostHistories       string
+	Tags                string
+	MergedIntoPosts     string
+	Reactions           string
+	SuggestedEdits      string
 }{
-	Creator:  "Creator",
-	Subtopic: "Subtopic",
-	Tenant:   "Tenant",
-	Answers:  "Answers",
-	Tags:     "Tags",
+	AssigneeRole:        "AssigneeRole",
+	AssigneeUser:        "AssigneeUser",
+	Creator:             "Creator",
+	MergedInto:          "MergedInto",
+	Subtopic:            "Subtopic",
+	Tenant:              "Tenant",
+	AnonymousPostAuthor: "AnonymousPostAuthor",
+	Poll:                "Poll",
+	Answers:             "Answers",
+	Notifications:       "Notifications",
+	PostHistories:       "PostHistories",
+	Tags:                "Tags",
+	MergedIntoPosts:     "MergedIntoPosts",
+	Reactions:           "Reactions",
+	SuggestedEdits:      "SuggestedEdits",
 }
 
 // postR is where relationships are stored.
 type postR struct {
-	Creator  *User       `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
-	Subtopic *SubTopic   `boil:"Subtopic" json:"Subtopic" toml:"Subtopic" yaml:"Subtopic"`
-	Tenant   *Tenant     `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	Answers  AnswerSlice `boil:"Answers" json:"Answers" toml:"Answers" yaml:"Answers"`
-	Tags     TagSlice    `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	AssigneeRole        *Role                `boil:"AssigneeRole" json:"AssigneeRole" toml:"AssigneeRole" yaml:"AssigneeRole"`
+	AssigneeUser        *User                `boil:"AssigneeUser" json:"AssigneeUser" toml:"AssigneeUser" yaml:"AssigneeUser"`
+	Creator             *User                `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
+	MergedInto          *Post                `boil:"MergedInto" json:"MergedInto" toml:"MergedInto" yaml:"MergedInto"`
+	Subtopic            *SubTopic            `boil:"Subtopic" json:"Subtopic" toml:"Subtopic" yaml:"Subtopic"`
+	Tenant              *Tenant              `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	AnonymousPostAuthor *AnonymousPostAuthor `boil:"AnonymousPostAuthor" json:"AnonymousPostAuthor" toml:"AnonymousPostAuthor" yaml:"AnonymousPostAuthor"`
+	Poll                *Poll                `boil:"Poll" json:"Poll" toml:"Poll" yaml:"Poll"`
+	Answers             AnswerSlice          `boil:"Answers" json:"Answers" toml:"Answers" yaml:"Answers"`
+	Notifications       NotificationSlice    `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	PostHistories       PostHistorySlice     `boil:"PostHistories" json:"PostHistories" toml:"PostHistories" yaml:"PostHistories"`
+	Tags                TagSlice             `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	MergedIntoPosts     PostSlice            `boil:"MergedIntoPosts" json:"MergedIntoPosts" toml:"MergedIntoPosts" yaml:"MergedIntoPosts"`
+	Reactions           ReactionSlice        `boil:"Reactions" json:"Reactions" toml:"Reactions" yaml:"Reactions"`
+	SuggestedEdits      SuggestedEditSlice   `boil:"SuggestedEdits" json:"SuggestedEdits" toml:"SuggestedEdits" yaml:"SuggestedEdits"`
 }
 
 // NewStruct creates a new relationship struct
@@ -114,6 +243,38 @@ func (*postR) NewStruct() *postR {
 	return &postR{}
 }
 
+func (o *Post) GetAssigneeRole() *Role {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAssigneeRole()
+}
+
+func (r *postR) GetAssigneeRole() *Role {
+	if r == nil {
+		return nil
+	}
+
+	return r.AssigneeRole
+}
+
+func (o *Post) GetAssigneeUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAssigneeUser()
+}
+
+func (r *postR) GetAssigneeUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.AssigneeUser
+}
+
 func (o *Post) GetCreator() *User {
 	if o == nil {
 		return nil
@@ -130,6 +291,22 @@ func (r *postR) GetCreator() *User {
 	return r.Creator
 }
 
+func (o *Post) GetMergedInto() *Post {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetMergedInto()
+}
+
+func (r *postR) GetMergedInto() *Post {
+	if r == nil {
+		return nil
+	}
+
+	return r.MergedInto
+}
+
 func (o *Post) GetSubtopic() *SubTopic {
 	if o == nil {
 		return nil
@@ -162,6 +339,38 @@ func (r *postR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *Post) GetAnonymousPostAuthor() *AnonymousPostAuthor {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAnonymousPostAuthor()
+}
+
+func (r *postR) GetAnonymousPostAuthor() *AnonymousPostAuthor {
+	if r == nil {
+		return nil
+	}
+
+	return r.AnonymousPostAuthor
+}
+
+func (o *Post) GetPoll() *Poll {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPoll()
+}
+
+func (r *postR) GetPoll() *Poll {
+	if r == nil {
+		return nil
+	}
+
+	return r.Poll
+}
+
 func (o *Post) GetAnswers() AnswerSlice {
 	if o == nil {
 		return nil
@@ -178,6 +387,38 @@ func (r *postR) GetAnswers() AnswerSlice {
 	return r.Answers
 }
 
+func (o *Post) GetNotifications() NotificationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotifications()
+}
+
+func (r *postR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Notifications
+}
+
+func (o *Post) GetPostHistories() PostHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPostHistories()
+}
+
+func (r *postR) GetPostHistories() PostHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PostHistories
+}
+
 func (o *Post) GetTags() TagSlice {
 	if o == nil {
 		return nil
@@ -194,13 +435,61 @@ func (r *postR) GetTags() TagSlice {
 	return r.Tags
 }
 
+func (o *Post) GetMergedIntoPosts() PostSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetMergedIntoPosts()
+}
+
+func (r *postR) GetMergedIntoPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.MergedIntoPosts
+}
+
+func (o *Post) GetReactions() ReactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReactions()
+}
+
+func (r *postR) GetReactions() ReactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reactions
+}
+
+func (o *Post) GetSuggestedEdits() SuggestedEditSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSuggestedEdits()
+}
+
+func (r *postR) GetSuggestedEdits() SuggestedEditSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SuggestedEdits
+}
+
 // postL is where Load methods for each relationship are stored.
 type postL struct{}
 
 var (
-	postAllColumns            = []string{"id", "creator_id", "subtopic_id", "tenant_id", "created_at", "updated_at"}
-	postColumnsWithoutDefault = []string{"creator_id", "subtopic_id", "tenant_id"}
-	postColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	postAllColumns            = []string{"id", "creator_id", "subtopic_id", "tenant_id", "created_at", "updated_at", "overdue_notified_at", "title", "body", "assignee_user_id", "assignee_role_id", "assigned_at", "merged_into_id", "closed_at", "locked_at", "anonymous", "fields", "custom_fields", "wiki", "version"}
+	postColumnsWithoutDefault = []string{"subtopic_id", "tenant_id"}
+	postColumnsWithDefault    = []string{"id", "creator_id", "created_at", "updated_at", "overdue_notified_at", "title", "body", "assignee_user_id", "assignee_role_id", "assigned_at", "merged_into_id", "closed_at", "locked_at", "anonymous", "fields", "custom_fields", "wiki", "version"}
 	postPrimaryKeyColumns     = []string{"id"}
 	postGeneratedColumns      = []string{"id"}
 )
@@ -510,6 +799,28 @@ func (q postQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// AssigneeRole pointed to by the foreign key.
+func (o *Post) AssigneeRole(mods ...qm.QueryMod) roleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AssigneeRoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Roles(queryMods...)
+}
+
+// AssigneeUser pointed to by the foreign key.
+func (o *Post) AssigneeUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AssigneeUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // Creator pointed to by the foreign key.
 func (o *Post) Creator(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -521,6 +832,17 @@ func (o *Post) Creator(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// MergedInto pointed to by the foreign key.
+func (o *Post) MergedInto(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MergedIntoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
 // Subtopic pointed to by the foreign key.
 func (o *Post) Subtopic(mods ...qm.QueryMod) subTopicQuery {
 	queryMods := []qm.QueryMod{
@@ -543,6 +865,28 @@ func (o *Post) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// AnonymousPostAuthor pointed to by the foreign key.
+func (o *Post) AnonymousPostAuthor(mods ...qm.QueryMod) anonymousPostAuthorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"post_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AnonymousPostAuthors(queryMods...)
+}
+
+// Poll pointed to by the foreign key.
+func (o *Post) Poll(mods ...qm.QueryMod) pollQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"post_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Polls(queryMods...)
+}
+
 // Answers retrieves all the answer's Answers with an executor.
 func (o *Post) Answers(mods ...qm.QueryMod) answerQuery {
 	var queryMods []qm.QueryMod
@@ -557,6 +901,34 @@ func (o *Post) Answers(mods ...qm.QueryMod) answerQuery {
 	return Answers(queryMods...)
 }
 
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *Post) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notifications\".\"post_id\"=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// PostHistories retrieves all the post_history's PostHistories with an executor.
+func (o *Post) PostHistories(mods ...qm.QueryMod) postHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_histories\".\"post_id\"=?", o.ID),
+	)
+
+	return PostHistories(queryMods...)
+}
+
 // Tags retrieves all the tag's Tags with an executor.
 func (o *Post) Tags(mods ...qm.QueryMod) tagQuery {
 	var queryMods []qm.QueryMod
@@ -572,9 +944,51 @@ func (o *Post) Tags(mods ...qm.QueryMod) tagQuery {
 	return Tags(queryMods...)
 }
 
-// LoadCreator allows an eager lookup of values, cached into the
+// MergedIntoPosts retrieves all the post's Posts with an executor via merged_into_id column.
+func (o *Post) MergedIntoPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"merged_into_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// Reactions retrieves all the reaction's Reactions with an executor.
+func (o *Post) Reactions(mods ...qm.QueryMod) reactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reactions\".\"post_id\"=?", o.ID),
+	)
+
+	return Reactions(queryMods...)
+}
+
+// SuggestedEdits retrieves all the suggested_edit's SuggestedEdits with an executor.
+func (o *Post) SuggestedEdits(mods ...qm.QueryMod) suggestedEditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"suggested_edits\".\"post_id\"=?", o.ID),
+	)
+
+	return SuggestedEdits(queryMods...)
+}
+
+// LoadAssigneeRole allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadAssigneeRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -605,7 +1019,9 @@ func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular b
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.CreatorID] = struct{}{}
+		if !queries.IsNil(object.AssigneeRoleID) {
+			args[object.AssigneeRoleID] = struct{}{}
+		}
 
 	} else {
 		for _, obj := range slice {
@@ -613,7 +1029,9 @@ func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular b
 				obj.R = &postR{}
 			}
 
-			args[obj.CreatorID] = struct{}{}
+			if !queries.IsNil(obj.AssigneeRoleID) {
+				args[obj.AssigneeRoleID] = struct{}{}
+			}
 
 		}
 	}
@@ -630,8 +1048,8 @@ func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -639,22 +1057,22 @@ func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load User")
+		return errors.Wrap(err, "failed to eager load Role")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*Role
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice User")
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for users")
+		return errors.Wrap(err, "failed to close results of eager load for roles")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(roleAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -668,22 +1086,22 @@ func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular b
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Creator = foreign
+		object.R.AssigneeRole = foreign
 		if foreign.R == nil {
-			foreign.R = &userR{}
+			foreign.R = &roleR{}
 		}
-		foreign.R.CreatorPosts = append(foreign.R.CreatorPosts, object)
+		foreign.R.AssigneeRolePosts = append(foreign.R.AssigneeRolePosts, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.CreatorID == foreign.ID {
-				local.R.Creator = foreign
+			if queries.Equal(local.AssigneeRoleID, foreign.ID) {
+				local.R.AssigneeRole = foreign
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &roleR{}
 				}
-				foreign.R.CreatorPosts = append(foreign.R.CreatorPosts, local)
+				foreign.R.AssigneeRolePosts = append(foreign.R.AssigneeRolePosts, local)
 				break
 			}
 		}
@@ -692,9 +1110,9 @@ func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadSubtopic allows an eager lookup of values, cached into the
+// LoadAssigneeUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadAssigneeUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -725,7 +1143,9 @@ func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.SubtopicID] = struct{}{}
+		if !queries.IsNil(object.AssigneeUserID) {
+			args[object.AssigneeUserID] = struct{}{}
+		}
 
 	} else {
 		for _, obj := range slice {
@@ -733,7 +1153,9 @@ func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular
 				obj.R = &postR{}
 			}
 
-			args[obj.SubtopicID] = struct{}{}
+			if !queries.IsNil(obj.AssigneeUserID) {
+				args[obj.AssigneeUserID] = struct{}{}
+			}
 
 		}
 	}
@@ -750,8 +1172,8 @@ func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`sub_topics`),
-		qm.WhereIn(`sub_topics.id in ?`, argsSlice...),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -759,22 +1181,22 @@ func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load SubTopic")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*SubTopic
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice SubTopic")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for sub_topics")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(subTopicAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -788,22 +1210,22 @@ func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Subtopic = foreign
+		object.R.AssigneeUser = foreign
 		if foreign.R == nil {
-			foreign.R = &subTopicR{}
+			foreign.R = &userR{}
 		}
-		foreign.R.SubtopicPosts = append(foreign.R.SubtopicPosts, object)
+		foreign.R.AssigneeUserPosts = append(foreign.R.AssigneeUserPosts, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.SubtopicID == foreign.ID {
-				local.R.Subtopic = foreign
+			if queries.Equal(local.AssigneeUserID, foreign.ID) {
+				local.R.AssigneeUser = foreign
 				if foreign.R == nil {
-					foreign.R = &subTopicR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.SubtopicPosts = append(foreign.R.SubtopicPosts, local)
+				foreign.R.AssigneeUserPosts = append(foreign.R.AssigneeUserPosts, local)
 				break
 			}
 		}
@@ -812,9 +1234,9 @@ func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadTenant allows an eager lookup of values, cached into the
+// LoadCreator allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -845,7 +1267,9 @@ func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.TenantID] = struct{}{}
+		if !queries.IsNil(object.CreatorID) {
+			args[object.CreatorID] = struct{}{}
+		}
 
 	} else {
 		for _, obj := range slice {
@@ -853,7 +1277,9 @@ func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 				obj.R = &postR{}
 			}
 
-			args[obj.TenantID] = struct{}{}
+			if !queries.IsNil(obj.CreatorID) {
+				args[obj.CreatorID] = struct{}{}
+			}
 
 		}
 	}
@@ -870,8 +1296,8 @@ func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`tenants`),
-		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -879,22 +1305,22 @@ func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Tenant")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*Tenant
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for tenants")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(tenantAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -908,22 +1334,22 @@ func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Tenant = foreign
+		object.R.Creator = foreign
 		if foreign.R == nil {
-			foreign.R = &tenantR{}
+			foreign.R = &userR{}
 		}
-		foreign.R.Posts = append(foreign.R.Posts, object)
+		foreign.R.CreatorPosts = append(foreign.R.CreatorPosts, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.TenantID == foreign.ID {
-				local.R.Tenant = foreign
+			if queries.Equal(local.CreatorID, foreign.ID) {
+				local.R.Creator = foreign
 				if foreign.R == nil {
-					foreign.R = &tenantR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Posts = append(foreign.R.Posts, local)
+				foreign.R.CreatorPosts = append(foreign.R.CreatorPosts, local)
 				break
 			}
 		}
@@ -932,9 +1358,9 @@ func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadAnswers allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+// LoadMergedInto allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadMergedInto(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -965,13 +1391,20 @@ func (postL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular b
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.MergedIntoID) {
+			args[object.MergedIntoID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &postR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.MergedIntoID) {
+				args[obj.MergedIntoID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -987,8 +1420,8 @@ func (postL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`answers`),
-		qm.WhereIn(`answers.post_id in ?`, argsSlice...),
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -996,47 +1429,51 @@ func (postL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load answers")
+		return errors.Wrap(err, "failed to eager load Post")
 	}
 
-	var resultSlice []*Answer
+	var resultSlice []*Post
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice answers")
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on answers")
+		return errors.Wrap(err, "failed to close results of eager load for posts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
 	}
 
-	if len(answerAfterSelectHooks) != 0 {
+	if len(postAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Answers = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &answerR{}
-			}
-			foreign.R.Post = object
+		foreign := resultSlice[0]
+		object.R.MergedInto = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
 		}
+		foreign.R.MergedIntoPosts = append(foreign.R.MergedIntoPosts, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.PostID {
-				local.R.Answers = append(local.R.Answers, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.MergedIntoID, foreign.ID) {
+				local.R.MergedInto = foreign
 				if foreign.R == nil {
-					foreign.R = &answerR{}
+					foreign.R = &postR{}
 				}
-				foreign.R.Post = local
+				foreign.R.MergedIntoPosts = append(foreign.R.MergedIntoPosts, local)
 				break
 			}
 		}
@@ -1045,9 +1482,9 @@ func (postL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadTags allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+// LoadSubtopic allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadSubtopic(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1078,13 +1515,16 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.ID] = struct{}{}
+		args[object.SubtopicID] = struct{}{}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &postR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			args[obj.SubtopicID] = struct{}{}
+
 		}
 	}
 
@@ -1100,10 +1540,8 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 	}
 
 	query := NewQuery(
-		qm.Select("\"tags\".\"id\", \"tags\".\"name\", \"tags\".\"tenant_id\", \"tags\".\"created_at\", \"tags\".\"updated_at\", \"a\".\"post_id\""),
-		qm.From("\"tags\""),
-		qm.InnerJoin("\"post_tags\" as \"a\" on \"tags\".\"id\" = \"a\".\"tag_id\""),
-		qm.WhereIn("\"a\".\"post_id\" in ?", argsSlice...),
+		qm.From(`sub_topics`),
+		qm.WhereIn(`sub_topics.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1111,62 +1549,51 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tags")
+		return errors.Wrap(err, "failed to eager load SubTopic")
 	}
 
-	var resultSlice []*Tag
-
-	var localJoinCols []int64
-	for results.Next() {
-		one := new(Tag)
-		var localJoinCol int64
-
-		err = results.Scan(&one.ID, &one.Name, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for tags")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tags")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*SubTopic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SubTopic")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tags")
+		return errors.Wrap(err, "failed to close results of eager load for sub_topics")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
 	}
 
-	if len(tagAfterSelectHooks) != 0 {
+	if len(subTopicAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Tags = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &tagR{}
-			}
-			foreign.R.Posts = append(foreign.R.Posts, object)
+		foreign := resultSlice[0]
+		object.R.Subtopic = foreign
+		if foreign.R == nil {
+			foreign.R = &subTopicR{}
 		}
+		foreign.R.SubtopicPosts = append(foreign.R.SubtopicPosts, object)
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
-		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Tags = append(local.R.Tags, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubtopicID == foreign.ID {
+				local.R.Subtopic = foreign
 				if foreign.R == nil {
-					foreign.R = &tagR{}
+					foreign.R = &subTopicR{}
 				}
-				foreign.R.Posts = append(foreign.R.Posts, local)
+				foreign.R.SubtopicPosts = append(foreign.R.SubtopicPosts, local)
 				break
 			}
 		}
@@ -1175,83 +1602,1518 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
-// SetCreator of the post to the related item.
-// Sets o.R.Creator to related.
-// Adds o to related.R.CreatorPosts.
-func (o *Post) SetCreator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"posts\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
-		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
 
-	o.CreatorID = related.ID
-	if o.R == nil {
-		o.R = &postR{
-			Creator: related,
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
 		}
 	} else {
-		o.R.Creator = related
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			CreatorPosts: PostSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
 		}
+		args[object.TenantID] = struct{}{}
+
 	} else {
-		related.R.CreatorPosts = append(related.R.CreatorPosts, o)
-	}
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
 
-	return nil
-}
+			args[obj.TenantID] = struct{}{}
 
-// SetSubtopic of the post to the related item.
-// Sets o.R.Subtopic to related.
-// Adds o to related.R.SubtopicPosts.
-func (o *Post) SetSubtopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SubTopic) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"posts\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"subtopic_id"}),
-		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	if len(args) == 0 {
+		return nil
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	o.SubtopicID = related.ID
-	if o.R == nil {
-		o.R = &postR{
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.Posts = append(foreign.R.Posts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.Posts = append(foreign.R.Posts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAnonymousPostAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (postL) LoadAnonymousPostAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`anonymous_post_authors`),
+		qm.WhereIn(`anonymous_post_authors.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AnonymousPostAuthor")
+	}
+
+	var resultSlice []*AnonymousPostAuthor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AnonymousPostAuthor")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for anonymous_post_authors")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for anonymous_post_authors")
+	}
+
+	if len(anonymousPostAuthorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AnonymousPostAuthor = foreign
+		if foreign.R == nil {
+			foreign.R = &anonymousPostAuthorR{}
+		}
+		foreign.R.Post = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PostID {
+				local.R.AnonymousPostAuthor = foreign
+				if foreign.R == nil {
+					foreign.R = &anonymousPostAuthorR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPoll allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (postL) LoadPoll(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`polls`),
+		qm.WhereIn(`polls.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Poll")
+	}
+
+	var resultSlice []*Poll
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Poll")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for polls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for polls")
+	}
+
+	if len(pollAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Poll = foreign
+		if foreign.R == nil {
+			foreign.R = &pollR{}
+		}
+		foreign.R.Post = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PostID {
+				local.R.Poll = foreign
+				if foreign.R == nil {
+					foreign.R = &pollR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAnswers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`answers`),
+		qm.WhereIn(`answers.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load answers")
+	}
+
+	var resultSlice []*Answer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice answers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on answers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
+	}
+
+	if len(answerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Answers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &answerR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.Answers = append(local.R.Answers, foreign)
+				if foreign.R == nil {
+					foreign.R = &answerR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PostID) {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_histories`),
+		qm.WhereIn(`post_histories.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_histories")
+	}
+
+	var resultSlice []*PostHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_histories")
+	}
+
+	if len(postHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postHistoryR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostHistories = append(local.R.PostHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &postHistoryR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"tags\".\"id\", \"tags\".\"name\", \"tags\".\"tenant_id\", \"tags\".\"created_at\", \"tags\".\"updated_at\", \"a\".\"post_id\""),
+		qm.From("\"tags\""),
+		qm.InnerJoin("\"post_tags\" as \"a\" on \"tags\".\"id\" = \"a\".\"tag_id\""),
+		qm.WhereIn("\"a\".\"post_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tags")
+	}
+
+	var resultSlice []*Tag
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Tag)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for tags")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tags")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+	}
+
+	if len(tagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Posts = append(foreign.R.Posts, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Tags = append(local.R.Tags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tagR{}
+				}
+				foreign.R.Posts = append(foreign.R.Posts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMergedIntoPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadMergedIntoPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.merged_into_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MergedIntoPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.MergedInto = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.MergedIntoID) {
+				local.R.MergedIntoPosts = append(local.R.MergedIntoPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.MergedInto = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reactions`),
+		qm.WhereIn(`reactions.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reactions")
+	}
+
+	var resultSlice []*Reaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reactions")
+	}
+
+	if len(reactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reactionR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PostID) {
+				local.R.Reactions = append(local.R.Reactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &reactionR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSuggestedEdits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadSuggestedEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`suggested_edits`),
+		qm.WhereIn(`suggested_edits.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load suggested_edits")
+	}
+
+	var resultSlice []*SuggestedEdit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice suggested_edits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on suggested_edits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for suggested_edits")
+	}
+
+	if len(suggestedEditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SuggestedEdits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &suggestedEditR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.SuggestedEdits = append(local.R.SuggestedEdits, foreign)
+				if foreign.R == nil {
+					foreign.R = &suggestedEditR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAssigneeRole of the post to the related item.
+// Sets o.R.AssigneeRole to related.
+// Adds o to related.R.AssigneeRolePosts.
+func (o *Post) SetAssigneeRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"assignee_role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AssigneeRoleID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			AssigneeRole: related,
+		}
+	} else {
+		o.R.AssigneeRole = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			AssigneeRolePosts: PostSlice{o},
+		}
+	} else {
+		related.R.AssigneeRolePosts = append(related.R.AssigneeRolePosts, o)
+	}
+
+	return nil
+}
+
+// RemoveAssigneeRole relationship.
+// Sets o.R.AssigneeRole to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemoveAssigneeRole(ctx context.Context, exec boil.ContextExecutor, related *Role) error {
+	var err error
+
+	queries.SetScanner(&o.AssigneeRoleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("assignee_role_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AssigneeRole = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AssigneeRolePosts {
+		if queries.Equal(o.AssigneeRoleID, ri.AssigneeRoleID) {
+			continue
+		}
+
+		ln := len(related.R.AssigneeRolePosts)
+		if ln > 1 && i < ln-1 {
+			related.R.AssigneeRolePosts[i] = related.R.AssigneeRolePosts[ln-1]
+		}
+		related.R.AssigneeRolePosts = related.R.AssigneeRolePosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetAssigneeUser of the post to the related item.
+// Sets o.R.AssigneeUser to related.
+// Adds o to related.R.AssigneeUserPosts.
+func (o *Post) SetAssigneeUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"assignee_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AssigneeUserID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			AssigneeUser: related,
+		}
+	} else {
+		o.R.AssigneeUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AssigneeUserPosts: PostSlice{o},
+		}
+	} else {
+		related.R.AssigneeUserPosts = append(related.R.AssigneeUserPosts, o)
+	}
+
+	return nil
+}
+
+// RemoveAssigneeUser relationship.
+// Sets o.R.AssigneeUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemoveAssigneeUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AssigneeUserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("assignee_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AssigneeUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AssigneeUserPosts {
+		if queries.Equal(o.AssigneeUserID, ri.AssigneeUserID) {
+			continue
+		}
+
+		ln := len(related.R.AssigneeUserPosts)
+		if ln > 1 && i < ln-1 {
+			related.R.AssigneeUserPosts[i] = related.R.AssigneeUserPosts[ln-1]
+		}
+		related.R.AssigneeUserPosts = related.R.AssigneeUserPosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCreator of the post to the related item.
+// Sets o.R.Creator to related.
+// Adds o to related.R.CreatorPosts.
+func (o *Post) SetCreator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatorID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			Creator: related,
+		}
+	} else {
+		o.R.Creator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatorPosts: PostSlice{o},
+		}
+	} else {
+		related.R.CreatorPosts = append(related.R.CreatorPosts, o)
+	}
+
+	return nil
+}
+
+// RemoveCreator relationship.
+// Sets o.R.Creator to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemoveCreator(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("creator_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Creator = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatorPosts {
+		if queries.Equal(o.CreatorID, ri.CreatorID) {
+			continue
+		}
+
+		ln := len(related.R.CreatorPosts)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatorPosts[i] = related.R.CreatorPosts[ln-1]
+		}
+		related.R.CreatorPosts = related.R.CreatorPosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetMergedInto of the post to the related item.
+// Sets o.R.MergedInto to related.
+// Adds o to related.R.MergedIntoPosts.
+func (o *Post) SetMergedInto(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"merged_into_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MergedIntoID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			MergedInto: related,
+		}
+	} else {
+		o.R.MergedInto = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			MergedIntoPosts: PostSlice{o},
+		}
+	} else {
+		related.R.MergedIntoPosts = append(related.R.MergedIntoPosts, o)
+	}
+
+	return nil
+}
+
+// RemoveMergedInto relationship.
+// Sets o.R.MergedInto to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemoveMergedInto(ctx context.Context, exec boil.ContextExecutor, related *Post) error {
+	var err error
+
+	queries.SetScanner(&o.MergedIntoID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("merged_into_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.MergedInto = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MergedIntoPosts {
+		if queries.Equal(o.MergedIntoID, ri.MergedIntoID) {
+			continue
+		}
+
+		ln := len(related.R.MergedIntoPosts)
+		if ln > 1 && i < ln-1 {
+			related.R.MergedIntoPosts[i] = related.R.MergedIntoPosts[ln-1]
+		}
+		related.R.MergedIntoPosts = related.R.MergedIntoPosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSubtopic of the post to the related item.
+// Sets o.R.Subtopic to related.
+// Adds o to related.R.SubtopicPosts.
+func (o *Post) SetSubtopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SubTopic) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subtopic_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubtopicID = related.ID
+	if o.R == nil {
+		o.R = &postR{
 			Subtopic: related,
 		}
 	} else {
@@ -1295,32 +3157,312 @@ func (o *Post) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert
 	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
-
-	o.TenantID = related.ID
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &postR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Posts: PostSlice{o},
+		}
+	} else {
+		related.R.Posts = append(related.R.Posts, o)
+	}
+
+	return nil
+}
+
+// SetAnonymousPostAuthor of the post to the related item.
+// Sets o.R.AnonymousPostAuthor to related.
+// Adds o to related.R.Post.
+func (o *Post) SetAnonymousPostAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AnonymousPostAuthor) error {
+	var err error
+
+	if insert {
+		related.PostID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+			strmangle.WhereClause("\"", "\"", 2, anonymousPostAuthorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PostID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			AnonymousPostAuthor: related,
+		}
+	} else {
+		o.R.AnonymousPostAuthor = related
+	}
+
+	if related.R == nil {
+		related.R = &anonymousPostAuthorR{
+			Post: o,
+		}
+	} else {
+		related.R.Post = o
+	}
+	return nil
+}
+
+// SetPoll of the post to the related item.
+// Sets o.R.Poll to related.
+// Adds o to related.R.Post.
+func (o *Post) SetPoll(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Poll) error {
+	var err error
+
+	if insert {
+		related.PostID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"polls\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+			strmangle.WhereClause("\"", "\"", 2, pollPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PostID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Poll: related,
+		}
+	} else {
+		o.R.Poll = related
+	}
+
+	if related.R == nil {
+		related.R = &pollR{
+			Post: o,
+		}
+	} else {
+		related.R.Post = o
+	}
+	return nil
+}
+
+// AddAnswers adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Answers.
+// Sets related.R.Post appropriately.
+func (o *Post) AddAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Answer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"answers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, answerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Answers: related,
+		}
+	} else {
+		o.R.Answers = append(o.R.Answers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &answerR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.Post appropriately.
+func (o *Post) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PostID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PostID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// SetNotifications removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Post's Notifications accordingly.
+// Replaces o.R.Notifications with related.
+// Sets related.R.Post's Notifications accordingly.
+func (o *Post) SetNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	query := "update \"notifications\" set \"post_id\" = null where \"post_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Notifications {
+			queries.SetScanner(&rel.PostID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Post = nil
+		}
+		o.R.Notifications = nil
+	}
+
+	return o.AddNotifications(ctx, exec, insert, related...)
+}
+
+// RemoveNotifications relationships from objects passed in.
+// Removes related items from R.Notifications (uses pointer comparison, removal does not keep order)
+// Sets related.R.Post.
+func (o *Post) RemoveNotifications(ctx context.Context, exec boil.ContextExecutor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PostID, nil)
+		if rel.R != nil {
+			rel.R.Post = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("post_id")); err != nil {
+			return err
+		}
+	}
 	if o.R == nil {
-		o.R = &postR{
-			Tenant: related,
-		}
-	} else {
-		o.R.Tenant = related
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &tenantR{
-			Posts: PostSlice{o},
+	for _, rel := range related {
+		for i, ri := range o.R.Notifications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Notifications)
+			if ln > 1 && i < ln-1 {
+				o.R.Notifications[i] = o.R.Notifications[ln-1]
+			}
+			o.R.Notifications = o.R.Notifications[:ln-1]
+			break
 		}
-	} else {
-		related.R.Posts = append(related.R.Posts, o)
 	}
 
 	return nil
 }
 
-// AddAnswers adds the given related objects to the existing relationships
+// AddPostHistories adds the given related objects to the existing relationships
 // of the post, optionally inserting them as new records.
-// Appends related to o.R.Answers.
+// Appends related to o.R.PostHistories.
 // Sets related.R.Post appropriately.
-func (o *Post) AddAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Answer) error {
+func (o *Post) AddPostHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostHistory) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1330,9 +3472,9 @@ func (o *Post) AddAnswers(ctx context.Context, exec boil.ContextExecutor, insert
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"answers\" SET %s WHERE %s",
+				"UPDATE \"post_histories\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
-				strmangle.WhereClause("\"", "\"", 2, answerPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, postHistoryPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1351,15 +3493,15 @@ func (o *Post) AddAnswers(ctx context.Context, exec boil.ContextExecutor, insert
 
 	if o.R == nil {
 		o.R = &postR{
-			Answers: related,
+			PostHistories: related,
 		}
 	} else {
-		o.R.Answers = append(o.R.Answers, related...)
+		o.R.PostHistories = append(o.R.PostHistories, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &answerR{
+			rel.R = &postHistoryR{
 				Post: o,
 			}
 		} else {
@@ -1514,6 +3656,313 @@ func removeTagsFromPostsSlice(o *Post, related []*Tag) {
 	}
 }
 
+// AddMergedIntoPosts adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.MergedIntoPosts.
+// Sets related.R.MergedInto appropriately.
+func (o *Post) AddMergedIntoPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.MergedIntoID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"merged_into_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.MergedIntoID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			MergedIntoPosts: related,
+		}
+	} else {
+		o.R.MergedIntoPosts = append(o.R.MergedIntoPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				MergedInto: o,
+			}
+		} else {
+			rel.R.MergedInto = o
+		}
+	}
+	return nil
+}
+
+// SetMergedIntoPosts removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.MergedInto's MergedIntoPosts accordingly.
+// Replaces o.R.MergedIntoPosts with related.
+// Sets related.R.MergedInto's MergedIntoPosts accordingly.
+func (o *Post) SetMergedIntoPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"merged_into_id\" = null where \"merged_into_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.MergedIntoPosts {
+			queries.SetScanner(&rel.MergedIntoID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.MergedInto = nil
+		}
+		o.R.MergedIntoPosts = nil
+	}
+
+	return o.AddMergedIntoPosts(ctx, exec, insert, related...)
+}
+
+// RemoveMergedIntoPosts relationships from objects passed in.
+// Removes related items from R.MergedIntoPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.MergedInto.
+func (o *Post) RemoveMergedIntoPosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.MergedIntoID, nil)
+		if rel.R != nil {
+			rel.R.MergedInto = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("merged_into_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MergedIntoPosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MergedIntoPosts)
+			if ln > 1 && i < ln-1 {
+				o.R.MergedIntoPosts[i] = o.R.MergedIntoPosts[ln-1]
+			}
+			o.R.MergedIntoPosts = o.R.MergedIntoPosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddReactions adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Reactions.
+// Sets related.R.Post appropriately.
+func (o *Post) AddReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PostID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PostID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Reactions: related,
+		}
+	} else {
+		o.R.Reactions = append(o.R.Reactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reactionR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// SetReactions removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Post's Reactions accordingly.
+// Replaces o.R.Reactions with related.
+// Sets related.R.Post's Reactions accordingly.
+func (o *Post) SetReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	query := "update \"reactions\" set \"post_id\" = null where \"post_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Reactions {
+			queries.SetScanner(&rel.PostID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Post = nil
+		}
+		o.R.Reactions = nil
+	}
+
+	return o.AddReactions(ctx, exec, insert, related...)
+}
+
+// RemoveReactions relationships from objects passed in.
+// Removes related items from R.Reactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Post.
+func (o *Post) RemoveReactions(ctx context.Context, exec boil.ContextExecutor, related ...*Reaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PostID, nil)
+		if rel.R != nil {
+			rel.R.Post = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("post_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Reactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Reactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Reactions[i] = o.R.Reactions[ln-1]
+			}
+			o.R.Reactions = o.R.Reactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSuggestedEdits adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.SuggestedEdits.
+// Sets related.R.Post appropriately.
+func (o *Post) AddSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"suggested_edits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			SuggestedEdits: related,
+		}
+	} else {
+		o.R.SuggestedEdits = append(o.R.SuggestedEdits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &suggestedEditR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
 // Posts retrieves all the records using an executor.
 func Posts(mods ...qm.QueryMod) postQuery {
 	mods = append(mods, qm.From("\"posts\""))
@@ -0,0 +1,1459 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// QuestionTemplateField is an object representing the database table.
+type QuestionTemplateField struct {
+	ID         int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SubTopicID int64             `boil:"sub_topic_id" json:"sub_topic_id" toml:"sub_topic_id" yaml:"sub_topic_id"`
+	Name       string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Label      string            `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Type       string            `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Required   bool              `boil:"required" json:"required" toml:"required" yaml:"required"`
+	Pattern    null.String       `boil:"pattern" json:"pattern,omitempty" toml:"pattern" yaml:"pattern,omitempty"`
+	MinValue   null.Float64      `boil:"min_value" json:"min_value,omitempty" toml:"min_value" yaml:"min_value,omitempty"`
+	MaxValue   null.Float64      `boil:"max_value" json:"max_value,omitempty" toml:"max_value" yaml:"max_value,omitempty"`
+	Options    types.StringArray `boil:"options" json:"options,omitempty" toml:"options" yaml:"options,omitempty"`
+	Position   int               `boil:"position" json:"position" toml:"position" yaml:"position"`
+	TenantID   int64             `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt  time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *questionTemplateFieldR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L questionTemplateFieldL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var QuestionTemplateFieldColumns = struct {
+	ID         string
+	SubTopicID string
+	Name       string
+	Label      string
+	Type       string
+	Required   string
+	Pattern    string
+	MinValue   string
+	MaxValue   string
+	Options    string
+	Position   string
+	TenantID   string
+	CreatedAt  string
+}{
+	ID:         "id",
+	SubTopicID: "sub_topic_id",
+	Name:       "name",
+	Label:      "label",
+	Type:       "type",
+	Required:   "required",
+	Pattern:    "pattern",
+	MinValue:   "min_value",
+	MaxValue:   "max_value",
+	Options:    "options",
+	Position:   "position",
+	TenantID:   "tenant_id",
+	CreatedAt:  "created_at",
+}
+
+var QuestionTemplateFieldTableColumns = struct {
+	ID         string
+	SubTopicID string
+	Name       string
+	Label      string
+	Type       string
+	Required   string
+	Pattern    string
+	MinValue   string
+	MaxValue   string
+	Options    string
+	Position   string
+	TenantID   string
+	CreatedAt  string
+}{
+	ID:         "question_template_fields.id",
+	SubTopicID: "question_template_fields.sub_topic_id",
+	Name:       "question_template_fields.name",
+	Label:      "question_template_fields.label",
+	Type:       "question_template_fields.type",
+	Required:   "question_template_fields.required",
+	Pattern:    "question_template_fields.pattern",
+	MinValue:   "question_template_fields.min_value",
+	MaxValue:   "question_template_fields.max_value",
+	Options:    "question_template_fields.options",
+	Position:   "question_template_fields.position",
+	TenantID:   "question_template_fields.tenant_id",
+	CreatedAt:  "question_template_fields.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var QuestionTemplateFieldWhere = struct {
+	ID         whereHelperint64
+	SubTopicID whereHelperint64
+	Name       whereHelperstring
+	Label      whereHelperstring
+	Type       whereHelperstring
+	Required   whereHelperbool
+	Pattern    whereHelpernull_String
+	MinValue   whereHelpernull_Float64
+	MaxValue   whereHelpernull_Float64
+	Options    whereHelpertypes_StringArray
+	Position   whereHelperint
+	TenantID   whereHelperint64
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint64{field: "\"question_template_fields\".\"id\""},
+	SubTopicID: whereHelperint64{field: "\"question_template_fields\".\"sub_topic_id\""},
+	Name:       whereHelperstring{field: "\"question_template_fields\".\"name\""},
+	Label:      whereHelperstring{field: "\"question_template_fields\".\"label\""},
+	Type:       whereHelperstring{field: "\"question_template_fields\".\"type\""},
+	Required:   whereHelperbool{field: "\"question_template_fields\".\"required\""},
+	Pattern:    whereHelpernull_String{field: "\"question_template_fields\".\"pattern\""},
+	MinValue:   whereHelpernull_Float64{field: "\"question_template_fields\".\"min_value\""},
+	MaxValue:   whereHelpernull_Float64{field: "\"question_template_fields\".\"max_value\""},
+	Options:    whereHelpertypes_StringArray{field: "\"question_template_fields\".\"options\""},
+	Position:   whereHelperint{field: "\"question_template_fields\".\"position\""},
+	TenantID:   whereHelperint64{field: "\"question_template_fields\".\"tenant_id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"question_template_fields\".\"created_at\""},
+}
+
+// QuestionTemplateFieldRels is where relationship names are stored.
+var QuestionTemplateFieldRels = struct {
+	SubTopic string
+	Tenant   string
+}{
+	SubTopic: "SubTopic",
+	Tenant:   "Tenant",
+}
+
+// questionTemplateFieldR is where relationships are stored.
+type questionTemplateFieldR struct {
+	SubTopic *SubTopic `boil:"SubTopic" json:"SubTopic" toml:"SubTopic" yaml:"SubTopic"`
+	Tenant   *Tenant   `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*questionTemplateFieldR) NewStruct() *questionTemplateFieldR {
+	return &questionTemplateFieldR{}
+}
+
+func (o *QuestionTemplateField) GetSubTopic() *SubTopic {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubTopic()
+}
+
+func (r *questionTemplateFieldR) GetSubTopic() *SubTopic {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubTopic
+}
+
+func (o *QuestionTemplateField) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *questionTemplateFieldR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// questionTemplateFieldL is where Load methods for each relationship are stored.
+type questionTemplateFieldL struct{}
+
+var (
+	questionTemplateFieldAllColumns            = []string{"id", "sub_topic_id", "name", "label", "type", "required", "pattern", "min_value", "max_value", "options", "position", "tenant_id", "created_at"}
+	questionTemplateFieldColumnsWithoutDefault = []string{"sub_topic_id", "name", "label", "type", "tenant_id"}
+	questionTemplateFieldColumnsWithDefault    = []string{"id", "required", "pattern", "min_value", "max_value", "options", "position", "created_at"}
+	questionTemplateFieldPrimaryKeyColumns     = []string{"id"}
+	questionTemplateFieldGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// QuestionTemplateFieldSlice is an alias for a slice of pointers to QuestionTemplateField.
+	// This should almost always be used instead of []QuestionTemplateField.
+	QuestionTemplateFieldSlice []*QuestionTemplateField
+	// QuestionTemplateFieldHook is the signature for custom QuestionTemplateField hook methods
+	QuestionTemplateFieldHook func(context.Context, boil.ContextExecutor, *QuestionTemplateField) error
+
+	questionTemplateFieldQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	questionTemplateFieldType                 = reflect.TypeOf(&QuestionTemplateField{})
+	questionTemplateFieldMapping              = queries.MakeStructMapping(questionTemplateFieldType)
+	questionTemplateFieldPrimaryKeyMapping, _ = queries.BindMapping(questionTemplateFieldType, questionTemplateFieldMapping, questionTemplateFieldPrimaryKeyColumns)
+	questionTemplateFieldInsertCacheMut       sync.RWMutex
+	questionTemplateFieldInsertCache          = make(map[string]insertCache)
+	questionTemplateFieldUpdateCacheMut       sync.RWMutex
+	questionTemplateFieldUpdateCache          = make(map[string]updateCache)
+	questionTemplateFieldUpsertCacheMut       sync.RWMutex
+	questionTemplateFieldUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var questionTemplateFieldAfterSelectMu sync.Mutex
+var questionTemplateFieldAfterSelectHooks []QuestionTemplateFieldHook
+
+var questionTemplateFieldBeforeInsertMu sync.Mutex
+var questionTemplateFieldBeforeInsertHooks []QuestionTemplateFieldHook
+var questionTemplateFieldAfterInsertMu sync.Mutex
+var questionTemplateFieldAfterInsertHooks []QuestionTemplateFieldHook
+
+var questionTemplateFieldBeforeUpdateMu sync.Mutex
+var questionTemplateFieldBeforeUpdateHooks []QuestionTemplateFieldHook
+var questionTemplateFieldAfterUpdateMu sync.Mutex
+var questionTemplateFieldAfterUpdateHooks []QuestionTemplateFieldHook
+
+var questionTemplateFieldBeforeDeleteMu sync.Mutex
+var questionTemplateFieldBeforeDeleteHooks []QuestionTemplateFieldHook
+var questionTemplateFieldAfterDeleteMu sync.Mutex
+var questionTemplateFieldAfterDeleteHooks []QuestionTemplateFieldHook
+
+var questionTemplateFieldBeforeUpsertMu sync.Mutex
+var questionTemplateFieldBeforeUpsertHooks []QuestionTemplateFieldHook
+var questionTemplateFieldAfterUpsertMu sync.Mutex
+var questionTemplateFieldAfterUpsertHooks []QuestionTemplateFieldHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *QuestionTemplateField) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *QuestionTemplateField) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *QuestionTemplateField) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *QuestionTemplateField) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *QuestionTemplateField) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *QuestionTemplateField) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *QuestionTemplateField) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *QuestionTemplateField) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *QuestionTemplateField) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range questionTemplateFieldAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddQuestionTemplateFieldHook registers your hook function for all future operations.
+func AddQuestionTemplateFieldHook(hookPoint boil.HookPoint, questionTemplateFieldHook QuestionTemplateFieldHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		questionTemplateFieldAfterSelectMu.Lock()
+		questionTemplateFieldAfterSelectHooks = append(questionTemplateFieldAfterSelectHooks, questionTemplateFieldHook)
+		questionTemplateFieldAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		questionTemplateFieldBeforeInsertMu.Lock()
+		questionTemplateFieldBeforeInsertHooks = append(questionTemplateFieldBeforeInsertHooks, questionTemplateFieldHook)
+		questionTemplateFieldBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		questionTemplateFieldAfterInsertMu.Lock()
+		questionTemplateFieldAfterInsertHooks = append(questionTemplateFieldAfterInsertHooks, questionTemplateFieldHook)
+		questionTemplateFieldAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		questionTemplateFieldBeforeUpdateMu.Lock()
+		questionTemplateFieldBeforeUpdateHooks = append(questionTemplateFieldBeforeUpdateHooks, questionTemplateFieldHook)
+		questionTemplateFieldBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		questionTemplateFieldAfterUpdateMu.Lock()
+		questionTemplateFieldAfterUpdateHooks = append(questionTemplateFieldAfterUpdateHooks, questionTemplateFieldHook)
+		questionTemplateFieldAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		questionTemplateFieldBeforeDeleteMu.Lock()
+		questionTemplateFieldBeforeDeleteHooks = append(questionTemplateFieldBeforeDeleteHooks, questionTemplateFieldHook)
+		questionTemplateFieldBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		questionTemplateFieldAfterDeleteMu.Lock()
+		questionTemplateFieldAfterDeleteHooks = append(questionTemplateFieldAfterDeleteHooks, questionTemplateFieldHook)
+		questionTemplateFieldAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		questionTemplateFieldBeforeUpsertMu.Lock()
+		questionTemplateFieldBeforeUpsertHooks = append(questionTemplateFieldBeforeUpsertHooks, questionTemplateFieldHook)
+		questionTemplateFieldBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		questionTemplateFieldAfterUpsertMu.Lock()
+		questionTemplateFieldAfterUpsertHooks = append(questionTemplateFieldAfterUpsertHooks, questionTemplateFieldHook)
+		questionTemplateFieldAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single questionTemplateField record from the query.
+func (q questionTemplateFieldQuery) One(ctx context.Context, exec boil.ContextExecutor) (*QuestionTemplateField, error) {
+	o := &QuestionTemplateField{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for question_template_fields")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all QuestionTemplateField records from the query.
+func (q questionTemplateFieldQuery) All(ctx context.Context, exec boil.ContextExecutor) (QuestionTemplateFieldSlice, error) {
+	var o []*QuestionTemplateField
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to QuestionTemplateField slice")
+	}
+
+	if len(questionTemplateFieldAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all QuestionTemplateField records in the query.
+func (q questionTemplateFieldQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count question_template_fields rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q questionTemplateFieldQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if question_template_fields exists")
+	}
+
+	return count > 0, nil
+}
+
+// SubTopic pointed to by the foreign key.
+func (o *QuestionTemplateField) SubTopic(mods ...qm.QueryMod) subTopicQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SubTopicID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SubTopics(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *QuestionTemplateField) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadSubTopic allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (questionTemplateFieldL) LoadSubTopic(ctx context.Context, e boil.ContextExecutor, singular bool, maybeQuestionTemplateField interface{}, mods queries.Applicator) error {
+	var slice []*QuestionTemplateField
+	var object *QuestionTemplateField
+
+	if singular {
+		var ok bool
+		object, ok = maybeQuestionTemplateField.(*QuestionTemplateField)
+		if !ok {
+			object = new(QuestionTemplateField)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeQuestionTemplateField)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeQuestionTemplateField))
+			}
+		}
+	} else {
+		s, ok := maybeQuestionTemplateField.(*[]*QuestionTemplateField)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeQuestionTemplateField)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeQuestionTemplateField))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &questionTemplateFieldR{}
+		}
+		args[object.SubTopicID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &questionTemplateFieldR{}
+			}
+
+			args[obj.SubTopicID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`sub_topics`),
+		qm.WhereIn(`sub_topics.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SubTopic")
+	}
+
+	var resultSlice []*SubTopic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SubTopic")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for sub_topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+	}
+
+	if len(subTopicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SubTopic = foreign
+		if foreign.R == nil {
+			foreign.R = &subTopicR{}
+		}
+		foreign.R.QuestionTemplateFields = append(foreign.R.QuestionTemplateFields, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubTopicID == foreign.ID {
+				local.R.SubTopic = foreign
+				if foreign.R == nil {
+					foreign.R = &subTopicR{}
+				}
+				foreign.R.QuestionTemplateFields = append(foreign.R.QuestionTemplateFields, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (questionTemplateFieldL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeQuestionTemplateField interface{}, mods queries.Applicator) error {
+	var slice []*QuestionTemplateField
+	var object *QuestionTemplateField
+
+	if singular {
+		var ok bool
+		object, ok = maybeQuestionTemplateField.(*QuestionTemplateField)
+		if !ok {
+			object = new(QuestionTemplateField)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeQuestionTemplateField)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeQuestionTemplateField))
+			}
+		}
+	} else {
+		s, ok := maybeQuestionTemplateField.(*[]*QuestionTemplateField)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeQuestionTemplateField)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeQuestionTemplateField))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &questionTemplateFieldR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &questionTemplateFieldR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.QuestionTemplateFields = append(foreign.R.QuestionTemplateFields, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.QuestionTemplateFields = append(foreign.R.QuestionTemplateFields, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubTopic of the questionTemplateField to the related item.
+// Sets o.R.SubTopic to related.
+// Adds o to related.R.QuestionTemplateFields.
+func (o *QuestionTemplateField) SetSubTopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SubTopic) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"question_template_fields\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"sub_topic_id"}),
+		strmangle.WhereClause("\"", "\"", 2, questionTemplateFieldPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubTopicID = related.ID
+	if o.R == nil {
+		o.R = &questionTemplateFieldR{
+			SubTopic: related,
+		}
+	} else {
+		o.R.SubTopic = related
+	}
+
+	if related.R == nil {
+		related.R = &subTopicR{
+			QuestionTemplateFields: QuestionTemplateFieldSlice{o},
+		}
+	} else {
+		related.R.QuestionTemplateFields = append(related.R.QuestionTemplateFields, o)
+	}
+
+	return nil
+}
+
+// SetTenant of the questionTemplateField to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.QuestionTemplateFields.
+func (o *QuestionTemplateField) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"question_template_fields\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, questionTemplateFieldPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &questionTemplateFieldR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			QuestionTemplateFields: QuestionTemplateFieldSlice{o},
+		}
+	} else {
+		related.R.QuestionTemplateFields = append(related.R.QuestionTemplateFields, o)
+	}
+
+	return nil
+}
+
+// QuestionTemplateFields retrieves all the records using an executor.
+func QuestionTemplateFields(mods ...qm.QueryMod) questionTemplateFieldQuery {
+	mods = append(mods, qm.From("\"question_template_fields\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"question_template_fields\".*"})
+	}
+
+	return questionTemplateFieldQuery{q}
+}
+
+// FindQuestionTemplateField retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindQuestionTemplateField(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*QuestionTemplateField, error) {
+	questionTemplateFieldObj := &QuestionTemplateField{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"question_template_fields\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, questionTemplateFieldObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from question_template_fields")
+	}
+
+	if err = questionTemplateFieldObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return questionTemplateFieldObj, err
+	}
+
+	return questionTemplateFieldObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *QuestionTemplateField) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no question_template_fields provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(questionTemplateFieldColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	questionTemplateFieldInsertCacheMut.RLock()
+	cache, cached := questionTemplateFieldInsertCache[key]
+	questionTemplateFieldInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			questionTemplateFieldAllColumns,
+			questionTemplateFieldColumnsWithDefault,
+			questionTemplateFieldColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, questionTemplateFieldGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(questionTemplateFieldType, questionTemplateFieldMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(questionTemplateFieldType, questionTemplateFieldMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"question_template_fields\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"question_template_fields\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into question_template_fields")
+	}
+
+	if !cached {
+		questionTemplateFieldInsertCacheMut.Lock()
+		questionTemplateFieldInsertCache[key] = cache
+		questionTemplateFieldInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the QuestionTemplateField.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *QuestionTemplateField) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	questionTemplateFieldUpdateCacheMut.RLock()
+	cache, cached := questionTemplateFieldUpdateCache[key]
+	questionTemplateFieldUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			questionTemplateFieldAllColumns,
+			questionTemplateFieldPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, questionTemplateFieldGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update question_template_fields, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"question_template_fields\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, questionTemplateFieldPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(questionTemplateFieldType, questionTemplateFieldMapping, append(wl, questionTemplateFieldPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update question_template_fields row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for question_template_fields")
+	}
+
+	if !cached {
+		questionTemplateFieldUpdateCacheMut.Lock()
+		questionTemplateFieldUpdateCache[key] = cache
+		questionTemplateFieldUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q questionTemplateFieldQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for question_template_fields")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for question_template_fields")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o QuestionTemplateFieldSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionTemplateFieldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"question_template_fields\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, questionTemplateFieldPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in questionTemplateField slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all questionTemplateField")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *QuestionTemplateField) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no question_template_fields provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(questionTemplateFieldColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	questionTemplateFieldUpsertCacheMut.RLock()
+	cache, cached := questionTemplateFieldUpsertCache[key]
+	questionTemplateFieldUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			questionTemplateFieldAllColumns,
+			questionTemplateFieldColumnsWithDefault,
+			questionTemplateFieldColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			questionTemplateFieldAllColumns,
+			questionTemplateFieldPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, questionTemplateFieldGeneratedColumns)
+		update = strmangle.SetComplement(update, questionTemplateFieldGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert question_template_fields, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(questionTemplateFieldAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(questionTemplateFieldPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert question_template_fields, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(questionTemplateFieldPrimaryKeyColumns))
+			copy(conflict, questionTemplateFieldPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"question_template_fields\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(questionTemplateFieldType, questionTemplateFieldMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(questionTemplateFieldType, questionTemplateFieldMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert question_template_fields")
+	}
+
+	if !cached {
+		questionTemplateFieldUpsertCacheMut.Lock()
+		questionTemplateFieldUpsertCache[key] = cache
+		questionTemplateFieldUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single QuestionTemplateField record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *QuestionTemplateField) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no QuestionTemplateField provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionTemplateFieldPrimaryKeyMapping)
+	sql := "DELETE FROM \"question_template_fields\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from question_template_fields")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for question_template_fields")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q questionTemplateFieldQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no questionTemplateFieldQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from question_template_fields")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for question_template_fields")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o QuestionTemplateFieldSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(questionTemplateFieldBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionTemplateFieldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"question_template_fields\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, questionTemplateFieldPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from questionTemplateField slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for question_template_fields")
+	}
+
+	if len(questionTemplateFieldAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *QuestionTemplateField) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindQuestionTemplateField(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *QuestionTemplateFieldSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := QuestionTemplateFieldSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionTemplateFieldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"question_template_fields\".* FROM \"question_template_fields\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, questionTemplateFieldPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in QuestionTemplateFieldSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// QuestionTemplateFieldExists checks if the QuestionTemplateField row exists.
+func QuestionTemplateFieldExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"question_template_fields\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if question_template_fields exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the QuestionTemplateField row exists.
+func (o *QuestionTemplateField) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return QuestionTemplateFieldExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,2087 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Reaction is an object representing the database table.
+type Reaction struct {
+	ID        int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    int64      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	PostID    null.Int64 `boil:"post_id" json:"post_id,omitempty" toml:"post_id" yaml:"post_id,omitempty"`
+	AnswerID  null.Int64 `boil:"answer_id" json:"answer_id,omitempty" toml:"answer_id" yaml:"answer_id,omitempty"`
+	CommentID null.Int64 `boil:"comment_id" json:"comment_id,omitempty" toml:"comment_id" yaml:"comment_id,omitempty"`
+	Emoji     string     `boil:"emoji" json:"emoji" toml:"emoji" yaml:"emoji"`
+	TenantID  int64      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *reactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReactionColumns = struct {
+	ID        string
+	UserID    string
+	PostID    string
+	AnswerID  string
+	CommentID string
+	Emoji     string
+	TenantID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	PostID:    "post_id",
+	AnswerID:  "answer_id",
+	CommentID: "comment_id",
+	Emoji:     "emoji",
+	TenantID:  "tenant_id",
+	CreatedAt: "created_at",
+}
+
+var ReactionTableColumns = struct {
+	ID        string
+	UserID    string
+	PostID    string
+	AnswerID  string
+	CommentID string
+	Emoji     string
+	TenantID  string
+	CreatedAt string
+}{
+	ID:        "reactions.id",
+	UserID:    "reactions.user_id",
+	PostID:    "reactions.post_id",
+	AnswerID:  "reactions.answer_id",
+	CommentID: "reactions.comment_id",
+	Emoji:     "reactions.emoji",
+	TenantID:  "reactions.tenant_id",
+	CreatedAt: "reactions.created_at",
+}
+
+// Generated where
+
+var ReactionWhere = struct {
+	ID        whereHelperint64
+	UserID    whereHelperint64
+	PostID    whereHelpernull_Int64
+	AnswerID  whereHelpernull_Int64
+	CommentID whereHelpernull_Int64
+	Emoji     whereHelperstring
+	TenantID  whereHelperint64
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"reactions\".\"id\""},
+	UserID:    whereHelperint64{field: "\"reactions\".\"user_id\""},
+	PostID:    whereHelpernull_Int64{field: "\"reactions\".\"post_id\""},
+	AnswerID:  whereHelpernull_Int64{field: "\"reactions\".\"answer_id\""},
+	CommentID: whereHelpernull_Int64{field: "\"reactions\".\"comment_id\""},
+	Emoji:     whereHelperstring{field: "\"reactions\".\"emoji\""},
+	TenantID:  whereHelperint64{field: "\"reactions\".\"tenant_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"reactions\".\"created_at\""},
+}
+
+// ReactionRels is where relationship names are stored.
+var ReactionRels = struct {
+	Answer  string
+	Comment string
+	Post    string
+	Tenant  string
+	User    string
+}{
+	Answer:  "Answer",
+	Comment: "Comment",
+	Post:    "Post",
+	Tenant:  "Tenant",
+	User:    "User",
+}
+
+// reactionR is where relationships are stored.
+type reactionR struct {
+	Answer  *Answer  `boil:"Answer" json:"Answer" toml:"Answer" yaml:"Answer"`
+	Comment *Comment `boil:"Comment" json:"Comment" toml:"Comment" yaml:"Comment"`
+	Post    *Post    `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Tenant  *Tenant  `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	User    *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reactionR) NewStruct() *reactionR {
+	return &reactionR{}
+}
+
+func (o *Reaction) GetAnswer() *Answer {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAnswer()
+}
+
+func (r *reactionR) GetAnswer() *Answer {
+	if r == nil {
+		return nil
+	}
+
+	return r.Answer
+}
+
+func (o *Reaction) GetComment() *Comment {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetComment()
+}
+
+func (r *reactionR) GetComment() *Comment {
+	if r == nil {
+		return nil
+	}
+
+	return r.Comment
+}
+
+func (o *Reaction) GetPost() *Post {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPost()
+}
+
+func (r *reactionR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+
+	return r.Post
+}
+
+func (o *Reaction) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *reactionR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *Reaction) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *reactionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// reactionL is where Load methods for each relationship are stored.
+type reactionL struct{}
+
+var (
+	reactionAllColumns            = []string{"id", "user_id", "post_id", "answer_id", "comment_id", "emoji", "tenant_id", "created_at"}
+	reactionColumnsWithoutDefault = []string{"user_id", "emoji", "tenant_id"}
+	reactionColumnsWithDefault    = []string{"id", "post_id", "answer_id", "comment_id", "created_at"}
+	reactionPrimaryKeyColumns     = []string{"id"}
+	reactionGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// ReactionSlice is an alias for a slice of pointers to Reaction.
+	// This should almost always be used instead of []Reaction.
+	ReactionSlice []*Reaction
+	// ReactionHook is the signature for custom Reaction hook methods
+	ReactionHook func(context.Context, boil.ContextExecutor, *Reaction) error
+
+	reactionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reactionType                 = reflect.TypeOf(&Reaction{})
+	reactionMapping              = queries.MakeStructMapping(reactionType)
+	reactionPrimaryKeyMapping, _ = queries.BindMapping(reactionType, reactionMapping, reactionPrimaryKeyColumns)
+	reactionInsertCacheMut       sync.RWMutex
+	reactionInsertCache          = make(map[string]insertCache)
+	reactionUpdateCacheMut       sync.RWMutex
+	reactionUpdateCache          = make(map[string]updateCache)
+	reactionUpsertCacheMut       sync.RWMutex
+	reactionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reactionAfterSelectMu sync.Mutex
+var reactionAfterSelectHooks []ReactionHook
+
+var reactionBeforeInsertMu sync.Mutex
+var reactionBeforeInsertHooks []ReactionHook
+var reactionAfterInsertMu sync.Mutex
+var reactionAfterInsertHooks []ReactionHook
+
+var reactionBeforeUpdateMu sync.Mutex
+var reactionBeforeUpdateHooks []ReactionHook
+var reactionAfterUpdateMu sync.Mutex
+var reactionAfterUpdateHooks []ReactionHook
+
+var reactionBeforeDeleteMu sync.Mutex
+var reactionBeforeDeleteHooks []ReactionHook
+var reactionAfterDeleteMu sync.Mutex
+var reactionAfterDeleteHooks []ReactionHook
+
+var reactionBeforeUpsertMu sync.Mutex
+var reactionBeforeUpsertHooks []ReactionHook
+var reactionAfterUpsertMu sync.Mutex
+var reactionAfterUpsertHooks []ReactionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Reaction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Reaction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Reaction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Reaction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Reaction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Reaction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Reaction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Reaction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Reaction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reactionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReactionHook registers your hook function for all future operations.
+func AddReactionHook(hookPoint boil.HookPoint, reactionHook ReactionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reactionAfterSelectMu.Lock()
+		reactionAfterSelectHooks = append(reactionAfterSelectHooks, reactionHook)
+		reactionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		reactionBeforeInsertMu.Lock()
+		reactionBeforeInsertHooks = append(reactionBeforeInsertHooks, reactionHook)
+		reactionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		reactionAfterInsertMu.Lock()
+		reactionAfterInsertHooks = append(reactionAfterInsertHooks, reactionHook)
+		reactionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		reactionBeforeUpdateMu.Lock()
+		reactionBeforeUpdateHooks = append(reactionBeforeUpdateHooks, reactionHook)
+		reactionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		reactionAfterUpdateMu.Lock()
+		reactionAfterUpdateHooks = append(reactionAfterUpdateHooks, reactionHook)
+		reactionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		reactionBeforeDeleteMu.Lock()
+		reactionBeforeDeleteHooks = append(reactionBeforeDeleteHooks, reactionHook)
+		reactionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		reactionAfterDeleteMu.Lock()
+		reactionAfterDeleteHooks = append(reactionAfterDeleteHooks, reactionHook)
+		reactionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		reactionBeforeUpsertMu.Lock()
+		reactionBeforeUpsertHooks = append(reactionBeforeUpsertHooks, reactionHook)
+		reactionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		reactionAfterUpsertMu.Lock()
+		reactionAfterUpsertHooks = append(reactionAfterUpsertHooks, reactionHook)
+		reactionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single reaction record from the query.
+func (q reactionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Reaction, error) {
+	o := &Reaction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for reactions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Reaction records from the query.
+func (q reactionQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReactionSlice, error) {
+	var o []*Reaction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Reaction slice")
+	}
+
+	if len(reactionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Reaction records in the query.
+func (q reactionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count reactions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if reactions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Answer pointed to by the foreign key.
+func (o *Reaction) Answer(mods ...qm.QueryMod) answerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AnswerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Answers(queryMods...)
+}
+
+// Comment pointed to by the foreign key.
+func (o *Reaction) Comment(mods ...qm.QueryMod) commentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CommentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Comments(queryMods...)
+}
+
+// Post pointed to by the foreign key.
+func (o *Reaction) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *Reaction) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *Reaction) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadAnswer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reactionL) LoadAnswer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReaction interface{}, mods queries.Applicator) error {
+	var slice []*Reaction
+	var object *Reaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeReaction.(*Reaction)
+		if !ok {
+			object = new(Reaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReaction))
+			}
+		}
+	} else {
+		s, ok := maybeReaction.(*[]*Reaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reactionR{}
+		}
+		if !queries.IsNil(object.AnswerID) {
+			args[object.AnswerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionR{}
+			}
+
+			if !queries.IsNil(obj.AnswerID) {
+				args[obj.AnswerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`answers`),
+		qm.WhereIn(`answers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Answer")
+	}
+
+	var resultSlice []*Answer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Answer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for answers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
+	}
+
+	if len(answerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Answer = foreign
+		if foreign.R == nil {
+			foreign.R = &answerR{}
+		}
+		foreign.R.Reactions = append(foreign.R.Reactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AnswerID, foreign.ID) {
+				local.R.Answer = foreign
+				if foreign.R == nil {
+					foreign.R = &answerR{}
+				}
+				foreign.R.Reactions = append(foreign.R.Reactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reactionL) LoadComment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReaction interface{}, mods queries.Applicator) error {
+	var slice []*Reaction
+	var object *Reaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeReaction.(*Reaction)
+		if !ok {
+			object = new(Reaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReaction))
+			}
+		}
+	} else {
+		s, ok := maybeReaction.(*[]*Reaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reactionR{}
+		}
+		if !queries.IsNil(object.CommentID) {
+			args[object.CommentID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionR{}
+			}
+
+			if !queries.IsNil(obj.CommentID) {
+				args[obj.CommentID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`comments`),
+		qm.WhereIn(`comments.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Comment")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Comment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	}
+
+	if len(commentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Comment = foreign
+		if foreign.R == nil {
+			foreign.R = &commentR{}
+		}
+		foreign.R.Reactions = append(foreign.R.Reactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CommentID, foreign.ID) {
+				local.R.Comment = foreign
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.Reactions = append(foreign.R.Reactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reactionL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReaction interface{}, mods queries.Applicator) error {
+	var slice []*Reaction
+	var object *Reaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeReaction.(*Reaction)
+		if !ok {
+			object = new(Reaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReaction))
+			}
+		}
+	} else {
+		s, ok := maybeReaction.(*[]*Reaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reactionR{}
+		}
+		if !queries.IsNil(object.PostID) {
+			args[object.PostID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionR{}
+			}
+
+			if !queries.IsNil(obj.PostID) {
+				args[obj.PostID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.Reactions = append(foreign.R.Reactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PostID, foreign.ID) {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Reactions = append(foreign.R.Reactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reactionL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReaction interface{}, mods queries.Applicator) error {
+	var slice []*Reaction
+	var object *Reaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeReaction.(*Reaction)
+		if !ok {
+			object = new(Reaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReaction))
+			}
+		}
+	} else {
+		s, ok := maybeReaction.(*[]*Reaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reactionR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.Reactions = append(foreign.R.Reactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.Reactions = append(foreign.R.Reactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reactionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReaction interface{}, mods queries.Applicator) error {
+	var slice []*Reaction
+	var object *Reaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeReaction.(*Reaction)
+		if !ok {
+			object = new(Reaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReaction))
+			}
+		}
+	} else {
+		s, ok := maybeReaction.(*[]*Reaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reactionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Reactions = append(foreign.R.Reactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Reactions = append(foreign.R.Reactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAnswer of the reaction to the related item.
+// Sets o.R.Answer to related.
+// Adds o to related.R.Reactions.
+func (o *Reaction) SetAnswer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Answer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"answer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AnswerID, related.ID)
+	if o.R == nil {
+		o.R = &reactionR{
+			Answer: related,
+		}
+	} else {
+		o.R.Answer = related
+	}
+
+	if related.R == nil {
+		related.R = &answerR{
+			Reactions: ReactionSlice{o},
+		}
+	} else {
+		related.R.Reactions = append(related.R.Reactions, o)
+	}
+
+	return nil
+}
+
+// RemoveAnswer relationship.
+// Sets o.R.Answer to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Reaction) RemoveAnswer(ctx context.Context, exec boil.ContextExecutor, related *Answer) error {
+	var err error
+
+	queries.SetScanner(&o.AnswerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("answer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Answer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Reactions {
+		if queries.Equal(o.AnswerID, ri.AnswerID) {
+			continue
+		}
+
+		ln := len(related.R.Reactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Reactions[i] = related.R.Reactions[ln-1]
+		}
+		related.R.Reactions = related.R.Reactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetComment of the reaction to the related item.
+// Sets o.R.Comment to related.
+// Adds o to related.R.Reactions.
+func (o *Reaction) SetComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Comment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"comment_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CommentID, related.ID)
+	if o.R == nil {
+		o.R = &reactionR{
+			Comment: related,
+		}
+	} else {
+		o.R.Comment = related
+	}
+
+	if related.R == nil {
+		related.R = &commentR{
+			Reactions: ReactionSlice{o},
+		}
+	} else {
+		related.R.Reactions = append(related.R.Reactions, o)
+	}
+
+	return nil
+}
+
+// RemoveComment relationship.
+// Sets o.R.Comment to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Reaction) RemoveComment(ctx context.Context, exec boil.ContextExecutor, related *Comment) error {
+	var err error
+
+	queries.SetScanner(&o.CommentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Comment = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Reactions {
+		if queries.Equal(o.CommentID, ri.CommentID) {
+			continue
+		}
+
+		ln := len(related.R.Reactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Reactions[i] = related.R.Reactions[ln-1]
+		}
+		related.R.Reactions = related.R.Reactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPost of the reaction to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.Reactions.
+func (o *Reaction) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PostID, related.ID)
+	if o.R == nil {
+		o.R = &reactionR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			Reactions: ReactionSlice{o},
+		}
+	} else {
+		related.R.Reactions = append(related.R.Reactions, o)
+	}
+
+	return nil
+}
+
+// RemovePost relationship.
+// Sets o.R.Post to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Reaction) RemovePost(ctx context.Context, exec boil.ContextExecutor, related *Post) error {
+	var err error
+
+	queries.SetScanner(&o.PostID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("post_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Post = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Reactions {
+		if queries.Equal(o.PostID, ri.PostID) {
+			continue
+		}
+
+		ln := len(related.R.Reactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Reactions[i] = related.R.Reactions[ln-1]
+		}
+		related.R.Reactions = related.R.Reactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenant of the reaction to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Reactions.
+func (o *Reaction) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &reactionR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Reactions: ReactionSlice{o},
+		}
+	} else {
+		related.R.Reactions = append(related.R.Reactions, o)
+	}
+
+	return nil
+}
+
+// SetUser of the reaction to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Reactions.
+func (o *Reaction) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &reactionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Reactions: ReactionSlice{o},
+		}
+	} else {
+		related.R.Reactions = append(related.R.Reactions, o)
+	}
+
+	return nil
+}
+
+// Reactions retrieves all the records using an executor.
+func Reactions(mods ...qm.QueryMod) reactionQuery {
+	mods = append(mods, qm.From("\"reactions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"reactions\".*"})
+	}
+
+	return reactionQuery{q}
+}
+
+// FindReaction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReaction(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Reaction, error) {
+	reactionObj := &Reaction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reactions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, reactionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from reactions")
+	}
+
+	if err = reactionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reactionObj, err
+	}
+
+	return reactionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Reaction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reactions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reactionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reactionInsertCacheMut.RLock()
+	cache, cached := reactionInsertCache[key]
+	reactionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reactionAllColumns,
+			reactionColumnsWithDefault,
+			reactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, reactionGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(reactionType, reactionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reactionType, reactionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reactions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reactions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into reactions")
+	}
+
+	if !cached {
+		reactionInsertCacheMut.Lock()
+		reactionInsertCache[key] = cache
+		reactionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Reaction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Reaction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reactionUpdateCacheMut.RLock()
+	cache, cached := reactionUpdateCache[key]
+	reactionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reactionAllColumns,
+			reactionPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, reactionGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update reactions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reactions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reactionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reactionType, reactionMapping, append(wl, reactionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update reactions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for reactions")
+	}
+
+	if !cached {
+		reactionUpdateCacheMut.Lock()
+		reactionUpdateCache[key] = cache
+		reactionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reactionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for reactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for reactions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reactionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in reaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all reaction")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Reaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no reactions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reactionUpsertCacheMut.RLock()
+	cache, cached := reactionUpsertCache[key]
+	reactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			reactionAllColumns,
+			reactionColumnsWithDefault,
+			reactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reactionAllColumns,
+			reactionPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, reactionGeneratedColumns)
+		update = strmangle.SetComplement(update, reactionGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert reactions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(reactionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(reactionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert reactions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(reactionPrimaryKeyColumns))
+			copy(conflict, reactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reactions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(reactionType, reactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reactionType, reactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert reactions")
+	}
+
+	if !cached {
+		reactionUpsertCacheMut.Lock()
+		reactionUpsertCache[key] = cache
+		reactionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Reaction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Reaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Reaction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reactionPrimaryKeyMapping)
+	sql := "DELETE FROM \"reactions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from reactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for reactions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reactionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no reactionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reactions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reactionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reactionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reactions")
+	}
+
+	if len(reactionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Reaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReaction(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReactionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reactions\".* FROM \"reactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reactionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReactionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReactionExists checks if the Reaction row exists.
+func ReactionExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reactions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if reactions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Reaction row exists.
+func (o *Reaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReactionExists(ctx, exec, o.ID)
+}
@@ -80,20 +80,35 @@ var RoleWhere = struct {
 
 // RoleRels is where relationship names are stored.
 var RoleRels = struct {
-	Tenant string
-	Claims string
-	Users  string
+	Tenant                         string
+	AssigneeRolePosts              string
+	Claims                         string
+	SubTopics                      string
+	DefaultRoleTenantOidcProviders string
+	DefaultRoleTenantSamlProviders string
+	Topics                         string
+	Users                          string
 }{
-	Tenant: "Tenant",
-	Claims: "Claims",
-	Users:  "Users",
+	Tenant:                         "Tenant",
+	AssigneeRolePosts:              "AssigneeRolePosts",
+	Claims:                         "Claims",
+	SubTopics:                      "SubTopics",
+	DefaultRoleTenantOidcProviders: "DefaultRoleTenantOidcProviders",
+	DefaultRoleTenantSamlProviders: "DefaultRoleTenantSamlProviders",
+	Topics:                         "Topics",
+	Users:                          "Users",
 }
 
 // roleR is where relationships are stored.
 type roleR struct {
-	Tenant *Tenant    `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	Claims ClaimSlice `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
-	Users  UserSlice  `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Tenant                         *Tenant                 `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	AssigneeRolePosts              PostSlice               `boil:"AssigneeRolePosts" json:"AssigneeRolePosts" toml:"AssigneeRolePosts" yaml:"AssigneeRolePosts"`
+	Claims                         ClaimSlice              `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
+	SubTopics                      SubTopicSlice           `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
+	DefaultRoleTenantOidcProviders TenantOidcProviderSlice `boil:"DefaultRoleTenantOidcProviders" json:"DefaultRoleTenantOidcProviders" toml:"DefaultRoleTenantOidcProviders" yaml:"DefaultRoleTenantOidcProviders"`
+	DefaultRoleTenantSamlProviders TenantSamlProviderSlice `boil:"DefaultRoleTenantSamlProviders" json:"DefaultRoleTenantSamlProviders" toml:"DefaultRoleTenantSamlProviders" yaml:"DefaultRoleTenantSamlProviders"`
+	Topics                         TopicSlice              `boil:"Topics" json:"Topics" toml:"Topics" yaml:"Topics"`
+	Users                          UserSlice               `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
 }
 
 // NewStruct creates a new relationship struct
@@ -117,6 +132,22 @@ func (r *roleR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *Role) GetAssigneeRolePosts() PostSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAssigneeRolePosts()
+}
+
+func (r *roleR) GetAssigneeRolePosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AssigneeRolePosts
+}
+
 func (o *Role) GetClaims() ClaimSlice {
 	if o == nil {
 		return nil
@@ -133,6 +164,70 @@ func (r *roleR) GetClaims() ClaimSlice {
 	return r.Claims
 }
 
+func (o *Role) GetSubTopics() SubTopicSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubTopics()
+}
+
+func (r *roleR) GetSubTopics() SubTopicSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubTopics
+}
+
+func (o *Role) GetDefaultRoleTenantOidcProviders() TenantOidcProviderSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDefaultRoleTenantOidcProviders()
+}
+
+func (r *roleR) GetDefaultRoleTenantOidcProviders() TenantOidcProviderSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.DefaultRoleTenantOidcProviders
+}
+
+func (o *Role) GetDefaultRoleTenantSamlProviders() TenantSamlProviderSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDefaultRoleTenantSamlProviders()
+}
+
+func (r *roleR) GetDefaultRoleTenantSamlProviders() TenantSamlProviderSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.DefaultRoleTenantSamlProviders
+}
+
+func (o *Role) GetTopics() TopicSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopics()
+}
+
+func (r *roleR) GetTopics() TopicSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Topics
+}
+
 func (o *Role) GetUsers() UserSlice {
 	if o == nil {
 		return nil
@@ -476,6 +571,20 @@ func (o *Role) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// AssigneeRolePosts retrieves all the post's Posts with an executor via assignee_role_id column.
+func (o *Role) AssigneeRolePosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"assignee_role_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
 // Claims retrieves all the claim's Claims with an executor.
 func (o *Role) Claims(mods ...qm.QueryMod) claimQuery {
 	var queryMods []qm.QueryMod
@@ -491,6 +600,64 @@ func (o *Role) Claims(mods ...qm.QueryMod) claimQuery {
 	return Claims(queryMods...)
 }
 
+// SubTopics retrieves all the sub_topic's SubTopics with an executor.
+func (o *Role) SubTopics(mods ...qm.QueryMod) subTopicQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"sub_topic_roles\" on \"sub_topics\".\"id\" = \"sub_topic_roles\".\"sub_topic_id\""),
+		qm.Where("\"sub_topic_roles\".\"role_id\"=?", o.ID),
+	)
+
+	return SubTopics(queryMods...)
+}
+
+// DefaultRoleTenantOidcProviders retrieves all the tenant_oidc_provider's TenantOidcProviders with an executor via default_role_id column.
+func (o *Role) DefaultRoleTenantOidcProviders(mods ...qm.QueryMod) tenantOidcProviderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tenant_oidc_providers\".\"default_role_id\"=?", o.ID),
+	)
+
+	return TenantOidcProviders(queryMods...)
+}
+
+// DefaultRoleTenantSamlProviders retrieves all the tenant_saml_provider's TenantSamlProviders with an executor via default_role_id column.
+func (o *Role) DefaultRoleTenantSamlProviders(mods ...qm.QueryMod) tenantSamlProviderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tenant_saml_providers\".\"default_role_id\"=?", o.ID),
+	)
+
+	return TenantSamlProviders(queryMods...)
+}
+
+// Topics retrieves all the topic's Topics with an executor.
+func (o *Role) Topics(mods ...qm.QueryMod) topicQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"topic_roles\" on \"topics\".\"id\" = \"topic_roles\".\"topic_id\""),
+		qm.Where("\"topic_roles\".\"role_id\"=?", o.ID),
+	)
+
+	return Topics(queryMods...)
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Role) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -625,6 +792,119 @@ func (roleL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// LoadAssigneeRolePosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadAssigneeRolePosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.assignee_role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssigneeRolePosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.AssigneeRole = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AssigneeRoleID) {
+				local.R.AssigneeRolePosts = append(local.R.AssigneeRolePosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.AssigneeRole = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadClaims allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (roleL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
@@ -755,9 +1035,9 @@ func (roleL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadUsers allows an eager lookup of values, cached into the
+// LoadSubTopics allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+func (roleL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
 	var slice []*Role
 	var object *Role
 
@@ -810,8 +1090,10 @@ func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular boo
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.role_id in ?`, argsSlice...),
+		qm.Select("\"sub_topics\".\"id\", \"sub_topics\".\"name\", \"sub_topics\".\"topic_id\", \"sub_topics\".\"tenant_id\", \"sub_topics\".\"created_at\", \"sub_topics\".\"updated_at\", \"sub_topics\".\"first_reply_target_minutes\", \"sub_topics\".\"description\", \"sub_topics\".\"icon\", \"sub_topics\".\"color\", \"sub_topics\".\"archived\", \"sub_topics\".\"template_body\", \"a\".\"role_id\""),
+		qm.From("\"sub_topics\""),
+		qm.InnerJoin("\"sub_topic_roles\" as \"a\" on \"sub_topics\".\"id\" = \"a\".\"sub_topic_id\""),
+		qm.WhereIn("\"a\".\"role_id\" in ?", argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -819,22 +1101,36 @@ func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
+		return errors.Wrap(err, "failed to eager load sub_topics")
 	}
 
-	var resultSlice []*User
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice users")
+	var resultSlice []*SubTopic
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(SubTopic)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TopicID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.FirstReplyTargetMinutes, &one.Description, &one.Icon, &one.Color, &one.Archived, &one.TemplateBody, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for sub_topics")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice sub_topics")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
+		return errors.Wrap(err, "failed to close results in eager load on sub_topics")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(subTopicAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -842,24 +1138,25 @@ func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular boo
 		}
 	}
 	if singular {
-		object.R.Users = resultSlice
+		object.R.SubTopics = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userR{}
+				foreign.R = &subTopicR{}
 			}
-			foreign.R.Role = object
+			foreign.R.Roles = append(foreign.R.Roles, object)
 		}
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
 		for _, local := range slice {
-			if local.ID == foreign.RoleID {
-				local.R.Users = append(local.R.Users, foreign)
+			if local.ID == localJoinCol {
+				local.R.SubTopics = append(local.R.SubTopics, foreign)
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &subTopicR{}
 				}
-				foreign.R.Role = local
+				foreign.R.Roles = append(foreign.R.Roles, local)
 				break
 			}
 		}
@@ -868,58 +1165,1198 @@ func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// SetTenant of the role to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.Roles.
-func (o *Role) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+// LoadDefaultRoleTenantOidcProviders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadDefaultRoleTenantOidcProviders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenant_oidc_providers`),
+		qm.WhereIn(`tenant_oidc_providers.default_role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tenant_oidc_providers")
+	}
+
+	var resultSlice []*TenantOidcProvider
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tenant_oidc_providers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tenant_oidc_providers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_oidc_providers")
+	}
+
+	if len(tenantOidcProviderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DefaultRoleTenantOidcProviders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tenantOidcProviderR{}
+			}
+			foreign.R.DefaultRole = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DefaultRoleID) {
+				local.R.DefaultRoleTenantOidcProviders = append(local.R.DefaultRoleTenantOidcProviders, foreign)
+				if foreign.R == nil {
+					foreign.R = &tenantOidcProviderR{}
+				}
+				foreign.R.DefaultRole = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDefaultRoleTenantSamlProviders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadDefaultRoleTenantSamlProviders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenant_saml_providers`),
+		qm.WhereIn(`tenant_saml_providers.default_role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tenant_saml_providers")
+	}
+
+	var resultSlice []*TenantSamlProvider
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tenant_saml_providers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tenant_saml_providers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_saml_providers")
+	}
+
+	if len(tenantSamlProviderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DefaultRoleTenantSamlProviders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tenantSamlProviderR{}
+			}
+			foreign.R.DefaultRole = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DefaultRoleID) {
+				local.R.DefaultRoleTenantSamlProviders = append(local.R.DefaultRoleTenantSamlProviders, foreign)
+				if foreign.R == nil {
+					foreign.R = &tenantSamlProviderR{}
+				}
+				foreign.R.DefaultRole = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"topics\".\"id\", \"topics\".\"name\", \"topics\".\"tenant_id\", \"topics\".\"created_at\", \"topics\".\"updated_at\", \"topics\".\"description\", \"topics\".\"icon\", \"topics\".\"color\", \"topics\".\"archived\", \"a\".\"role_id\""),
+		qm.From("\"topics\""),
+		qm.InnerJoin("\"topic_roles\" as \"a\" on \"topics\".\"id\" = \"a\".\"topic_id\""),
+		qm.WhereIn("\"a\".\"role_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topics")
+	}
+
+	var resultSlice []*Topic
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Topic)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.Description, &one.Icon, &one.Color, &one.Archived, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for topics")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice topics")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topics")
+	}
+
+	if len(topicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Topics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicR{}
+			}
+			foreign.R.Roles = append(foreign.R.Roles, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Topics = append(local.R.Topics, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicR{}
+				}
+				foreign.R.Roles = append(foreign.R.Roles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Users = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.Users = append(local.R.Users, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the role to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Roles.
+func (o *Role) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE \"roles\" SET %s WHERE %s",
 		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
 		strmangle.WhereClause("\"", "\"", 2, rolePrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &roleR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Roles: RoleSlice{o},
+		}
+	} else {
+		related.R.Roles = append(related.R.Roles, o)
+	}
+
+	return nil
+}
+
+// AddAssigneeRolePosts adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.AssigneeRolePosts.
+// Sets related.R.AssigneeRole appropriately.
+func (o *Role) AddAssigneeRolePosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AssigneeRoleID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"assignee_role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AssigneeRoleID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			AssigneeRolePosts: related,
+		}
+	} else {
+		o.R.AssigneeRolePosts = append(o.R.AssigneeRolePosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				AssigneeRole: o,
+			}
+		} else {
+			rel.R.AssigneeRole = o
+		}
+	}
+	return nil
+}
+
+// SetAssigneeRolePosts removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AssigneeRole's AssigneeRolePosts accordingly.
+// Replaces o.R.AssigneeRolePosts with related.
+// Sets related.R.AssigneeRole's AssigneeRolePosts accordingly.
+func (o *Role) SetAssigneeRolePosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"assignee_role_id\" = null where \"assignee_role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AssigneeRolePosts {
+			queries.SetScanner(&rel.AssigneeRoleID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AssigneeRole = nil
+		}
+		o.R.AssigneeRolePosts = nil
+	}
+
+	return o.AddAssigneeRolePosts(ctx, exec, insert, related...)
+}
+
+// RemoveAssigneeRolePosts relationships from objects passed in.
+// Removes related items from R.AssigneeRolePosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.AssigneeRole.
+func (o *Role) RemoveAssigneeRolePosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AssigneeRoleID, nil)
+		if rel.R != nil {
+			rel.R.AssigneeRole = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("assignee_role_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AssigneeRolePosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AssigneeRolePosts)
+			if ln > 1 && i < ln-1 {
+				o.R.AssigneeRolePosts[i] = o.R.AssigneeRolePosts[ln-1]
+			}
+			o.R.AssigneeRolePosts = o.R.AssigneeRolePosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddClaims adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.Claims.
+// Sets related.R.Roles appropriately.
+func (o *Role) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"role_claims\" (\"role_id\", \"claim_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &roleR{
+			Claims: related,
+		}
+	} else {
+		o.R.Claims = append(o.R.Claims, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &claimR{
+				Roles: RoleSlice{o},
+			}
+		} else {
+			rel.R.Roles = append(rel.R.Roles, o)
+		}
+	}
+	return nil
+}
+
+// SetClaims removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Roles's Claims accordingly.
+// Replaces o.R.Claims with related.
+// Sets related.R.Roles's Claims accordingly.
+func (o *Role) SetClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	query := "delete from \"role_claims\" where \"role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeClaimsFromRolesSlice(o, related)
+	if o.R != nil {
+		o.R.Claims = nil
+	}
+
+	return o.AddClaims(ctx, exec, insert, related...)
+}
+
+// RemoveClaims relationships from objects passed in.
+// Removes related items from R.Claims (uses pointer comparison, removal does not keep order)
+// Sets related.R.Roles.
+func (o *Role) RemoveClaims(ctx context.Context, exec boil.ContextExecutor, related ...*Claim) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"role_claims\" where \"role_id\" = $1 and \"claim_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeClaimsFromRolesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Claims {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Claims)
+			if ln > 1 && i < ln-1 {
+				o.R.Claims[i] = o.R.Claims[ln-1]
+			}
+			o.R.Claims = o.R.Claims[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeClaimsFromRolesSlice(o *Role, related []*Claim) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Roles {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Roles)
+			if ln > 1 && i < ln-1 {
+				rel.R.Roles[i] = rel.R.Roles[ln-1]
+			}
+			rel.R.Roles = rel.R.Roles[:ln-1]
+			break
+		}
+	}
+}
+
+// AddSubTopics adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.SubTopics.
+// Sets related.R.Roles appropriately.
+func (o *Role) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"sub_topic_roles\" (\"role_id\", \"sub_topic_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &roleR{
+			SubTopics: related,
+		}
+	} else {
+		o.R.SubTopics = append(o.R.SubTopics, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &subTopicR{
+				Roles: RoleSlice{o},
+			}
+		} else {
+			rel.R.Roles = append(rel.R.Roles, o)
+		}
+	}
+	return nil
+}
+
+// SetSubTopics removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Roles's SubTopics accordingly.
+// Replaces o.R.SubTopics with related.
+// Sets related.R.Roles's SubTopics accordingly.
+func (o *Role) SetSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+	query := "delete from \"sub_topic_roles\" where \"role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeSubTopicsFromRolesSlice(o, related)
+	if o.R != nil {
+		o.R.SubTopics = nil
+	}
+
+	return o.AddSubTopics(ctx, exec, insert, related...)
+}
+
+// RemoveSubTopics relationships from objects passed in.
+// Removes related items from R.SubTopics (uses pointer comparison, removal does not keep order)
+// Sets related.R.Roles.
+func (o *Role) RemoveSubTopics(ctx context.Context, exec boil.ContextExecutor, related ...*SubTopic) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"sub_topic_roles\" where \"role_id\" = $1 and \"sub_topic_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeSubTopicsFromRolesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubTopics {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubTopics)
+			if ln > 1 && i < ln-1 {
+				o.R.SubTopics[i] = o.R.SubTopics[ln-1]
+			}
+			o.R.SubTopics = o.R.SubTopics[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeSubTopicsFromRolesSlice(o *Role, related []*SubTopic) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Roles {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Roles)
+			if ln > 1 && i < ln-1 {
+				rel.R.Roles[i] = rel.R.Roles[ln-1]
+			}
+			rel.R.Roles = rel.R.Roles[:ln-1]
+			break
+		}
+	}
+}
+
+// AddDefaultRoleTenantOidcProviders adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.DefaultRoleTenantOidcProviders.
+// Sets related.R.DefaultRole appropriately.
+func (o *Role) AddDefaultRoleTenantOidcProviders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TenantOidcProvider) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DefaultRoleID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"tenant_oidc_providers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"default_role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, tenantOidcProviderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TenantID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DefaultRoleID, o.ID)
+		}
 	}
 
-	o.TenantID = related.ID
 	if o.R == nil {
 		o.R = &roleR{
-			Tenant: related,
+			DefaultRoleTenantOidcProviders: related,
 		}
 	} else {
-		o.R.Tenant = related
+		o.R.DefaultRoleTenantOidcProviders = append(o.R.DefaultRoleTenantOidcProviders, related...)
 	}
 
-	if related.R == nil {
-		related.R = &tenantR{
-			Roles: RoleSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tenantOidcProviderR{
+				DefaultRole: o,
+			}
+		} else {
+			rel.R.DefaultRole = o
+		}
+	}
+	return nil
+}
+
+// SetDefaultRoleTenantOidcProviders removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DefaultRole's DefaultRoleTenantOidcProviders accordingly.
+// Replaces o.R.DefaultRoleTenantOidcProviders with related.
+// Sets related.R.DefaultRole's DefaultRoleTenantOidcProviders accordingly.
+func (o *Role) SetDefaultRoleTenantOidcProviders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TenantOidcProvider) error {
+	query := "update \"tenant_oidc_providers\" set \"default_role_id\" = null where \"default_role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DefaultRoleTenantOidcProviders {
+			queries.SetScanner(&rel.DefaultRoleID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DefaultRole = nil
+		}
+		o.R.DefaultRoleTenantOidcProviders = nil
+	}
+
+	return o.AddDefaultRoleTenantOidcProviders(ctx, exec, insert, related...)
+}
+
+// RemoveDefaultRoleTenantOidcProviders relationships from objects passed in.
+// Removes related items from R.DefaultRoleTenantOidcProviders (uses pointer comparison, removal does not keep order)
+// Sets related.R.DefaultRole.
+func (o *Role) RemoveDefaultRoleTenantOidcProviders(ctx context.Context, exec boil.ContextExecutor, related ...*TenantOidcProvider) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DefaultRoleID, nil)
+		if rel.R != nil {
+			rel.R.DefaultRole = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("default_role_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DefaultRoleTenantOidcProviders {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DefaultRoleTenantOidcProviders)
+			if ln > 1 && i < ln-1 {
+				o.R.DefaultRoleTenantOidcProviders[i] = o.R.DefaultRoleTenantOidcProviders[ln-1]
+			}
+			o.R.DefaultRoleTenantOidcProviders = o.R.DefaultRoleTenantOidcProviders[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddDefaultRoleTenantSamlProviders adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.DefaultRoleTenantSamlProviders.
+// Sets related.R.DefaultRole appropriately.
+func (o *Role) AddDefaultRoleTenantSamlProviders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TenantSamlProvider) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DefaultRoleID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"tenant_saml_providers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"default_role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, tenantSamlProviderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TenantID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DefaultRoleID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			DefaultRoleTenantSamlProviders: related,
 		}
 	} else {
-		related.R.Roles = append(related.R.Roles, o)
+		o.R.DefaultRoleTenantSamlProviders = append(o.R.DefaultRoleTenantSamlProviders, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tenantSamlProviderR{
+				DefaultRole: o,
+			}
+		} else {
+			rel.R.DefaultRole = o
+		}
+	}
 	return nil
 }
 
-// AddClaims adds the given related objects to the existing relationships
+// SetDefaultRoleTenantSamlProviders removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DefaultRole's DefaultRoleTenantSamlProviders accordingly.
+// Replaces o.R.DefaultRoleTenantSamlProviders with related.
+// Sets related.R.DefaultRole's DefaultRoleTenantSamlProviders accordingly.
+func (o *Role) SetDefaultRoleTenantSamlProviders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TenantSamlProvider) error {
+	query := "update \"tenant_saml_providers\" set \"default_role_id\" = null where \"default_role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DefaultRoleTenantSamlProviders {
+			queries.SetScanner(&rel.DefaultRoleID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DefaultRole = nil
+		}
+		o.R.DefaultRoleTenantSamlProviders = nil
+	}
+
+	return o.AddDefaultRoleTenantSamlProviders(ctx, exec, insert, related...)
+}
+
+// RemoveDefaultRoleTenantSamlProviders relationships from objects passed in.
+// Removes related items from R.DefaultRoleTenantSamlProviders (uses pointer comparison, removal does not keep order)
+// Sets related.R.DefaultRole.
+func (o *Role) RemoveDefaultRoleTenantSamlProviders(ctx context.Context, exec boil.ContextExecutor, related ...*TenantSamlProvider) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DefaultRoleID, nil)
+		if rel.R != nil {
+			rel.R.DefaultRole = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("default_role_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DefaultRoleTenantSamlProviders {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DefaultRoleTenantSamlProviders)
+			if ln > 1 && i < ln-1 {
+				o.R.DefaultRoleTenantSamlProviders[i] = o.R.DefaultRoleTenantSamlProviders[ln-1]
+			}
+			o.R.DefaultRoleTenantSamlProviders = o.R.DefaultRoleTenantSamlProviders[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTopics adds the given related objects to the existing relationships
 // of the role, optionally inserting them as new records.
-// Appends related to o.R.Claims.
+// Appends related to o.R.Topics.
 // Sets related.R.Roles appropriately.
-func (o *Role) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+func (o *Role) AddTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Topic) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -930,7 +2367,7 @@ func (o *Role) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert
 	}
 
 	for _, rel := range related {
-		query := "insert into \"role_claims\" (\"role_id\", \"claim_id\") values ($1, $2)"
+		query := "insert into \"topic_roles\" (\"role_id\", \"topic_id\") values ($1, $2)"
 		values := []interface{}{o.ID, rel.ID}
 
 		if boil.IsDebug(ctx) {
@@ -945,15 +2382,15 @@ func (o *Role) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert
 	}
 	if o.R == nil {
 		o.R = &roleR{
-			Claims: related,
+			Topics: related,
 		}
 	} else {
-		o.R.Claims = append(o.R.Claims, related...)
+		o.R.Topics = append(o.R.Topics, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &claimR{
+			rel.R = &topicR{
 				Roles: RoleSlice{o},
 			}
 		} else {
@@ -963,14 +2400,14 @@ func (o *Role) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
-// SetClaims removes all previously related items of the
+// SetTopics removes all previously related items of the
 // role replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.Roles's Claims accordingly.
-// Replaces o.R.Claims with related.
-// Sets related.R.Roles's Claims accordingly.
-func (o *Role) SetClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
-	query := "delete from \"role_claims\" where \"role_id\" = $1"
+// Sets o.R.Roles's Topics accordingly.
+// Replaces o.R.Topics with related.
+// Sets related.R.Roles's Topics accordingly.
+func (o *Role) SetTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Topic) error {
+	query := "delete from \"topic_roles\" where \"role_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -982,25 +2419,25 @@ func (o *Role) SetClaims(ctx context.Context, exec boil.ContextExecutor, insert
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removeClaimsFromRolesSlice(o, related)
+	removeTopicsFromRolesSlice(o, related)
 	if o.R != nil {
-		o.R.Claims = nil
+		o.R.Topics = nil
 	}
 
-	return o.AddClaims(ctx, exec, insert, related...)
+	return o.AddTopics(ctx, exec, insert, related...)
 }
 
-// RemoveClaims relationships from objects passed in.
-// Removes related items from R.Claims (uses pointer comparison, removal does not keep order)
+// RemoveTopics relationships from objects passed in.
+// Removes related items from R.Topics (uses pointer comparison, removal does not keep order)
 // Sets related.R.Roles.
-func (o *Role) RemoveClaims(ctx context.Context, exec boil.ContextExecutor, related ...*Claim) error {
+func (o *Role) RemoveTopics(ctx context.Context, exec boil.ContextExecutor, related ...*Topic) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	query := fmt.Sprintf(
-		"delete from \"role_claims\" where \"role_id\" = $1 and \"claim_id\" in (%s)",
+		"delete from \"topic_roles\" where \"role_id\" = $1 and \"topic_id\" in (%s)",
 		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
 	)
 	values := []interface{}{o.ID}
@@ -1017,22 +2454,22 @@ func (o *Role) RemoveClaims(ctx context.Context, exec boil.ContextExecutor, rela
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
-	removeClaimsFromRolesSlice(o, related)
+	removeTopicsFromRolesSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.Claims {
+		for i, ri := range o.R.Topics {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.Claims)
+			ln := len(o.R.Topics)
 			if ln > 1 && i < ln-1 {
-				o.R.Claims[i] = o.R.Claims[ln-1]
+				o.R.Topics[i] = o.R.Topics[ln-1]
 			}
-			o.R.Claims = o.R.Claims[:ln-1]
+			o.R.Topics = o.R.Topics[:ln-1]
 			break
 		}
 	}
@@ -1040,7 +2477,7 @@ func (o *Role) RemoveClaims(ctx context.Context, exec boil.ContextExecutor, rela
 	return nil
 }
 
-func removeClaimsFromRolesSlice(o *Role, related []*Claim) {
+func removeTopicsFromRolesSlice(o *Role, related []*Topic) {
 	for _, rel := range related {
 		if rel.R == nil {
 			continue
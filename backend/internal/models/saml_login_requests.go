@@ -0,0 +1,1140 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// SamlLoginRequest is an object representing the database table.
+type SamlLoginRequest struct {
+	RelayState string    `boil:"relay_state" json:"relay_state" toml:"relay_state" yaml:"relay_state"`
+	TenantID   int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	RequestID  string    `boil:"request_id" json:"request_id" toml:"request_id" yaml:"request_id"`
+	ExpiresAt  time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *samlLoginRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L samlLoginRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SamlLoginRequestColumns = struct {
+	RelayState string
+	TenantID   string
+	RequestID  string
+	ExpiresAt  string
+}{
+	RelayState: "relay_state",
+	TenantID:   "tenant_id",
+	RequestID:  "request_id",
+	ExpiresAt:  "expires_at",
+}
+
+var SamlLoginRequestTableColumns = struct {
+	RelayState string
+	TenantID   string
+	RequestID  string
+	ExpiresAt  string
+}{
+	RelayState: "saml_login_requests.relay_state",
+	TenantID:   "saml_login_requests.tenant_id",
+	RequestID:  "saml_login_requests.request_id",
+	ExpiresAt:  "saml_login_requests.expires_at",
+}
+
+// Generated where
+
+var SamlLoginRequestWhere = struct {
+	RelayState whereHelperstring
+	TenantID   whereHelperint64
+	RequestID  whereHelperstring
+	ExpiresAt  whereHelpertime_Time
+}{
+	RelayState: whereHelperstring{field: "\"saml_login_requests\".\"relay_state\""},
+	TenantID:   whereHelperint64{field: "\"saml_login_requests\".\"tenant_id\""},
+	RequestID:  whereHelperstring{field: "\"saml_login_requests\".\"request_id\""},
+	ExpiresAt:  whereHelpertime_Time{field: "\"saml_login_requests\".\"expires_at\""},
+}
+
+// SamlLoginRequestRels is where relationship names are stored.
+var SamlLoginRequestRels = struct {
+	Tenant string
+}{
+	Tenant: "Tenant",
+}
+
+// samlLoginRequestR is where relationships are stored.
+type samlLoginRequestR struct {
+	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*samlLoginRequestR) NewStruct() *samlLoginRequestR {
+	return &samlLoginRequestR{}
+}
+
+func (o *SamlLoginRequest) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *samlLoginRequestR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// samlLoginRequestL is where Load methods for each relationship are stored.
+type samlLoginRequestL struct{}
+
+var (
+	samlLoginRequestAllColumns            = []string{"relay_state", "tenant_id", "request_id", "expires_at"}
+	samlLoginRequestColumnsWithoutDefault = []string{"relay_state", "tenant_id", "request_id", "expires_at"}
+	samlLoginRequestColumnsWithDefault    = []string{}
+	samlLoginRequestPrimaryKeyColumns     = []string{"relay_state"}
+	samlLoginRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// SamlLoginRequestSlice is an alias for a slice of pointers to SamlLoginRequest.
+	// This should almost always be used instead of []SamlLoginRequest.
+	SamlLoginRequestSlice []*SamlLoginRequest
+	// SamlLoginRequestHook is the signature for custom SamlLoginRequest hook methods
+	SamlLoginRequestHook func(context.Context, boil.ContextExecutor, *SamlLoginRequest) error
+
+	samlLoginRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	samlLoginRequestType                 = reflect.TypeOf(&SamlLoginRequest{})
+	samlLoginRequestMapping              = queries.MakeStructMapping(samlLoginRequestType)
+	samlLoginRequestPrimaryKeyMapping, _ = queries.BindMapping(samlLoginRequestType, samlLoginRequestMapping, samlLoginRequestPrimaryKeyColumns)
+	samlLoginRequestInsertCacheMut       sync.RWMutex
+	samlLoginRequestInsertCache          = make(map[string]insertCache)
+	samlLoginRequestUpdateCacheMut       sync.RWMutex
+	samlLoginRequestUpdateCache          = make(map[string]updateCache)
+	samlLoginRequestUpsertCacheMut       sync.RWMutex
+	samlLoginRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var samlLoginRequestAfterSelectMu sync.Mutex
+var samlLoginRequestAfterSelectHooks []SamlLoginRequestHook
+
+var samlLoginRequestBeforeInsertMu sync.Mutex
+var samlLoginRequestBeforeInsertHooks []SamlLoginRequestHook
+var samlLoginRequestAfterInsertMu sync.Mutex
+var samlLoginRequestAfterInsertHooks []SamlLoginRequestHook
+
+var samlLoginRequestBeforeUpdateMu sync.Mutex
+var samlLoginRequestBeforeUpdateHooks []SamlLoginRequestHook
+var samlLoginRequestAfterUpdateMu sync.Mutex
+var samlLoginRequestAfterUpdateHooks []SamlLoginRequestHook
+
+var samlLoginRequestBeforeDeleteMu sync.Mutex
+var samlLoginRequestBeforeDeleteHooks []SamlLoginRequestHook
+var samlLoginRequestAfterDeleteMu sync.Mutex
+var samlLoginRequestAfterDeleteHooks []SamlLoginRequestHook
+
+var samlLoginRequestBeforeUpsertMu sync.Mutex
+var samlLoginRequestBeforeUpsertHooks []SamlLoginRequestHook
+var samlLoginRequestAfterUpsertMu sync.Mutex
+var samlLoginRequestAfterUpsertHooks []SamlLoginRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SamlLoginRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SamlLoginRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SamlLoginRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SamlLoginRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SamlLoginRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SamlLoginRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SamlLoginRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SamlLoginRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SamlLoginRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range samlLoginRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSamlLoginRequestHook registers your hook function for all future operations.
+func AddSamlLoginRequestHook(hookPoint boil.HookPoint, samlLoginRequestHook SamlLoginRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		samlLoginRequestAfterSelectMu.Lock()
+		samlLoginRequestAfterSelectHooks = append(samlLoginRequestAfterSelectHooks, samlLoginRequestHook)
+		samlLoginRequestAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		samlLoginRequestBeforeInsertMu.Lock()
+		samlLoginRequestBeforeInsertHooks = append(samlLoginRequestBeforeInsertHooks, samlLoginRequestHook)
+		samlLoginRequestBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		samlLoginRequestAfterInsertMu.Lock()
+		samlLoginRequestAfterInsertHooks = append(samlLoginRequestAfterInsertHooks, samlLoginRequestHook)
+		samlLoginRequestAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		samlLoginRequestBeforeUpdateMu.Lock()
+		samlLoginRequestBeforeUpdateHooks = append(samlLoginRequestBeforeUpdateHooks, samlLoginRequestHook)
+		samlLoginRequestBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		samlLoginRequestAfterUpdateMu.Lock()
+		samlLoginRequestAfterUpdateHooks = append(samlLoginRequestAfterUpdateHooks, samlLoginRequestHook)
+		samlLoginRequestAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		samlLoginRequestBeforeDeleteMu.Lock()
+		samlLoginRequestBeforeDeleteHooks = append(samlLoginRequestBeforeDeleteHooks, samlLoginRequestHook)
+		samlLoginRequestBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		samlLoginRequestAfterDeleteMu.Lock()
+		samlLoginRequestAfterDeleteHooks = append(samlLoginRequestAfterDeleteHooks, samlLoginRequestHook)
+		samlLoginRequestAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		samlLoginRequestBeforeUpsertMu.Lock()
+		samlLoginRequestBeforeUpsertHooks = append(samlLoginRequestBeforeUpsertHooks, samlLoginRequestHook)
+		samlLoginRequestBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		samlLoginRequestAfterUpsertMu.Lock()
+		samlLoginRequestAfterUpsertHooks = append(samlLoginRequestAfterUpsertHooks, samlLoginRequestHook)
+		samlLoginRequestAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single samlLoginRequest record from the query.
+func (q samlLoginRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SamlLoginRequest, error) {
+	o := &SamlLoginRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for saml_login_requests")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SamlLoginRequest records from the query.
+func (q samlLoginRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (SamlLoginRequestSlice, error) {
+	var o []*SamlLoginRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SamlLoginRequest slice")
+	}
+
+	if len(samlLoginRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SamlLoginRequest records in the query.
+func (q samlLoginRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count saml_login_requests rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q samlLoginRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if saml_login_requests exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tenant pointed to by the foreign key.
+func (o *SamlLoginRequest) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (samlLoginRequestL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSamlLoginRequest interface{}, mods queries.Applicator) error {
+	var slice []*SamlLoginRequest
+	var object *SamlLoginRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeSamlLoginRequest.(*SamlLoginRequest)
+		if !ok {
+			object = new(SamlLoginRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSamlLoginRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSamlLoginRequest))
+			}
+		}
+	} else {
+		s, ok := maybeSamlLoginRequest.(*[]*SamlLoginRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSamlLoginRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSamlLoginRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &samlLoginRequestR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &samlLoginRequestR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.SamlLoginRequests = append(foreign.R.SamlLoginRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.SamlLoginRequests = append(foreign.R.SamlLoginRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the samlLoginRequest to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.SamlLoginRequests.
+func (o *SamlLoginRequest) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"saml_login_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, samlLoginRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.RelayState}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &samlLoginRequestR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			SamlLoginRequests: SamlLoginRequestSlice{o},
+		}
+	} else {
+		related.R.SamlLoginRequests = append(related.R.SamlLoginRequests, o)
+	}
+
+	return nil
+}
+
+// SamlLoginRequests retrieves all the records using an executor.
+func SamlLoginRequests(mods ...qm.QueryMod) samlLoginRequestQuery {
+	mods = append(mods, qm.From("\"saml_login_requests\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"saml_login_requests\".*"})
+	}
+
+	return samlLoginRequestQuery{q}
+}
+
+// FindSamlLoginRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSamlLoginRequest(ctx context.Context, exec boil.ContextExecutor, relayState string, selectCols ...string) (*SamlLoginRequest, error) {
+	samlLoginRequestObj := &SamlLoginRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"saml_login_requests\" where \"relay_state\"=$1", sel,
+	)
+
+	q := queries.Raw(query, relayState)
+
+	err := q.Bind(ctx, exec, samlLoginRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from saml_login_requests")
+	}
+
+	if err = samlLoginRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return samlLoginRequestObj, err
+	}
+
+	return samlLoginRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SamlLoginRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no saml_login_requests provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(samlLoginRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	samlLoginRequestInsertCacheMut.RLock()
+	cache, cached := samlLoginRequestInsertCache[key]
+	samlLoginRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			samlLoginRequestAllColumns,
+			samlLoginRequestColumnsWithDefault,
+			samlLoginRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(samlLoginRequestType, samlLoginRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(samlLoginRequestType, samlLoginRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"saml_login_requests\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"saml_login_requests\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into saml_login_requests")
+	}
+
+	if !cached {
+		samlLoginRequestInsertCacheMut.Lock()
+		samlLoginRequestInsertCache[key] = cache
+		samlLoginRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SamlLoginRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SamlLoginRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	samlLoginRequestUpdateCacheMut.RLock()
+	cache, cached := samlLoginRequestUpdateCache[key]
+	samlLoginRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			samlLoginRequestAllColumns,
+			samlLoginRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update saml_login_requests, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"saml_login_requests\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, samlLoginRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(samlLoginRequestType, samlLoginRequestMapping, append(wl, samlLoginRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update saml_login_requests row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for saml_login_requests")
+	}
+
+	if !cached {
+		samlLoginRequestUpdateCacheMut.Lock()
+		samlLoginRequestUpdateCache[key] = cache
+		samlLoginRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q samlLoginRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for saml_login_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for saml_login_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SamlLoginRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), samlLoginRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"saml_login_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, samlLoginRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in samlLoginRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all samlLoginRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SamlLoginRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no saml_login_requests provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(samlLoginRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	samlLoginRequestUpsertCacheMut.RLock()
+	cache, cached := samlLoginRequestUpsertCache[key]
+	samlLoginRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			samlLoginRequestAllColumns,
+			samlLoginRequestColumnsWithDefault,
+			samlLoginRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			samlLoginRequestAllColumns,
+			samlLoginRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert saml_login_requests, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(samlLoginRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(samlLoginRequestPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert saml_login_requests, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(samlLoginRequestPrimaryKeyColumns))
+			copy(conflict, samlLoginRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"saml_login_requests\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(samlLoginRequestType, samlLoginRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(samlLoginRequestType, samlLoginRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert saml_login_requests")
+	}
+
+	if !cached {
+		samlLoginRequestUpsertCacheMut.Lock()
+		samlLoginRequestUpsertCache[key] = cache
+		samlLoginRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SamlLoginRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SamlLoginRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SamlLoginRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), samlLoginRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"saml_login_requests\" WHERE \"relay_state\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from saml_login_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for saml_login_requests")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q samlLoginRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no samlLoginRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from saml_login_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for saml_login_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SamlLoginRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(samlLoginRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), samlLoginRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"saml_login_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, samlLoginRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from samlLoginRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for saml_login_requests")
+	}
+
+	if len(samlLoginRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SamlLoginRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSamlLoginRequest(ctx, exec, o.RelayState)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SamlLoginRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SamlLoginRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), samlLoginRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"saml_login_requests\".* FROM \"saml_login_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, samlLoginRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SamlLoginRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SamlLoginRequestExists checks if the SamlLoginRequest row exists.
+func SamlLoginRequestExists(ctx context.Context, exec boil.ContextExecutor, relayState string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"saml_login_requests\" where \"relay_state\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, relayState)
+	}
+	row := exec.QueryRowContext(ctx, sql, relayState)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if saml_login_requests exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SamlLoginRequest row exists.
+func (o *SamlLoginRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SamlLoginRequestExists(ctx, exec, o.RelayState)
+}
@@ -0,0 +1,1133 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// SsoLoginCode is an object representing the database table.
+type SsoLoginCode struct {
+	CodeHash  string    `boil:"code_hash" json:"code_hash" toml:"code_hash" yaml:"code_hash"`
+	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *ssoLoginCodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ssoLoginCodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SsoLoginCodeColumns = struct {
+	CodeHash  string
+	UserID    string
+	ExpiresAt string
+}{
+	CodeHash:  "code_hash",
+	UserID:    "user_id",
+	ExpiresAt: "expires_at",
+}
+
+var SsoLoginCodeTableColumns = struct {
+	CodeHash  string
+	UserID    string
+	ExpiresAt string
+}{
+	CodeHash:  "sso_login_codes.code_hash",
+	UserID:    "sso_login_codes.user_id",
+	ExpiresAt: "sso_login_codes.expires_at",
+}
+
+// Generated where
+
+var SsoLoginCodeWhere = struct {
+	CodeHash  whereHelperstring
+	UserID    whereHelperint64
+	ExpiresAt whereHelpertime_Time
+}{
+	CodeHash:  whereHelperstring{field: "\"sso_login_codes\".\"code_hash\""},
+	UserID:    whereHelperint64{field: "\"sso_login_codes\".\"user_id\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"sso_login_codes\".\"expires_at\""},
+}
+
+// SsoLoginCodeRels is where relationship names are stored.
+var SsoLoginCodeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// ssoLoginCodeR is where relationships are stored.
+type ssoLoginCodeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*ssoLoginCodeR) NewStruct() *ssoLoginCodeR {
+	return &ssoLoginCodeR{}
+}
+
+func (o *SsoLoginCode) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *ssoLoginCodeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// ssoLoginCodeL is where Load methods for each relationship are stored.
+type ssoLoginCodeL struct{}
+
+var (
+	ssoLoginCodeAllColumns            = []string{"code_hash", "user_id", "expires_at"}
+	ssoLoginCodeColumnsWithoutDefault = []string{"code_hash", "user_id", "expires_at"}
+	ssoLoginCodeColumnsWithDefault    = []string{}
+	ssoLoginCodePrimaryKeyColumns     = []string{"code_hash"}
+	ssoLoginCodeGeneratedColumns      = []string{}
+)
+
+type (
+	// SsoLoginCodeSlice is an alias for a slice of pointers to SsoLoginCode.
+	// This should almost always be used instead of []SsoLoginCode.
+	SsoLoginCodeSlice []*SsoLoginCode
+	// SsoLoginCodeHook is the signature for custom SsoLoginCode hook methods
+	SsoLoginCodeHook func(context.Context, boil.ContextExecutor, *SsoLoginCode) error
+
+	ssoLoginCodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ssoLoginCodeType                 = reflect.TypeOf(&SsoLoginCode{})
+	ssoLoginCodeMapping              = queries.MakeStructMapping(ssoLoginCodeType)
+	ssoLoginCodePrimaryKeyMapping, _ = queries.BindMapping(ssoLoginCodeType, ssoLoginCodeMapping, ssoLoginCodePrimaryKeyColumns)
+	ssoLoginCodeInsertCacheMut       sync.RWMutex
+	ssoLoginCodeInsertCache          = make(map[string]insertCache)
+	ssoLoginCodeUpdateCacheMut       sync.RWMutex
+	ssoLoginCodeUpdateCache          = make(map[string]updateCache)
+	ssoLoginCodeUpsertCacheMut       sync.RWMutex
+	ssoLoginCodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ssoLoginCodeAfterSelectMu sync.Mutex
+var ssoLoginCodeAfterSelectHooks []SsoLoginCodeHook
+
+var ssoLoginCodeBeforeInsertMu sync.Mutex
+var ssoLoginCodeBeforeInsertHooks []SsoLoginCodeHook
+var ssoLoginCodeAfterInsertMu sync.Mutex
+var ssoLoginCodeAfterInsertHooks []SsoLoginCodeHook
+
+var ssoLoginCodeBeforeUpdateMu sync.Mutex
+var ssoLoginCodeBeforeUpdateHooks []SsoLoginCodeHook
+var ssoLoginCodeAfterUpdateMu sync.Mutex
+var ssoLoginCodeAfterUpdateHooks []SsoLoginCodeHook
+
+var ssoLoginCodeBeforeDeleteMu sync.Mutex
+var ssoLoginCodeBeforeDeleteHooks []SsoLoginCodeHook
+var ssoLoginCodeAfterDeleteMu sync.Mutex
+var ssoLoginCodeAfterDeleteHooks []SsoLoginCodeHook
+
+var ssoLoginCodeBeforeUpsertMu sync.Mutex
+var ssoLoginCodeBeforeUpsertHooks []SsoLoginCodeHook
+var ssoLoginCodeAfterUpsertMu sync.Mutex
+var ssoLoginCodeAfterUpsertHooks []SsoLoginCodeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SsoLoginCode) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SsoLoginCode) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SsoLoginCode) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SsoLoginCode) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SsoLoginCode) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SsoLoginCode) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SsoLoginCode) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SsoLoginCode) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SsoLoginCode) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ssoLoginCodeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSsoLoginCodeHook registers your hook function for all future operations.
+func AddSsoLoginCodeHook(hookPoint boil.HookPoint, ssoLoginCodeHook SsoLoginCodeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		ssoLoginCodeAfterSelectMu.Lock()
+		ssoLoginCodeAfterSelectHooks = append(ssoLoginCodeAfterSelectHooks, ssoLoginCodeHook)
+		ssoLoginCodeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		ssoLoginCodeBeforeInsertMu.Lock()
+		ssoLoginCodeBeforeInsertHooks = append(ssoLoginCodeBeforeInsertHooks, ssoLoginCodeHook)
+		ssoLoginCodeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		ssoLoginCodeAfterInsertMu.Lock()
+		ssoLoginCodeAfterInsertHooks = append(ssoLoginCodeAfterInsertHooks, ssoLoginCodeHook)
+		ssoLoginCodeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		ssoLoginCodeBeforeUpdateMu.Lock()
+		ssoLoginCodeBeforeUpdateHooks = append(ssoLoginCodeBeforeUpdateHooks, ssoLoginCodeHook)
+		ssoLoginCodeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		ssoLoginCodeAfterUpdateMu.Lock()
+		ssoLoginCodeAfterUpdateHooks = append(ssoLoginCodeAfterUpdateHooks, ssoLoginCodeHook)
+		ssoLoginCodeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		ssoLoginCodeBeforeDeleteMu.Lock()
+		ssoLoginCodeBeforeDeleteHooks = append(ssoLoginCodeBeforeDeleteHooks, ssoLoginCodeHook)
+		ssoLoginCodeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		ssoLoginCodeAfterDeleteMu.Lock()
+		ssoLoginCodeAfterDeleteHooks = append(ssoLoginCodeAfterDeleteHooks, ssoLoginCodeHook)
+		ssoLoginCodeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		ssoLoginCodeBeforeUpsertMu.Lock()
+		ssoLoginCodeBeforeUpsertHooks = append(ssoLoginCodeBeforeUpsertHooks, ssoLoginCodeHook)
+		ssoLoginCodeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		ssoLoginCodeAfterUpsertMu.Lock()
+		ssoLoginCodeAfterUpsertHooks = append(ssoLoginCodeAfterUpsertHooks, ssoLoginCodeHook)
+		ssoLoginCodeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single ssoLoginCode record from the query.
+func (q ssoLoginCodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SsoLoginCode, error) {
+	o := &SsoLoginCode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for sso_login_codes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SsoLoginCode records from the query.
+func (q ssoLoginCodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (SsoLoginCodeSlice, error) {
+	var o []*SsoLoginCode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SsoLoginCode slice")
+	}
+
+	if len(ssoLoginCodeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SsoLoginCode records in the query.
+func (q ssoLoginCodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count sso_login_codes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q ssoLoginCodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if sso_login_codes exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *SsoLoginCode) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (ssoLoginCodeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSsoLoginCode interface{}, mods queries.Applicator) error {
+	var slice []*SsoLoginCode
+	var object *SsoLoginCode
+
+	if singular {
+		var ok bool
+		object, ok = maybeSsoLoginCode.(*SsoLoginCode)
+		if !ok {
+			object = new(SsoLoginCode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSsoLoginCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSsoLoginCode))
+			}
+		}
+	} else {
+		s, ok := maybeSsoLoginCode.(*[]*SsoLoginCode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSsoLoginCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSsoLoginCode))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ssoLoginCodeR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ssoLoginCodeR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SsoLoginCodes = append(foreign.R.SsoLoginCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SsoLoginCodes = append(foreign.R.SsoLoginCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the ssoLoginCode to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.SsoLoginCodes.
+func (o *SsoLoginCode) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"sso_login_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, ssoLoginCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CodeHash}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &ssoLoginCodeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SsoLoginCodes: SsoLoginCodeSlice{o},
+		}
+	} else {
+		related.R.SsoLoginCodes = append(related.R.SsoLoginCodes, o)
+	}
+
+	return nil
+}
+
+// SsoLoginCodes retrieves all the records using an executor.
+func SsoLoginCodes(mods ...qm.QueryMod) ssoLoginCodeQuery {
+	mods = append(mods, qm.From("\"sso_login_codes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"sso_login_codes\".*"})
+	}
+
+	return ssoLoginCodeQuery{q}
+}
+
+// FindSsoLoginCode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSsoLoginCode(ctx context.Context, exec boil.ContextExecutor, codeHash string, selectCols ...string) (*SsoLoginCode, error) {
+	ssoLoginCodeObj := &SsoLoginCode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"sso_login_codes\" where \"code_hash\"=$1", sel,
+	)
+
+	q := queries.Raw(query, codeHash)
+
+	err := q.Bind(ctx, exec, ssoLoginCodeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from sso_login_codes")
+	}
+
+	if err = ssoLoginCodeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return ssoLoginCodeObj, err
+	}
+
+	return ssoLoginCodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SsoLoginCode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no sso_login_codes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ssoLoginCodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ssoLoginCodeInsertCacheMut.RLock()
+	cache, cached := ssoLoginCodeInsertCache[key]
+	ssoLoginCodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ssoLoginCodeAllColumns,
+			ssoLoginCodeColumnsWithDefault,
+			ssoLoginCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(ssoLoginCodeType, ssoLoginCodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ssoLoginCodeType, ssoLoginCodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"sso_login_codes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"sso_login_codes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into sso_login_codes")
+	}
+
+	if !cached {
+		ssoLoginCodeInsertCacheMut.Lock()
+		ssoLoginCodeInsertCache[key] = cache
+		ssoLoginCodeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SsoLoginCode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SsoLoginCode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ssoLoginCodeUpdateCacheMut.RLock()
+	cache, cached := ssoLoginCodeUpdateCache[key]
+	ssoLoginCodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ssoLoginCodeAllColumns,
+			ssoLoginCodePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update sso_login_codes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"sso_login_codes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, ssoLoginCodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ssoLoginCodeType, ssoLoginCodeMapping, append(wl, ssoLoginCodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update sso_login_codes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for sso_login_codes")
+	}
+
+	if !cached {
+		ssoLoginCodeUpdateCacheMut.Lock()
+		ssoLoginCodeUpdateCache[key] = cache
+		ssoLoginCodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ssoLoginCodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for sso_login_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for sso_login_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SsoLoginCodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ssoLoginCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"sso_login_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, ssoLoginCodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in ssoLoginCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all ssoLoginCode")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SsoLoginCode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no sso_login_codes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ssoLoginCodeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ssoLoginCodeUpsertCacheMut.RLock()
+	cache, cached := ssoLoginCodeUpsertCache[key]
+	ssoLoginCodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			ssoLoginCodeAllColumns,
+			ssoLoginCodeColumnsWithDefault,
+			ssoLoginCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			ssoLoginCodeAllColumns,
+			ssoLoginCodePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert sso_login_codes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(ssoLoginCodeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(ssoLoginCodePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert sso_login_codes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(ssoLoginCodePrimaryKeyColumns))
+			copy(conflict, ssoLoginCodePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"sso_login_codes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(ssoLoginCodeType, ssoLoginCodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ssoLoginCodeType, ssoLoginCodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert sso_login_codes")
+	}
+
+	if !cached {
+		ssoLoginCodeUpsertCacheMut.Lock()
+		ssoLoginCodeUpsertCache[key] = cache
+		ssoLoginCodeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SsoLoginCode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SsoLoginCode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SsoLoginCode provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ssoLoginCodePrimaryKeyMapping)
+	sql := "DELETE FROM \"sso_login_codes\" WHERE \"code_hash\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from sso_login_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for sso_login_codes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q ssoLoginCodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no ssoLoginCodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sso_login_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sso_login_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SsoLoginCodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ssoLoginCodeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ssoLoginCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"sso_login_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ssoLoginCodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from ssoLoginCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sso_login_codes")
+	}
+
+	if len(ssoLoginCodeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SsoLoginCode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSsoLoginCode(ctx, exec, o.CodeHash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SsoLoginCodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SsoLoginCodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ssoLoginCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"sso_login_codes\".* FROM \"sso_login_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ssoLoginCodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SsoLoginCodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SsoLoginCodeExists checks if the SsoLoginCode row exists.
+func SsoLoginCodeExists(ctx context.Context, exec boil.ContextExecutor, codeHash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"sso_login_codes\" where \"code_hash\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, codeHash)
+	}
+	row := exec.QueryRowContext(ctx, sql, codeHash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if sso_login_codes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SsoLoginCode row exists.
+func (o *SsoLoginCode) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SsoLoginCodeExists(ctx, exec, o.CodeHash)
+}
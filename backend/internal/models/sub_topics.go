@@ -24,83 +24,181 @@ import (
 
 // SubTopic is an object representing the database table.
 type SubTopic struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	TopicID   int64     `boil:"topic_id" json:"topic_id" toml:"topic_id" yaml:"topic_id"`
-	TenantID  int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID                      int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                    string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	TopicID                 int64       `boil:"topic_id" json:"topic_id" toml:"topic_id" yaml:"topic_id"`
+	TenantID                int64       `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt               time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt               null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	FirstReplyTargetMinutes null.Int    `boil:"first_reply_target_minutes" json:"first_reply_target_minutes,omitempty" toml:"first_reply_target_minutes" yaml:"first_reply_target_minutes,omitempty"`
+	Description             string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Icon                    null.String `boil:"icon" json:"icon,omitempty" toml:"icon" yaml:"icon,omitempty"`
+	Color                   null.String `boil:"color" json:"color,omitempty" toml:"color" yaml:"color,omitempty"`
+	Archived                bool        `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
+	TemplateBody            string      `boil:"template_body" json:"template_body" toml:"template_body" yaml:"template_body"`
 
 	R *subTopicR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L subTopicL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SubTopicColumns = struct {
-	ID        string
-	Name      string
-	TopicID   string
-	TenantID  string
-	CreatedAt string
-	UpdatedAt string
+	ID                      string
+	Name                    string
+	TopicID                 string
+	TenantID                string
+	CreatedAt               string
+	UpdatedAt               string
+	FirstReplyTargetMinutes string
+	Description             string
+	Icon                    string
+	Color                   string
+	Archived                string
+	TemplateBody            string
 }{
-	ID:        "id",
-	Name:      "name",
-	TopicID:   "topic_id",
-	TenantID:  "tenant_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:                      "id",
+	Name:                    "name",
+	TopicID:                 "topic_id",
+	TenantID:                "tenant_id",
+	CreatedAt:               "created_at",
+	UpdatedAt:               "updated_at",
+	FirstReplyTargetMinutes: "first_reply_target_minutes",
+	Description:             "description",
+	Icon:                    "icon",
+	Color:                   "color",
+	Archived:                "archived",
+	TemplateBody:            "template_body",
 }
 
 var SubTopicTableColumns = struct {
-	ID        string
-	Name      string
-	TopicID   string
-	TenantID  string
-	CreatedAt string
-	UpdatedAt string
+	ID                      string
+	Name                    string
+	TopicID                 string
+	TenantID                string
+	CreatedAt               string
+	UpdatedAt               string
+	FirstReplyTargetMinutes string
+	Description             string
+	Icon                    string
+	Color                   string
+	Archived                string
+	TemplateBody            string
 }{
-	ID:        "sub_topics.id",
-	Name:      "sub_topics.name",
-	TopicID:   "sub_topics.topic_id",
-	TenantID:  "sub_topics.tenant_id",
-	CreatedAt: "sub_topics.created_at",
-	UpdatedAt: "sub_topics.updated_at",
+	ID:                      "sub_topics.id",
+	Name:                    "sub_topics.name",
+	TopicID:                 "sub_topics.topic_id",
+	TenantID:                "sub_topics.tenant_id",
+	CreatedAt:               "sub_topics.created_at",
+	UpdatedAt:               "sub_topics.updated_at",
+	FirstReplyTargetMinutes: "sub_topics.first_reply_target_minutes",
+	Description:             "sub_topics.description",
+	Icon:                    "sub_topics.icon",
+	Color:                   "sub_topics.color",
+	Archived:                "sub_topics.archived",
+	TemplateBody:            "sub_topics.template_body",
 }
 
 // Generated where
 
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var SubTopicWhere = struct {
-	ID        whereHelperint64
-	Name      whereHelperstring
-	TopicID   whereHelperint64
-	TenantID  whereHelperint64
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpernull_Time
+	ID                      whereHelperint64
+	Name                    whereHelperstring
+	TopicID                 whereHelperint64
+	TenantID                whereHelperint64
+	CreatedAt               whereHelpertime_Time
+	UpdatedAt               whereHelpernull_Time
+	FirstReplyTargetMinutes whereHelpernull_Int
+	Description             whereHelperstring
+	Icon                    whereHelpernull_String
+	Color                   whereHelpernull_String
+	Archived                whereHelperbool
+	TemplateBody            whereHelperstring
 }{
-	ID:        whereHelperint64{field: "\"sub_topics\".\"id\""},
-	Name:      whereHelperstring{field: "\"sub_topics\".\"name\""},
-	TopicID:   whereHelperint64{field: "\"sub_topics\".\"topic_id\""},
-	TenantID:  whereHelperint64{field: "\"sub_topics\".\"tenant_id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"sub_topics\".\"created_at\""},
-	UpdatedAt: whereHelpernull_Time{field: "\"sub_topics\".\"updated_at\""},
+	ID:                      whereHelperint64{field: "\"sub_topics\".\"id\""},
+	Name:                    whereHelperstring{field: "\"sub_topics\".\"name\""},
+	TopicID:                 whereHelperint64{field: "\"sub_topics\".\"topic_id\""},
+	TenantID:                whereHelperint64{field: "\"sub_topics\".\"tenant_id\""},
+	CreatedAt:               whereHelpertime_Time{field: "\"sub_topics\".\"created_at\""},
+	UpdatedAt:               whereHelpernull_Time{field: "\"sub_topics\".\"updated_at\""},
+	FirstReplyTargetMinutes: whereHelpernull_Int{field: "\"sub_topics\".\"first_reply_target_minutes\""},
+	Description:             whereHelperstring{field: "\"sub_topics\".\"description\""},
+	Icon:                    whereHelpernull_String{field: "\"sub_topics\".\"icon\""},
+	Color:                   whereHelpernull_String{field: "\"sub_topics\".\"color\""},
+	Archived:                whereHelperbool{field: "\"sub_topics\".\"archived\""},
+	TemplateBody:            whereHelperstring{field: "\"sub_topics\".\"template_body\""},
 }
 
 // SubTopicRels is where relationship names are stored.
 var SubTopicRels = struct {
-	Tenant        string
-	Topic         string
-	SubtopicPosts string
+	Tenant                 string
+	Topic                  string
+	Category               string
+	SubtopicPosts          string
+	QuestionTemplateFields string
+	Claims                 string
+	Users                  string
+	Roles                  string
+	TopicModerators        string
 }{
-	Tenant:        "Tenant",
-	Topic:         "Topic",
-	SubtopicPosts: "SubtopicPosts",
+	Tenant:                 "Tenant",
+	Topic:                  "Topic",
+	Category:               "Category",
+	SubtopicPosts:          "SubtopicPosts",
+	QuestionTemplateFields: "QuestionTemplateFields",
+	Claims:                 "Claims",
+	Users:                  "Users",
+	Roles:                  "Roles",
+	TopicModerators:        "TopicModerators",
 }
 
 // subTopicR is where relationships are stored.
 type subTopicR struct {
-	Tenant        *Tenant   `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	Topic         *Topic    `boil:"Topic" json:"Topic" toml:"Topic" yaml:"Topic"`
-	SubtopicPosts PostSlice `boil:"SubtopicPosts" json:"SubtopicPosts" toml:"SubtopicPosts" yaml:"SubtopicPosts"`
+	Tenant                 *Tenant                    `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Topic                  *Topic                     `boil:"Topic" json:"Topic" toml:"Topic" yaml:"Topic"`
+	Category               *Category                  `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	SubtopicPosts          PostSlice                  `boil:"SubtopicPosts" json:"SubtopicPosts" toml:"SubtopicPosts" yaml:"SubtopicPosts"`
+	QuestionTemplateFields QuestionTemplateFieldSlice `boil:"QuestionTemplateFields" json:"QuestionTemplateFields" toml:"QuestionTemplateFields" yaml:"QuestionTemplateFields"`
+	Claims                 ClaimSlice                 `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
+	Users                  UserSlice                  `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Roles                  RoleSlice                  `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
+	TopicModerators        TopicModeratorSlice        `boil:"TopicModerators" json:"TopicModerators" toml:"TopicModerators" yaml:"TopicModerators"`
 }
 
 // NewStruct creates a new relationship struct
@@ -140,6 +238,22 @@ func (r *subTopicR) GetTopic() *Topic {
 	return r.Topic
 }
 
+func (o *SubTopic) GetCategory() *Category {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategory()
+}
+
+func (r *subTopicR) GetCategory() *Category {
+	if r == nil {
+		return nil
+	}
+
+	return r.Category
+}
+
 func (o *SubTopic) GetSubtopicPosts() PostSlice {
 	if o == nil {
 		return nil
@@ -156,13 +270,93 @@ func (r *subTopicR) GetSubtopicPosts() PostSlice {
 	return r.SubtopicPosts
 }
 
+func (o *SubTopic) GetQuestionTemplateFields() QuestionTemplateFieldSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetQuestionTemplateFields()
+}
+
+func (r *subTopicR) GetQuestionTemplateFields() QuestionTemplateFieldSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.QuestionTemplateFields
+}
+
+func (o *SubTopic) GetClaims() ClaimSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetClaims()
+}
+
+func (r *subTopicR) GetClaims() ClaimSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Claims
+}
+
+func (o *SubTopic) GetUsers() UserSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUsers()
+}
+
+func (r *subTopicR) GetUsers() UserSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Users
+}
+
+func (o *SubTopic) GetRoles() RoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRoles()
+}
+
+func (r *subTopicR) GetRoles() RoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Roles
+}
+
+func (o *SubTopic) GetTopicModerators() TopicModeratorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopicModerators()
+}
+
+func (r *subTopicR) GetTopicModerators() TopicModeratorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TopicModerators
+}
+
 // subTopicL is where Load methods for each relationship are stored.
 type subTopicL struct{}
 
 var (
-	subTopicAllColumns            = []string{"id", "name", "topic_id", "tenant_id", "created_at", "updated_at"}
+	subTopicAllColumns            = []string{"id", "name", "topic_id", "tenant_id", "created_at", "updated_at", "first_reply_target_minutes", "description", "icon", "color", "archived", "template_body"}
 	subTopicColumnsWithoutDefault = []string{"name", "topic_id", "tenant_id"}
-	subTopicColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	subTopicColumnsWithDefault    = []string{"id", "created_at", "updated_at", "first_reply_target_minutes", "description", "icon", "color", "archived", "template_body"}
 	subTopicPrimaryKeyColumns     = []string{"id"}
 	subTopicGeneratedColumns      = []string{"id"}
 )
@@ -494,6 +688,17 @@ func (o *SubTopic) Topic(mods ...qm.QueryMod) topicQuery {
 	return Topics(queryMods...)
 }
 
+// Category pointed to by the foreign key.
+func (o *SubTopic) Category(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"sub_topic_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
 // SubtopicPosts retrieves all the post's Posts with an executor via subtopic_id column.
 func (o *SubTopic) SubtopicPosts(mods ...qm.QueryMod) postQuery {
 	var queryMods []qm.QueryMod
@@ -508,6 +713,79 @@ func (o *SubTopic) SubtopicPosts(mods ...qm.QueryMod) postQuery {
 	return Posts(queryMods...)
 }
 
+// QuestionTemplateFields retrieves all the question_template_field's QuestionTemplateFields with an executor.
+func (o *SubTopic) QuestionTemplateFields(mods ...qm.QueryMod) questionTemplateFieldQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"question_template_fields\".\"sub_topic_id\"=?", o.ID),
+	)
+
+	return QuestionTemplateFields(queryMods...)
+}
+
+// Claims retrieves all the claim's Claims with an executor.
+func (o *SubTopic) Claims(mods ...qm.QueryMod) claimQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"sub_topic_claims\" on \"claims\".\"id\" = \"sub_topic_claims\".\"claim_id\""),
+		qm.Where("\"sub_topic_claims\".\"sub_topic_id\"=?", o.ID),
+	)
+
+	return Claims(queryMods...)
+}
+
+// Users retrieves all the user's Users with an executor.
+func (o *SubTopic) Users(mods ...qm.QueryMod) userQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"sub_topic_responders\" on \"users\".\"id\" = \"sub_topic_responders\".\"user_id\""),
+		qm.Where("\"sub_topic_responders\".\"sub_topic_id\"=?", o.ID),
+	)
+
+	return Users(queryMods...)
+}
+
+// Roles retrieves all the role's Roles with an executor.
+func (o *SubTopic) Roles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"sub_topic_roles\" on \"roles\".\"id\" = \"sub_topic_roles\".\"role_id\""),
+		qm.Where("\"sub_topic_roles\".\"sub_topic_id\"=?", o.ID),
+	)
+
+	return Roles(queryMods...)
+}
+
+// TopicModerators retrieves all the topic_moderator's TopicModerators with an executor.
+func (o *SubTopic) TopicModerators(mods ...qm.QueryMod) topicModeratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"topic_moderators\".\"sub_topic_id\"=?", o.ID),
+	)
+
+	return TopicModerators(queryMods...)
+}
+
 // LoadTenant allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (subTopicL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
@@ -748,6 +1026,123 @@ func (subTopicL) LoadTopic(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (subTopicL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
+	var slice []*SubTopic
+	var object *SubTopic
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubTopic.(*SubTopic)
+		if !ok {
+			object = new(SubTopic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubTopic))
+			}
+		}
+	} else {
+		s, ok := maybeSubTopic.(*[]*SubTopic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subTopicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subTopicR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.sub_topic_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Category")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
+		}
+		foreign.R.SubTopic = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.SubTopicID) {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.SubTopic = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSubtopicPosts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (subTopicL) LoadSubtopicPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
@@ -861,117 +1256,1348 @@ func (subTopicL) LoadSubtopicPosts(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// SetTenant of the subTopic to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.SubTopics.
-func (o *SubTopic) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+// LoadQuestionTemplateFields allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subTopicL) LoadQuestionTemplateFields(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
+	var slice []*SubTopic
+	var object *SubTopic
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"sub_topics\" SET %s WHERE %s",
+	if singular {
+		var ok bool
+		object, ok = maybeSubTopic.(*SubTopic)
+		if !ok {
+			object = new(SubTopic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubTopic))
+			}
+		}
+	} else {
+		s, ok := maybeSubTopic.(*[]*SubTopic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subTopicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subTopicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`question_template_fields`),
+		qm.WhereIn(`question_template_fields.sub_topic_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load question_template_fields")
+	}
+
+	var resultSlice []*QuestionTemplateField
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice question_template_fields")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on question_template_fields")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for question_template_fields")
+	}
+
+	if len(questionTemplateFieldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.QuestionTemplateFields = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &questionTemplateFieldR{}
+			}
+			foreign.R.SubTopic = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubTopicID {
+				local.R.QuestionTemplateFields = append(local.R.QuestionTemplateFields, foreign)
+				if foreign.R == nil {
+					foreign.R = &questionTemplateFieldR{}
+				}
+				foreign.R.SubTopic = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClaims allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subTopicL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
+	var slice []*SubTopic
+	var object *SubTopic
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubTopic.(*SubTopic)
+		if !ok {
+			object = new(SubTopic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubTopic))
+			}
+		}
+	} else {
+		s, ok := maybeSubTopic.(*[]*SubTopic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subTopicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subTopicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"claims\".\"id\", \"claims\".\"name\", \"claims\".\"description\", \"claims\".\"tenant_id\", \"claims\".\"created_at\", \"claims\".\"updated_at\", \"a\".\"sub_topic_id\""),
+		qm.From("\"claims\""),
+		qm.InnerJoin("\"sub_topic_claims\" as \"a\" on \"claims\".\"id\" = \"a\".\"claim_id\""),
+		qm.WhereIn("\"a\".\"sub_topic_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load claims")
+	}
+
+	var resultSlice []*Claim
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Claim)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for claims")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice claims")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on claims")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claims")
+	}
+
+	if len(claimAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Claims = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &claimR{}
+			}
+			foreign.R.SubTopics = append(foreign.R.SubTopics, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Claims = append(local.R.Claims, foreign)
+				if foreign.R == nil {
+					foreign.R = &claimR{}
+				}
+				foreign.R.SubTopics = append(foreign.R.SubTopics, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subTopicL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
+	var slice []*SubTopic
+	var object *SubTopic
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubTopic.(*SubTopic)
+		if !ok {
+			object = new(SubTopic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubTopic))
+			}
+		}
+	} else {
+		s, ok := maybeSubTopic.(*[]*SubTopic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subTopicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subTopicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"users\".\"id\", \"users\".\"name\", \"users\".\"email\", \"users\".\"vsc_account\", \"users\".\"role_id\", \"users\".\"tenant_id\", \"users\".\"created_at\", \"users\".\"updated_at\", \"users\".\"password\", \"users\".\"email_verified_at\", \"users\".\"email_verification_sent_at\", \"users\".\"webauthn_handle\", \"a\".\"sub_topic_id\""),
+		qm.From("\"users\""),
+		qm.InnerJoin("\"sub_topic_responders\" as \"a\" on \"users\".\"id\" = \"a\".\"user_id\""),
+		qm.WhereIn("\"a\".\"sub_topic_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(User)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.Email, &one.VSCAccount, &one.RoleID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.Password, &one.EmailVerifiedAt, &one.EmailVerificationSentAt, &one.WebauthnHandle, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for users")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice users")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Users = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.SubTopics = append(foreign.R.SubTopics, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Users = append(local.R.Users, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SubTopics = append(foreign.R.SubTopics, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subTopicL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
+	var slice []*SubTopic
+	var object *SubTopic
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubTopic.(*SubTopic)
+		if !ok {
+			object = new(SubTopic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubTopic))
+			}
+		}
+	} else {
+		s, ok := maybeSubTopic.(*[]*SubTopic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subTopicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subTopicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"roles\".\"id\", \"roles\".\"name\", \"roles\".\"tenant_id\", \"roles\".\"created_at\", \"roles\".\"updated_at\", \"a\".\"sub_topic_id\""),
+		qm.From("\"roles\""),
+		qm.InnerJoin("\"sub_topic_roles\" as \"a\" on \"roles\".\"id\" = \"a\".\"role_id\""),
+		qm.WhereIn("\"a\".\"sub_topic_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Role)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for roles")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice roles")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Roles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.SubTopics = append(foreign.R.SubTopics, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Roles = append(local.R.Roles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.SubTopics = append(foreign.R.SubTopics, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopicModerators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subTopicL) LoadTopicModerators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubTopic interface{}, mods queries.Applicator) error {
+	var slice []*SubTopic
+	var object *SubTopic
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubTopic.(*SubTopic)
+		if !ok {
+			object = new(SubTopic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubTopic))
+			}
+		}
+	} else {
+		s, ok := maybeSubTopic.(*[]*SubTopic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subTopicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subTopicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`topic_moderators`),
+		qm.WhereIn(`topic_moderators.sub_topic_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topic_moderators")
+	}
+
+	var resultSlice []*TopicModerator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice topic_moderators")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topic_moderators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topic_moderators")
+	}
+
+	if len(topicModeratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TopicModerators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicModeratorR{}
+			}
+			foreign.R.SubTopic = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SubTopicID) {
+				local.R.TopicModerators = append(local.R.TopicModerators, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicModeratorR{}
+				}
+				foreign.R.SubTopic = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the subTopic to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.SubTopics.
+func (o *SubTopic) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"sub_topics\" SET %s WHERE %s",
 		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
 		strmangle.WhereClause("\"", "\"", 2, subTopicPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &subTopicR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			SubTopics: SubTopicSlice{o},
+		}
+	} else {
+		related.R.SubTopics = append(related.R.SubTopics, o)
+	}
+
+	return nil
+}
+
+// SetTopic of the subTopic to the related item.
+// Sets o.R.Topic to related.
+// Adds o to related.R.SubTopics.
+func (o *SubTopic) SetTopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Topic) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"sub_topics\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"topic_id"}),
+		strmangle.WhereClause("\"", "\"", 2, subTopicPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TopicID = related.ID
+	if o.R == nil {
+		o.R = &subTopicR{
+			Topic: related,
+		}
+	} else {
+		o.R.Topic = related
+	}
+
+	if related.R == nil {
+		related.R = &topicR{
+			SubTopics: SubTopicSlice{o},
+		}
+	} else {
+		related.R.SubTopics = append(related.R.SubTopics, o)
+	}
+
+	return nil
+}
+
+// SetCategory of the subTopic to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.SubTopic.
+func (o *SubTopic) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.SubTopicID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"categories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"sub_topic_id"}),
+			strmangle.WhereClause("\"", "\"", 2, categoryPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.SubTopicID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &subTopicR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			SubTopic: o,
+		}
+	} else {
+		related.R.SubTopic = o
+	}
+	return nil
+}
+
+// RemoveCategory relationship.
+// Sets o.R.Category to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *SubTopic) RemoveCategory(ctx context.Context, exec boil.ContextExecutor, related *Category) error {
+	var err error
+
+	queries.SetScanner(&related.SubTopicID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("sub_topic_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Category = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.SubTopic = nil
+
+	return nil
+}
+
+// AddSubtopicPosts adds the given related objects to the existing relationships
+// of the sub_topic, optionally inserting them as new records.
+// Appends related to o.R.SubtopicPosts.
+// Sets related.R.Subtopic appropriately.
+func (o *SubTopic) AddSubtopicPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubtopicID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subtopic_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubtopicID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &subTopicR{
+			SubtopicPosts: related,
+		}
+	} else {
+		o.R.SubtopicPosts = append(o.R.SubtopicPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Subtopic: o,
+			}
+		} else {
+			rel.R.Subtopic = o
+		}
+	}
+	return nil
+}
+
+// AddQuestionTemplateFields adds the given related objects to the existing relationships
+// of the sub_topic, optionally inserting them as new records.
+// Appends related to o.R.QuestionTemplateFields.
+// Sets related.R.SubTopic appropriately.
+func (o *SubTopic) AddQuestionTemplateFields(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*QuestionTemplateField) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubTopicID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"question_template_fields\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sub_topic_id"}),
+				strmangle.WhereClause("\"", "\"", 2, questionTemplateFieldPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubTopicID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &subTopicR{
+			QuestionTemplateFields: related,
+		}
+	} else {
+		o.R.QuestionTemplateFields = append(o.R.QuestionTemplateFields, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &questionTemplateFieldR{
+				SubTopic: o,
+			}
+		} else {
+			rel.R.SubTopic = o
+		}
+	}
+	return nil
+}
+
+// AddClaims adds the given related objects to the existing relationships
+// of the sub_topic, optionally inserting them as new records.
+// Appends related to o.R.Claims.
+// Sets related.R.SubTopics appropriately.
+func (o *SubTopic) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"sub_topic_claims\" (\"sub_topic_id\", \"claim_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &subTopicR{
+			Claims: related,
+		}
+	} else {
+		o.R.Claims = append(o.R.Claims, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &claimR{
+				SubTopics: SubTopicSlice{o},
+			}
+		} else {
+			rel.R.SubTopics = append(rel.R.SubTopics, o)
+		}
+	}
+	return nil
+}
+
+// SetClaims removes all previously related items of the
+// sub_topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SubTopics's Claims accordingly.
+// Replaces o.R.Claims with related.
+// Sets related.R.SubTopics's Claims accordingly.
+func (o *SubTopic) SetClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	query := "delete from \"sub_topic_claims\" where \"sub_topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeClaimsFromSubTopicsSlice(o, related)
+	if o.R != nil {
+		o.R.Claims = nil
+	}
+
+	return o.AddClaims(ctx, exec, insert, related...)
+}
+
+// RemoveClaims relationships from objects passed in.
+// Removes related items from R.Claims (uses pointer comparison, removal does not keep order)
+// Sets related.R.SubTopics.
+func (o *SubTopic) RemoveClaims(ctx context.Context, exec boil.ContextExecutor, related ...*Claim) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"sub_topic_claims\" where \"sub_topic_id\" = $1 and \"claim_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeClaimsFromSubTopicsSlice(o, related)
+	if o.R == nil {
+		return nil
 	}
 
-	o.TenantID = related.ID
+	for _, rel := range related {
+		for i, ri := range o.R.Claims {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Claims)
+			if ln > 1 && i < ln-1 {
+				o.R.Claims[i] = o.R.Claims[ln-1]
+			}
+			o.R.Claims = o.R.Claims[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeClaimsFromSubTopicsSlice(o *SubTopic, related []*Claim) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.SubTopics {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.SubTopics)
+			if ln > 1 && i < ln-1 {
+				rel.R.SubTopics[i] = rel.R.SubTopics[ln-1]
+			}
+			rel.R.SubTopics = rel.R.SubTopics[:ln-1]
+			break
+		}
+	}
+}
+
+// AddUsers adds the given related objects to the existing relationships
+// of the sub_topic, optionally inserting them as new records.
+// Appends related to o.R.Users.
+// Sets related.R.SubTopics appropriately.
+func (o *SubTopic) AddUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"sub_topic_responders\" (\"sub_topic_id\", \"user_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
 	if o.R == nil {
 		o.R = &subTopicR{
-			Tenant: related,
+			Users: related,
 		}
 	} else {
-		o.R.Tenant = related
+		o.R.Users = append(o.R.Users, related...)
 	}
 
-	if related.R == nil {
-		related.R = &tenantR{
-			SubTopics: SubTopicSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userR{
+				SubTopics: SubTopicSlice{o},
+			}
+		} else {
+			rel.R.SubTopics = append(rel.R.SubTopics, o)
 		}
-	} else {
-		related.R.SubTopics = append(related.R.SubTopics, o)
 	}
-
 	return nil
 }
 
-// SetTopic of the subTopic to the related item.
-// Sets o.R.Topic to related.
-// Adds o to related.R.SubTopics.
-func (o *SubTopic) SetTopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Topic) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+// SetUsers removes all previously related items of the
+// sub_topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SubTopics's Users accordingly.
+// Replaces o.R.Users with related.
+// Sets related.R.SubTopics's Users accordingly.
+func (o *SubTopic) SetUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
+	query := "delete from \"sub_topic_responders\" where \"sub_topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"sub_topics\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"topic_id"}),
-		strmangle.WhereClause("\"", "\"", 2, subTopicPrimaryKeyColumns),
+	removeUsersFromSubTopicsSlice(o, related)
+	if o.R != nil {
+		o.R.Users = nil
+	}
+
+	return o.AddUsers(ctx, exec, insert, related...)
+}
+
+// RemoveUsers relationships from objects passed in.
+// Removes related items from R.Users (uses pointer comparison, removal does not keep order)
+// Sets related.R.SubTopics.
+func (o *SubTopic) RemoveUsers(ctx context.Context, exec boil.ContextExecutor, related ...*User) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"sub_topic_responders\" where \"sub_topic_id\" = $1 and \"user_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeUsersFromSubTopicsSlice(o, related)
+	if o.R == nil {
+		return nil
 	}
 
-	o.TopicID = related.ID
+	for _, rel := range related {
+		for i, ri := range o.R.Users {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Users)
+			if ln > 1 && i < ln-1 {
+				o.R.Users[i] = o.R.Users[ln-1]
+			}
+			o.R.Users = o.R.Users[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeUsersFromSubTopicsSlice(o *SubTopic, related []*User) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.SubTopics {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.SubTopics)
+			if ln > 1 && i < ln-1 {
+				rel.R.SubTopics[i] = rel.R.SubTopics[ln-1]
+			}
+			rel.R.SubTopics = rel.R.SubTopics[:ln-1]
+			break
+		}
+	}
+}
+
+// AddRoles adds the given related objects to the existing relationships
+// of the sub_topic, optionally inserting them as new records.
+// Appends related to o.R.Roles.
+// Sets related.R.SubTopics appropriately.
+func (o *SubTopic) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"sub_topic_roles\" (\"sub_topic_id\", \"role_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
 	if o.R == nil {
 		o.R = &subTopicR{
-			Topic: related,
+			Roles: related,
 		}
 	} else {
-		o.R.Topic = related
+		o.R.Roles = append(o.R.Roles, related...)
 	}
 
-	if related.R == nil {
-		related.R = &topicR{
-			SubTopics: SubTopicSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				SubTopics: SubTopicSlice{o},
+			}
+		} else {
+			rel.R.SubTopics = append(rel.R.SubTopics, o)
+		}
+	}
+	return nil
+}
+
+// SetRoles removes all previously related items of the
+// sub_topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SubTopics's Roles accordingly.
+// Replaces o.R.Roles with related.
+// Sets related.R.SubTopics's Roles accordingly.
+func (o *SubTopic) SetRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	query := "delete from \"sub_topic_roles\" where \"sub_topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeRolesFromSubTopicsSlice(o, related)
+	if o.R != nil {
+		o.R.Roles = nil
+	}
+
+	return o.AddRoles(ctx, exec, insert, related...)
+}
+
+// RemoveRoles relationships from objects passed in.
+// Removes related items from R.Roles (uses pointer comparison, removal does not keep order)
+// Sets related.R.SubTopics.
+func (o *SubTopic) RemoveRoles(ctx context.Context, exec boil.ContextExecutor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"sub_topic_roles\" where \"sub_topic_id\" = $1 and \"role_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeRolesFromSubTopicsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Roles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Roles)
+			if ln > 1 && i < ln-1 {
+				o.R.Roles[i] = o.R.Roles[ln-1]
+			}
+			o.R.Roles = o.R.Roles[:ln-1]
+			break
 		}
-	} else {
-		related.R.SubTopics = append(related.R.SubTopics, o)
 	}
 
 	return nil
 }
 
-// AddSubtopicPosts adds the given related objects to the existing relationships
+func removeRolesFromSubTopicsSlice(o *SubTopic, related []*Role) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.SubTopics {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.SubTopics)
+			if ln > 1 && i < ln-1 {
+				rel.R.SubTopics[i] = rel.R.SubTopics[ln-1]
+			}
+			rel.R.SubTopics = rel.R.SubTopics[:ln-1]
+			break
+		}
+	}
+}
+
+// AddTopicModerators adds the given related objects to the existing relationships
 // of the sub_topic, optionally inserting them as new records.
-// Appends related to o.R.SubtopicPosts.
-// Sets related.R.Subtopic appropriately.
-func (o *SubTopic) AddSubtopicPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+// Appends related to o.R.TopicModerators.
+// Sets related.R.SubTopic appropriately.
+func (o *SubTopic) AddTopicModerators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TopicModerator) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.SubtopicID = o.ID
+			queries.Assign(&rel.SubTopicID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"posts\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"subtopic_id"}),
-				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+				"UPDATE \"topic_moderators\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sub_topic_id"}),
+				strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -984,27 +2610,101 @@ func (o *SubTopic) AddSubtopicPosts(ctx context.Context, exec boil.ContextExecut
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.SubtopicID = o.ID
+			queries.Assign(&rel.SubTopicID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &subTopicR{
-			SubtopicPosts: related,
+			TopicModerators: related,
 		}
 	} else {
-		o.R.SubtopicPosts = append(o.R.SubtopicPosts, related...)
+		o.R.TopicModerators = append(o.R.TopicModerators, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &postR{
-				Subtopic: o,
+			rel.R = &topicModeratorR{
+				SubTopic: o,
 			}
 		} else {
-			rel.R.Subtopic = o
+			rel.R.SubTopic = o
+		}
+	}
+	return nil
+}
+
+// SetTopicModerators removes all previously related items of the
+// sub_topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SubTopic's TopicModerators accordingly.
+// Replaces o.R.TopicModerators with related.
+// Sets related.R.SubTopic's TopicModerators accordingly.
+func (o *SubTopic) SetTopicModerators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TopicModerator) error {
+	query := "update \"topic_moderators\" set \"sub_topic_id\" = null where \"sub_topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TopicModerators {
+			queries.SetScanner(&rel.SubTopicID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SubTopic = nil
+		}
+		o.R.TopicModerators = nil
+	}
+
+	return o.AddTopicModerators(ctx, exec, insert, related...)
+}
+
+// RemoveTopicModerators relationships from objects passed in.
+// Removes related items from R.TopicModerators (uses pointer comparison, removal does not keep order)
+// Sets related.R.SubTopic.
+func (o *SubTopic) RemoveTopicModerators(ctx context.Context, exec boil.ContextExecutor, related ...*TopicModerator) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SubTopicID, nil)
+		if rel.R != nil {
+			rel.R.SubTopic = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("sub_topic_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TopicModerators {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TopicModerators)
+			if ln > 1 && i < ln-1 {
+				o.R.TopicModerators[i] = o.R.TopicModerators[ln-1]
+			}
+			o.R.TopicModerators = o.R.TopicModerators[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
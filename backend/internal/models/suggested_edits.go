@@ -0,0 +1,2092 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// SuggestedEdit is an object representing the database table.
+type SuggestedEdit struct {
+	ID            int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PostID        int64       `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	AnswerID      null.Int64  `boil:"answer_id" json:"answer_id,omitempty" toml:"answer_id" yaml:"answer_id,omitempty"`
+	SuggesterID   int64       `boil:"suggester_id" json:"suggester_id" toml:"suggester_id" yaml:"suggester_id"`
+	Title         null.String `boil:"title" json:"title,omitempty" toml:"title" yaml:"title,omitempty"`
+	Body          string      `boil:"body" json:"body" toml:"body" yaml:"body"`
+	Comment       null.String `boil:"comment" json:"comment,omitempty" toml:"comment" yaml:"comment,omitempty"`
+	BaseVersion   int         `boil:"base_version" json:"base_version" toml:"base_version" yaml:"base_version"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ReviewerID    null.Int64  `boil:"reviewer_id" json:"reviewer_id,omitempty" toml:"reviewer_id" yaml:"reviewer_id,omitempty"`
+	ReviewComment null.String `boil:"review_comment" json:"review_comment,omitempty" toml:"review_comment" yaml:"review_comment,omitempty"`
+	ReviewedAt    null.Time   `boil:"reviewed_at" json:"reviewed_at,omitempty" toml:"reviewed_at" yaml:"reviewed_at,omitempty"`
+	TenantID      int64       `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *suggestedEditR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L suggestedEditL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SuggestedEditColumns = struct {
+	ID            string
+	PostID        string
+	AnswerID      string
+	SuggesterID   string
+	Title         string
+	Body          string
+	Comment       string
+	BaseVersion   string
+	Status        string
+	ReviewerID    string
+	ReviewComment string
+	ReviewedAt    string
+	TenantID      string
+	CreatedAt     string
+}{
+	ID:            "id",
+	PostID:        "post_id",
+	AnswerID:      "answer_id",
+	SuggesterID:   "suggester_id",
+	Title:         "title",
+	Body:          "body",
+	Comment:       "comment",
+	BaseVersion:   "base_version",
+	Status:        "status",
+	ReviewerID:    "reviewer_id",
+	ReviewComment: "review_comment",
+	ReviewedAt:    "reviewed_at",
+	TenantID:      "tenant_id",
+	CreatedAt:     "created_at",
+}
+
+var SuggestedEditTableColumns = struct {
+	ID            string
+	PostID        string
+	AnswerID      string
+	SuggesterID   string
+	Title         string
+	Body          string
+	Comment       string
+	BaseVersion   string
+	Status        string
+	ReviewerID    string
+	ReviewComment string
+	ReviewedAt    string
+	TenantID      string
+	CreatedAt     string
+}{
+	ID:            "suggested_edits.id",
+	PostID:        "suggested_edits.post_id",
+	AnswerID:      "suggested_edits.answer_id",
+	SuggesterID:   "suggested_edits.suggester_id",
+	Title:         "suggested_edits.title",
+	Body:          "suggested_edits.body",
+	Comment:       "suggested_edits.comment",
+	BaseVersion:   "suggested_edits.base_version",
+	Status:        "suggested_edits.status",
+	ReviewerID:    "suggested_edits.reviewer_id",
+	ReviewComment: "suggested_edits.review_comment",
+	ReviewedAt:    "suggested_edits.reviewed_at",
+	TenantID:      "suggested_edits.tenant_id",
+	CreatedAt:     "suggested_edits.created_at",
+}
+
+// Generated where
+
+var SuggestedEditWhere = struct {
+	ID            whereHelperint64
+	PostID        whereHelperint64
+	AnswerID      whereHelpernull_Int64
+	SuggesterID   whereHelperint64
+	Title         whereHelpernull_String
+	Body          whereHelperstring
+	Comment       whereHelpernull_String
+	BaseVersion   whereHelperint
+	Status        whereHelperstring
+	ReviewerID    whereHelpernull_Int64
+	ReviewComment whereHelpernull_String
+	ReviewedAt    whereHelpernull_Time
+	TenantID      whereHelperint64
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint64{field: "\"suggested_edits\".\"id\""},
+	PostID:        whereHelperint64{field: "\"suggested_edits\".\"post_id\""},
+	AnswerID:      whereHelpernull_Int64{field: "\"suggested_edits\".\"answer_id\""},
+	SuggesterID:   whereHelperint64{field: "\"suggested_edits\".\"suggester_id\""},
+	Title:         whereHelpernull_String{field: "\"suggested_edits\".\"title\""},
+	Body:          whereHelperstring{field: "\"suggested_edits\".\"body\""},
+	Comment:       whereHelpernull_String{field: "\"suggested_edits\".\"comment\""},
+	BaseVersion:   whereHelperint{field: "\"suggested_edits\".\"base_version\""},
+	Status:        whereHelperstring{field: "\"suggested_edits\".\"status\""},
+	ReviewerID:    whereHelpernull_Int64{field: "\"suggested_edits\".\"reviewer_id\""},
+	ReviewComment: whereHelpernull_String{field: "\"suggested_edits\".\"review_comment\""},
+	ReviewedAt:    whereHelpernull_Time{field: "\"suggested_edits\".\"reviewed_at\""},
+	TenantID:      whereHelperint64{field: "\"suggested_edits\".\"tenant_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"suggested_edits\".\"created_at\""},
+}
+
+// SuggestedEditRels is where relationship names are stored.
+var SuggestedEditRels = struct {
+	Answer    string
+	Post      string
+	Reviewer  string
+	Suggester string
+	Tenant    string
+}{
+	Answer:    "Answer",
+	Post:      "Post",
+	Reviewer:  "Reviewer",
+	Suggester: "Suggester",
+	Tenant:    "Tenant",
+}
+
+// suggestedEditR is where relationships are stored.
+type suggestedEditR struct {
+	Answer    *Answer `boil:"Answer" json:"Answer" toml:"Answer" yaml:"Answer"`
+	Post      *Post   `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Reviewer  *User   `boil:"Reviewer" json:"Reviewer" toml:"Reviewer" yaml:"Reviewer"`
+	Suggester *User   `boil:"Suggester" json:"Suggester" toml:"Suggester" yaml:"Suggester"`
+	Tenant    *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*suggestedEditR) NewStruct() *suggestedEditR {
+	return &suggestedEditR{}
+}
+
+func (o *SuggestedEdit) GetAnswer() *Answer {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAnswer()
+}
+
+func (r *suggestedEditR) GetAnswer() *Answer {
+	if r == nil {
+		return nil
+	}
+
+	return r.Answer
+}
+
+func (o *SuggestedEdit) GetPost() *Post {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPost()
+}
+
+func (r *suggestedEditR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+
+	return r.Post
+}
+
+func (o *SuggestedEdit) GetReviewer() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReviewer()
+}
+
+func (r *suggestedEditR) GetReviewer() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reviewer
+}
+
+func (o *SuggestedEdit) GetSuggester() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSuggester()
+}
+
+func (r *suggestedEditR) GetSuggester() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Suggester
+}
+
+func (o *SuggestedEdit) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *suggestedEditR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// suggestedEditL is where Load methods for each relationship are stored.
+type suggestedEditL struct{}
+
+var (
+	suggestedEditAllColumns            = []string{"id", "post_id", "answer_id", "suggester_id", "title", "body", "comment", "base_version", "status", "reviewer_id", "review_comment", "reviewed_at", "tenant_id", "created_at"}
+	suggestedEditColumnsWithoutDefault = []string{"post_id", "suggester_id", "body", "base_version", "tenant_id"}
+	suggestedEditColumnsWithDefault    = []string{"id", "answer_id", "title", "comment", "status", "reviewer_id", "review_comment", "reviewed_at", "created_at"}
+	suggestedEditPrimaryKeyColumns     = []string{"id"}
+	suggestedEditGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// SuggestedEditSlice is an alias for a slice of pointers to SuggestedEdit.
+	// This should almost always be used instead of []SuggestedEdit.
+	SuggestedEditSlice []*SuggestedEdit
+	// SuggestedEditHook is the signature for custom SuggestedEdit hook methods
+	SuggestedEditHook func(context.Context, boil.ContextExecutor, *SuggestedEdit) error
+
+	suggestedEditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	suggestedEditType                 = reflect.TypeOf(&SuggestedEdit{})
+	suggestedEditMapping              = queries.MakeStructMapping(suggestedEditType)
+	suggestedEditPrimaryKeyMapping, _ = queries.BindMapping(suggestedEditType, suggestedEditMapping, suggestedEditPrimaryKeyColumns)
+	suggestedEditInsertCacheMut       sync.RWMutex
+	suggestedEditInsertCache          = make(map[string]insertCache)
+	suggestedEditUpdateCacheMut       sync.RWMutex
+	suggestedEditUpdateCache          = make(map[string]updateCache)
+	suggestedEditUpsertCacheMut       sync.RWMutex
+	suggestedEditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var suggestedEditAfterSelectMu sync.Mutex
+var suggestedEditAfterSelectHooks []SuggestedEditHook
+
+var suggestedEditBeforeInsertMu sync.Mutex
+var suggestedEditBeforeInsertHooks []SuggestedEditHook
+var suggestedEditAfterInsertMu sync.Mutex
+var suggestedEditAfterInsertHooks []SuggestedEditHook
+
+var suggestedEditBeforeUpdateMu sync.Mutex
+var suggestedEditBeforeUpdateHooks []SuggestedEditHook
+var suggestedEditAfterUpdateMu sync.Mutex
+var suggestedEditAfterUpdateHooks []SuggestedEditHook
+
+var suggestedEditBeforeDeleteMu sync.Mutex
+var suggestedEditBeforeDeleteHooks []SuggestedEditHook
+var suggestedEditAfterDeleteMu sync.Mutex
+var suggestedEditAfterDeleteHooks []SuggestedEditHook
+
+var suggestedEditBeforeUpsertMu sync.Mutex
+var suggestedEditBeforeUpsertHooks []SuggestedEditHook
+var suggestedEditAfterUpsertMu sync.Mutex
+var suggestedEditAfterUpsertHooks []SuggestedEditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SuggestedEdit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SuggestedEdit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SuggestedEdit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SuggestedEdit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SuggestedEdit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SuggestedEdit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SuggestedEdit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SuggestedEdit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SuggestedEdit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range suggestedEditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSuggestedEditHook registers your hook function for all future operations.
+func AddSuggestedEditHook(hookPoint boil.HookPoint, suggestedEditHook SuggestedEditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		suggestedEditAfterSelectMu.Lock()
+		suggestedEditAfterSelectHooks = append(suggestedEditAfterSelectHooks, suggestedEditHook)
+		suggestedEditAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		suggestedEditBeforeInsertMu.Lock()
+		suggestedEditBeforeInsertHooks = append(suggestedEditBeforeInsertHooks, suggestedEditHook)
+		suggestedEditBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		suggestedEditAfterInsertMu.Lock()
+		suggestedEditAfterInsertHooks = append(suggestedEditAfterInsertHooks, suggestedEditHook)
+		suggestedEditAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		suggestedEditBeforeUpdateMu.Lock()
+		suggestedEditBeforeUpdateHooks = append(suggestedEditBeforeUpdateHooks, suggestedEditHook)
+		suggestedEditBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		suggestedEditAfterUpdateMu.Lock()
+		suggestedEditAfterUpdateHooks = append(suggestedEditAfterUpdateHooks, suggestedEditHook)
+		suggestedEditAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		suggestedEditBeforeDeleteMu.Lock()
+		suggestedEditBeforeDeleteHooks = append(suggestedEditBeforeDeleteHooks, suggestedEditHook)
+		suggestedEditBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		suggestedEditAfterDeleteMu.Lock()
+		suggestedEditAfterDeleteHooks = append(suggestedEditAfterDeleteHooks, suggestedEditHook)
+		suggestedEditAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		suggestedEditBeforeUpsertMu.Lock()
+		suggestedEditBeforeUpsertHooks = append(suggestedEditBeforeUpsertHooks, suggestedEditHook)
+		suggestedEditBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		suggestedEditAfterUpsertMu.Lock()
+		suggestedEditAfterUpsertHooks = append(suggestedEditAfterUpsertHooks, suggestedEditHook)
+		suggestedEditAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single suggestedEdit record from the query.
+func (q suggestedEditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SuggestedEdit, error) {
+	o := &SuggestedEdit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for suggested_edits")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SuggestedEdit records from the query.
+func (q suggestedEditQuery) All(ctx context.Context, exec boil.ContextExecutor) (SuggestedEditSlice, error) {
+	var o []*SuggestedEdit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SuggestedEdit slice")
+	}
+
+	if len(suggestedEditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SuggestedEdit records in the query.
+func (q suggestedEditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count suggested_edits rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q suggestedEditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if suggested_edits exists")
+	}
+
+	return count > 0, nil
+}
+
+// Answer pointed to by the foreign key.
+func (o *SuggestedEdit) Answer(mods ...qm.QueryMod) answerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AnswerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Answers(queryMods...)
+}
+
+// Post pointed to by the foreign key.
+func (o *SuggestedEdit) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// Reviewer pointed to by the foreign key.
+func (o *SuggestedEdit) Reviewer(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReviewerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Suggester pointed to by the foreign key.
+func (o *SuggestedEdit) Suggester(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SuggesterID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *SuggestedEdit) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadAnswer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (suggestedEditL) LoadAnswer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSuggestedEdit interface{}, mods queries.Applicator) error {
+	var slice []*SuggestedEdit
+	var object *SuggestedEdit
+
+	if singular {
+		var ok bool
+		object, ok = maybeSuggestedEdit.(*SuggestedEdit)
+		if !ok {
+			object = new(SuggestedEdit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSuggestedEdit))
+			}
+		}
+	} else {
+		s, ok := maybeSuggestedEdit.(*[]*SuggestedEdit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSuggestedEdit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &suggestedEditR{}
+		}
+		if !queries.IsNil(object.AnswerID) {
+			args[object.AnswerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &suggestedEditR{}
+			}
+
+			if !queries.IsNil(obj.AnswerID) {
+				args[obj.AnswerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`answers`),
+		qm.WhereIn(`answers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Answer")
+	}
+
+	var resultSlice []*Answer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Answer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for answers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
+	}
+
+	if len(answerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Answer = foreign
+		if foreign.R == nil {
+			foreign.R = &answerR{}
+		}
+		foreign.R.SuggestedEdits = append(foreign.R.SuggestedEdits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AnswerID, foreign.ID) {
+				local.R.Answer = foreign
+				if foreign.R == nil {
+					foreign.R = &answerR{}
+				}
+				foreign.R.SuggestedEdits = append(foreign.R.SuggestedEdits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (suggestedEditL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSuggestedEdit interface{}, mods queries.Applicator) error {
+	var slice []*SuggestedEdit
+	var object *SuggestedEdit
+
+	if singular {
+		var ok bool
+		object, ok = maybeSuggestedEdit.(*SuggestedEdit)
+		if !ok {
+			object = new(SuggestedEdit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSuggestedEdit))
+			}
+		}
+	} else {
+		s, ok := maybeSuggestedEdit.(*[]*SuggestedEdit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSuggestedEdit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &suggestedEditR{}
+		}
+		args[object.PostID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &suggestedEditR{}
+			}
+
+			args[obj.PostID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.SuggestedEdits = append(foreign.R.SuggestedEdits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.SuggestedEdits = append(foreign.R.SuggestedEdits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (suggestedEditL) LoadReviewer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSuggestedEdit interface{}, mods queries.Applicator) error {
+	var slice []*SuggestedEdit
+	var object *SuggestedEdit
+
+	if singular {
+		var ok bool
+		object, ok = maybeSuggestedEdit.(*SuggestedEdit)
+		if !ok {
+			object = new(SuggestedEdit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSuggestedEdit))
+			}
+		}
+	} else {
+		s, ok := maybeSuggestedEdit.(*[]*SuggestedEdit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSuggestedEdit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &suggestedEditR{}
+		}
+		if !queries.IsNil(object.ReviewerID) {
+			args[object.ReviewerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &suggestedEditR{}
+			}
+
+			if !queries.IsNil(obj.ReviewerID) {
+				args[obj.ReviewerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Reviewer = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReviewerSuggestedEdits = append(foreign.R.ReviewerSuggestedEdits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReviewerID, foreign.ID) {
+				local.R.Reviewer = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReviewerSuggestedEdits = append(foreign.R.ReviewerSuggestedEdits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSuggester allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (suggestedEditL) LoadSuggester(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSuggestedEdit interface{}, mods queries.Applicator) error {
+	var slice []*SuggestedEdit
+	var object *SuggestedEdit
+
+	if singular {
+		var ok bool
+		object, ok = maybeSuggestedEdit.(*SuggestedEdit)
+		if !ok {
+			object = new(SuggestedEdit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSuggestedEdit))
+			}
+		}
+	} else {
+		s, ok := maybeSuggestedEdit.(*[]*SuggestedEdit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSuggestedEdit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &suggestedEditR{}
+		}
+		args[object.SuggesterID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &suggestedEditR{}
+			}
+
+			args[obj.SuggesterID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Suggester = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SuggesterSuggestedEdits = append(foreign.R.SuggesterSuggestedEdits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SuggesterID == foreign.ID {
+				local.R.Suggester = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SuggesterSuggestedEdits = append(foreign.R.SuggesterSuggestedEdits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (suggestedEditL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSuggestedEdit interface{}, mods queries.Applicator) error {
+	var slice []*SuggestedEdit
+	var object *SuggestedEdit
+
+	if singular {
+		var ok bool
+		object, ok = maybeSuggestedEdit.(*SuggestedEdit)
+		if !ok {
+			object = new(SuggestedEdit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSuggestedEdit))
+			}
+		}
+	} else {
+		s, ok := maybeSuggestedEdit.(*[]*SuggestedEdit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSuggestedEdit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSuggestedEdit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &suggestedEditR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &suggestedEditR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.SuggestedEdits = append(foreign.R.SuggestedEdits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.SuggestedEdits = append(foreign.R.SuggestedEdits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAnswer of the suggestedEdit to the related item.
+// Sets o.R.Answer to related.
+// Adds o to related.R.SuggestedEdits.
+func (o *SuggestedEdit) SetAnswer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Answer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"suggested_edits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"answer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AnswerID, related.ID)
+	if o.R == nil {
+		o.R = &suggestedEditR{
+			Answer: related,
+		}
+	} else {
+		o.R.Answer = related
+	}
+
+	if related.R == nil {
+		related.R = &answerR{
+			SuggestedEdits: SuggestedEditSlice{o},
+		}
+	} else {
+		related.R.SuggestedEdits = append(related.R.SuggestedEdits, o)
+	}
+
+	return nil
+}
+
+// RemoveAnswer relationship.
+// Sets o.R.Answer to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *SuggestedEdit) RemoveAnswer(ctx context.Context, exec boil.ContextExecutor, related *Answer) error {
+	var err error
+
+	queries.SetScanner(&o.AnswerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("answer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Answer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SuggestedEdits {
+		if queries.Equal(o.AnswerID, ri.AnswerID) {
+			continue
+		}
+
+		ln := len(related.R.SuggestedEdits)
+		if ln > 1 && i < ln-1 {
+			related.R.SuggestedEdits[i] = related.R.SuggestedEdits[ln-1]
+		}
+		related.R.SuggestedEdits = related.R.SuggestedEdits[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPost of the suggestedEdit to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.SuggestedEdits.
+func (o *SuggestedEdit) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"suggested_edits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &suggestedEditR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			SuggestedEdits: SuggestedEditSlice{o},
+		}
+	} else {
+		related.R.SuggestedEdits = append(related.R.SuggestedEdits, o)
+	}
+
+	return nil
+}
+
+// SetReviewer of the suggestedEdit to the related item.
+// Sets o.R.Reviewer to related.
+// Adds o to related.R.ReviewerSuggestedEdits.
+func (o *SuggestedEdit) SetReviewer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"suggested_edits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reviewer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReviewerID, related.ID)
+	if o.R == nil {
+		o.R = &suggestedEditR{
+			Reviewer: related,
+		}
+	} else {
+		o.R.Reviewer = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReviewerSuggestedEdits: SuggestedEditSlice{o},
+		}
+	} else {
+		related.R.ReviewerSuggestedEdits = append(related.R.ReviewerSuggestedEdits, o)
+	}
+
+	return nil
+}
+
+// RemoveReviewer relationship.
+// Sets o.R.Reviewer to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *SuggestedEdit) RemoveReviewer(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ReviewerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("reviewer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Reviewer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReviewerSuggestedEdits {
+		if queries.Equal(o.ReviewerID, ri.ReviewerID) {
+			continue
+		}
+
+		ln := len(related.R.ReviewerSuggestedEdits)
+		if ln > 1 && i < ln-1 {
+			related.R.ReviewerSuggestedEdits[i] = related.R.ReviewerSuggestedEdits[ln-1]
+		}
+		related.R.ReviewerSuggestedEdits = related.R.ReviewerSuggestedEdits[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSuggester of the suggestedEdit to the related item.
+// Sets o.R.Suggester to related.
+// Adds o to related.R.SuggesterSuggestedEdits.
+func (o *SuggestedEdit) SetSuggester(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"suggested_edits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"suggester_id"}),
+		strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SuggesterID = related.ID
+	if o.R == nil {
+		o.R = &suggestedEditR{
+			Suggester: related,
+		}
+	} else {
+		o.R.Suggester = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SuggesterSuggestedEdits: SuggestedEditSlice{o},
+		}
+	} else {
+		related.R.SuggesterSuggestedEdits = append(related.R.SuggesterSuggestedEdits, o)
+	}
+
+	return nil
+}
+
+// SetTenant of the suggestedEdit to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.SuggestedEdits.
+func (o *SuggestedEdit) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"suggested_edits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &suggestedEditR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			SuggestedEdits: SuggestedEditSlice{o},
+		}
+	} else {
+		related.R.SuggestedEdits = append(related.R.SuggestedEdits, o)
+	}
+
+	return nil
+}
+
+// SuggestedEdits retrieves all the records using an executor.
+func SuggestedEdits(mods ...qm.QueryMod) suggestedEditQuery {
+	mods = append(mods, qm.From("\"suggested_edits\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"suggested_edits\".*"})
+	}
+
+	return suggestedEditQuery{q}
+}
+
+// FindSuggestedEdit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSuggestedEdit(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*SuggestedEdit, error) {
+	suggestedEditObj := &SuggestedEdit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"suggested_edits\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, suggestedEditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from suggested_edits")
+	}
+
+	if err = suggestedEditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return suggestedEditObj, err
+	}
+
+	return suggestedEditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SuggestedEdit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no suggested_edits provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(suggestedEditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	suggestedEditInsertCacheMut.RLock()
+	cache, cached := suggestedEditInsertCache[key]
+	suggestedEditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			suggestedEditAllColumns,
+			suggestedEditColumnsWithDefault,
+			suggestedEditColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, suggestedEditGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(suggestedEditType, suggestedEditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(suggestedEditType, suggestedEditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"suggested_edits\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"suggested_edits\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into suggested_edits")
+	}
+
+	if !cached {
+		suggestedEditInsertCacheMut.Lock()
+		suggestedEditInsertCache[key] = cache
+		suggestedEditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SuggestedEdit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SuggestedEdit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	suggestedEditUpdateCacheMut.RLock()
+	cache, cached := suggestedEditUpdateCache[key]
+	suggestedEditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			suggestedEditAllColumns,
+			suggestedEditPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, suggestedEditGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update suggested_edits, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"suggested_edits\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, suggestedEditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(suggestedEditType, suggestedEditMapping, append(wl, suggestedEditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update suggested_edits row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for suggested_edits")
+	}
+
+	if !cached {
+		suggestedEditUpdateCacheMut.Lock()
+		suggestedEditUpdateCache[key] = cache
+		suggestedEditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q suggestedEditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for suggested_edits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for suggested_edits")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SuggestedEditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), suggestedEditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"suggested_edits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, suggestedEditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in suggestedEdit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all suggestedEdit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SuggestedEdit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no suggested_edits provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(suggestedEditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	suggestedEditUpsertCacheMut.RLock()
+	cache, cached := suggestedEditUpsertCache[key]
+	suggestedEditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			suggestedEditAllColumns,
+			suggestedEditColumnsWithDefault,
+			suggestedEditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			suggestedEditAllColumns,
+			suggestedEditPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, suggestedEditGeneratedColumns)
+		update = strmangle.SetComplement(update, suggestedEditGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert suggested_edits, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(suggestedEditAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(suggestedEditPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert suggested_edits, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(suggestedEditPrimaryKeyColumns))
+			copy(conflict, suggestedEditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"suggested_edits\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(suggestedEditType, suggestedEditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(suggestedEditType, suggestedEditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert suggested_edits")
+	}
+
+	if !cached {
+		suggestedEditUpsertCacheMut.Lock()
+		suggestedEditUpsertCache[key] = cache
+		suggestedEditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SuggestedEdit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SuggestedEdit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SuggestedEdit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), suggestedEditPrimaryKeyMapping)
+	sql := "DELETE FROM \"suggested_edits\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from suggested_edits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for suggested_edits")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q suggestedEditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no suggestedEditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from suggested_edits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for suggested_edits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SuggestedEditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(suggestedEditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), suggestedEditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"suggested_edits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, suggestedEditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from suggestedEdit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for suggested_edits")
+	}
+
+	if len(suggestedEditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SuggestedEdit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSuggestedEdit(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SuggestedEditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SuggestedEditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), suggestedEditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"suggested_edits\".* FROM \"suggested_edits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, suggestedEditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SuggestedEditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SuggestedEditExists checks if the SuggestedEdit row exists.
+func SuggestedEditExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"suggested_edits\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if suggested_edits exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SuggestedEdit row exists.
+func (o *SuggestedEdit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SuggestedEditExists(ctx, exec, o.ID)
+}
@@ -647,7 +647,7 @@ func (tagL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool
 	}
 
 	query := NewQuery(
-		qm.Select("\"posts\".\"id\", \"posts\".\"creator_id\", \"posts\".\"subtopic_id\", \"posts\".\"tenant_id\", \"posts\".\"created_at\", \"posts\".\"updated_at\", \"a\".\"tag_id\""),
+		qm.Select("\"posts\".\"id\", \"posts\".\"creator_id\", \"posts\".\"subtopic_id\", \"posts\".\"tenant_id\", \"posts\".\"created_at\", \"posts\".\"updated_at\", \"posts\".\"overdue_notified_at\", \"posts\".\"title\", \"posts\".\"body\", \"posts\".\"assignee_user_id\", \"posts\".\"assignee_role_id\", \"posts\".\"assigned_at\", \"posts\".\"merged_into_id\", \"posts\".\"closed_at\", \"posts\".\"locked_at\", \"posts\".\"anonymous\", \"posts\".\"fields\", \"posts\".\"custom_fields\", \"posts\".\"wiki\", \"posts\".\"version\", \"a\".\"tag_id\""),
 		qm.From("\"posts\""),
 		qm.InnerJoin("\"post_tags\" as \"a\" on \"posts\".\"id\" = \"a\".\"post_id\""),
 		qm.WhereIn("\"a\".\"tag_id\" in ?", argsSlice...),
@@ -668,7 +668,7 @@ func (tagL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool
 		one := new(Post)
 		var localJoinCol int64
 
-		err = results.Scan(&one.ID, &one.CreatorID, &one.SubtopicID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.CreatorID, &one.SubtopicID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.OverdueNotifiedAt, &one.Title, &one.Body, &one.AssigneeUserID, &one.AssigneeRoleID, &one.AssignedAt, &one.MergedIntoID, &one.ClosedAt, &one.LockedAt, &one.Anonymous, &one.Fields, &one.CustomFields, &one.Wiki, &one.Version, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for posts")
 		}
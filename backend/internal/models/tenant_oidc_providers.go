@@ -0,0 +1,1449 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TenantOidcProvider is an object representing the database table.
+type TenantOidcProvider struct {
+	TenantID      int64             `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	Enabled       bool              `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	Issuer        string            `boil:"issuer" json:"issuer" toml:"issuer" yaml:"issuer"`
+	ClientID      string            `boil:"client_id" json:"client_id" toml:"client_id" yaml:"client_id"`
+	ClientSecret  string            `boil:"client_secret" json:"client_secret" toml:"client_secret" yaml:"client_secret"`
+	Scopes        types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
+	RoleClaim     string            `boil:"role_claim" json:"role_claim" toml:"role_claim" yaml:"role_claim"`
+	RoleMappings  types.JSON        `boil:"role_mappings" json:"role_mappings" toml:"role_mappings" yaml:"role_mappings"`
+	DefaultRoleID null.Int64        `boil:"default_role_id" json:"default_role_id,omitempty" toml:"default_role_id" yaml:"default_role_id,omitempty"`
+	CreatedAt     time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tenantOidcProviderR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tenantOidcProviderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TenantOidcProviderColumns = struct {
+	TenantID      string
+	Enabled       string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        string
+	RoleClaim     string
+	RoleMappings  string
+	DefaultRoleID string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	TenantID:      "tenant_id",
+	Enabled:       "enabled",
+	Issuer:        "issuer",
+	ClientID:      "client_id",
+	ClientSecret:  "client_secret",
+	Scopes:        "scopes",
+	RoleClaim:     "role_claim",
+	RoleMappings:  "role_mappings",
+	DefaultRoleID: "default_role_id",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var TenantOidcProviderTableColumns = struct {
+	TenantID      string
+	Enabled       string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        string
+	RoleClaim     string
+	RoleMappings  string
+	DefaultRoleID string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	TenantID:      "tenant_oidc_providers.tenant_id",
+	Enabled:       "tenant_oidc_providers.enabled",
+	Issuer:        "tenant_oidc_providers.issuer",
+	ClientID:      "tenant_oidc_providers.client_id",
+	ClientSecret:  "tenant_oidc_providers.client_secret",
+	Scopes:        "tenant_oidc_providers.scopes",
+	RoleClaim:     "tenant_oidc_providers.role_claim",
+	RoleMappings:  "tenant_oidc_providers.role_mappings",
+	DefaultRoleID: "tenant_oidc_providers.default_role_id",
+	CreatedAt:     "tenant_oidc_providers.created_at",
+	UpdatedAt:     "tenant_oidc_providers.updated_at",
+}
+
+// Generated where
+
+var TenantOidcProviderWhere = struct {
+	TenantID      whereHelperint64
+	Enabled       whereHelperbool
+	Issuer        whereHelperstring
+	ClientID      whereHelperstring
+	ClientSecret  whereHelperstring
+	Scopes        whereHelpertypes_StringArray
+	RoleClaim     whereHelperstring
+	RoleMappings  whereHelpertypes_JSON
+	DefaultRoleID whereHelpernull_Int64
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	TenantID:      whereHelperint64{field: "\"tenant_oidc_providers\".\"tenant_id\""},
+	Enabled:       whereHelperbool{field: "\"tenant_oidc_providers\".\"enabled\""},
+	Issuer:        whereHelperstring{field: "\"tenant_oidc_providers\".\"issuer\""},
+	ClientID:      whereHelperstring{field: "\"tenant_oidc_providers\".\"client_id\""},
+	ClientSecret:  whereHelperstring{field: "\"tenant_oidc_providers\".\"client_secret\""},
+	Scopes:        whereHelpertypes_StringArray{field: "\"tenant_oidc_providers\".\"scopes\""},
+	RoleClaim:     whereHelperstring{field: "\"tenant_oidc_providers\".\"role_claim\""},
+	RoleMappings:  whereHelpertypes_JSON{field: "\"tenant_oidc_providers\".\"role_mappings\""},
+	DefaultRoleID: whereHelpernull_Int64{field: "\"tenant_oidc_providers\".\"default_role_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"tenant_oidc_providers\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"tenant_oidc_providers\".\"updated_at\""},
+}
+
+// TenantOidcProviderRels is where relationship names are stored.
+var TenantOidcProviderRels = struct {
+	DefaultRole string
+	Tenant      string
+}{
+	DefaultRole: "DefaultRole",
+	Tenant:      "Tenant",
+}
+
+// tenantOidcProviderR is where relationships are stored.
+type tenantOidcProviderR struct {
+	DefaultRole *Role   `boil:"DefaultRole" json:"DefaultRole" toml:"DefaultRole" yaml:"DefaultRole"`
+	Tenant      *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tenantOidcProviderR) NewStruct() *tenantOidcProviderR {
+	return &tenantOidcProviderR{}
+}
+
+func (o *TenantOidcProvider) GetDefaultRole() *Role {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDefaultRole()
+}
+
+func (r *tenantOidcProviderR) GetDefaultRole() *Role {
+	if r == nil {
+		return nil
+	}
+
+	return r.DefaultRole
+}
+
+func (o *TenantOidcProvider) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *tenantOidcProviderR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// tenantOidcProviderL is where Load methods for each relationship are stored.
+type tenantOidcProviderL struct{}
+
+var (
+	tenantOidcProviderAllColumns            = []string{"tenant_id", "enabled", "issuer", "client_id", "client_secret", "scopes", "role_claim", "role_mappings", "default_role_id", "created_at", "updated_at"}
+	tenantOidcProviderColumnsWithoutDefault = []string{"tenant_id", "issuer", "client_id", "client_secret"}
+	tenantOidcProviderColumnsWithDefault    = []string{"enabled", "scopes", "role_claim", "role_mappings", "default_role_id", "created_at", "updated_at"}
+	tenantOidcProviderPrimaryKeyColumns     = []string{"tenant_id"}
+	tenantOidcProviderGeneratedColumns      = []string{}
+)
+
+type (
+	// TenantOidcProviderSlice is an alias for a slice of pointers to TenantOidcProvider.
+	// This should almost always be used instead of []TenantOidcProvider.
+	TenantOidcProviderSlice []*TenantOidcProvider
+	// TenantOidcProviderHook is the signature for custom TenantOidcProvider hook methods
+	TenantOidcProviderHook func(context.Context, boil.ContextExecutor, *TenantOidcProvider) error
+
+	tenantOidcProviderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tenantOidcProviderType                 = reflect.TypeOf(&TenantOidcProvider{})
+	tenantOidcProviderMapping              = queries.MakeStructMapping(tenantOidcProviderType)
+	tenantOidcProviderPrimaryKeyMapping, _ = queries.BindMapping(tenantOidcProviderType, tenantOidcProviderMapping, tenantOidcProviderPrimaryKeyColumns)
+	tenantOidcProviderInsertCacheMut       sync.RWMutex
+	tenantOidcProviderInsertCache          = make(map[string]insertCache)
+	tenantOidcProviderUpdateCacheMut       sync.RWMutex
+	tenantOidcProviderUpdateCache          = make(map[string]updateCache)
+	tenantOidcProviderUpsertCacheMut       sync.RWMutex
+	tenantOidcProviderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tenantOidcProviderAfterSelectMu sync.Mutex
+var tenantOidcProviderAfterSelectHooks []TenantOidcProviderHook
+
+var tenantOidcProviderBeforeInsertMu sync.Mutex
+var tenantOidcProviderBeforeInsertHooks []TenantOidcProviderHook
+var tenantOidcProviderAfterInsertMu sync.Mutex
+var tenantOidcProviderAfterInsertHooks []TenantOidcProviderHook
+
+var tenantOidcProviderBeforeUpdateMu sync.Mutex
+var tenantOidcProviderBeforeUpdateHooks []TenantOidcProviderHook
+var tenantOidcProviderAfterUpdateMu sync.Mutex
+var tenantOidcProviderAfterUpdateHooks []TenantOidcProviderHook
+
+var tenantOidcProviderBeforeDeleteMu sync.Mutex
+var tenantOidcProviderBeforeDeleteHooks []TenantOidcProviderHook
+var tenantOidcProviderAfterDeleteMu sync.Mutex
+var tenantOidcProviderAfterDeleteHooks []TenantOidcProviderHook
+
+var tenantOidcProviderBeforeUpsertMu sync.Mutex
+var tenantOidcProviderBeforeUpsertHooks []TenantOidcProviderHook
+var tenantOidcProviderAfterUpsertMu sync.Mutex
+var tenantOidcProviderAfterUpsertHooks []TenantOidcProviderHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TenantOidcProvider) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TenantOidcProvider) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TenantOidcProvider) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TenantOidcProvider) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TenantOidcProvider) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TenantOidcProvider) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TenantOidcProvider) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TenantOidcProvider) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TenantOidcProvider) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantOidcProviderAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTenantOidcProviderHook registers your hook function for all future operations.
+func AddTenantOidcProviderHook(hookPoint boil.HookPoint, tenantOidcProviderHook TenantOidcProviderHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tenantOidcProviderAfterSelectMu.Lock()
+		tenantOidcProviderAfterSelectHooks = append(tenantOidcProviderAfterSelectHooks, tenantOidcProviderHook)
+		tenantOidcProviderAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tenantOidcProviderBeforeInsertMu.Lock()
+		tenantOidcProviderBeforeInsertHooks = append(tenantOidcProviderBeforeInsertHooks, tenantOidcProviderHook)
+		tenantOidcProviderBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tenantOidcProviderAfterInsertMu.Lock()
+		tenantOidcProviderAfterInsertHooks = append(tenantOidcProviderAfterInsertHooks, tenantOidcProviderHook)
+		tenantOidcProviderAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tenantOidcProviderBeforeUpdateMu.Lock()
+		tenantOidcProviderBeforeUpdateHooks = append(tenantOidcProviderBeforeUpdateHooks, tenantOidcProviderHook)
+		tenantOidcProviderBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tenantOidcProviderAfterUpdateMu.Lock()
+		tenantOidcProviderAfterUpdateHooks = append(tenantOidcProviderAfterUpdateHooks, tenantOidcProviderHook)
+		tenantOidcProviderAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tenantOidcProviderBeforeDeleteMu.Lock()
+		tenantOidcProviderBeforeDeleteHooks = append(tenantOidcProviderBeforeDeleteHooks, tenantOidcProviderHook)
+		tenantOidcProviderBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tenantOidcProviderAfterDeleteMu.Lock()
+		tenantOidcProviderAfterDeleteHooks = append(tenantOidcProviderAfterDeleteHooks, tenantOidcProviderHook)
+		tenantOidcProviderAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tenantOidcProviderBeforeUpsertMu.Lock()
+		tenantOidcProviderBeforeUpsertHooks = append(tenantOidcProviderBeforeUpsertHooks, tenantOidcProviderHook)
+		tenantOidcProviderBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tenantOidcProviderAfterUpsertMu.Lock()
+		tenantOidcProviderAfterUpsertHooks = append(tenantOidcProviderAfterUpsertHooks, tenantOidcProviderHook)
+		tenantOidcProviderAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tenantOidcProvider record from the query.
+func (q tenantOidcProviderQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TenantOidcProvider, error) {
+	o := &TenantOidcProvider{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for tenant_oidc_providers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TenantOidcProvider records from the query.
+func (q tenantOidcProviderQuery) All(ctx context.Context, exec boil.ContextExecutor) (TenantOidcProviderSlice, error) {
+	var o []*TenantOidcProvider
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TenantOidcProvider slice")
+	}
+
+	if len(tenantOidcProviderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TenantOidcProvider records in the query.
+func (q tenantOidcProviderQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count tenant_oidc_providers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tenantOidcProviderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if tenant_oidc_providers exists")
+	}
+
+	return count > 0, nil
+}
+
+// DefaultRole pointed to by the foreign key.
+func (o *TenantOidcProvider) DefaultRole(mods ...qm.QueryMod) roleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DefaultRoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Roles(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *TenantOidcProvider) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadDefaultRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tenantOidcProviderL) LoadDefaultRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenantOidcProvider interface{}, mods queries.Applicator) error {
+	var slice []*TenantOidcProvider
+	var object *TenantOidcProvider
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenantOidcProvider.(*TenantOidcProvider)
+		if !ok {
+			object = new(TenantOidcProvider)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenantOidcProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenantOidcProvider))
+			}
+		}
+	} else {
+		s, ok := maybeTenantOidcProvider.(*[]*TenantOidcProvider)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenantOidcProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenantOidcProvider))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantOidcProviderR{}
+		}
+		if !queries.IsNil(object.DefaultRoleID) {
+			args[object.DefaultRoleID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantOidcProviderR{}
+			}
+
+			if !queries.IsNil(obj.DefaultRoleID) {
+				args[obj.DefaultRoleID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Role")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DefaultRole = foreign
+		if foreign.R == nil {
+			foreign.R = &roleR{}
+		}
+		foreign.R.DefaultRoleTenantOidcProviders = append(foreign.R.DefaultRoleTenantOidcProviders, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DefaultRoleID, foreign.ID) {
+				local.R.DefaultRole = foreign
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.DefaultRoleTenantOidcProviders = append(foreign.R.DefaultRoleTenantOidcProviders, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tenantOidcProviderL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenantOidcProvider interface{}, mods queries.Applicator) error {
+	var slice []*TenantOidcProvider
+	var object *TenantOidcProvider
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenantOidcProvider.(*TenantOidcProvider)
+		if !ok {
+			object = new(TenantOidcProvider)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenantOidcProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenantOidcProvider))
+			}
+		}
+	} else {
+		s, ok := maybeTenantOidcProvider.(*[]*TenantOidcProvider)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenantOidcProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenantOidcProvider))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantOidcProviderR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantOidcProviderR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.TenantOidcProviders = append(foreign.R.TenantOidcProviders, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.TenantOidcProviders = append(foreign.R.TenantOidcProviders, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDefaultRole of the tenantOidcProvider to the related item.
+// Sets o.R.DefaultRole to related.
+// Adds o to related.R.DefaultRoleTenantOidcProviders.
+func (o *TenantOidcProvider) SetDefaultRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tenant_oidc_providers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"default_role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, tenantOidcProviderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TenantID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DefaultRoleID, related.ID)
+	if o.R == nil {
+		o.R = &tenantOidcProviderR{
+			DefaultRole: related,
+		}
+	} else {
+		o.R.DefaultRole = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			DefaultRoleTenantOidcProviders: TenantOidcProviderSlice{o},
+		}
+	} else {
+		related.R.DefaultRoleTenantOidcProviders = append(related.R.DefaultRoleTenantOidcProviders, o)
+	}
+
+	return nil
+}
+
+// RemoveDefaultRole relationship.
+// Sets o.R.DefaultRole to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TenantOidcProvider) RemoveDefaultRole(ctx context.Context, exec boil.ContextExecutor, related *Role) error {
+	var err error
+
+	queries.SetScanner(&o.DefaultRoleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("default_role_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DefaultRole = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DefaultRoleTenantOidcProviders {
+		if queries.Equal(o.DefaultRoleID, ri.DefaultRoleID) {
+			continue
+		}
+
+		ln := len(related.R.DefaultRoleTenantOidcProviders)
+		if ln > 1 && i < ln-1 {
+			related.R.DefaultRoleTenantOidcProviders[i] = related.R.DefaultRoleTenantOidcProviders[ln-1]
+		}
+		related.R.DefaultRoleTenantOidcProviders = related.R.DefaultRoleTenantOidcProviders[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenant of the tenantOidcProvider to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.TenantOidcProviders.
+func (o *TenantOidcProvider) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tenant_oidc_providers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, tenantOidcProviderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TenantID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &tenantOidcProviderR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			TenantOidcProviders: TenantOidcProviderSlice{o},
+		}
+	} else {
+		related.R.TenantOidcProviders = append(related.R.TenantOidcProviders, o)
+	}
+
+	return nil
+}
+
+// TenantOidcProviders retrieves all the records using an executor.
+func TenantOidcProviders(mods ...qm.QueryMod) tenantOidcProviderQuery {
+	mods = append(mods, qm.From("\"tenant_oidc_providers\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"tenant_oidc_providers\".*"})
+	}
+
+	return tenantOidcProviderQuery{q}
+}
+
+// FindTenantOidcProvider retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTenantOidcProvider(ctx context.Context, exec boil.ContextExecutor, tenantID int64, selectCols ...string) (*TenantOidcProvider, error) {
+	tenantOidcProviderObj := &TenantOidcProvider{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"tenant_oidc_providers\" where \"tenant_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, tenantID)
+
+	err := q.Bind(ctx, exec, tenantOidcProviderObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from tenant_oidc_providers")
+	}
+
+	if err = tenantOidcProviderObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tenantOidcProviderObj, err
+	}
+
+	return tenantOidcProviderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TenantOidcProvider) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no tenant_oidc_providers provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tenantOidcProviderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tenantOidcProviderInsertCacheMut.RLock()
+	cache, cached := tenantOidcProviderInsertCache[key]
+	tenantOidcProviderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tenantOidcProviderAllColumns,
+			tenantOidcProviderColumnsWithDefault,
+			tenantOidcProviderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tenantOidcProviderType, tenantOidcProviderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tenantOidcProviderType, tenantOidcProviderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"tenant_oidc_providers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"tenant_oidc_providers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into tenant_oidc_providers")
+	}
+
+	if !cached {
+		tenantOidcProviderInsertCacheMut.Lock()
+		tenantOidcProviderInsertCache[key] = cache
+		tenantOidcProviderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TenantOidcProvider.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TenantOidcProvider) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tenantOidcProviderUpdateCacheMut.RLock()
+	cache, cached := tenantOidcProviderUpdateCache[key]
+	tenantOidcProviderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tenantOidcProviderAllColumns,
+			tenantOidcProviderPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update tenant_oidc_providers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"tenant_oidc_providers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, tenantOidcProviderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tenantOidcProviderType, tenantOidcProviderMapping, append(wl, tenantOidcProviderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update tenant_oidc_providers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for tenant_oidc_providers")
+	}
+
+	if !cached {
+		tenantOidcProviderUpdateCacheMut.Lock()
+		tenantOidcProviderUpdateCache[key] = cache
+		tenantOidcProviderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tenantOidcProviderQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for tenant_oidc_providers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for tenant_oidc_providers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TenantOidcProviderSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tenantOidcProviderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"tenant_oidc_providers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, tenantOidcProviderPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in tenantOidcProvider slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all tenantOidcProvider")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TenantOidcProvider) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no tenant_oidc_providers provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tenantOidcProviderColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tenantOidcProviderUpsertCacheMut.RLock()
+	cache, cached := tenantOidcProviderUpsertCache[key]
+	tenantOidcProviderUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tenantOidcProviderAllColumns,
+			tenantOidcProviderColumnsWithDefault,
+			tenantOidcProviderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tenantOidcProviderAllColumns,
+			tenantOidcProviderPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert tenant_oidc_providers, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tenantOidcProviderAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(tenantOidcProviderPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert tenant_oidc_providers, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(tenantOidcProviderPrimaryKeyColumns))
+			copy(conflict, tenantOidcProviderPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"tenant_oidc_providers\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(tenantOidcProviderType, tenantOidcProviderMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tenantOidcProviderType, tenantOidcProviderMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert tenant_oidc_providers")
+	}
+
+	if !cached {
+		tenantOidcProviderUpsertCacheMut.Lock()
+		tenantOidcProviderUpsertCache[key] = cache
+		tenantOidcProviderUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TenantOidcProvider record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TenantOidcProvider) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TenantOidcProvider provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tenantOidcProviderPrimaryKeyMapping)
+	sql := "DELETE FROM \"tenant_oidc_providers\" WHERE \"tenant_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from tenant_oidc_providers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for tenant_oidc_providers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tenantOidcProviderQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no tenantOidcProviderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tenant_oidc_providers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for tenant_oidc_providers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TenantOidcProviderSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tenantOidcProviderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tenantOidcProviderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"tenant_oidc_providers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tenantOidcProviderPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tenantOidcProvider slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for tenant_oidc_providers")
+	}
+
+	if len(tenantOidcProviderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TenantOidcProvider) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTenantOidcProvider(ctx, exec, o.TenantID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TenantOidcProviderSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TenantOidcProviderSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tenantOidcProviderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"tenant_oidc_providers\".* FROM \"tenant_oidc_providers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tenantOidcProviderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TenantOidcProviderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TenantOidcProviderExists checks if the TenantOidcProvider row exists.
+func TenantOidcProviderExists(ctx context.Context, exec boil.ContextExecutor, tenantID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"tenant_oidc_providers\" where \"tenant_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tenantID)
+	}
+	row := exec.QueryRowContext(ctx, sql, tenantID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if tenant_oidc_providers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TenantOidcProvider row exists.
+func (o *TenantOidcProvider) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TenantOidcProviderExists(ctx, exec, o.TenantID)
+}
@@ -0,0 +1,1470 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TenantSamlProvider is an object representing the database table.
+type TenantSamlProvider struct {
+	TenantID          int64      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	Enabled           bool       `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	IdpEntityID       string     `boil:"idp_entity_id" json:"idp_entity_id" toml:"idp_entity_id" yaml:"idp_entity_id"`
+	IdpSsoURL         string     `boil:"idp_sso_url" json:"idp_sso_url" toml:"idp_sso_url" yaml:"idp_sso_url"`
+	IdpCertificate    string     `boil:"idp_certificate" json:"idp_certificate" toml:"idp_certificate" yaml:"idp_certificate"`
+	EmailAttribute    string     `boil:"email_attribute" json:"email_attribute" toml:"email_attribute" yaml:"email_attribute"`
+	NameAttribute     string     `boil:"name_attribute" json:"name_attribute" toml:"name_attribute" yaml:"name_attribute"`
+	UsernameAttribute string     `boil:"username_attribute" json:"username_attribute" toml:"username_attribute" yaml:"username_attribute"`
+	RoleAttribute     string     `boil:"role_attribute" json:"role_attribute" toml:"role_attribute" yaml:"role_attribute"`
+	RoleMappings      types.JSON `boil:"role_mappings" json:"role_mappings" toml:"role_mappings" yaml:"role_mappings"`
+	DefaultRoleID     null.Int64 `boil:"default_role_id" json:"default_role_id,omitempty" toml:"default_role_id" yaml:"default_role_id,omitempty"`
+	CreatedAt         time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	LinkExistingUsers bool       `boil:"link_existing_users" json:"link_existing_users" toml:"link_existing_users" yaml:"link_existing_users"`
+
+	R *tenantSamlProviderR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tenantSamlProviderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TenantSamlProviderColumns = struct {
+	TenantID          string
+	Enabled           string
+	IdpEntityID       string
+	IdpSsoURL         string
+	IdpCertificate    string
+	EmailAttribute    string
+	NameAttribute     string
+	UsernameAttribute string
+	RoleAttribute     string
+	RoleMappings      string
+	DefaultRoleID     string
+	CreatedAt         string
+	UpdatedAt         string
+	LinkExistingUsers string
+}{
+	TenantID:          "tenant_id",
+	Enabled:           "enabled",
+	IdpEntityID:       "idp_entity_id",
+	IdpSsoURL:         "idp_sso_url",
+	IdpCertificate:    "idp_certificate",
+	EmailAttribute:    "email_attribute",
+	NameAttribute:     "name_attribute",
+	UsernameAttribute: "username_attribute",
+	RoleAttribute:     "role_attribute",
+	RoleMappings:      "role_mappings",
+	DefaultRoleID:     "default_role_id",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	LinkExistingUsers: "link_existing_users",
+}
+
+var TenantSamlProviderTableColumns = struct {
+	TenantID          string
+	Enabled           string
+	IdpEntityID       string
+	IdpSsoURL         string
+	IdpCertificate    string
+	EmailAttribute    string
+	NameAttribute     string
+	UsernameAttribute string
+	RoleAttribute     string
+	RoleMappings      string
+	DefaultRoleID     string
+	CreatedAt         string
+	UpdatedAt         string
+	LinkExistingUsers string
+}{
+	TenantID:          "tenant_saml_providers.tenant_id",
+	Enabled:           "tenant_saml_providers.enabled",
+	IdpEntityID:       "tenant_saml_providers.idp_entity_id",
+	IdpSsoURL:         "tenant_saml_providers.idp_sso_url",
+	IdpCertificate:    "tenant_saml_providers.idp_certificate",
+	EmailAttribute:    "tenant_saml_providers.email_attribute",
+	NameAttribute:     "tenant_saml_providers.name_attribute",
+	UsernameAttribute: "tenant_saml_providers.username_attribute",
+	RoleAttribute:     "tenant_saml_providers.role_attribute",
+	RoleMappings:      "tenant_saml_providers.role_mappings",
+	DefaultRoleID:     "tenant_saml_providers.default_role_id",
+	CreatedAt:         "tenant_saml_providers.created_at",
+	UpdatedAt:         "tenant_saml_providers.updated_at",
+	LinkExistingUsers: "tenant_saml_providers.link_existing_users",
+}
+
+// Generated where
+
+var TenantSamlProviderWhere = struct {
+	TenantID          whereHelperint64
+	Enabled           whereHelperbool
+	IdpEntityID       whereHelperstring
+	IdpSsoURL         whereHelperstring
+	IdpCertificate    whereHelperstring
+	EmailAttribute    whereHelperstring
+	NameAttribute     whereHelperstring
+	UsernameAttribute whereHelperstring
+	RoleAttribute     whereHelperstring
+	RoleMappings      whereHelpertypes_JSON
+	DefaultRoleID     whereHelpernull_Int64
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	LinkExistingUsers whereHelperbool
+}{
+	TenantID:          whereHelperint64{field: "\"tenant_saml_providers\".\"tenant_id\""},
+	Enabled:           whereHelperbool{field: "\"tenant_saml_providers\".\"enabled\""},
+	IdpEntityID:       whereHelperstring{field: "\"tenant_saml_providers\".\"idp_entity_id\""},
+	IdpSsoURL:         whereHelperstring{field: "\"tenant_saml_providers\".\"idp_sso_url\""},
+	IdpCertificate:    whereHelperstring{field: "\"tenant_saml_providers\".\"idp_certificate\""},
+	EmailAttribute:    whereHelperstring{field: "\"tenant_saml_providers\".\"email_attribute\""},
+	NameAttribute:     whereHelperstring{field: "\"tenant_saml_providers\".\"name_attribute\""},
+	UsernameAttribute: whereHelperstring{field: "\"tenant_saml_providers\".\"username_attribute\""},
+	RoleAttribute:     whereHelperstring{field: "\"tenant_saml_providers\".\"role_attribute\""},
+	RoleMappings:      whereHelpertypes_JSON{field: "\"tenant_saml_providers\".\"role_mappings\""},
+	DefaultRoleID:     whereHelpernull_Int64{field: "\"tenant_saml_providers\".\"default_role_id\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"tenant_saml_providers\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"tenant_saml_providers\".\"updated_at\""},
+	LinkExistingUsers: whereHelperbool{field: "\"tenant_saml_providers\".\"link_existing_users\""},
+}
+
+// TenantSamlProviderRels is where relationship names are stored.
+var TenantSamlProviderRels = struct {
+	DefaultRole string
+	Tenant      string
+}{
+	DefaultRole: "DefaultRole",
+	Tenant:      "Tenant",
+}
+
+// tenantSamlProviderR is where relationships are stored.
+type tenantSamlProviderR struct {
+	DefaultRole *Role   `boil:"DefaultRole" json:"DefaultRole" toml:"DefaultRole" yaml:"DefaultRole"`
+	Tenant      *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tenantSamlProviderR) NewStruct() *tenantSamlProviderR {
+	return &tenantSamlProviderR{}
+}
+
+func (o *TenantSamlProvider) GetDefaultRole() *Role {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDefaultRole()
+}
+
+func (r *tenantSamlProviderR) GetDefaultRole() *Role {
+	if r == nil {
+		return nil
+	}
+
+	return r.DefaultRole
+}
+
+func (o *TenantSamlProvider) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *tenantSamlProviderR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+// tenantSamlProviderL is where Load methods for each relationship are stored.
+type tenantSamlProviderL struct{}
+
+var (
+	tenantSamlProviderAllColumns            = []string{"tenant_id", "enabled", "idp_entity_id", "idp_sso_url", "idp_certificate", "email_attribute", "name_attribute", "username_attribute", "role_attribute", "role_mappings", "default_role_id", "created_at", "updated_at", "link_existing_users"}
+	tenantSamlProviderColumnsWithoutDefault = []string{"tenant_id", "idp_entity_id", "idp_sso_url", "idp_certificate"}
+	tenantSamlProviderColumnsWithDefault    = []string{"enabled", "email_attribute", "name_attribute", "username_attribute", "role_attribute", "role_mappings", "default_role_id", "created_at", "updated_at", "link_existing_users"}
+	tenantSamlProviderPrimaryKeyColumns     = []string{"tenant_id"}
+	tenantSamlProviderGeneratedColumns      = []string{}
+)
+
+type (
+	// TenantSamlProviderSlice is an alias for a slice of pointers to TenantSamlProvider.
+	// This should almost always be used instead of []TenantSamlProvider.
+	TenantSamlProviderSlice []*TenantSamlProvider
+	// TenantSamlProviderHook is the signature for custom TenantSamlProvider hook methods
+	TenantSamlProviderHook func(context.Context, boil.ContextExecutor, *TenantSamlProvider) error
+
+	tenantSamlProviderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tenantSamlProviderType                 = reflect.TypeOf(&TenantSamlProvider{})
+	tenantSamlProviderMapping              = queries.MakeStructMapping(tenantSamlProviderType)
+	tenantSamlProviderPrimaryKeyMapping, _ = queries.BindMapping(tenantSamlProviderType, tenantSamlProviderMapping, tenantSamlProviderPrimaryKeyColumns)
+	tenantSamlProviderInsertCacheMut       sync.RWMutex
+	tenantSamlProviderInsertCache          = make(map[string]insertCache)
+	tenantSamlProviderUpdateCacheMut       sync.RWMutex
+	tenantSamlProviderUpdateCache          = make(map[string]updateCache)
+	tenantSamlProviderUpsertCacheMut       sync.RWMutex
+	tenantSamlProviderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tenantSamlProviderAfterSelectMu sync.Mutex
+var tenantSamlProviderAfterSelectHooks []TenantSamlProviderHook
+
+var tenantSamlProviderBeforeInsertMu sync.Mutex
+var tenantSamlProviderBeforeInsertHooks []TenantSamlProviderHook
+var tenantSamlProviderAfterInsertMu sync.Mutex
+var tenantSamlProviderAfterInsertHooks []TenantSamlProviderHook
+
+var tenantSamlProviderBeforeUpdateMu sync.Mutex
+var tenantSamlProviderBeforeUpdateHooks []TenantSamlProviderHook
+var tenantSamlProviderAfterUpdateMu sync.Mutex
+var tenantSamlProviderAfterUpdateHooks []TenantSamlProviderHook
+
+var tenantSamlProviderBeforeDeleteMu sync.Mutex
+var tenantSamlProviderBeforeDeleteHooks []TenantSamlProviderHook
+var tenantSamlProviderAfterDeleteMu sync.Mutex
+var tenantSamlProviderAfterDeleteHooks []TenantSamlProviderHook
+
+var tenantSamlProviderBeforeUpsertMu sync.Mutex
+var tenantSamlProviderBeforeUpsertHooks []TenantSamlProviderHook
+var tenantSamlProviderAfterUpsertMu sync.Mutex
+var tenantSamlProviderAfterUpsertHooks []TenantSamlProviderHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TenantSamlProvider) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TenantSamlProvider) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TenantSamlProvider) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TenantSamlProvider) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TenantSamlProvider) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TenantSamlProvider) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TenantSamlProvider) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TenantSamlProvider) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TenantSamlProvider) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tenantSamlProviderAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTenantSamlProviderHook registers your hook function for all future operations.
+func AddTenantSamlProviderHook(hookPoint boil.HookPoint, tenantSamlProviderHook TenantSamlProviderHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tenantSamlProviderAfterSelectMu.Lock()
+		tenantSamlProviderAfterSelectHooks = append(tenantSamlProviderAfterSelectHooks, tenantSamlProviderHook)
+		tenantSamlProviderAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tenantSamlProviderBeforeInsertMu.Lock()
+		tenantSamlProviderBeforeInsertHooks = append(tenantSamlProviderBeforeInsertHooks, tenantSamlProviderHook)
+		tenantSamlProviderBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tenantSamlProviderAfterInsertMu.Lock()
+		tenantSamlProviderAfterInsertHooks = append(tenantSamlProviderAfterInsertHooks, tenantSamlProviderHook)
+		tenantSamlProviderAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tenantSamlProviderBeforeUpdateMu.Lock()
+		tenantSamlProviderBeforeUpdateHooks = append(tenantSamlProviderBeforeUpdateHooks, tenantSamlProviderHook)
+		tenantSamlProviderBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tenantSamlProviderAfterUpdateMu.Lock()
+		tenantSamlProviderAfterUpdateHooks = append(tenantSamlProviderAfterUpdateHooks, tenantSamlProviderHook)
+		tenantSamlProviderAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tenantSamlProviderBeforeDeleteMu.Lock()
+		tenantSamlProviderBeforeDeleteHooks = append(tenantSamlProviderBeforeDeleteHooks, tenantSamlProviderHook)
+		tenantSamlProviderBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tenantSamlProviderAfterDeleteMu.Lock()
+		tenantSamlProviderAfterDeleteHooks = append(tenantSamlProviderAfterDeleteHooks, tenantSamlProviderHook)
+		tenantSamlProviderAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tenantSamlProviderBeforeUpsertMu.Lock()
+		tenantSamlProviderBeforeUpsertHooks = append(tenantSamlProviderBeforeUpsertHooks, tenantSamlProviderHook)
+		tenantSamlProviderBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tenantSamlProviderAfterUpsertMu.Lock()
+		tenantSamlProviderAfterUpsertHooks = append(tenantSamlProviderAfterUpsertHooks, tenantSamlProviderHook)
+		tenantSamlProviderAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tenantSamlProvider record from the query.
+func (q tenantSamlProviderQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TenantSamlProvider, error) {
+	o := &TenantSamlProvider{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for tenant_saml_providers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TenantSamlProvider records from the query.
+func (q tenantSamlProviderQuery) All(ctx context.Context, exec boil.ContextExecutor) (TenantSamlProviderSlice, error) {
+	var o []*TenantSamlProvider
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TenantSamlProvider slice")
+	}
+
+	if len(tenantSamlProviderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TenantSamlProvider records in the query.
+func (q tenantSamlProviderQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count tenant_saml_providers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tenantSamlProviderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if tenant_saml_providers exists")
+	}
+
+	return count > 0, nil
+}
+
+// DefaultRole pointed to by the foreign key.
+func (o *TenantSamlProvider) DefaultRole(mods ...qm.QueryMod) roleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DefaultRoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Roles(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *TenantSamlProvider) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// LoadDefaultRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tenantSamlProviderL) LoadDefaultRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenantSamlProvider interface{}, mods queries.Applicator) error {
+	var slice []*TenantSamlProvider
+	var object *TenantSamlProvider
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenantSamlProvider.(*TenantSamlProvider)
+		if !ok {
+			object = new(TenantSamlProvider)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenantSamlProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenantSamlProvider))
+			}
+		}
+	} else {
+		s, ok := maybeTenantSamlProvider.(*[]*TenantSamlProvider)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenantSamlProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenantSamlProvider))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantSamlProviderR{}
+		}
+		if !queries.IsNil(object.DefaultRoleID) {
+			args[object.DefaultRoleID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantSamlProviderR{}
+			}
+
+			if !queries.IsNil(obj.DefaultRoleID) {
+				args[obj.DefaultRoleID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Role")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DefaultRole = foreign
+		if foreign.R == nil {
+			foreign.R = &roleR{}
+		}
+		foreign.R.DefaultRoleTenantSamlProviders = append(foreign.R.DefaultRoleTenantSamlProviders, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DefaultRoleID, foreign.ID) {
+				local.R.DefaultRole = foreign
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.DefaultRoleTenantSamlProviders = append(foreign.R.DefaultRoleTenantSamlProviders, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tenantSamlProviderL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenantSamlProvider interface{}, mods queries.Applicator) error {
+	var slice []*TenantSamlProvider
+	var object *TenantSamlProvider
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenantSamlProvider.(*TenantSamlProvider)
+		if !ok {
+			object = new(TenantSamlProvider)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenantSamlProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenantSamlProvider))
+			}
+		}
+	} else {
+		s, ok := maybeTenantSamlProvider.(*[]*TenantSamlProvider)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenantSamlProvider)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenantSamlProvider))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantSamlProviderR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantSamlProviderR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.TenantSamlProviders = append(foreign.R.TenantSamlProviders, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.TenantSamlProviders = append(foreign.R.TenantSamlProviders, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDefaultRole of the tenantSamlProvider to the related item.
+// Sets o.R.DefaultRole to related.
+// Adds o to related.R.DefaultRoleTenantSamlProviders.
+func (o *TenantSamlProvider) SetDefaultRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tenant_saml_providers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"default_role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, tenantSamlProviderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TenantID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DefaultRoleID, related.ID)
+	if o.R == nil {
+		o.R = &tenantSamlProviderR{
+			DefaultRole: related,
+		}
+	} else {
+		o.R.DefaultRole = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			DefaultRoleTenantSamlProviders: TenantSamlProviderSlice{o},
+		}
+	} else {
+		related.R.DefaultRoleTenantSamlProviders = append(related.R.DefaultRoleTenantSamlProviders, o)
+	}
+
+	return nil
+}
+
+// RemoveDefaultRole relationship.
+// Sets o.R.DefaultRole to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TenantSamlProvider) RemoveDefaultRole(ctx context.Context, exec boil.ContextExecutor, related *Role) error {
+	var err error
+
+	queries.SetScanner(&o.DefaultRoleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("default_role_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DefaultRole = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DefaultRoleTenantSamlProviders {
+		if queries.Equal(o.DefaultRoleID, ri.DefaultRoleID) {
+			continue
+		}
+
+		ln := len(related.R.DefaultRoleTenantSamlProviders)
+		if ln > 1 && i < ln-1 {
+			related.R.DefaultRoleTenantSamlProviders[i] = related.R.DefaultRoleTenantSamlProviders[ln-1]
+		}
+		related.R.DefaultRoleTenantSamlProviders = related.R.DefaultRoleTenantSamlProviders[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenant of the tenantSamlProvider to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.TenantSamlProviders.
+func (o *TenantSamlProvider) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tenant_saml_providers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, tenantSamlProviderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TenantID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &tenantSamlProviderR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			TenantSamlProviders: TenantSamlProviderSlice{o},
+		}
+	} else {
+		related.R.TenantSamlProviders = append(related.R.TenantSamlProviders, o)
+	}
+
+	return nil
+}
+
+// TenantSamlProviders retrieves all the records using an executor.
+func TenantSamlProviders(mods ...qm.QueryMod) tenantSamlProviderQuery {
+	mods = append(mods, qm.From("\"tenant_saml_providers\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"tenant_saml_providers\".*"})
+	}
+
+	return tenantSamlProviderQuery{q}
+}
+
+// FindTenantSamlProvider retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTenantSamlProvider(ctx context.Context, exec boil.ContextExecutor, tenantID int64, selectCols ...string) (*TenantSamlProvider, error) {
+	tenantSamlProviderObj := &TenantSamlProvider{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"tenant_saml_providers\" where \"tenant_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, tenantID)
+
+	err := q.Bind(ctx, exec, tenantSamlProviderObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from tenant_saml_providers")
+	}
+
+	if err = tenantSamlProviderObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tenantSamlProviderObj, err
+	}
+
+	return tenantSamlProviderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TenantSamlProvider) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no tenant_saml_providers provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tenantSamlProviderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tenantSamlProviderInsertCacheMut.RLock()
+	cache, cached := tenantSamlProviderInsertCache[key]
+	tenantSamlProviderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tenantSamlProviderAllColumns,
+			tenantSamlProviderColumnsWithDefault,
+			tenantSamlProviderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tenantSamlProviderType, tenantSamlProviderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tenantSamlProviderType, tenantSamlProviderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"tenant_saml_providers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"tenant_saml_providers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into tenant_saml_providers")
+	}
+
+	if !cached {
+		tenantSamlProviderInsertCacheMut.Lock()
+		tenantSamlProviderInsertCache[key] = cache
+		tenantSamlProviderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TenantSamlProvider.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TenantSamlProvider) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tenantSamlProviderUpdateCacheMut.RLock()
+	cache, cached := tenantSamlProviderUpdateCache[key]
+	tenantSamlProviderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tenantSamlProviderAllColumns,
+			tenantSamlProviderPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update tenant_saml_providers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"tenant_saml_providers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, tenantSamlProviderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tenantSamlProviderType, tenantSamlProviderMapping, append(wl, tenantSamlProviderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update tenant_saml_providers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for tenant_saml_providers")
+	}
+
+	if !cached {
+		tenantSamlProviderUpdateCacheMut.Lock()
+		tenantSamlProviderUpdateCache[key] = cache
+		tenantSamlProviderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tenantSamlProviderQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for tenant_saml_providers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for tenant_saml_providers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TenantSamlProviderSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tenantSamlProviderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"tenant_saml_providers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, tenantSamlProviderPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in tenantSamlProvider slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all tenantSamlProvider")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TenantSamlProvider) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no tenant_saml_providers provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tenantSamlProviderColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tenantSamlProviderUpsertCacheMut.RLock()
+	cache, cached := tenantSamlProviderUpsertCache[key]
+	tenantSamlProviderUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tenantSamlProviderAllColumns,
+			tenantSamlProviderColumnsWithDefault,
+			tenantSamlProviderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tenantSamlProviderAllColumns,
+			tenantSamlProviderPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert tenant_saml_providers, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tenantSamlProviderAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(tenantSamlProviderPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert tenant_saml_providers, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(tenantSamlProviderPrimaryKeyColumns))
+			copy(conflict, tenantSamlProviderPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"tenant_saml_providers\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(tenantSamlProviderType, tenantSamlProviderMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tenantSamlProviderType, tenantSamlProviderMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert tenant_saml_providers")
+	}
+
+	if !cached {
+		tenantSamlProviderUpsertCacheMut.Lock()
+		tenantSamlProviderUpsertCache[key] = cache
+		tenantSamlProviderUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TenantSamlProvider record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TenantSamlProvider) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TenantSamlProvider provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tenantSamlProviderPrimaryKeyMapping)
+	sql := "DELETE FROM \"tenant_saml_providers\" WHERE \"tenant_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from tenant_saml_providers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for tenant_saml_providers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tenantSamlProviderQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no tenantSamlProviderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tenant_saml_providers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for tenant_saml_providers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TenantSamlProviderSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tenantSamlProviderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tenantSamlProviderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"tenant_saml_providers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tenantSamlProviderPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tenantSamlProvider slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for tenant_saml_providers")
+	}
+
+	if len(tenantSamlProviderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TenantSamlProvider) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTenantSamlProvider(ctx, exec, o.TenantID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TenantSamlProviderSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TenantSamlProviderSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tenantSamlProviderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"tenant_saml_providers\".* FROM \"tenant_saml_providers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tenantSamlProviderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TenantSamlProviderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TenantSamlProviderExists checks if the TenantSamlProvider row exists.
+func TenantSamlProviderExists(ctx context.Context, exec boil.ContextExecutor, tenantID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"tenant_saml_providers\" where \"tenant_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tenantID)
+	}
+	row := exec.QueryRowContext(ctx, sql, tenantID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if tenant_saml_providers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TenantSamlProvider row exists.
+func (o *TenantSamlProvider) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TenantSamlProviderExists(ctx, exec, o.TenantID)
+}
@@ -18,96 +18,207 @@ import (
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"github.com/aarondl/strmangle"
 	"github.com/friendsofgo/errors"
 )
 
 // Tenant is an object representing the database table.
 type Tenant struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID                    int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                  string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt             time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	AllowAnonymousPosts   bool              `boil:"allow_anonymous_posts" json:"allow_anonymous_posts" toml:"allow_anonymous_posts" yaml:"allow_anonymous_posts"`
+	ReactionEmojis        types.StringArray `boil:"reaction_emojis" json:"reaction_emojis" toml:"reaction_emojis" yaml:"reaction_emojis"`
+	WikiEditMinReputation int               `boil:"wiki_edit_min_reputation" json:"wiki_edit_min_reputation" toml:"wiki_edit_min_reputation" yaml:"wiki_edit_min_reputation"`
+	UnverifiedUserAccess  string            `boil:"unverified_user_access" json:"unverified_user_access" toml:"unverified_user_access" yaml:"unverified_user_access"`
+	MfaRequiredRoleIds    types.Int64Array  `boil:"mfa_required_role_ids" json:"mfa_required_role_ids" toml:"mfa_required_role_ids" yaml:"mfa_required_role_ids"`
 
 	R *tenantR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tenantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TenantColumns = struct {
-	ID        string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
+	ID                    string
+	Name                  string
+	CreatedAt             string
+	UpdatedAt             string
+	AllowAnonymousPosts   string
+	ReactionEmojis        string
+	WikiEditMinReputation string
+	UnverifiedUserAccess  string
+	MfaRequiredRoleIds    string
 }{
-	ID:        "id",
-	Name:      "name",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:                    "id",
+	Name:                  "name",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	AllowAnonymousPosts:   "allow_anonymous_posts",
+	ReactionEmojis:        "reaction_emojis",
+	WikiEditMinReputation: "wiki_edit_min_reputation",
+	UnverifiedUserAccess:  "unverified_user_access",
+	MfaRequiredRoleIds:    "mfa_required_role_ids",
 }
 
 var TenantTableColumns = struct {
-	ID        string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
+	ID                    string
+	Name                  string
+	CreatedAt             string
+	UpdatedAt             string
+	AllowAnonymousPosts   string
+	ReactionEmojis        string
+	WikiEditMinReputation string
+	UnverifiedUserAccess  string
+	MfaRequiredRoleIds    string
 }{
-	ID:        "tenants.id",
-	Name:      "tenants.name",
-	CreatedAt: "tenants.created_at",
-	UpdatedAt: "tenants.updated_at",
+	ID:                    "tenants.id",
+	Name:                  "tenants.name",
+	CreatedAt:             "tenants.created_at",
+	UpdatedAt:             "tenants.updated_at",
+	AllowAnonymousPosts:   "tenants.allow_anonymous_posts",
+	ReactionEmojis:        "tenants.reaction_emojis",
+	WikiEditMinReputation: "tenants.wiki_edit_min_reputation",
+	UnverifiedUserAccess:  "tenants.unverified_user_access",
+	MfaRequiredRoleIds:    "tenants.mfa_required_role_ids",
 }
 
 // Generated where
 
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var TenantWhere = struct {
-	ID        whereHelperint64
-	Name      whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpernull_Time
+	ID                    whereHelperint64
+	Name                  whereHelperstring
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpernull_Time
+	AllowAnonymousPosts   whereHelperbool
+	ReactionEmojis        whereHelpertypes_StringArray
+	WikiEditMinReputation whereHelperint
+	UnverifiedUserAccess  whereHelperstring
+	MfaRequiredRoleIds    whereHelpertypes_Int64Array
 }{
-	ID:        whereHelperint64{field: "\"tenants\".\"id\""},
-	Name:      whereHelperstring{field: "\"tenants\".\"name\""},
-	CreatedAt: whereHelpertime_Time{field: "\"tenants\".\"created_at\""},
-	UpdatedAt: whereHelpernull_Time{field: "\"tenants\".\"updated_at\""},
+	ID:                    whereHelperint64{field: "\"tenants\".\"id\""},
+	Name:                  whereHelperstring{field: "\"tenants\".\"name\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"tenants\".\"created_at\""},
+	UpdatedAt:             whereHelpernull_Time{field: "\"tenants\".\"updated_at\""},
+	AllowAnonymousPosts:   whereHelperbool{field: "\"tenants\".\"allow_anonymous_posts\""},
+	ReactionEmojis:        whereHelpertypes_StringArray{field: "\"tenants\".\"reaction_emojis\""},
+	WikiEditMinReputation: whereHelperint{field: "\"tenants\".\"wiki_edit_min_reputation\""},
+	UnverifiedUserAccess:  whereHelperstring{field: "\"tenants\".\"unverified_user_access\""},
+	MfaRequiredRoleIds:    whereHelpertypes_Int64Array{field: "\"tenants\".\"mfa_required_role_ids\""},
 }
 
 // TenantRels is where relationship names are stored.
 var TenantRels = struct {
-	Answers   string
-	Claims    string
-	Comments  string
-	Posts     string
-	Roles     string
-	SubTopics string
-	Tags      string
-	Topics    string
-	Users     string
-	Votes     string
+	AnonymousPostAuthors   string
+	Answers                string
+	Categories             string
+	Claims                 string
+	Comments               string
+	CustomFields           string
+	Notifications          string
+	OidcLoginStates        string
+	PollOptions            string
+	PollVotes              string
+	Polls                  string
+	PostHistories          string
+	Posts                  string
+	QuestionTemplateFields string
+	Reactions              string
+	Roles                  string
+	SamlLoginRequests      string
+	SubTopics              string
+	SuggestedEdits         string
+	Tags                   string
+	TenantOidcProviders    string
+	TenantSamlProviders    string
+	TopicModerators        string
+	Topics                 string
+	UserIdentities         string
+	Users                  string
+	Votes                  string
+	WebauthnCredentials    string
 }{
-	Answers:   "Answers",
-	Claims:    "Claims",
-	Comments:  "Comments",
-	Posts:     "Posts",
-	Roles:     "Roles",
-	SubTopics: "SubTopics",
-	Tags:      "Tags",
-	Topics:    "Topics",
-	Users:     "Users",
-	Votes:     "Votes",
+	AnonymousPostAuthors:   "AnonymousPostAuthors",
+	Answers:                "Answers",
+	Categories:             "Categories",
+	Claims:                 "Claims",
+	Comments:               "Comments",
+	CustomFields:           "CustomFields",
+	Notifications:          "Notifications",
+	OidcLoginStates:        "OidcLoginStates",
+	PollOptions:            "PollOptions",
+	PollVotes:              "PollVotes",
+	Polls:                  "Polls",
+	PostHistories:          "PostHistories",
+	Posts:                  "Posts",
+	QuestionTemplateFields: "QuestionTemplateFields",
+	Reactions:              "Reactions",
+	Roles:                  "Roles",
+	SamlLoginRequests:      "SamlLoginRequests",
+	SubTopics:              "SubTopics",
+	SuggestedEdits:         "SuggestedEdits",
+	Tags:                   "Tags",
+	TenantOidcProviders:    "TenantOidcProviders",
+	TenantSamlProviders:    "TenantSamlProviders",
+	TopicModerators:        "TopicModerators",
+	Topics:                 "Topics",
+	UserIdentities:         "UserIdentities",
+	Users:                  "Users",
+	Votes:                  "Votes",
+	WebauthnCredentials:    "WebauthnCredentials",
 }
 
 // tenantR is where relationships are stored.
 type tenantR struct {
-	Answers   AnswerSlice   `boil:"Answers" json:"Answers" toml:"Answers" yaml:"Answers"`
-	Claims    ClaimSlice    `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
-	Comments  CommentSlice  `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
-	Posts     PostSlice     `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
-	Roles     RoleSlice     `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
-	SubTopics SubTopicSlice `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
-	Tags      TagSlice      `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
-	Topics    TopicSlice    `boil:"Topics" json:"Topics" toml:"Topics" yaml:"Topics"`
-	Users     UserSlice     `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
-	Votes     VoteSlice     `boil:"Votes" json:"Votes" toml:"Votes" yaml:"Votes"`
+	AnonymousPostAuthors   AnonymousPostAuthorSlice   `boil:"AnonymousPostAuthors" json:"AnonymousPostAuthors" toml:"AnonymousPostAuthors" yaml:"AnonymousPostAuthors"`
+	Answers                AnswerSlice                `boil:"Answers" json:"Answers" toml:"Answers" yaml:"Answers"`
+	Categories             CategorySlice              `boil:"Categories" json:"Categories" toml:"Categories" yaml:"Categories"`
+	Claims                 ClaimSlice                 `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
+	Comments               CommentSlice               `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	CustomFields           CustomFieldSlice           `boil:"CustomFields" json:"CustomFields" toml:"CustomFields" yaml:"CustomFields"`
+	Notifications          NotificationSlice          `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	OidcLoginStates        OidcLoginStateSlice        `boil:"OidcLoginStates" json:"OidcLoginStates" toml:"OidcLoginStates" yaml:"OidcLoginStates"`
+	PollOptions            PollOptionSlice            `boil:"PollOptions" json:"PollOptions" toml:"PollOptions" yaml:"PollOptions"`
+	PollVotes              PollVoteSlice              `boil:"PollVotes" json:"PollVotes" toml:"PollVotes" yaml:"PollVotes"`
+	Polls                  PollSlice                  `boil:"Polls" json:"Polls" toml:"Polls" yaml:"Polls"`
+	PostHistories          PostHistorySlice           `boil:"PostHistories" json:"PostHistories" toml:"PostHistories" yaml:"PostHistories"`
+	Posts                  PostSlice                  `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
+	QuestionTemplateFields QuestionTemplateFieldSlice `boil:"QuestionTemplateFields" json:"QuestionTemplateFields" toml:"QuestionTemplateFields" yaml:"QuestionTemplateFields"`
+	Reactions              ReactionSlice              `boil:"Reactions" json:"Reactions" toml:"Reactions" yaml:"Reactions"`
+	Roles                  RoleSlice                  `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
+	SamlLoginRequests      SamlLoginRequestSlice      `boil:"SamlLoginRequests" json:"SamlLoginRequests" toml:"SamlLoginRequests" yaml:"SamlLoginRequests"`
+	SubTopics              SubTopicSlice              `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
+	SuggestedEdits         SuggestedEditSlice         `boil:"SuggestedEdits" json:"SuggestedEdits" toml:"SuggestedEdits" yaml:"SuggestedEdits"`
+	Tags                   TagSlice                   `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	TenantOidcProviders    TenantOidcProviderSlice    `boil:"TenantOidcProviders" json:"TenantOidcProviders" toml:"TenantOidcProviders" yaml:"TenantOidcProviders"`
+	TenantSamlProviders    TenantSamlProviderSlice    `boil:"TenantSamlProviders" json:"TenantSamlProviders" toml:"TenantSamlProviders" yaml:"TenantSamlProviders"`
+	TopicModerators        TopicModeratorSlice        `boil:"TopicModerators" json:"TopicModerators" toml:"TopicModerators" yaml:"TopicModerators"`
+	Topics                 TopicSlice                 `boil:"Topics" json:"Topics" toml:"Topics" yaml:"Topics"`
+	UserIdentities         UserIdentitySlice          `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	Users                  UserSlice                  `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Votes                  VoteSlice                  `boil:"Votes" json:"Votes" toml:"Votes" yaml:"Votes"`
+	WebauthnCredentials    WebauthnCredentialSlice    `boil:"WebauthnCredentials" json:"WebauthnCredentials" toml:"WebauthnCredentials" yaml:"WebauthnCredentials"`
 }
 
 // NewStruct creates a new relationship struct
@@ -115,6 +226,22 @@ func (*tenantR) NewStruct() *tenantR {
 	return &tenantR{}
 }
 
+func (o *Tenant) GetAnonymousPostAuthors() AnonymousPostAuthorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAnonymousPostAuthors()
+}
+
+func (r *tenantR) GetAnonymousPostAuthors() AnonymousPostAuthorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AnonymousPostAuthors
+}
+
 func (o *Tenant) GetAnswers() AnswerSlice {
 	if o == nil {
 		return nil
@@ -131,6 +258,22 @@ func (r *tenantR) GetAnswers() AnswerSlice {
 	return r.Answers
 }
 
+func (o *Tenant) GetCategories() CategorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategories()
+}
+
+func (r *tenantR) GetCategories() CategorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Categories
+}
+
 func (o *Tenant) GetClaims() ClaimSlice {
 	if o == nil {
 		return nil
@@ -163,6 +306,118 @@ func (r *tenantR) GetComments() CommentSlice {
 	return r.Comments
 }
 
+func (o *Tenant) GetCustomFields() CustomFieldSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCustomFields()
+}
+
+func (r *tenantR) GetCustomFields() CustomFieldSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CustomFields
+}
+
+func (o *Tenant) GetNotifications() NotificationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotifications()
+}
+
+func (r *tenantR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Notifications
+}
+
+func (o *Tenant) GetOidcLoginStates() OidcLoginStateSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOidcLoginStates()
+}
+
+func (r *tenantR) GetOidcLoginStates() OidcLoginStateSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OidcLoginStates
+}
+
+func (o *Tenant) GetPollOptions() PollOptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPollOptions()
+}
+
+func (r *tenantR) GetPollOptions() PollOptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PollOptions
+}
+
+func (o *Tenant) GetPollVotes() PollVoteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPollVotes()
+}
+
+func (r *tenantR) GetPollVotes() PollVoteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PollVotes
+}
+
+func (o *Tenant) GetPolls() PollSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPolls()
+}
+
+func (r *tenantR) GetPolls() PollSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Polls
+}
+
+func (o *Tenant) GetPostHistories() PostHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPostHistories()
+}
+
+func (r *tenantR) GetPostHistories() PostHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PostHistories
+}
+
 func (o *Tenant) GetPosts() PostSlice {
 	if o == nil {
 		return nil
@@ -179,6 +434,38 @@ func (r *tenantR) GetPosts() PostSlice {
 	return r.Posts
 }
 
+func (o *Tenant) GetQuestionTemplateFields() QuestionTemplateFieldSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetQuestionTemplateFields()
+}
+
+func (r *tenantR) GetQuestionTemplateFields() QuestionTemplateFieldSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.QuestionTemplateFields
+}
+
+func (o *Tenant) GetReactions() ReactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReactions()
+}
+
+func (r *tenantR) GetReactions() ReactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reactions
+}
+
 func (o *Tenant) GetRoles() RoleSlice {
 	if o == nil {
 		return nil
@@ -195,6 +482,22 @@ func (r *tenantR) GetRoles() RoleSlice {
 	return r.Roles
 }
 
+func (o *Tenant) GetSamlLoginRequests() SamlLoginRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSamlLoginRequests()
+}
+
+func (r *tenantR) GetSamlLoginRequests() SamlLoginRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SamlLoginRequests
+}
+
 func (o *Tenant) GetSubTopics() SubTopicSlice {
 	if o == nil {
 		return nil
@@ -211,6 +514,22 @@ func (r *tenantR) GetSubTopics() SubTopicSlice {
 	return r.SubTopics
 }
 
+func (o *Tenant) GetSuggestedEdits() SuggestedEditSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSuggestedEdits()
+}
+
+func (r *tenantR) GetSuggestedEdits() SuggestedEditSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SuggestedEdits
+}
+
 func (o *Tenant) GetTags() TagSlice {
 	if o == nil {
 		return nil
@@ -227,6 +546,54 @@ func (r *tenantR) GetTags() TagSlice {
 	return r.Tags
 }
 
+func (o *Tenant) GetTenantOidcProviders() TenantOidcProviderSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenantOidcProviders()
+}
+
+func (r *tenantR) GetTenantOidcProviders() TenantOidcProviderSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TenantOidcProviders
+}
+
+func (o *Tenant) GetTenantSamlProviders() TenantSamlProviderSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenantSamlProviders()
+}
+
+func (r *tenantR) GetTenantSamlProviders() TenantSamlProviderSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TenantSamlProviders
+}
+
+func (o *Tenant) GetTopicModerators() TopicModeratorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopicModerators()
+}
+
+func (r *tenantR) GetTopicModerators() TopicModeratorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TopicModerators
+}
+
 func (o *Tenant) GetTopics() TopicSlice {
 	if o == nil {
 		return nil
@@ -243,6 +610,22 @@ func (r *tenantR) GetTopics() TopicSlice {
 	return r.Topics
 }
 
+func (o *Tenant) GetUserIdentities() UserIdentitySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserIdentities()
+}
+
+func (r *tenantR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserIdentities
+}
+
 func (o *Tenant) GetUsers() UserSlice {
 	if o == nil {
 		return nil
@@ -275,13 +658,29 @@ func (r *tenantR) GetVotes() VoteSlice {
 	return r.Votes
 }
 
+func (o *Tenant) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnCredentials()
+}
+
+func (r *tenantR) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCredentials
+}
+
 // tenantL is where Load methods for each relationship are stored.
 type tenantL struct{}
 
 var (
-	tenantAllColumns            = []string{"id", "name", "created_at", "updated_at"}
+	tenantAllColumns            = []string{"id", "name", "created_at", "updated_at", "allow_anonymous_posts", "reaction_emojis", "wiki_edit_min_reputation", "unverified_user_access", "mfa_required_role_ids"}
 	tenantColumnsWithoutDefault = []string{"name"}
-	tenantColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tenantColumnsWithDefault    = []string{"id", "created_at", "updated_at", "allow_anonymous_posts", "reaction_emojis", "wiki_edit_min_reputation", "unverified_user_access", "mfa_required_role_ids"}
 	tenantPrimaryKeyColumns     = []string{"id"}
 	tenantGeneratedColumns      = []string{"id"}
 )
@@ -591,6 +990,20 @@ func (q tenantQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (boo
 	return count > 0, nil
 }
 
+// AnonymousPostAuthors retrieves all the anonymous_post_author's AnonymousPostAuthors with an executor.
+func (o *Tenant) AnonymousPostAuthors(mods ...qm.QueryMod) anonymousPostAuthorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"anonymous_post_authors\".\"tenant_id\"=?", o.ID),
+	)
+
+	return AnonymousPostAuthors(queryMods...)
+}
+
 // Answers retrieves all the answer's Answers with an executor.
 func (o *Tenant) Answers(mods ...qm.QueryMod) answerQuery {
 	var queryMods []qm.QueryMod
@@ -605,6 +1018,20 @@ func (o *Tenant) Answers(mods ...qm.QueryMod) answerQuery {
 	return Answers(queryMods...)
 }
 
+// Categories retrieves all the category's Categories with an executor.
+func (o *Tenant) Categories(mods ...qm.QueryMod) categoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"categories\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Categories(queryMods...)
+}
+
 // Claims retrieves all the claim's Claims with an executor.
 func (o *Tenant) Claims(mods ...qm.QueryMod) claimQuery {
 	var queryMods []qm.QueryMod
@@ -633,46 +1060,200 @@ func (o *Tenant) Comments(mods ...qm.QueryMod) commentQuery {
 	return Comments(queryMods...)
 }
 
-// Posts retrieves all the post's Posts with an executor.
-func (o *Tenant) Posts(mods ...qm.QueryMod) postQuery {
+// CustomFields retrieves all the custom_field's CustomFields with an executor.
+func (o *Tenant) CustomFields(mods ...qm.QueryMod) customFieldQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"posts\".\"tenant_id\"=?", o.ID),
+		qm.Where("\"custom_fields\".\"tenant_id\"=?", o.ID),
 	)
 
-	return Posts(queryMods...)
+	return CustomFields(queryMods...)
 }
 
-// Roles retrieves all the role's Roles with an executor.
-func (o *Tenant) Roles(mods ...qm.QueryMod) roleQuery {
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *Tenant) Notifications(mods ...qm.QueryMod) notificationQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"roles\".\"tenant_id\"=?", o.ID),
+		qm.Where("\"notifications\".\"tenant_id\"=?", o.ID),
 	)
 
-	return Roles(queryMods...)
+	return Notifications(queryMods...)
 }
 
-// SubTopics retrieves all the sub_topic's SubTopics with an executor.
-func (o *Tenant) SubTopics(mods ...qm.QueryMod) subTopicQuery {
+// OidcLoginStates retrieves all the oidc_login_state's OidcLoginStates with an executor.
+func (o *Tenant) OidcLoginStates(mods ...qm.QueryMod) oidcLoginStateQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"sub_topics\".\"tenant_id\"=?", o.ID),
+		qm.Where("\"oidc_login_states\".\"tenant_id\"=?", o.ID),
 	)
 
-	return SubTopics(queryMods...)
+	return OidcLoginStates(queryMods...)
+}
+
+// PollOptions retrieves all the poll_option's PollOptions with an executor.
+func (o *Tenant) PollOptions(mods ...qm.QueryMod) pollOptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"poll_options\".\"tenant_id\"=?", o.ID),
+	)
+
+	return PollOptions(queryMods...)
+}
+
+// PollVotes retrieves all the poll_vote's PollVotes with an executor.
+func (o *Tenant) PollVotes(mods ...qm.QueryMod) pollVoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"poll_votes\".\"tenant_id\"=?", o.ID),
+	)
+
+	return PollVotes(queryMods...)
+}
+
+// Polls retrieves all the poll's Polls with an executor.
+func (o *Tenant) Polls(mods ...qm.QueryMod) pollQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"polls\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Polls(queryMods...)
+}
+
+// PostHistories retrieves all the post_history's PostHistories with an executor.
+func (o *Tenant) PostHistories(mods ...qm.QueryMod) postHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_histories\".\"tenant_id\"=?", o.ID),
+	)
+
+	return PostHistories(queryMods...)
+}
+
+// Posts retrieves all the post's Posts with an executor.
+func (o *Tenant) Posts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// QuestionTemplateFields retrieves all the question_template_field's QuestionTemplateFields with an executor.
+func (o *Tenant) QuestionTemplateFields(mods ...qm.QueryMod) questionTemplateFieldQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"question_template_fields\".\"tenant_id\"=?", o.ID),
+	)
+
+	return QuestionTemplateFields(queryMods...)
+}
+
+// Reactions retrieves all the reaction's Reactions with an executor.
+func (o *Tenant) Reactions(mods ...qm.QueryMod) reactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reactions\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Reactions(queryMods...)
+}
+
+// Roles retrieves all the role's Roles with an executor.
+func (o *Tenant) Roles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"roles\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Roles(queryMods...)
+}
+
+// SamlLoginRequests retrieves all the saml_login_request's SamlLoginRequests with an executor.
+func (o *Tenant) SamlLoginRequests(mods ...qm.QueryMod) samlLoginRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"saml_login_requests\".\"tenant_id\"=?", o.ID),
+	)
+
+	return SamlLoginRequests(queryMods...)
+}
+
+// SubTopics retrieves all the sub_topic's SubTopics with an executor.
+func (o *Tenant) SubTopics(mods ...qm.QueryMod) subTopicQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sub_topics\".\"tenant_id\"=?", o.ID),
+	)
+
+	return SubTopics(queryMods...)
+}
+
+// SuggestedEdits retrieves all the suggested_edit's SuggestedEdits with an executor.
+func (o *Tenant) SuggestedEdits(mods ...qm.QueryMod) suggestedEditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"suggested_edits\".\"tenant_id\"=?", o.ID),
+	)
+
+	return SuggestedEdits(queryMods...)
 }
 
 // Tags retrieves all the tag's Tags with an executor.
@@ -689,6 +1270,48 @@ func (o *Tenant) Tags(mods ...qm.QueryMod) tagQuery {
 	return Tags(queryMods...)
 }
 
+// TenantOidcProviders retrieves all the tenant_oidc_provider's TenantOidcProviders with an executor.
+func (o *Tenant) TenantOidcProviders(mods ...qm.QueryMod) tenantOidcProviderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tenant_oidc_providers\".\"tenant_id\"=?", o.ID),
+	)
+
+	return TenantOidcProviders(queryMods...)
+}
+
+// TenantSamlProviders retrieves all the tenant_saml_provider's TenantSamlProviders with an executor.
+func (o *Tenant) TenantSamlProviders(mods ...qm.QueryMod) tenantSamlProviderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tenant_saml_providers\".\"tenant_id\"=?", o.ID),
+	)
+
+	return TenantSamlProviders(queryMods...)
+}
+
+// TopicModerators retrieves all the topic_moderator's TopicModerators with an executor.
+func (o *Tenant) TopicModerators(mods ...qm.QueryMod) topicModeratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"topic_moderators\".\"tenant_id\"=?", o.ID),
+	)
+
+	return TopicModerators(queryMods...)
+}
+
 // Topics retrieves all the topic's Topics with an executor.
 func (o *Tenant) Topics(mods ...qm.QueryMod) topicQuery {
 	var queryMods []qm.QueryMod
@@ -703,6 +1326,20 @@ func (o *Tenant) Topics(mods ...qm.QueryMod) topicQuery {
 	return Topics(queryMods...)
 }
 
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *Tenant) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"tenant_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Tenant) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -731,9 +1368,23 @@ func (o *Tenant) Votes(mods ...qm.QueryMod) voteQuery {
 	return Votes(queryMods...)
 }
 
-// LoadAnswers allows an eager lookup of values, cached into the
+// WebauthnCredentials retrieves all the webauthn_credential's WebauthnCredentials with an executor.
+func (o *Tenant) WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_credentials\".\"tenant_id\"=?", o.ID),
+	)
+
+	return WebauthnCredentials(queryMods...)
+}
+
+// LoadAnonymousPostAuthors allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadAnonymousPostAuthors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -786,8 +1437,8 @@ func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`answers`),
-		qm.WhereIn(`answers.tenant_id in ?`, argsSlice...),
+		qm.From(`anonymous_post_authors`),
+		qm.WhereIn(`anonymous_post_authors.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -795,22 +1446,22 @@ func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load answers")
+		return errors.Wrap(err, "failed to eager load anonymous_post_authors")
 	}
 
-	var resultSlice []*Answer
+	var resultSlice []*AnonymousPostAuthor
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice answers")
+		return errors.Wrap(err, "failed to bind eager loaded slice anonymous_post_authors")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on answers")
+		return errors.Wrap(err, "failed to close results in eager load on anonymous_post_authors")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for anonymous_post_authors")
 	}
 
-	if len(answerAfterSelectHooks) != 0 {
+	if len(anonymousPostAuthorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -818,10 +1469,10 @@ func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Answers = resultSlice
+		object.R.AnonymousPostAuthors = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &answerR{}
+				foreign.R = &anonymousPostAuthorR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -831,9 +1482,9 @@ func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Answers = append(local.R.Answers, foreign)
+				local.R.AnonymousPostAuthors = append(local.R.AnonymousPostAuthors, foreign)
 				if foreign.R == nil {
-					foreign.R = &answerR{}
+					foreign.R = &anonymousPostAuthorR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -844,9 +1495,9 @@ func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadClaims allows an eager lookup of values, cached into the
+// LoadAnswers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -899,8 +1550,8 @@ func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`claims`),
-		qm.WhereIn(`claims.tenant_id in ?`, argsSlice...),
+		qm.From(`answers`),
+		qm.WhereIn(`answers.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -908,22 +1559,22 @@ func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load claims")
+		return errors.Wrap(err, "failed to eager load answers")
 	}
 
-	var resultSlice []*Claim
+	var resultSlice []*Answer
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice claims")
+		return errors.Wrap(err, "failed to bind eager loaded slice answers")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on claims")
+		return errors.Wrap(err, "failed to close results in eager load on answers")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claims")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
 	}
 
-	if len(claimAfterSelectHooks) != 0 {
+	if len(answerAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -931,10 +1582,10 @@ func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Claims = resultSlice
+		object.R.Answers = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &claimR{}
+				foreign.R = &answerR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -944,9 +1595,9 @@ func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Claims = append(local.R.Claims, foreign)
+				local.R.Answers = append(local.R.Answers, foreign)
 				if foreign.R == nil {
-					foreign.R = &claimR{}
+					foreign.R = &answerR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -957,9 +1608,9 @@ func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadComments allows an eager lookup of values, cached into the
+// LoadCategories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1012,8 +1663,8 @@ func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`comments`),
-		qm.WhereIn(`comments.tenant_id in ?`, argsSlice...),
+		qm.From(`categories`),
+		qm.WhereIn(`categories.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1021,22 +1672,22 @@ func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load comments")
+		return errors.Wrap(err, "failed to eager load categories")
 	}
 
-	var resultSlice []*Comment
+	var resultSlice []*Category
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+		return errors.Wrap(err, "failed to bind eager loaded slice categories")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comments")
+		return errors.Wrap(err, "failed to close results in eager load on categories")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
 	}
 
-	if len(commentAfterSelectHooks) != 0 {
+	if len(categoryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1044,10 +1695,10 @@ func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.Comments = resultSlice
+		object.R.Categories = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &commentR{}
+				foreign.R = &categoryR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1057,9 +1708,9 @@ func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singula
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Comments = append(local.R.Comments, foreign)
+				local.R.Categories = append(local.R.Categories, foreign)
 				if foreign.R == nil {
-					foreign.R = &commentR{}
+					foreign.R = &categoryR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1070,9 +1721,9 @@ func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadPosts allows an eager lookup of values, cached into the
+// LoadClaims allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1125,8 +1776,8 @@ func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`posts`),
-		qm.WhereIn(`posts.tenant_id in ?`, argsSlice...),
+		qm.From(`claims`),
+		qm.WhereIn(`claims.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1134,22 +1785,22 @@ func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load posts")
+		return errors.Wrap(err, "failed to eager load claims")
 	}
 
-	var resultSlice []*Post
+	var resultSlice []*Claim
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+		return errors.Wrap(err, "failed to bind eager loaded slice claims")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on posts")
+		return errors.Wrap(err, "failed to close results in eager load on claims")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claims")
 	}
 
-	if len(postAfterSelectHooks) != 0 {
+	if len(claimAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1157,10 +1808,10 @@ func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular b
 		}
 	}
 	if singular {
-		object.R.Posts = resultSlice
+		object.R.Claims = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postR{}
+				foreign.R = &claimR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1170,9 +1821,9 @@ func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular b
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Posts = append(local.R.Posts, foreign)
+				local.R.Claims = append(local.R.Claims, foreign)
 				if foreign.R == nil {
-					foreign.R = &postR{}
+					foreign.R = &claimR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1183,9 +1834,9 @@ func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadRoles allows an eager lookup of values, cached into the
+// LoadComments allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1238,8 +1889,8 @@ func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`roles`),
-		qm.WhereIn(`roles.tenant_id in ?`, argsSlice...),
+		qm.From(`comments`),
+		qm.WhereIn(`comments.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1247,22 +1898,22 @@ func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load roles")
+		return errors.Wrap(err, "failed to eager load comments")
 	}
 
-	var resultSlice []*Role
+	var resultSlice []*Comment
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice roles")
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on roles")
+		return errors.Wrap(err, "failed to close results in eager load on comments")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
 	}
 
-	if len(roleAfterSelectHooks) != 0 {
+	if len(commentAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1270,10 +1921,10 @@ func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular b
 		}
 	}
 	if singular {
-		object.R.Roles = resultSlice
+		object.R.Comments = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &roleR{}
+				foreign.R = &commentR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1283,9 +1934,9 @@ func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular b
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Roles = append(local.R.Roles, foreign)
+				local.R.Comments = append(local.R.Comments, foreign)
 				if foreign.R == nil {
-					foreign.R = &roleR{}
+					foreign.R = &commentR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1296,9 +1947,9 @@ func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadSubTopics allows an eager lookup of values, cached into the
+// LoadCustomFields allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadCustomFields(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1351,8 +2002,8 @@ func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`sub_topics`),
-		qm.WhereIn(`sub_topics.tenant_id in ?`, argsSlice...),
+		qm.From(`custom_fields`),
+		qm.WhereIn(`custom_fields.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1360,22 +2011,22 @@ func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load sub_topics")
+		return errors.Wrap(err, "failed to eager load custom_fields")
 	}
 
-	var resultSlice []*SubTopic
+	var resultSlice []*CustomField
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice sub_topics")
+		return errors.Wrap(err, "failed to bind eager loaded slice custom_fields")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on sub_topics")
+		return errors.Wrap(err, "failed to close results in eager load on custom_fields")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for custom_fields")
 	}
 
-	if len(subTopicAfterSelectHooks) != 0 {
+	if len(customFieldAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1383,10 +2034,10 @@ func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.SubTopics = resultSlice
+		object.R.CustomFields = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &subTopicR{}
+				foreign.R = &customFieldR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1396,9 +2047,9 @@ func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singul
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.SubTopics = append(local.R.SubTopics, foreign)
+				local.R.CustomFields = append(local.R.CustomFields, foreign)
 				if foreign.R == nil {
-					foreign.R = &subTopicR{}
+					foreign.R = &customFieldR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1409,9 +2060,9 @@ func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadTags allows an eager lookup of values, cached into the
+// LoadNotifications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1464,8 +2115,8 @@ func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`tags`),
-		qm.WhereIn(`tags.tenant_id in ?`, argsSlice...),
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1473,22 +2124,22 @@ func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tags")
+		return errors.Wrap(err, "failed to eager load notifications")
 	}
 
-	var resultSlice []*Tag
+	var resultSlice []*Notification
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tags")
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tags")
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
 	}
 
-	if len(tagAfterSelectHooks) != 0 {
+	if len(notificationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1496,10 +2147,10 @@ func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bo
 		}
 	}
 	if singular {
-		object.R.Tags = resultSlice
+		object.R.Notifications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tagR{}
+				foreign.R = &notificationR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1509,9 +2160,9 @@ func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bo
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Tags = append(local.R.Tags, foreign)
+				local.R.Notifications = append(local.R.Notifications, foreign)
 				if foreign.R == nil {
-					foreign.R = &tagR{}
+					foreign.R = &notificationR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1522,9 +2173,9 @@ func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadTopics allows an eager lookup of values, cached into the
+// LoadOidcLoginStates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadOidcLoginStates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1577,8 +2228,8 @@ func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`topics`),
-		qm.WhereIn(`topics.tenant_id in ?`, argsSlice...),
+		qm.From(`oidc_login_states`),
+		qm.WhereIn(`oidc_login_states.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1586,22 +2237,22 @@ func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load topics")
+		return errors.Wrap(err, "failed to eager load oidc_login_states")
 	}
 
-	var resultSlice []*Topic
+	var resultSlice []*OidcLoginState
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice topics")
+		return errors.Wrap(err, "failed to bind eager loaded slice oidc_login_states")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on topics")
+		return errors.Wrap(err, "failed to close results in eager load on oidc_login_states")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topics")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oidc_login_states")
 	}
 
-	if len(topicAfterSelectHooks) != 0 {
+	if len(oidcLoginStateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1609,10 +2260,10 @@ func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Topics = resultSlice
+		object.R.OidcLoginStates = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &topicR{}
+				foreign.R = &oidcLoginStateR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1622,9 +2273,9 @@ func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Topics = append(local.R.Topics, foreign)
+				local.R.OidcLoginStates = append(local.R.OidcLoginStates, foreign)
 				if foreign.R == nil {
-					foreign.R = &topicR{}
+					foreign.R = &oidcLoginStateR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1635,9 +2286,9 @@ func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadUsers allows an eager lookup of values, cached into the
+// LoadPollOptions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadPollOptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1690,8 +2341,8 @@ func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.tenant_id in ?`, argsSlice...),
+		qm.From(`poll_options`),
+		qm.WhereIn(`poll_options.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1699,22 +2350,22 @@ func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
+		return errors.Wrap(err, "failed to eager load poll_options")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*PollOption
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice users")
+		return errors.Wrap(err, "failed to bind eager loaded slice poll_options")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
+		return errors.Wrap(err, "failed to close results in eager load on poll_options")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_options")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(pollOptionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1722,10 +2373,10 @@ func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular b
 		}
 	}
 	if singular {
-		object.R.Users = resultSlice
+		object.R.PollOptions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userR{}
+				foreign.R = &pollOptionR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1735,9 +2386,9 @@ func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular b
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Users = append(local.R.Users, foreign)
+				local.R.PollOptions = append(local.R.PollOptions, foreign)
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &pollOptionR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1748,9 +2399,9 @@ func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadVotes allows an eager lookup of values, cached into the
+// LoadPollVotes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadPollVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1803,69 +2454,2951 @@ func (tenantL) LoadVotes(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`votes`),
-		qm.WhereIn(`votes.tenant_id in ?`, argsSlice...),
+		qm.From(`poll_votes`),
+		qm.WhereIn(`poll_votes.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load poll_votes")
+	}
+
+	var resultSlice []*PollVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice poll_votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on poll_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_votes")
+	}
+
+	if len(pollVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PollVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pollVoteR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.PollVotes = append(local.R.PollVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &pollVoteR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPolls allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadPolls(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`polls`),
+		qm.WhereIn(`polls.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load votes")
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load polls")
+	}
+
+	var resultSlice []*Poll
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice polls")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on polls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for polls")
+	}
+
+	if len(pollAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Polls = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pollR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Polls = append(local.R.Polls, foreign)
+				if foreign.R == nil {
+					foreign.R = &pollR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadPostHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_histories`),
+		qm.WhereIn(`post_histories.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_histories")
+	}
+
+	var resultSlice []*PostHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_histories")
+	}
+
+	if len(postHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postHistoryR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.PostHistories = append(local.R.PostHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &postHistoryR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Posts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Posts = append(local.R.Posts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadQuestionTemplateFields allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadQuestionTemplateFields(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`question_template_fields`),
+		qm.WhereIn(`question_template_fields.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load question_template_fields")
+	}
+
+	var resultSlice []*QuestionTemplateField
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice question_template_fields")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on question_template_fields")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for question_template_fields")
+	}
+
+	if len(questionTemplateFieldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.QuestionTemplateFields = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &questionTemplateFieldR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.QuestionTemplateFields = append(local.R.QuestionTemplateFields, foreign)
+				if foreign.R == nil {
+					foreign.R = &questionTemplateFieldR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reactions`),
+		qm.WhereIn(`reactions.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reactions")
+	}
+
+	var resultSlice []*Reaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reactions")
+	}
+
+	if len(reactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reactionR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Reactions = append(local.R.Reactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &reactionR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Roles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Roles = append(local.R.Roles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSamlLoginRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadSamlLoginRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`saml_login_requests`),
+		qm.WhereIn(`saml_login_requests.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load saml_login_requests")
+	}
+
+	var resultSlice []*SamlLoginRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice saml_login_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on saml_login_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for saml_login_requests")
+	}
+
+	if len(samlLoginRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SamlLoginRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &samlLoginRequestR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.SamlLoginRequests = append(local.R.SamlLoginRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &samlLoginRequestR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubTopics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`sub_topics`),
+		qm.WhereIn(`sub_topics.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sub_topics")
+	}
+
+	var resultSlice []*SubTopic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sub_topics")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sub_topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+	}
+
+	if len(subTopicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubTopics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &subTopicR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.SubTopics = append(local.R.SubTopics, foreign)
+				if foreign.R == nil {
+					foreign.R = &subTopicR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSuggestedEdits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadSuggestedEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`suggested_edits`),
+		qm.WhereIn(`suggested_edits.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load suggested_edits")
+	}
+
+	var resultSlice []*SuggestedEdit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice suggested_edits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on suggested_edits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for suggested_edits")
+	}
+
+	if len(suggestedEditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SuggestedEdits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &suggestedEditR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.SuggestedEdits = append(local.R.SuggestedEdits, foreign)
+				if foreign.R == nil {
+					foreign.R = &suggestedEditR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tags`),
+		qm.WhereIn(`tags.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tags")
+	}
+
+	var resultSlice []*Tag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+	}
+
+	if len(tagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Tags = append(local.R.Tags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tagR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenantOidcProviders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadTenantOidcProviders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenant_oidc_providers`),
+		qm.WhereIn(`tenant_oidc_providers.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tenant_oidc_providers")
+	}
+
+	var resultSlice []*TenantOidcProvider
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tenant_oidc_providers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tenant_oidc_providers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_oidc_providers")
+	}
+
+	if len(tenantOidcProviderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TenantOidcProviders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tenantOidcProviderR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.TenantOidcProviders = append(local.R.TenantOidcProviders, foreign)
+				if foreign.R == nil {
+					foreign.R = &tenantOidcProviderR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenantSamlProviders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadTenantSamlProviders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenant_saml_providers`),
+		qm.WhereIn(`tenant_saml_providers.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tenant_saml_providers")
+	}
+
+	var resultSlice []*TenantSamlProvider
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tenant_saml_providers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tenant_saml_providers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_saml_providers")
+	}
+
+	if len(tenantSamlProviderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TenantSamlProviders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tenantSamlProviderR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.TenantSamlProviders = append(local.R.TenantSamlProviders, foreign)
+				if foreign.R == nil {
+					foreign.R = &tenantSamlProviderR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopicModerators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadTopicModerators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`topic_moderators`),
+		qm.WhereIn(`topic_moderators.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topic_moderators")
+	}
+
+	var resultSlice []*TopicModerator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice topic_moderators")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topic_moderators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topic_moderators")
+	}
+
+	if len(topicModeratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TopicModerators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicModeratorR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.TopicModerators = append(local.R.TopicModerators, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicModeratorR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`topics`),
+		qm.WhereIn(`topics.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topics")
+	}
+
+	var resultSlice []*Topic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice topics")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topics")
+	}
+
+	if len(topicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Topics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Topics = append(local.R.Topics, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
+
+	if len(userIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Users = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Users = append(local.R.Users, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`votes`),
+		qm.WhereIn(`votes.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load votes")
+	}
+
+	var resultSlice []*Vote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for votes")
+	}
+
+	if len(voteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Votes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &voteR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Votes = append(local.R.Votes, foreign)
+				if foreign.R == nil {
+					foreign.R = &voteR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadWebauthnCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_credentials`),
+		qm.WhereIn(`webauthn_credentials.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_credentials")
+	}
+
+	var resultSlice []*WebauthnCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCredentialR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.WebauthnCredentials = append(local.R.WebauthnCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCredentialR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAnonymousPostAuthors adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.AnonymousPostAuthors.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddAnonymousPostAuthors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AnonymousPostAuthor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, anonymousPostAuthorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			AnonymousPostAuthors: related,
+		}
+	} else {
+		o.R.AnonymousPostAuthors = append(o.R.AnonymousPostAuthors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &anonymousPostAuthorR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddAnswers adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Answers.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Answer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"answers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, answerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Answers: related,
+		}
+	} else {
+		o.R.Answers = append(o.R.Answers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &answerR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddCategories adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Categories.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"categories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, categoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Categories: related,
+		}
+	} else {
+		o.R.Categories = append(o.R.Categories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &categoryR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddClaims adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Claims.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"claims\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, claimPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Claims: related,
+		}
+	} else {
+		o.R.Claims = append(o.R.Claims, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &claimR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddComments adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Comments: related,
+		}
+	} else {
+		o.R.Comments = append(o.R.Comments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddCustomFields adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.CustomFields.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddCustomFields(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomField) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"custom_fields\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customFieldPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			CustomFields: related,
+		}
+	} else {
+		o.R.CustomFields = append(o.R.CustomFields, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customFieldR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddOidcLoginStates adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.OidcLoginStates.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddOidcLoginStates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OidcLoginState) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oidc_login_states\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oidcLoginStatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.State}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			OidcLoginStates: related,
+		}
+	} else {
+		o.R.OidcLoginStates = append(o.R.OidcLoginStates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oidcLoginStateR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddPollOptions adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.PollOptions.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddPollOptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PollOption) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"poll_options\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollOptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			PollOptions: related,
+		}
+	} else {
+		o.R.PollOptions = append(o.R.PollOptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollOptionR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddPollVotes adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.PollVotes.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddPollVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PollVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"poll_votes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OptionID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			PollVotes: related,
+		}
+	} else {
+		o.R.PollVotes = append(o.R.PollVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollVoteR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddPolls adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Polls.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddPolls(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Poll) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"polls\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Polls: related,
+		}
+	} else {
+		o.R.Polls = append(o.R.Polls, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddPostHistories adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.PostHistories.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddPostHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_histories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			PostHistories: related,
+		}
+	} else {
+		o.R.PostHistories = append(o.R.PostHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postHistoryR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddPosts adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Posts: related,
+		}
+	} else {
+		o.R.Posts = append(o.R.Posts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddQuestionTemplateFields adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.QuestionTemplateFields.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddQuestionTemplateFields(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*QuestionTemplateField) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"question_template_fields\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, questionTemplateFieldPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			QuestionTemplateFields: related,
+		}
+	} else {
+		o.R.QuestionTemplateFields = append(o.R.QuestionTemplateFields, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &questionTemplateFieldR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddReactions adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Reactions.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
 	}
 
-	var resultSlice []*Vote
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice votes")
+	if o.R == nil {
+		o.R = &tenantR{
+			Reactions: related,
+		}
+	} else {
+		o.R.Reactions = append(o.R.Reactions, related...)
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on votes")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for votes")
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reactionR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
 	}
+	return nil
+}
 
-	if len(voteAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+// AddRoles adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Roles.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"roles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
 		}
 	}
-	if singular {
-		object.R.Votes = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &voteR{}
-			}
-			foreign.R.Tenant = object
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Roles: related,
 		}
-		return nil
+	} else {
+		o.R.Roles = append(o.R.Roles, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.TenantID {
-				local.R.Votes = append(local.R.Votes, foreign)
-				if foreign.R == nil {
-					foreign.R = &voteR{}
-				}
-				foreign.R.Tenant = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				Tenant: o,
 			}
+		} else {
+			rel.R.Tenant = o
 		}
 	}
-
 	return nil
 }
 
-// AddAnswers adds the given related objects to the existing relationships
+// AddSamlLoginRequests adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Answers.
+// Appends related to o.R.SamlLoginRequests.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Answer) error {
+func (o *Tenant) AddSamlLoginRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SamlLoginRequest) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1875,11 +5408,11 @@ func (o *Tenant) AddAnswers(ctx context.Context, exec boil.ContextExecutor, inse
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"answers\" SET %s WHERE %s",
+				"UPDATE \"saml_login_requests\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, answerPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, samlLoginRequestPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.RelayState}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1896,15 +5429,15 @@ func (o *Tenant) AddAnswers(ctx context.Context, exec boil.ContextExecutor, inse
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Answers: related,
+			SamlLoginRequests: related,
 		}
 	} else {
-		o.R.Answers = append(o.R.Answers, related...)
+		o.R.SamlLoginRequests = append(o.R.SamlLoginRequests, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &answerR{
+			rel.R = &samlLoginRequestR{
 				Tenant: o,
 			}
 		} else {
@@ -1914,11 +5447,11 @@ func (o *Tenant) AddAnswers(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
-// AddClaims adds the given related objects to the existing relationships
+// AddSubTopics adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Claims.
+// Appends related to o.R.SubTopics.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+func (o *Tenant) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1928,9 +5461,9 @@ func (o *Tenant) AddClaims(ctx context.Context, exec boil.ContextExecutor, inser
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"claims\" SET %s WHERE %s",
+				"UPDATE \"sub_topics\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, claimPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, subTopicPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1949,15 +5482,15 @@ func (o *Tenant) AddClaims(ctx context.Context, exec boil.ContextExecutor, inser
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Claims: related,
+			SubTopics: related,
 		}
 	} else {
-		o.R.Claims = append(o.R.Claims, related...)
+		o.R.SubTopics = append(o.R.SubTopics, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &claimR{
+			rel.R = &subTopicR{
 				Tenant: o,
 			}
 		} else {
@@ -1967,11 +5500,11 @@ func (o *Tenant) AddClaims(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
-// AddComments adds the given related objects to the existing relationships
+// AddSuggestedEdits adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Comments.
+// Appends related to o.R.SuggestedEdits.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+func (o *Tenant) AddSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1981,9 +5514,9 @@ func (o *Tenant) AddComments(ctx context.Context, exec boil.ContextExecutor, ins
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comments\" SET %s WHERE %s",
+				"UPDATE \"suggested_edits\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2002,15 +5535,15 @@ func (o *Tenant) AddComments(ctx context.Context, exec boil.ContextExecutor, ins
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Comments: related,
+			SuggestedEdits: related,
 		}
 	} else {
-		o.R.Comments = append(o.R.Comments, related...)
+		o.R.SuggestedEdits = append(o.R.SuggestedEdits, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
+			rel.R = &suggestedEditR{
 				Tenant: o,
 			}
 		} else {
@@ -2020,11 +5553,11 @@ func (o *Tenant) AddComments(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
-// AddPosts adds the given related objects to the existing relationships
+// AddTags adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Posts.
+// Appends related to o.R.Tags.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+func (o *Tenant) AddTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2034,9 +5567,9 @@ func (o *Tenant) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"posts\" SET %s WHERE %s",
+				"UPDATE \"tags\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, tagPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2055,15 +5588,15 @@ func (o *Tenant) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Posts: related,
+			Tags: related,
 		}
 	} else {
-		o.R.Posts = append(o.R.Posts, related...)
+		o.R.Tags = append(o.R.Tags, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &postR{
+			rel.R = &tagR{
 				Tenant: o,
 			}
 		} else {
@@ -2073,11 +5606,11 @@ func (o *Tenant) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
-// AddRoles adds the given related objects to the existing relationships
+// AddTenantOidcProviders adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Roles.
+// Appends related to o.R.TenantOidcProviders.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+func (o *Tenant) AddTenantOidcProviders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TenantOidcProvider) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2087,11 +5620,11 @@ func (o *Tenant) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"roles\" SET %s WHERE %s",
+				"UPDATE \"tenant_oidc_providers\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, rolePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, tenantOidcProviderPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.TenantID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2108,15 +5641,15 @@ func (o *Tenant) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Roles: related,
+			TenantOidcProviders: related,
 		}
 	} else {
-		o.R.Roles = append(o.R.Roles, related...)
+		o.R.TenantOidcProviders = append(o.R.TenantOidcProviders, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &roleR{
+			rel.R = &tenantOidcProviderR{
 				Tenant: o,
 			}
 		} else {
@@ -2126,11 +5659,11 @@ func (o *Tenant) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
-// AddSubTopics adds the given related objects to the existing relationships
+// AddTenantSamlProviders adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.SubTopics.
+// Appends related to o.R.TenantSamlProviders.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+func (o *Tenant) AddTenantSamlProviders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TenantSamlProvider) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2140,11 +5673,11 @@ func (o *Tenant) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, in
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"sub_topics\" SET %s WHERE %s",
+				"UPDATE \"tenant_saml_providers\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, subTopicPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, tenantSamlProviderPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.TenantID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2161,15 +5694,15 @@ func (o *Tenant) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, in
 
 	if o.R == nil {
 		o.R = &tenantR{
-			SubTopics: related,
+			TenantSamlProviders: related,
 		}
 	} else {
-		o.R.SubTopics = append(o.R.SubTopics, related...)
+		o.R.TenantSamlProviders = append(o.R.TenantSamlProviders, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &subTopicR{
+			rel.R = &tenantSamlProviderR{
 				Tenant: o,
 			}
 		} else {
@@ -2179,11 +5712,11 @@ func (o *Tenant) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
-// AddTags adds the given related objects to the existing relationships
+// AddTopicModerators adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Tags.
+// Appends related to o.R.TopicModerators.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
+func (o *Tenant) AddTopicModerators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TopicModerator) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2193,9 +5726,9 @@ func (o *Tenant) AddTags(ctx context.Context, exec boil.ContextExecutor, insert
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"tags\" SET %s WHERE %s",
+				"UPDATE \"topic_moderators\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, tagPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2214,15 +5747,15 @@ func (o *Tenant) AddTags(ctx context.Context, exec boil.ContextExecutor, insert
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Tags: related,
+			TopicModerators: related,
 		}
 	} else {
-		o.R.Tags = append(o.R.Tags, related...)
+		o.R.TopicModerators = append(o.R.TopicModerators, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &tagR{
+			rel.R = &topicModeratorR{
 				Tenant: o,
 			}
 		} else {
@@ -2285,6 +5818,59 @@ func (o *Tenant) AddTopics(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddUserIdentities adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_identities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			UserIdentities: related,
+		}
+	} else {
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userIdentityR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
 // AddUsers adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
 // Appends related to o.R.Users.
@@ -2391,6 +5977,59 @@ func (o *Tenant) AddVotes(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddWebauthnCredentials adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCredentials.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddWebauthnCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCredential) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			WebauthnCredentials: related,
+		}
+	} else {
+		o.R.WebauthnCredentials = append(o.R.WebauthnCredentials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnCredentialR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
 // Tenants retrieves all the records using an executor.
 func Tenants(mods ...qm.QueryMod) tenantQuery {
 	mods = append(mods, qm.From("\"tenants\""))
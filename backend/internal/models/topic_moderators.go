@@ -0,0 +1,1839 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TopicModerator is an object representing the database table.
+type TopicModerator struct {
+	ID         int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID     int64      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TopicID    null.Int64 `boil:"topic_id" json:"topic_id,omitempty" toml:"topic_id" yaml:"topic_id,omitempty"`
+	SubTopicID null.Int64 `boil:"sub_topic_id" json:"sub_topic_id,omitempty" toml:"sub_topic_id" yaml:"sub_topic_id,omitempty"`
+	TenantID   int64      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt  time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *topicModeratorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L topicModeratorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TopicModeratorColumns = struct {
+	ID         string
+	UserID     string
+	TopicID    string
+	SubTopicID string
+	TenantID   string
+	CreatedAt  string
+}{
+	ID:         "id",
+	UserID:     "user_id",
+	TopicID:    "topic_id",
+	SubTopicID: "sub_topic_id",
+	TenantID:   "tenant_id",
+	CreatedAt:  "created_at",
+}
+
+var TopicModeratorTableColumns = struct {
+	ID         string
+	UserID     string
+	TopicID    string
+	SubTopicID string
+	TenantID   string
+	CreatedAt  string
+}{
+	ID:         "topic_moderators.id",
+	UserID:     "topic_moderators.user_id",
+	TopicID:    "topic_moderators.topic_id",
+	SubTopicID: "topic_moderators.sub_topic_id",
+	TenantID:   "topic_moderators.tenant_id",
+	CreatedAt:  "topic_moderators.created_at",
+}
+
+// Generated where
+
+var TopicModeratorWhere = struct {
+	ID         whereHelperint64
+	UserID     whereHelperint64
+	TopicID    whereHelpernull_Int64
+	SubTopicID whereHelpernull_Int64
+	TenantID   whereHelperint64
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint64{field: "\"topic_moderators\".\"id\""},
+	UserID:     whereHelperint64{field: "\"topic_moderators\".\"user_id\""},
+	TopicID:    whereHelpernull_Int64{field: "\"topic_moderators\".\"topic_id\""},
+	SubTopicID: whereHelpernull_Int64{field: "\"topic_moderators\".\"sub_topic_id\""},
+	TenantID:   whereHelperint64{field: "\"topic_moderators\".\"tenant_id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"topic_moderators\".\"created_at\""},
+}
+
+// TopicModeratorRels is where relationship names are stored.
+var TopicModeratorRels = struct {
+	SubTopic string
+	Tenant   string
+	Topic    string
+	User     string
+}{
+	SubTopic: "SubTopic",
+	Tenant:   "Tenant",
+	Topic:    "Topic",
+	User:     "User",
+}
+
+// topicModeratorR is where relationships are stored.
+type topicModeratorR struct {
+	SubTopic *SubTopic `boil:"SubTopic" json:"SubTopic" toml:"SubTopic" yaml:"SubTopic"`
+	Tenant   *Tenant   `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Topic    *Topic    `boil:"Topic" json:"Topic" toml:"Topic" yaml:"Topic"`
+	User     *User     `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*topicModeratorR) NewStruct() *topicModeratorR {
+	return &topicModeratorR{}
+}
+
+func (o *TopicModerator) GetSubTopic() *SubTopic {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubTopic()
+}
+
+func (r *topicModeratorR) GetSubTopic() *SubTopic {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubTopic
+}
+
+func (o *TopicModerator) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *topicModeratorR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *TopicModerator) GetTopic() *Topic {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopic()
+}
+
+func (r *topicModeratorR) GetTopic() *Topic {
+	if r == nil {
+		return nil
+	}
+
+	return r.Topic
+}
+
+func (o *TopicModerator) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *topicModeratorR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// topicModeratorL is where Load methods for each relationship are stored.
+type topicModeratorL struct{}
+
+var (
+	topicModeratorAllColumns            = []string{"id", "user_id", "topic_id", "sub_topic_id", "tenant_id", "created_at"}
+	topicModeratorColumnsWithoutDefault = []string{"user_id", "tenant_id"}
+	topicModeratorColumnsWithDefault    = []string{"id", "topic_id", "sub_topic_id", "created_at"}
+	topicModeratorPrimaryKeyColumns     = []string{"id"}
+	topicModeratorGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// TopicModeratorSlice is an alias for a slice of pointers to TopicModerator.
+	// This should almost always be used instead of []TopicModerator.
+	TopicModeratorSlice []*TopicModerator
+	// TopicModeratorHook is the signature for custom TopicModerator hook methods
+	TopicModeratorHook func(context.Context, boil.ContextExecutor, *TopicModerator) error
+
+	topicModeratorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	topicModeratorType                 = reflect.TypeOf(&TopicModerator{})
+	topicModeratorMapping              = queries.MakeStructMapping(topicModeratorType)
+	topicModeratorPrimaryKeyMapping, _ = queries.BindMapping(topicModeratorType, topicModeratorMapping, topicModeratorPrimaryKeyColumns)
+	topicModeratorInsertCacheMut       sync.RWMutex
+	topicModeratorInsertCache          = make(map[string]insertCache)
+	topicModeratorUpdateCacheMut       sync.RWMutex
+	topicModeratorUpdateCache          = make(map[string]updateCache)
+	topicModeratorUpsertCacheMut       sync.RWMutex
+	topicModeratorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var topicModeratorAfterSelectMu sync.Mutex
+var topicModeratorAfterSelectHooks []TopicModeratorHook
+
+var topicModeratorBeforeInsertMu sync.Mutex
+var topicModeratorBeforeInsertHooks []TopicModeratorHook
+var topicModeratorAfterInsertMu sync.Mutex
+var topicModeratorAfterInsertHooks []TopicModeratorHook
+
+var topicModeratorBeforeUpdateMu sync.Mutex
+var topicModeratorBeforeUpdateHooks []TopicModeratorHook
+var topicModeratorAfterUpdateMu sync.Mutex
+var topicModeratorAfterUpdateHooks []TopicModeratorHook
+
+var topicModeratorBeforeDeleteMu sync.Mutex
+var topicModeratorBeforeDeleteHooks []TopicModeratorHook
+var topicModeratorAfterDeleteMu sync.Mutex
+var topicModeratorAfterDeleteHooks []TopicModeratorHook
+
+var topicModeratorBeforeUpsertMu sync.Mutex
+var topicModeratorBeforeUpsertHooks []TopicModeratorHook
+var topicModeratorAfterUpsertMu sync.Mutex
+var topicModeratorAfterUpsertHooks []TopicModeratorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TopicModerator) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TopicModerator) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TopicModerator) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TopicModerator) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TopicModerator) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TopicModerator) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TopicModerator) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TopicModerator) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TopicModerator) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range topicModeratorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTopicModeratorHook registers your hook function for all future operations.
+func AddTopicModeratorHook(hookPoint boil.HookPoint, topicModeratorHook TopicModeratorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		topicModeratorAfterSelectMu.Lock()
+		topicModeratorAfterSelectHooks = append(topicModeratorAfterSelectHooks, topicModeratorHook)
+		topicModeratorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		topicModeratorBeforeInsertMu.Lock()
+		topicModeratorBeforeInsertHooks = append(topicModeratorBeforeInsertHooks, topicModeratorHook)
+		topicModeratorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		topicModeratorAfterInsertMu.Lock()
+		topicModeratorAfterInsertHooks = append(topicModeratorAfterInsertHooks, topicModeratorHook)
+		topicModeratorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		topicModeratorBeforeUpdateMu.Lock()
+		topicModeratorBeforeUpdateHooks = append(topicModeratorBeforeUpdateHooks, topicModeratorHook)
+		topicModeratorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		topicModeratorAfterUpdateMu.Lock()
+		topicModeratorAfterUpdateHooks = append(topicModeratorAfterUpdateHooks, topicModeratorHook)
+		topicModeratorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		topicModeratorBeforeDeleteMu.Lock()
+		topicModeratorBeforeDeleteHooks = append(topicModeratorBeforeDeleteHooks, topicModeratorHook)
+		topicModeratorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		topicModeratorAfterDeleteMu.Lock()
+		topicModeratorAfterDeleteHooks = append(topicModeratorAfterDeleteHooks, topicModeratorHook)
+		topicModeratorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		topicModeratorBeforeUpsertMu.Lock()
+		topicModeratorBeforeUpsertHooks = append(topicModeratorBeforeUpsertHooks, topicModeratorHook)
+		topicModeratorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		topicModeratorAfterUpsertMu.Lock()
+		topicModeratorAfterUpsertHooks = append(topicModeratorAfterUpsertHooks, topicModeratorHook)
+		topicModeratorAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single topicModerator record from the query.
+func (q topicModeratorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TopicModerator, error) {
+	o := &TopicModerator{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for topic_moderators")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TopicModerator records from the query.
+func (q topicModeratorQuery) All(ctx context.Context, exec boil.ContextExecutor) (TopicModeratorSlice, error) {
+	var o []*TopicModerator
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TopicModerator slice")
+	}
+
+	if len(topicModeratorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TopicModerator records in the query.
+func (q topicModeratorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count topic_moderators rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q topicModeratorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if topic_moderators exists")
+	}
+
+	return count > 0, nil
+}
+
+// SubTopic pointed to by the foreign key.
+func (o *TopicModerator) SubTopic(mods ...qm.QueryMod) subTopicQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SubTopicID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SubTopics(queryMods...)
+}
+
+// Tenant pointed to by the foreign key.
+func (o *TopicModerator) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// Topic pointed to by the foreign key.
+func (o *TopicModerator) Topic(mods ...qm.QueryMod) topicQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TopicID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Topics(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *TopicModerator) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadSubTopic allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (topicModeratorL) LoadSubTopic(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopicModerator interface{}, mods queries.Applicator) error {
+	var slice []*TopicModerator
+	var object *TopicModerator
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopicModerator.(*TopicModerator)
+		if !ok {
+			object = new(TopicModerator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopicModerator))
+			}
+		}
+	} else {
+		s, ok := maybeTopicModerator.(*[]*TopicModerator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopicModerator))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicModeratorR{}
+		}
+		if !queries.IsNil(object.SubTopicID) {
+			args[object.SubTopicID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicModeratorR{}
+			}
+
+			if !queries.IsNil(obj.SubTopicID) {
+				args[obj.SubTopicID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`sub_topics`),
+		qm.WhereIn(`sub_topics.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SubTopic")
+	}
+
+	var resultSlice []*SubTopic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SubTopic")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for sub_topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+	}
+
+	if len(subTopicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SubTopic = foreign
+		if foreign.R == nil {
+			foreign.R = &subTopicR{}
+		}
+		foreign.R.TopicModerators = append(foreign.R.TopicModerators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SubTopicID, foreign.ID) {
+				local.R.SubTopic = foreign
+				if foreign.R == nil {
+					foreign.R = &subTopicR{}
+				}
+				foreign.R.TopicModerators = append(foreign.R.TopicModerators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (topicModeratorL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopicModerator interface{}, mods queries.Applicator) error {
+	var slice []*TopicModerator
+	var object *TopicModerator
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopicModerator.(*TopicModerator)
+		if !ok {
+			object = new(TopicModerator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopicModerator))
+			}
+		}
+	} else {
+		s, ok := maybeTopicModerator.(*[]*TopicModerator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopicModerator))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicModeratorR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicModeratorR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.TopicModerators = append(foreign.R.TopicModerators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.TopicModerators = append(foreign.R.TopicModerators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopic allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (topicModeratorL) LoadTopic(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopicModerator interface{}, mods queries.Applicator) error {
+	var slice []*TopicModerator
+	var object *TopicModerator
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopicModerator.(*TopicModerator)
+		if !ok {
+			object = new(TopicModerator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopicModerator))
+			}
+		}
+	} else {
+		s, ok := maybeTopicModerator.(*[]*TopicModerator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopicModerator))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicModeratorR{}
+		}
+		if !queries.IsNil(object.TopicID) {
+			args[object.TopicID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicModeratorR{}
+			}
+
+			if !queries.IsNil(obj.TopicID) {
+				args[obj.TopicID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`topics`),
+		qm.WhereIn(`topics.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Topic")
+	}
+
+	var resultSlice []*Topic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Topic")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topics")
+	}
+
+	if len(topicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Topic = foreign
+		if foreign.R == nil {
+			foreign.R = &topicR{}
+		}
+		foreign.R.TopicModerators = append(foreign.R.TopicModerators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TopicID, foreign.ID) {
+				local.R.Topic = foreign
+				if foreign.R == nil {
+					foreign.R = &topicR{}
+				}
+				foreign.R.TopicModerators = append(foreign.R.TopicModerators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (topicModeratorL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopicModerator interface{}, mods queries.Applicator) error {
+	var slice []*TopicModerator
+	var object *TopicModerator
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopicModerator.(*TopicModerator)
+		if !ok {
+			object = new(TopicModerator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopicModerator))
+			}
+		}
+	} else {
+		s, ok := maybeTopicModerator.(*[]*TopicModerator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopicModerator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopicModerator))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicModeratorR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicModeratorR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TopicModerators = append(foreign.R.TopicModerators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TopicModerators = append(foreign.R.TopicModerators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubTopic of the topicModerator to the related item.
+// Sets o.R.SubTopic to related.
+// Adds o to related.R.TopicModerators.
+func (o *TopicModerator) SetSubTopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SubTopic) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"topic_moderators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"sub_topic_id"}),
+		strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SubTopicID, related.ID)
+	if o.R == nil {
+		o.R = &topicModeratorR{
+			SubTopic: related,
+		}
+	} else {
+		o.R.SubTopic = related
+	}
+
+	if related.R == nil {
+		related.R = &subTopicR{
+			TopicModerators: TopicModeratorSlice{o},
+		}
+	} else {
+		related.R.TopicModerators = append(related.R.TopicModerators, o)
+	}
+
+	return nil
+}
+
+// RemoveSubTopic relationship.
+// Sets o.R.SubTopic to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TopicModerator) RemoveSubTopic(ctx context.Context, exec boil.ContextExecutor, related *SubTopic) error {
+	var err error
+
+	queries.SetScanner(&o.SubTopicID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("sub_topic_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.SubTopic = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TopicModerators {
+		if queries.Equal(o.SubTopicID, ri.SubTopicID) {
+			continue
+		}
+
+		ln := len(related.R.TopicModerators)
+		if ln > 1 && i < ln-1 {
+			related.R.TopicModerators[i] = related.R.TopicModerators[ln-1]
+		}
+		related.R.TopicModerators = related.R.TopicModerators[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenant of the topicModerator to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.TopicModerators.
+func (o *TopicModerator) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"topic_moderators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &topicModeratorR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			TopicModerators: TopicModeratorSlice{o},
+		}
+	} else {
+		related.R.TopicModerators = append(related.R.TopicModerators, o)
+	}
+
+	return nil
+}
+
+// SetTopic of the topicModerator to the related item.
+// Sets o.R.Topic to related.
+// Adds o to related.R.TopicModerators.
+func (o *TopicModerator) SetTopic(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Topic) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"topic_moderators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"topic_id"}),
+		strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TopicID, related.ID)
+	if o.R == nil {
+		o.R = &topicModeratorR{
+			Topic: related,
+		}
+	} else {
+		o.R.Topic = related
+	}
+
+	if related.R == nil {
+		related.R = &topicR{
+			TopicModerators: TopicModeratorSlice{o},
+		}
+	} else {
+		related.R.TopicModerators = append(related.R.TopicModerators, o)
+	}
+
+	return nil
+}
+
+// RemoveTopic relationship.
+// Sets o.R.Topic to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TopicModerator) RemoveTopic(ctx context.Context, exec boil.ContextExecutor, related *Topic) error {
+	var err error
+
+	queries.SetScanner(&o.TopicID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("topic_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Topic = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TopicModerators {
+		if queries.Equal(o.TopicID, ri.TopicID) {
+			continue
+		}
+
+		ln := len(related.R.TopicModerators)
+		if ln > 1 && i < ln-1 {
+			related.R.TopicModerators[i] = related.R.TopicModerators[ln-1]
+		}
+		related.R.TopicModerators = related.R.TopicModerators[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the topicModerator to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TopicModerators.
+func (o *TopicModerator) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"topic_moderators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &topicModeratorR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TopicModerators: TopicModeratorSlice{o},
+		}
+	} else {
+		related.R.TopicModerators = append(related.R.TopicModerators, o)
+	}
+
+	return nil
+}
+
+// TopicModerators retrieves all the records using an executor.
+func TopicModerators(mods ...qm.QueryMod) topicModeratorQuery {
+	mods = append(mods, qm.From("\"topic_moderators\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"topic_moderators\".*"})
+	}
+
+	return topicModeratorQuery{q}
+}
+
+// FindTopicModerator retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTopicModerator(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*TopicModerator, error) {
+	topicModeratorObj := &TopicModerator{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"topic_moderators\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, topicModeratorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from topic_moderators")
+	}
+
+	if err = topicModeratorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return topicModeratorObj, err
+	}
+
+	return topicModeratorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TopicModerator) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no topic_moderators provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(topicModeratorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	topicModeratorInsertCacheMut.RLock()
+	cache, cached := topicModeratorInsertCache[key]
+	topicModeratorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			topicModeratorAllColumns,
+			topicModeratorColumnsWithDefault,
+			topicModeratorColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, topicModeratorGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(topicModeratorType, topicModeratorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(topicModeratorType, topicModeratorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"topic_moderators\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"topic_moderators\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into topic_moderators")
+	}
+
+	if !cached {
+		topicModeratorInsertCacheMut.Lock()
+		topicModeratorInsertCache[key] = cache
+		topicModeratorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TopicModerator.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TopicModerator) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	topicModeratorUpdateCacheMut.RLock()
+	cache, cached := topicModeratorUpdateCache[key]
+	topicModeratorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			topicModeratorAllColumns,
+			topicModeratorPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, topicModeratorGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update topic_moderators, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"topic_moderators\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, topicModeratorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(topicModeratorType, topicModeratorMapping, append(wl, topicModeratorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update topic_moderators row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for topic_moderators")
+	}
+
+	if !cached {
+		topicModeratorUpdateCacheMut.Lock()
+		topicModeratorUpdateCache[key] = cache
+		topicModeratorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q topicModeratorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for topic_moderators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for topic_moderators")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TopicModeratorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), topicModeratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"topic_moderators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, topicModeratorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in topicModerator slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all topicModerator")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TopicModerator) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no topic_moderators provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(topicModeratorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	topicModeratorUpsertCacheMut.RLock()
+	cache, cached := topicModeratorUpsertCache[key]
+	topicModeratorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			topicModeratorAllColumns,
+			topicModeratorColumnsWithDefault,
+			topicModeratorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			topicModeratorAllColumns,
+			topicModeratorPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, topicModeratorGeneratedColumns)
+		update = strmangle.SetComplement(update, topicModeratorGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert topic_moderators, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(topicModeratorAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(topicModeratorPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert topic_moderators, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(topicModeratorPrimaryKeyColumns))
+			copy(conflict, topicModeratorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"topic_moderators\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(topicModeratorType, topicModeratorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(topicModeratorType, topicModeratorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert topic_moderators")
+	}
+
+	if !cached {
+		topicModeratorUpsertCacheMut.Lock()
+		topicModeratorUpsertCache[key] = cache
+		topicModeratorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TopicModerator record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TopicModerator) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TopicModerator provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), topicModeratorPrimaryKeyMapping)
+	sql := "DELETE FROM \"topic_moderators\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from topic_moderators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for topic_moderators")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q topicModeratorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no topicModeratorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from topic_moderators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for topic_moderators")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TopicModeratorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(topicModeratorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), topicModeratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"topic_moderators\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, topicModeratorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from topicModerator slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for topic_moderators")
+	}
+
+	if len(topicModeratorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TopicModerator) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTopicModerator(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TopicModeratorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TopicModeratorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), topicModeratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"topic_moderators\".* FROM \"topic_moderators\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, topicModeratorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TopicModeratorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TopicModeratorExists checks if the TopicModerator row exists.
+func TopicModeratorExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"topic_moderators\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if topic_moderators exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TopicModerator row exists.
+func (o *TopicModerator) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TopicModeratorExists(ctx, exec, o.ID)
+}
@@ -24,73 +24,113 @@ import (
 
 // Topic is an object representing the database table.
 type Topic struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	TenantID  int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID          int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	TenantID    int64       `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Description string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Icon        null.String `boil:"icon" json:"icon,omitempty" toml:"icon" yaml:"icon,omitempty"`
+	Color       null.String `boil:"color" json:"color,omitempty" toml:"color" yaml:"color,omitempty"`
+	Archived    bool        `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
 
 	R *topicR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L topicL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TopicColumns = struct {
-	ID        string
-	Name      string
-	TenantID  string
-	CreatedAt string
-	UpdatedAt string
+	ID          string
+	Name        string
+	TenantID    string
+	CreatedAt   string
+	UpdatedAt   string
+	Description string
+	Icon        string
+	Color       string
+	Archived    string
 }{
-	ID:        "id",
-	Name:      "name",
-	TenantID:  "tenant_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:          "id",
+	Name:        "name",
+	TenantID:    "tenant_id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	Description: "description",
+	Icon:        "icon",
+	Color:       "color",
+	Archived:    "archived",
 }
 
 var TopicTableColumns = struct {
-	ID        string
-	Name      string
-	TenantID  string
-	CreatedAt string
-	UpdatedAt string
+	ID          string
+	Name        string
+	TenantID    string
+	CreatedAt   string
+	UpdatedAt   string
+	Description string
+	Icon        string
+	Color       string
+	Archived    string
 }{
-	ID:        "topics.id",
-	Name:      "topics.name",
-	TenantID:  "topics.tenant_id",
-	CreatedAt: "topics.created_at",
-	UpdatedAt: "topics.updated_at",
+	ID:          "topics.id",
+	Name:        "topics.name",
+	TenantID:    "topics.tenant_id",
+	CreatedAt:   "topics.created_at",
+	UpdatedAt:   "topics.updated_at",
+	Description: "topics.description",
+	Icon:        "topics.icon",
+	Color:       "topics.color",
+	Archived:    "topics.archived",
 }
 
 // Generated where
 
 var TopicWhere = struct {
-	ID        whereHelperint64
-	Name      whereHelperstring
-	TenantID  whereHelperint64
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpernull_Time
+	ID          whereHelperint64
+	Name        whereHelperstring
+	TenantID    whereHelperint64
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+	Description whereHelperstring
+	Icon        whereHelpernull_String
+	Color       whereHelpernull_String
+	Archived    whereHelperbool
 }{
-	ID:        whereHelperint64{field: "\"topics\".\"id\""},
-	Name:      whereHelperstring{field: "\"topics\".\"name\""},
-	TenantID:  whereHelperint64{field: "\"topics\".\"tenant_id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"topics\".\"created_at\""},
-	UpdatedAt: whereHelpernull_Time{field: "\"topics\".\"updated_at\""},
+	ID:          whereHelperint64{field: "\"topics\".\"id\""},
+	Name:        whereHelperstring{field: "\"topics\".\"name\""},
+	TenantID:    whereHelperint64{field: "\"topics\".\"tenant_id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"topics\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"topics\".\"updated_at\""},
+	Description: whereHelperstring{field: "\"topics\".\"description\""},
+	Icon:        whereHelpernull_String{field: "\"topics\".\"icon\""},
+	Color:       whereHelpernull_String{field: "\"topics\".\"color\""},
+	Archived:    whereHelperbool{field: "\"topics\".\"archived\""},
 }
 
 // TopicRels is where relationship names are stored.
 var TopicRels = struct {
-	Tenant    string
-	SubTopics string
+	Tenant          string
+	Category        string
+	SubTopics       string
+	Claims          string
+	TopicModerators string
+	Roles           string
 }{
-	Tenant:    "Tenant",
-	SubTopics: "SubTopics",
+	Tenant:          "Tenant",
+	Category:        "Category",
+	SubTopics:       "SubTopics",
+	Claims:          "Claims",
+	TopicModerators: "TopicModerators",
+	Roles:           "Roles",
 }
 
 // topicR is where relationships are stored.
 type topicR struct {
-	Tenant    *Tenant       `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	SubTopics SubTopicSlice `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
+	Tenant          *Tenant             `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Category        *Category           `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	SubTopics       SubTopicSlice       `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
+	Claims          ClaimSlice          `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
+	TopicModerators TopicModeratorSlice `boil:"TopicModerators" json:"TopicModerators" toml:"TopicModerators" yaml:"TopicModerators"`
+	Roles           RoleSlice           `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
 }
 
 // NewStruct creates a new relationship struct
@@ -114,6 +154,22 @@ func (r *topicR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *Topic) GetCategory() *Category {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategory()
+}
+
+func (r *topicR) GetCategory() *Category {
+	if r == nil {
+		return nil
+	}
+
+	return r.Category
+}
+
 func (o *Topic) GetSubTopics() SubTopicSlice {
 	if o == nil {
 		return nil
@@ -130,13 +186,61 @@ func (r *topicR) GetSubTopics() SubTopicSlice {
 	return r.SubTopics
 }
 
+func (o *Topic) GetClaims() ClaimSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetClaims()
+}
+
+func (r *topicR) GetClaims() ClaimSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Claims
+}
+
+func (o *Topic) GetTopicModerators() TopicModeratorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopicModerators()
+}
+
+func (r *topicR) GetTopicModerators() TopicModeratorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TopicModerators
+}
+
+func (o *Topic) GetRoles() RoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRoles()
+}
+
+func (r *topicR) GetRoles() RoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Roles
+}
+
 // topicL is where Load methods for each relationship are stored.
 type topicL struct{}
 
 var (
-	topicAllColumns            = []string{"id", "name", "tenant_id", "created_at", "updated_at"}
+	topicAllColumns            = []string{"id", "name", "tenant_id", "created_at", "updated_at", "description", "icon", "color", "archived"}
 	topicColumnsWithoutDefault = []string{"name", "tenant_id"}
-	topicColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	topicColumnsWithDefault    = []string{"id", "created_at", "updated_at", "description", "icon", "color", "archived"}
 	topicPrimaryKeyColumns     = []string{"id"}
 	topicGeneratedColumns      = []string{"id"}
 )
@@ -457,6 +561,17 @@ func (o *Topic) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// Category pointed to by the foreign key.
+func (o *Topic) Category(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"topic_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
 // SubTopics retrieves all the sub_topic's SubTopics with an executor.
 func (o *Topic) SubTopics(mods ...qm.QueryMod) subTopicQuery {
 	var queryMods []qm.QueryMod
@@ -471,6 +586,50 @@ func (o *Topic) SubTopics(mods ...qm.QueryMod) subTopicQuery {
 	return SubTopics(queryMods...)
 }
 
+// Claims retrieves all the claim's Claims with an executor.
+func (o *Topic) Claims(mods ...qm.QueryMod) claimQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"topic_claims\" on \"claims\".\"id\" = \"topic_claims\".\"claim_id\""),
+		qm.Where("\"topic_claims\".\"topic_id\"=?", o.ID),
+	)
+
+	return Claims(queryMods...)
+}
+
+// TopicModerators retrieves all the topic_moderator's TopicModerators with an executor.
+func (o *Topic) TopicModerators(mods ...qm.QueryMod) topicModeratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"topic_moderators\".\"topic_id\"=?", o.ID),
+	)
+
+	return TopicModerators(queryMods...)
+}
+
+// Roles retrieves all the role's Roles with an executor.
+func (o *Topic) Roles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"topic_roles\" on \"roles\".\"id\" = \"topic_roles\".\"role_id\""),
+		qm.Where("\"topic_roles\".\"topic_id\"=?", o.ID),
+	)
+
+	return Roles(queryMods...)
+}
+
 // LoadTenant allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (topicL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopic interface{}, mods queries.Applicator) error {
@@ -591,6 +750,123 @@ func (topicL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (topicL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopic interface{}, mods queries.Applicator) error {
+	var slice []*Topic
+	var object *Topic
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopic.(*Topic)
+		if !ok {
+			object = new(Topic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopic))
+			}
+		}
+	} else {
+		s, ok := maybeTopic.(*[]*Topic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.topic_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Category")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
+		}
+		foreign.R.Topic = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.TopicID) {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Topic = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSubTopics allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (topicL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopic interface{}, mods queries.Applicator) error {
@@ -704,54 +980,501 @@ func (topicL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// SetTenant of the topic to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.Topics.
-func (o *Topic) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"topics\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-		strmangle.WhereClause("\"", "\"", 2, topicPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadClaims allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (topicL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopic interface{}, mods queries.Applicator) error {
+	var slice []*Topic
+	var object *Topic
 
-	o.TenantID = related.ID
-	if o.R == nil {
-		o.R = &topicR{
-			Tenant: related,
+	if singular {
+		var ok bool
+		object, ok = maybeTopic.(*Topic)
+		if !ok {
+			object = new(Topic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopic))
+			}
 		}
 	} else {
-		o.R.Tenant = related
+		s, ok := maybeTopic.(*[]*Topic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopic))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &tenantR{
-			Topics: TopicSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		related.R.Topics = append(related.R.Topics, o)
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	return nil
-}
-
-// AddSubTopics adds the given related objects to the existing relationships
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"claims\".\"id\", \"claims\".\"name\", \"claims\".\"description\", \"claims\".\"tenant_id\", \"claims\".\"created_at\", \"claims\".\"updated_at\", \"a\".\"topic_id\""),
+		qm.From("\"claims\""),
+		qm.InnerJoin("\"topic_claims\" as \"a\" on \"claims\".\"id\" = \"a\".\"claim_id\""),
+		qm.WhereIn("\"a\".\"topic_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load claims")
+	}
+
+	var resultSlice []*Claim
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Claim)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for claims")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice claims")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on claims")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claims")
+	}
+
+	if len(claimAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Claims = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &claimR{}
+			}
+			foreign.R.Topics = append(foreign.R.Topics, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Claims = append(local.R.Claims, foreign)
+				if foreign.R == nil {
+					foreign.R = &claimR{}
+				}
+				foreign.R.Topics = append(foreign.R.Topics, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopicModerators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (topicL) LoadTopicModerators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopic interface{}, mods queries.Applicator) error {
+	var slice []*Topic
+	var object *Topic
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopic.(*Topic)
+		if !ok {
+			object = new(Topic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopic))
+			}
+		}
+	} else {
+		s, ok := maybeTopic.(*[]*Topic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`topic_moderators`),
+		qm.WhereIn(`topic_moderators.topic_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topic_moderators")
+	}
+
+	var resultSlice []*TopicModerator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice topic_moderators")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topic_moderators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topic_moderators")
+	}
+
+	if len(topicModeratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TopicModerators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicModeratorR{}
+			}
+			foreign.R.Topic = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TopicID) {
+				local.R.TopicModerators = append(local.R.TopicModerators, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicModeratorR{}
+				}
+				foreign.R.Topic = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (topicL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTopic interface{}, mods queries.Applicator) error {
+	var slice []*Topic
+	var object *Topic
+
+	if singular {
+		var ok bool
+		object, ok = maybeTopic.(*Topic)
+		if !ok {
+			object = new(Topic)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTopic))
+			}
+		}
+	} else {
+		s, ok := maybeTopic.(*[]*Topic)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTopic)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTopic))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &topicR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &topicR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"roles\".\"id\", \"roles\".\"name\", \"roles\".\"tenant_id\", \"roles\".\"created_at\", \"roles\".\"updated_at\", \"a\".\"topic_id\""),
+		qm.From("\"roles\""),
+		qm.InnerJoin("\"topic_roles\" as \"a\" on \"roles\".\"id\" = \"a\".\"role_id\""),
+		qm.WhereIn("\"a\".\"topic_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Role)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for roles")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice roles")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Roles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.Topics = append(foreign.R.Topics, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Roles = append(local.R.Roles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.Topics = append(foreign.R.Topics, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the topic to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Topics.
+func (o *Topic) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"topics\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, topicPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &topicR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Topics: TopicSlice{o},
+		}
+	} else {
+		related.R.Topics = append(related.R.Topics, o)
+	}
+
+	return nil
+}
+
+// SetCategory of the topic to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.Topic.
+func (o *Topic) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.TopicID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"categories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"topic_id"}),
+			strmangle.WhereClause("\"", "\"", 2, categoryPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.TopicID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &topicR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			Topic: o,
+		}
+	} else {
+		related.R.Topic = o
+	}
+	return nil
+}
+
+// RemoveCategory relationship.
+// Sets o.R.Category to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Topic) RemoveCategory(ctx context.Context, exec boil.ContextExecutor, related *Category) error {
+	var err error
+
+	queries.SetScanner(&related.TopicID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("topic_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Category = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Topic = nil
+
+	return nil
+}
+
+// AddSubTopics adds the given related objects to the existing relationships
 // of the topic, optionally inserting them as new records.
 // Appends related to o.R.SubTopics.
 // Sets related.R.Topic appropriately.
@@ -804,6 +1527,423 @@ func (o *Topic) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddClaims adds the given related objects to the existing relationships
+// of the topic, optionally inserting them as new records.
+// Appends related to o.R.Claims.
+// Sets related.R.Topics appropriately.
+func (o *Topic) AddClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"topic_claims\" (\"topic_id\", \"claim_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &topicR{
+			Claims: related,
+		}
+	} else {
+		o.R.Claims = append(o.R.Claims, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &claimR{
+				Topics: TopicSlice{o},
+			}
+		} else {
+			rel.R.Topics = append(rel.R.Topics, o)
+		}
+	}
+	return nil
+}
+
+// SetClaims removes all previously related items of the
+// topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Topics's Claims accordingly.
+// Replaces o.R.Claims with related.
+// Sets related.R.Topics's Claims accordingly.
+func (o *Topic) SetClaims(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Claim) error {
+	query := "delete from \"topic_claims\" where \"topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeClaimsFromTopicsSlice(o, related)
+	if o.R != nil {
+		o.R.Claims = nil
+	}
+
+	return o.AddClaims(ctx, exec, insert, related...)
+}
+
+// RemoveClaims relationships from objects passed in.
+// Removes related items from R.Claims (uses pointer comparison, removal does not keep order)
+// Sets related.R.Topics.
+func (o *Topic) RemoveClaims(ctx context.Context, exec boil.ContextExecutor, related ...*Claim) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"topic_claims\" where \"topic_id\" = $1 and \"claim_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeClaimsFromTopicsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Claims {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Claims)
+			if ln > 1 && i < ln-1 {
+				o.R.Claims[i] = o.R.Claims[ln-1]
+			}
+			o.R.Claims = o.R.Claims[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeClaimsFromTopicsSlice(o *Topic, related []*Claim) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Topics {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Topics)
+			if ln > 1 && i < ln-1 {
+				rel.R.Topics[i] = rel.R.Topics[ln-1]
+			}
+			rel.R.Topics = rel.R.Topics[:ln-1]
+			break
+		}
+	}
+}
+
+// AddTopicModerators adds the given related objects to the existing relationships
+// of the topic, optionally inserting them as new records.
+// Appends related to o.R.TopicModerators.
+// Sets related.R.Topic appropriately.
+func (o *Topic) AddTopicModerators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TopicModerator) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TopicID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"topic_moderators\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"topic_id"}),
+				strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TopicID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &topicR{
+			TopicModerators: related,
+		}
+	} else {
+		o.R.TopicModerators = append(o.R.TopicModerators, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &topicModeratorR{
+				Topic: o,
+			}
+		} else {
+			rel.R.Topic = o
+		}
+	}
+	return nil
+}
+
+// SetTopicModerators removes all previously related items of the
+// topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Topic's TopicModerators accordingly.
+// Replaces o.R.TopicModerators with related.
+// Sets related.R.Topic's TopicModerators accordingly.
+func (o *Topic) SetTopicModerators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TopicModerator) error {
+	query := "update \"topic_moderators\" set \"topic_id\" = null where \"topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TopicModerators {
+			queries.SetScanner(&rel.TopicID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Topic = nil
+		}
+		o.R.TopicModerators = nil
+	}
+
+	return o.AddTopicModerators(ctx, exec, insert, related...)
+}
+
+// RemoveTopicModerators relationships from objects passed in.
+// Removes related items from R.TopicModerators (uses pointer comparison, removal does not keep order)
+// Sets related.R.Topic.
+func (o *Topic) RemoveTopicModerators(ctx context.Context, exec boil.ContextExecutor, related ...*TopicModerator) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TopicID, nil)
+		if rel.R != nil {
+			rel.R.Topic = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("topic_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TopicModerators {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TopicModerators)
+			if ln > 1 && i < ln-1 {
+				o.R.TopicModerators[i] = o.R.TopicModerators[ln-1]
+			}
+			o.R.TopicModerators = o.R.TopicModerators[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRoles adds the given related objects to the existing relationships
+// of the topic, optionally inserting them as new records.
+// Appends related to o.R.Roles.
+// Sets related.R.Topics appropriately.
+func (o *Topic) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"topic_roles\" (\"topic_id\", \"role_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &topicR{
+			Roles: related,
+		}
+	} else {
+		o.R.Roles = append(o.R.Roles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				Topics: TopicSlice{o},
+			}
+		} else {
+			rel.R.Topics = append(rel.R.Topics, o)
+		}
+	}
+	return nil
+}
+
+// SetRoles removes all previously related items of the
+// topic replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Topics's Roles accordingly.
+// Replaces o.R.Roles with related.
+// Sets related.R.Topics's Roles accordingly.
+func (o *Topic) SetRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	query := "delete from \"topic_roles\" where \"topic_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeRolesFromTopicsSlice(o, related)
+	if o.R != nil {
+		o.R.Roles = nil
+	}
+
+	return o.AddRoles(ctx, exec, insert, related...)
+}
+
+// RemoveRoles relationships from objects passed in.
+// Removes related items from R.Roles (uses pointer comparison, removal does not keep order)
+// Sets related.R.Topics.
+func (o *Topic) RemoveRoles(ctx context.Context, exec boil.ContextExecutor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"topic_roles\" where \"topic_id\" = $1 and \"role_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeRolesFromTopicsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Roles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Roles)
+			if ln > 1 && i < ln-1 {
+				o.R.Roles[i] = o.R.Roles[ln-1]
+			}
+			o.R.Roles = o.R.Roles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeRolesFromTopicsSlice(o *Topic, related []*Role) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Topics {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Topics)
+			if ln > 1 && i < ln-1 {
+				rel.R.Topics[i] = rel.R.Topics[ln-1]
+			}
+			rel.R.Topics = rel.R.Topics[:ln-1]
+			break
+		}
+	}
+}
+
 // Topics retrieves all the records using an executor.
 func Topics(mods ...qm.QueryMod) topicQuery {
 	mods = append(mods, qm.From("\"topics\""))
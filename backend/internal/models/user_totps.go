@@ -0,0 +1,1176 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// UserTotp is an object representing the database table.
+type UserTotp struct {
+	UserID         int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Secret         string    `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	ConfirmedAt    null.Time `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+	LastUsedStep   int64     `boil:"last_used_step" json:"last_used_step" toml:"last_used_step" yaml:"last_used_step"`
+	FailedAttempts int       `boil:"failed_attempts" json:"failed_attempts" toml:"failed_attempts" yaml:"failed_attempts"`
+	LockedUntil    null.Time `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *userTotpR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userTotpL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserTotpColumns = struct {
+	UserID         string
+	Secret         string
+	ConfirmedAt    string
+	LastUsedStep   string
+	FailedAttempts string
+	LockedUntil    string
+	CreatedAt      string
+}{
+	UserID:         "user_id",
+	Secret:         "secret",
+	ConfirmedAt:    "confirmed_at",
+	LastUsedStep:   "last_used_step",
+	FailedAttempts: "failed_attempts",
+	LockedUntil:    "locked_until",
+	CreatedAt:      "created_at",
+}
+
+var UserTotpTableColumns = struct {
+	UserID         string
+	Secret         string
+	ConfirmedAt    string
+	LastUsedStep   string
+	FailedAttempts string
+	LockedUntil    string
+	CreatedAt      string
+}{
+	UserID:         "user_totps.user_id",
+	Secret:         "user_totps.secret",
+	ConfirmedAt:    "user_totps.confirmed_at",
+	LastUsedStep:   "user_totps.last_used_step",
+	FailedAttempts: "user_totps.failed_attempts",
+	LockedUntil:    "user_totps.locked_until",
+	CreatedAt:      "user_totps.created_at",
+}
+
+// Generated where
+
+var UserTotpWhere = struct {
+	UserID         whereHelperint64
+	Secret         whereHelperstring
+	ConfirmedAt    whereHelpernull_Time
+	LastUsedStep   whereHelperint64
+	FailedAttempts whereHelperint
+	LockedUntil    whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+}{
+	UserID:         whereHelperint64{field: "\"user_totps\".\"user_id\""},
+	Secret:         whereHelperstring{field: "\"user_totps\".\"secret\""},
+	ConfirmedAt:    whereHelpernull_Time{field: "\"user_totps\".\"confirmed_at\""},
+	LastUsedStep:   whereHelperint64{field: "\"user_totps\".\"last_used_step\""},
+	FailedAttempts: whereHelperint{field: "\"user_totps\".\"failed_attempts\""},
+	LockedUntil:    whereHelpernull_Time{field: "\"user_totps\".\"locked_until\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"user_totps\".\"created_at\""},
+}
+
+// UserTotpRels is where relationship names are stored.
+var UserTotpRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// userTotpR is where relationships are stored.
+type userTotpR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userTotpR) NewStruct() *userTotpR {
+	return &userTotpR{}
+}
+
+func (o *UserTotp) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *userTotpR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// userTotpL is where Load methods for each relationship are stored.
+type userTotpL struct{}
+
+var (
+	userTotpAllColumns            = []string{"user_id", "secret", "confirmed_at", "last_used_step", "failed_attempts", "locked_until", "created_at"}
+	userTotpColumnsWithoutDefault = []string{"user_id", "secret"}
+	userTotpColumnsWithDefault    = []string{"confirmed_at", "last_used_step", "failed_attempts", "locked_until", "created_at"}
+	userTotpPrimaryKeyColumns     = []string{"user_id"}
+	userTotpGeneratedColumns      = []string{}
+)
+
+type (
+	// UserTotpSlice is an alias for a slice of pointers to UserTotp.
+	// This should almost always be used instead of []UserTotp.
+	UserTotpSlice []*UserTotp
+	// UserTotpHook is the signature for custom UserTotp hook methods
+	UserTotpHook func(context.Context, boil.ContextExecutor, *UserTotp) error
+
+	userTotpQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userTotpType                 = reflect.TypeOf(&UserTotp{})
+	userTotpMapping              = queries.MakeStructMapping(userTotpType)
+	userTotpPrimaryKeyMapping, _ = queries.BindMapping(userTotpType, userTotpMapping, userTotpPrimaryKeyColumns)
+	userTotpInsertCacheMut       sync.RWMutex
+	userTotpInsertCache          = make(map[string]insertCache)
+	userTotpUpdateCacheMut       sync.RWMutex
+	userTotpUpdateCache          = make(map[string]updateCache)
+	userTotpUpsertCacheMut       sync.RWMutex
+	userTotpUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userTotpAfterSelectMu sync.Mutex
+var userTotpAfterSelectHooks []UserTotpHook
+
+var userTotpBeforeInsertMu sync.Mutex
+var userTotpBeforeInsertHooks []UserTotpHook
+var userTotpAfterInsertMu sync.Mutex
+var userTotpAfterInsertHooks []UserTotpHook
+
+var userTotpBeforeUpdateMu sync.Mutex
+var userTotpBeforeUpdateHooks []UserTotpHook
+var userTotpAfterUpdateMu sync.Mutex
+var userTotpAfterUpdateHooks []UserTotpHook
+
+var userTotpBeforeDeleteMu sync.Mutex
+var userTotpBeforeDeleteHooks []UserTotpHook
+var userTotpAfterDeleteMu sync.Mutex
+var userTotpAfterDeleteHooks []UserTotpHook
+
+var userTotpBeforeUpsertMu sync.Mutex
+var userTotpBeforeUpsertHooks []UserTotpHook
+var userTotpAfterUpsertMu sync.Mutex
+var userTotpAfterUpsertHooks []UserTotpHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserTotp) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserTotp) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserTotp) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserTotp) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserTotp) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserTotp) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserTotp) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserTotp) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserTotp) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTotpAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserTotpHook registers your hook function for all future operations.
+func AddUserTotpHook(hookPoint boil.HookPoint, userTotpHook UserTotpHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userTotpAfterSelectMu.Lock()
+		userTotpAfterSelectHooks = append(userTotpAfterSelectHooks, userTotpHook)
+		userTotpAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userTotpBeforeInsertMu.Lock()
+		userTotpBeforeInsertHooks = append(userTotpBeforeInsertHooks, userTotpHook)
+		userTotpBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userTotpAfterInsertMu.Lock()
+		userTotpAfterInsertHooks = append(userTotpAfterInsertHooks, userTotpHook)
+		userTotpAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userTotpBeforeUpdateMu.Lock()
+		userTotpBeforeUpdateHooks = append(userTotpBeforeUpdateHooks, userTotpHook)
+		userTotpBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userTotpAfterUpdateMu.Lock()
+		userTotpAfterUpdateHooks = append(userTotpAfterUpdateHooks, userTotpHook)
+		userTotpAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userTotpBeforeDeleteMu.Lock()
+		userTotpBeforeDeleteHooks = append(userTotpBeforeDeleteHooks, userTotpHook)
+		userTotpBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userTotpAfterDeleteMu.Lock()
+		userTotpAfterDeleteHooks = append(userTotpAfterDeleteHooks, userTotpHook)
+		userTotpAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userTotpBeforeUpsertMu.Lock()
+		userTotpBeforeUpsertHooks = append(userTotpBeforeUpsertHooks, userTotpHook)
+		userTotpBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userTotpAfterUpsertMu.Lock()
+		userTotpAfterUpsertHooks = append(userTotpAfterUpsertHooks, userTotpHook)
+		userTotpAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single userTotp record from the query.
+func (q userTotpQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserTotp, error) {
+	o := &UserTotp{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for user_totps")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserTotp records from the query.
+func (q userTotpQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserTotpSlice, error) {
+	var o []*UserTotp
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UserTotp slice")
+	}
+
+	if len(userTotpAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserTotp records in the query.
+func (q userTotpQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count user_totps rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userTotpQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if user_totps exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *UserTotp) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userTotpL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserTotp interface{}, mods queries.Applicator) error {
+	var slice []*UserTotp
+	var object *UserTotp
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserTotp.(*UserTotp)
+		if !ok {
+			object = new(UserTotp)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserTotp)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserTotp))
+			}
+		}
+	} else {
+		s, ok := maybeUserTotp.(*[]*UserTotp)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserTotp)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserTotp))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userTotpR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userTotpR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserTotps = append(foreign.R.UserTotps, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserTotps = append(foreign.R.UserTotps, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the userTotp to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTotps.
+func (o *UserTotp) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_totps\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userTotpPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &userTotpR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserTotps: UserTotpSlice{o},
+		}
+	} else {
+		related.R.UserTotps = append(related.R.UserTotps, o)
+	}
+
+	return nil
+}
+
+// UserTotps retrieves all the records using an executor.
+func UserTotps(mods ...qm.QueryMod) userTotpQuery {
+	mods = append(mods, qm.From("\"user_totps\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_totps\".*"})
+	}
+
+	return userTotpQuery{q}
+}
+
+// FindUserTotp retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserTotp(ctx context.Context, exec boil.ContextExecutor, userID int64, selectCols ...string) (*UserTotp, error) {
+	userTotpObj := &UserTotp{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_totps\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, userTotpObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from user_totps")
+	}
+
+	if err = userTotpObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userTotpObj, err
+	}
+
+	return userTotpObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserTotp) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_totps provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userTotpColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userTotpInsertCacheMut.RLock()
+	cache, cached := userTotpInsertCache[key]
+	userTotpInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userTotpAllColumns,
+			userTotpColumnsWithDefault,
+			userTotpColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userTotpType, userTotpMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userTotpType, userTotpMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_totps\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_totps\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into user_totps")
+	}
+
+	if !cached {
+		userTotpInsertCacheMut.Lock()
+		userTotpInsertCache[key] = cache
+		userTotpInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserTotp.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserTotp) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userTotpUpdateCacheMut.RLock()
+	cache, cached := userTotpUpdateCache[key]
+	userTotpUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userTotpAllColumns,
+			userTotpPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update user_totps, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_totps\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userTotpPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userTotpType, userTotpMapping, append(wl, userTotpPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update user_totps row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for user_totps")
+	}
+
+	if !cached {
+		userTotpUpdateCacheMut.Lock()
+		userTotpUpdateCache[key] = cache
+		userTotpUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userTotpQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for user_totps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for user_totps")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserTotpSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTotpPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_totps\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userTotpPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in userTotp slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all userTotp")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserTotp) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no user_totps provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userTotpColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userTotpUpsertCacheMut.RLock()
+	cache, cached := userTotpUpsertCache[key]
+	userTotpUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userTotpAllColumns,
+			userTotpColumnsWithDefault,
+			userTotpColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userTotpAllColumns,
+			userTotpPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert user_totps, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userTotpAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userTotpPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert user_totps, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userTotpPrimaryKeyColumns))
+			copy(conflict, userTotpPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_totps\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userTotpType, userTotpMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userTotpType, userTotpMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert user_totps")
+	}
+
+	if !cached {
+		userTotpUpsertCacheMut.Lock()
+		userTotpUpsertCache[key] = cache
+		userTotpUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserTotp record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserTotp) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UserTotp provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userTotpPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_totps\" WHERE \"user_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from user_totps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for user_totps")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userTotpQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userTotpQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user_totps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_totps")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserTotpSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userTotpBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTotpPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_totps\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userTotpPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from userTotp slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_totps")
+	}
+
+	if len(userTotpAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserTotp) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserTotp(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserTotpSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserTotpSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTotpPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_totps\".* FROM \"user_totps\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userTotpPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserTotpSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserTotpExists checks if the UserTotp row exists.
+func UserTotpExists(ctx context.Context, exec boil.ContextExecutor, userID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_totps\" where \"user_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if user_totps exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserTotp row exists.
+func (o *UserTotp) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserTotpExists(ctx, exec, o.UserID)
+}
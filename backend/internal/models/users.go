@@ -24,116 +24,215 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID         int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Email      string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	VSCAccount string    `boil:"vsc_account" json:"vsc_account" toml:"vsc_account" yaml:"vsc_account"`
-	RoleID     int64     `boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
-	TenantID   int64     `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	Password   string    `boil:"password" json:"password" toml:"password" yaml:"password"`
+	ID                      int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                    string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Email                   string     `boil:"email" json:"email" toml:"email" yaml:"email"`
+	VSCAccount              string     `boil:"vsc_account" json:"vsc_account" toml:"vsc_account" yaml:"vsc_account"`
+	RoleID                  int64      `boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
+	TenantID                int64      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CreatedAt               time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt               null.Time  `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Password                string     `boil:"password" json:"password" toml:"password" yaml:"password"`
+	EmailVerifiedAt         null.Time  `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
+	EmailVerificationSentAt null.Time  `boil:"email_verification_sent_at" json:"email_verification_sent_at,omitempty" toml:"email_verification_sent_at" yaml:"email_verification_sent_at,omitempty"`
+	WebauthnHandle          null.Bytes `boil:"webauthn_handle" json:"webauthn_handle,omitempty" toml:"webauthn_handle" yaml:"webauthn_handle,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID         string
-	Name       string
-	Email      string
-	VSCAccount string
-	RoleID     string
-	TenantID   string
-	CreatedAt  string
-	UpdatedAt  string
-	Password   string
+	ID                      string
+	Name                    string
+	Email                   string
+	VSCAccount              string
+	RoleID                  string
+	TenantID                string
+	CreatedAt               string
+	UpdatedAt               string
+	Password                string
+	EmailVerifiedAt         string
+	EmailVerificationSentAt string
+	WebauthnHandle          string
 }{
-	ID:         "id",
-	Name:       "name",
-	Email:      "email",
-	VSCAccount: "vsc_account",
-	RoleID:     "role_id",
-	TenantID:   "tenant_id",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
-	Password:   "password",
+	ID:                      "id",
+	Name:                    "name",
+	Email:                   "email",
+	VSCAccount:              "vsc_account",
+	RoleID:                  "role_id",
+	TenantID:                "tenant_id",
+	CreatedAt:               "created_at",
+	UpdatedAt:               "updated_at",
+	Password:                "password",
+	EmailVerifiedAt:         "email_verified_at",
+	EmailVerificationSentAt: "email_verification_sent_at",
+	WebauthnHandle:          "webauthn_handle",
 }
 
 var UserTableColumns = struct {
-	ID         string
-	Name       string
-	Email      string
-	VSCAccount string
-	RoleID     string
-	TenantID   string
-	CreatedAt  string
-	UpdatedAt  string
-	Password   string
+	ID                      string
+	Name                    string
+	Email                   string
+	VSCAccount              string
+	RoleID                  string
+	TenantID                string
+	CreatedAt               string
+	UpdatedAt               string
+	Password                string
+	EmailVerifiedAt         string
+	EmailVerificationSentAt string
+	WebauthnHandle          string
 }{
-	ID:         "users.id",
-	Name:       "users.name",
-	Email:      "users.email",
-	VSCAccount: "users.vsc_account",
-	RoleID:     "users.role_id",
-	TenantID:   "users.tenant_id",
-	CreatedAt:  "users.created_at",
-	UpdatedAt:  "users.updated_at",
-	Password:   "users.password",
+	ID:                      "users.id",
+	Name:                    "users.name",
+	Email:                   "users.email",
+	VSCAccount:              "users.vsc_account",
+	RoleID:                  "users.role_id",
+	TenantID:                "users.tenant_id",
+	CreatedAt:               "users.created_at",
+	UpdatedAt:               "users.updated_at",
+	Password:                "users.password",
+	EmailVerifiedAt:         "users.email_verified_at",
+	EmailVerificationSentAt: "users.email_verification_sent_at",
+	WebauthnHandle:          "users.webauthn_handle",
 }
 
 // Generated where
 
+type whereHelpernull_Bytes struct{ field string }
+
+func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bytes) NEQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bytes) LT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bytes) LTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bytes) GT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bytes) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bytes) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var UserWhere = struct {
-	ID         whereHelperint64
-	Name       whereHelperstring
-	Email      whereHelperstring
-	VSCAccount whereHelperstring
-	RoleID     whereHelperint64
-	TenantID   whereHelperint64
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpernull_Time
-	Password   whereHelperstring
+	ID                      whereHelperint64
+	Name                    whereHelperstring
+	Email                   whereHelperstring
+	VSCAccount              whereHelperstring
+	RoleID                  whereHelperint64
+	TenantID                whereHelperint64
+	CreatedAt               whereHelpertime_Time
+	UpdatedAt               whereHelpernull_Time
+	Password                whereHelperstring
+	EmailVerifiedAt         whereHelpernull_Time
+	EmailVerificationSentAt whereHelpernull_Time
+	WebauthnHandle          whereHelpernull_Bytes
 }{
-	ID:         whereHelperint64{field: "\"users\".\"id\""},
-	Name:       whereHelperstring{field: "\"users\".\"name\""},
-	Email:      whereHelperstring{field: "\"users\".\"email\""},
-	VSCAccount: whereHelperstring{field: "\"users\".\"vsc_account\""},
-	RoleID:     whereHelperint64{field: "\"users\".\"role_id\""},
-	TenantID:   whereHelperint64{field: "\"users\".\"tenant_id\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"users\".\"created_at\""},
-	UpdatedAt:  whereHelpernull_Time{field: "\"users\".\"updated_at\""},
-	Password:   whereHelperstring{field: "\"users\".\"password\""},
+	ID:                      whereHelperint64{field: "\"users\".\"id\""},
+	Name:                    whereHelperstring{field: "\"users\".\"name\""},
+	Email:                   whereHelperstring{field: "\"users\".\"email\""},
+	VSCAccount:              whereHelperstring{field: "\"users\".\"vsc_account\""},
+	RoleID:                  whereHelperint64{field: "\"users\".\"role_id\""},
+	TenantID:                whereHelperint64{field: "\"users\".\"tenant_id\""},
+	CreatedAt:               whereHelpertime_Time{field: "\"users\".\"created_at\""},
+	UpdatedAt:               whereHelpernull_Time{field: "\"users\".\"updated_at\""},
+	Password:                whereHelperstring{field: "\"users\".\"password\""},
+	EmailVerifiedAt:         whereHelpernull_Time{field: "\"users\".\"email_verified_at\""},
+	EmailVerificationSentAt: whereHelpernull_Time{field: "\"users\".\"email_verification_sent_at\""},
+	WebauthnHandle:          whereHelpernull_Bytes{field: "\"users\".\"webauthn_handle\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Role           string
-	Tenant         string
-	CreatorAnswers string
-	SenderComments string
-	CreatorPosts   string
-	Claims         string
-	VoterVotes     string
+	Role                    string
+	Tenant                  string
+	AnonymousPostAuthors    string
+	CreatorAnswers          string
+	SenderComments          string
+	Notifications           string
+	PasswordResetTokens     string
+	PollVotes               string
+	ActorPostHistories      string
+	AssigneeUserPosts       string
+	CreatorPosts            string
+	Reactions               string
+	RecoveryCodes           string
+	RefreshTokens           string
+	SsoLoginCodes           string
+	SubTopics               string
+	ReviewerSuggestedEdits  string
+	SuggesterSuggestedEdits string
+	TopicModerators         string
+	Claims                  string
+	UserIdentities          string
+	UserTotps               string
+	VoterVotes              string
+	WebauthnCeremonies      string
+	WebauthnCredentials     string
 }{
-	Role:           "Role",
-	Tenant:         "Tenant",
-	CreatorAnswers: "CreatorAnswers",
-	SenderComments: "SenderComments",
-	CreatorPosts:   "CreatorPosts",
-	Claims:         "Claims",
-	VoterVotes:     "VoterVotes",
+	Role:                    "Role",
+	Tenant:                  "Tenant",
+	AnonymousPostAuthors:    "AnonymousPostAuthors",
+	CreatorAnswers:          "CreatorAnswers",
+	SenderComments:          "SenderComments",
+	Notifications:           "Notifications",
+	PasswordResetTokens:     "PasswordResetTokens",
+	PollVotes:               "PollVotes",
+	ActorPostHistories:      "ActorPostHistories",
+	AssigneeUserPosts:       "AssigneeUserPosts",
+	CreatorPosts:            "CreatorPosts",
+	Reactions:               "Reactions",
+	RecoveryCodes:           "RecoveryCodes",
+	RefreshTokens:           "RefreshTokens",
+	SsoLoginCodes:           "SsoLoginCodes",
+	SubTopics:               "SubTopics",
+	ReviewerSuggestedEdits:  "ReviewerSuggestedEdits",
+	SuggesterSuggestedEdits: "SuggesterSuggestedEdits",
+	TopicModerators:         "TopicModerators",
+	Claims:                  "Claims",
+	UserIdentities:          "UserIdentities",
+	UserTotps:               "UserTotps",
+	VoterVotes:              "VoterVotes",
+	WebauthnCeremonies:      "WebauthnCeremonies",
+	WebauthnCredentials:     "WebauthnCredentials",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Role           *Role        `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
-	Tenant         *Tenant      `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	CreatorAnswers AnswerSlice  `boil:"CreatorAnswers" json:"CreatorAnswers" toml:"CreatorAnswers" yaml:"CreatorAnswers"`
-	SenderComments CommentSlice `boil:"SenderComments" json:"SenderComments" toml:"SenderComments" yaml:"SenderComments"`
-	CreatorPosts   PostSlice    `boil:"CreatorPosts" json:"CreatorPosts" toml:"CreatorPosts" yaml:"CreatorPosts"`
-	Claims         ClaimSlice   `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
-	VoterVotes     VoteSlice    `boil:"VoterVotes" json:"VoterVotes" toml:"VoterVotes" yaml:"VoterVotes"`
+	Role                    *Role                    `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+	Tenant                  *Tenant                  `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	AnonymousPostAuthors    AnonymousPostAuthorSlice `boil:"AnonymousPostAuthors" json:"AnonymousPostAuthors" toml:"AnonymousPostAuthors" yaml:"AnonymousPostAuthors"`
+	CreatorAnswers          AnswerSlice              `boil:"CreatorAnswers" json:"CreatorAnswers" toml:"CreatorAnswers" yaml:"CreatorAnswers"`
+	SenderComments          CommentSlice             `boil:"SenderComments" json:"SenderComments" toml:"SenderComments" yaml:"SenderComments"`
+	Notifications           NotificationSlice        `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	PasswordResetTokens     PasswordResetTokenSlice  `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
+	PollVotes               PollVoteSlice            `boil:"PollVotes" json:"PollVotes" toml:"PollVotes" yaml:"PollVotes"`
+	ActorPostHistories      PostHistorySlice         `boil:"ActorPostHistories" json:"ActorPostHistories" toml:"ActorPostHistories" yaml:"ActorPostHistories"`
+	AssigneeUserPosts       PostSlice                `boil:"AssigneeUserPosts" json:"AssigneeUserPosts" toml:"AssigneeUserPosts" yaml:"AssigneeUserPosts"`
+	CreatorPosts            PostSlice                `boil:"CreatorPosts" json:"CreatorPosts" toml:"CreatorPosts" yaml:"CreatorPosts"`
+	Reactions               ReactionSlice            `boil:"Reactions" json:"Reactions" toml:"Reactions" yaml:"Reactions"`
+	RecoveryCodes           RecoveryCodeSlice        `boil:"RecoveryCodes" json:"RecoveryCodes" toml:"RecoveryCodes" yaml:"RecoveryCodes"`
+	RefreshTokens           RefreshTokenSlice        `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	SsoLoginCodes           SsoLoginCodeSlice        `boil:"SsoLoginCodes" json:"SsoLoginCodes" toml:"SsoLoginCodes" yaml:"SsoLoginCodes"`
+	SubTopics               SubTopicSlice            `boil:"SubTopics" json:"SubTopics" toml:"SubTopics" yaml:"SubTopics"`
+	ReviewerSuggestedEdits  SuggestedEditSlice       `boil:"ReviewerSuggestedEdits" json:"ReviewerSuggestedEdits" toml:"ReviewerSuggestedEdits" yaml:"ReviewerSuggestedEdits"`
+	SuggesterSuggestedEdits SuggestedEditSlice       `boil:"SuggesterSuggestedEdits" json:"SuggesterSuggestedEdits" toml:"SuggesterSuggestedEdits" yaml:"SuggesterSuggestedEdits"`
+	TopicModerators         TopicModeratorSlice      `boil:"TopicModerators" json:"TopicModerators" toml:"TopicModerators" yaml:"TopicModerators"`
+	Claims                  ClaimSlice               `boil:"Claims" json:"Claims" toml:"Claims" yaml:"Claims"`
+	UserIdentities          UserIdentitySlice        `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	UserTotps               UserTotpSlice            `boil:"UserTotps" json:"UserTotps" toml:"UserTotps" yaml:"UserTotps"`
+	VoterVotes              VoteSlice                `boil:"VoterVotes" json:"VoterVotes" toml:"VoterVotes" yaml:"VoterVotes"`
+	WebauthnCeremonies      WebauthnCeremonySlice    `boil:"WebauthnCeremonies" json:"WebauthnCeremonies" toml:"WebauthnCeremonies" yaml:"WebauthnCeremonies"`
+	WebauthnCredentials     WebauthnCredentialSlice  `boil:"WebauthnCredentials" json:"WebauthnCredentials" toml:"WebauthnCredentials" yaml:"WebauthnCredentials"`
 }
 
 // NewStruct creates a new relationship struct
@@ -173,6 +272,22 @@ func (r *userR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *User) GetAnonymousPostAuthors() AnonymousPostAuthorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAnonymousPostAuthors()
+}
+
+func (r *userR) GetAnonymousPostAuthors() AnonymousPostAuthorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AnonymousPostAuthors
+}
+
 func (o *User) GetCreatorAnswers() AnswerSlice {
 	if o == nil {
 		return nil
@@ -205,6 +320,86 @@ func (r *userR) GetSenderComments() CommentSlice {
 	return r.SenderComments
 }
 
+func (o *User) GetNotifications() NotificationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotifications()
+}
+
+func (r *userR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Notifications
+}
+
+func (o *User) GetPasswordResetTokens() PasswordResetTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPasswordResetTokens()
+}
+
+func (r *userR) GetPasswordResetTokens() PasswordResetTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PasswordResetTokens
+}
+
+func (o *User) GetPollVotes() PollVoteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPollVotes()
+}
+
+func (r *userR) GetPollVotes() PollVoteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PollVotes
+}
+
+func (o *User) GetActorPostHistories() PostHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActorPostHistories()
+}
+
+func (r *userR) GetActorPostHistories() PostHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ActorPostHistories
+}
+
+func (o *User) GetAssigneeUserPosts() PostSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAssigneeUserPosts()
+}
+
+func (r *userR) GetAssigneeUserPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AssigneeUserPosts
+}
+
 func (o *User) GetCreatorPosts() PostSlice {
 	if o == nil {
 		return nil
@@ -221,6 +416,134 @@ func (r *userR) GetCreatorPosts() PostSlice {
 	return r.CreatorPosts
 }
 
+func (o *User) GetReactions() ReactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReactions()
+}
+
+func (r *userR) GetReactions() ReactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reactions
+}
+
+func (o *User) GetRecoveryCodes() RecoveryCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRecoveryCodes()
+}
+
+func (r *userR) GetRecoveryCodes() RecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RecoveryCodes
+}
+
+func (o *User) GetRefreshTokens() RefreshTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRefreshTokens()
+}
+
+func (r *userR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RefreshTokens
+}
+
+func (o *User) GetSsoLoginCodes() SsoLoginCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSsoLoginCodes()
+}
+
+func (r *userR) GetSsoLoginCodes() SsoLoginCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SsoLoginCodes
+}
+
+func (o *User) GetSubTopics() SubTopicSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubTopics()
+}
+
+func (r *userR) GetSubTopics() SubTopicSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubTopics
+}
+
+func (o *User) GetReviewerSuggestedEdits() SuggestedEditSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReviewerSuggestedEdits()
+}
+
+func (r *userR) GetReviewerSuggestedEdits() SuggestedEditSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ReviewerSuggestedEdits
+}
+
+func (o *User) GetSuggesterSuggestedEdits() SuggestedEditSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSuggesterSuggestedEdits()
+}
+
+func (r *userR) GetSuggesterSuggestedEdits() SuggestedEditSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SuggesterSuggestedEdits
+}
+
+func (o *User) GetTopicModerators() TopicModeratorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTopicModerators()
+}
+
+func (r *userR) GetTopicModerators() TopicModeratorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TopicModerators
+}
+
 func (o *User) GetClaims() ClaimSlice {
 	if o == nil {
 		return nil
@@ -237,6 +560,38 @@ func (r *userR) GetClaims() ClaimSlice {
 	return r.Claims
 }
 
+func (o *User) GetUserIdentities() UserIdentitySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserIdentities()
+}
+
+func (r *userR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserIdentities
+}
+
+func (o *User) GetUserTotps() UserTotpSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTotps()
+}
+
+func (r *userR) GetUserTotps() UserTotpSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTotps
+}
+
 func (o *User) GetVoterVotes() VoteSlice {
 	if o == nil {
 		return nil
@@ -253,13 +608,45 @@ func (r *userR) GetVoterVotes() VoteSlice {
 	return r.VoterVotes
 }
 
+func (o *User) GetWebauthnCeremonies() WebauthnCeremonySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnCeremonies()
+}
+
+func (r *userR) GetWebauthnCeremonies() WebauthnCeremonySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCeremonies
+}
+
+func (o *User) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnCredentials()
+}
+
+func (r *userR) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCredentials
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "name", "email", "vsc_account", "role_id", "tenant_id", "created_at", "updated_at", "password"}
+	userAllColumns            = []string{"id", "name", "email", "vsc_account", "role_id", "tenant_id", "created_at", "updated_at", "password", "email_verified_at", "email_verification_sent_at", "webauthn_handle"}
 	userColumnsWithoutDefault = []string{"name", "email", "vsc_account", "role_id", "tenant_id", "password"}
-	userColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	userColumnsWithDefault    = []string{"id", "created_at", "updated_at", "email_verified_at", "email_verification_sent_at", "webauthn_handle"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{"id"}
 )
@@ -591,6 +978,20 @@ func (o *User) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// AnonymousPostAuthors retrieves all the anonymous_post_author's AnonymousPostAuthors with an executor.
+func (o *User) AnonymousPostAuthors(mods ...qm.QueryMod) anonymousPostAuthorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"anonymous_post_authors\".\"user_id\"=?", o.ID),
+	)
+
+	return AnonymousPostAuthors(queryMods...)
+}
+
 // CreatorAnswers retrieves all the answer's Answers with an executor via creator_id column.
 func (o *User) CreatorAnswers(mods ...qm.QueryMod) answerQuery {
 	var queryMods []qm.QueryMod
@@ -619,57 +1020,296 @@ func (o *User) SenderComments(mods ...qm.QueryMod) commentQuery {
 	return Comments(queryMods...)
 }
 
-// CreatorPosts retrieves all the post's Posts with an executor via creator_id column.
-func (o *User) CreatorPosts(mods ...qm.QueryMod) postQuery {
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *User) Notifications(mods ...qm.QueryMod) notificationQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"posts\".\"creator_id\"=?", o.ID),
+		qm.Where("\"notifications\".\"user_id\"=?", o.ID),
 	)
 
-	return Posts(queryMods...)
+	return Notifications(queryMods...)
 }
 
-// Claims retrieves all the claim's Claims with an executor.
-func (o *User) Claims(mods ...qm.QueryMod) claimQuery {
+// PasswordResetTokens retrieves all the password_reset_token's PasswordResetTokens with an executor.
+func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.InnerJoin("\"user_claims\" on \"claims\".\"id\" = \"user_claims\".\"claim_id\""),
-		qm.Where("\"user_claims\".\"user_id\"=?", o.ID),
+		qm.Where("\"password_reset_tokens\".\"user_id\"=?", o.ID),
 	)
 
-	return Claims(queryMods...)
+	return PasswordResetTokens(queryMods...)
 }
 
-// VoterVotes retrieves all the vote's Votes with an executor via voter_id column.
-func (o *User) VoterVotes(mods ...qm.QueryMod) voteQuery {
+// PollVotes retrieves all the poll_vote's PollVotes with an executor.
+func (o *User) PollVotes(mods ...qm.QueryMod) pollVoteQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"votes\".\"voter_id\"=?", o.ID),
+		qm.Where("\"poll_votes\".\"user_id\"=?", o.ID),
 	)
 
-	return Votes(queryMods...)
+	return PollVotes(queryMods...)
 }
 
-// LoadRole allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
+// ActorPostHistories retrieves all the post_history's PostHistories with an executor via actor_id column.
+func (o *User) ActorPostHistories(mods ...qm.QueryMod) postHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_histories\".\"actor_id\"=?", o.ID),
+	)
+
+	return PostHistories(queryMods...)
+}
+
+// AssigneeUserPosts retrieves all the post's Posts with an executor via assignee_user_id column.
+func (o *User) AssigneeUserPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"assignee_user_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// CreatorPosts retrieves all the post's Posts with an executor via creator_id column.
+func (o *User) CreatorPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"creator_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// Reactions retrieves all the reaction's Reactions with an executor.
+func (o *User) Reactions(mods ...qm.QueryMod) reactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reactions\".\"user_id\"=?", o.ID),
+	)
+
+	return Reactions(queryMods...)
+}
+
+// RecoveryCodes retrieves all the recovery_code's RecoveryCodes with an executor.
+func (o *User) RecoveryCodes(mods ...qm.QueryMod) recoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return RecoveryCodes(queryMods...)
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
+// SsoLoginCodes retrieves all the sso_login_code's SsoLoginCodes with an executor.
+func (o *User) SsoLoginCodes(mods ...qm.QueryMod) ssoLoginCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sso_login_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return SsoLoginCodes(queryMods...)
+}
+
+// SubTopics retrieves all the sub_topic's SubTopics with an executor.
+func (o *User) SubTopics(mods ...qm.QueryMod) subTopicQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"sub_topic_responders\" on \"sub_topics\".\"id\" = \"sub_topic_responders\".\"sub_topic_id\""),
+		qm.Where("\"sub_topic_responders\".\"user_id\"=?", o.ID),
+	)
+
+	return SubTopics(queryMods...)
+}
+
+// ReviewerSuggestedEdits retrieves all the suggested_edit's SuggestedEdits with an executor via reviewer_id column.
+func (o *User) ReviewerSuggestedEdits(mods ...qm.QueryMod) suggestedEditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"suggested_edits\".\"reviewer_id\"=?", o.ID),
+	)
+
+	return SuggestedEdits(queryMods...)
+}
+
+// SuggesterSuggestedEdits retrieves all the suggested_edit's SuggestedEdits with an executor via suggester_id column.
+func (o *User) SuggesterSuggestedEdits(mods ...qm.QueryMod) suggestedEditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"suggested_edits\".\"suggester_id\"=?", o.ID),
+	)
+
+	return SuggestedEdits(queryMods...)
+}
+
+// TopicModerators retrieves all the topic_moderator's TopicModerators with an executor.
+func (o *User) TopicModerators(mods ...qm.QueryMod) topicModeratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"topic_moderators\".\"user_id\"=?", o.ID),
+	)
+
+	return TopicModerators(queryMods...)
+}
+
+// Claims retrieves all the claim's Claims with an executor.
+func (o *User) Claims(mods ...qm.QueryMod) claimQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"user_claims\" on \"claims\".\"id\" = \"user_claims\".\"claim_id\""),
+		qm.Where("\"user_claims\".\"user_id\"=?", o.ID),
+	)
+
+	return Claims(queryMods...)
+}
+
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *User) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"user_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
+// UserTotps retrieves all the user_totp's UserTotps with an executor.
+func (o *User) UserTotps(mods ...qm.QueryMod) userTotpQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_totps\".\"user_id\"=?", o.ID),
+	)
+
+	return UserTotps(queryMods...)
+}
+
+// VoterVotes retrieves all the vote's Votes with an executor via voter_id column.
+func (o *User) VoterVotes(mods ...qm.QueryMod) voteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"votes\".\"voter_id\"=?", o.ID),
+	)
+
+	return Votes(queryMods...)
+}
+
+// WebauthnCeremonies retrieves all the webauthn_ceremony's WebauthnCeremonies with an executor.
+func (o *User) WebauthnCeremonies(mods ...qm.QueryMod) webauthnCeremonyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_ceremonies\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnCeremonies(queryMods...)
+}
+
+// WebauthnCredentials retrieves all the webauthn_credential's WebauthnCredentials with an executor.
+func (o *User) WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_credentials\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnCredentials(queryMods...)
+}
+
+// LoadRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
 		object, ok = maybeUser.(*User)
 		if !ok {
 			object = new(User)
@@ -902,9 +1542,9 @@ func (userL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadCreatorAnswers allows an eager lookup of values, cached into the
+// LoadAnonymousPostAuthors allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatorAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAnonymousPostAuthors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -957,8 +1597,8 @@ func (userL) LoadCreatorAnswers(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`answers`),
-		qm.WhereIn(`answers.creator_id in ?`, argsSlice...),
+		qm.From(`anonymous_post_authors`),
+		qm.WhereIn(`anonymous_post_authors.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -966,22 +1606,22 @@ func (userL) LoadCreatorAnswers(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load answers")
+		return errors.Wrap(err, "failed to eager load anonymous_post_authors")
 	}
 
-	var resultSlice []*Answer
+	var resultSlice []*AnonymousPostAuthor
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice answers")
+		return errors.Wrap(err, "failed to bind eager loaded slice anonymous_post_authors")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on answers")
+		return errors.Wrap(err, "failed to close results in eager load on anonymous_post_authors")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for anonymous_post_authors")
 	}
 
-	if len(answerAfterSelectHooks) != 0 {
+	if len(anonymousPostAuthorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -989,24 +1629,24 @@ func (userL) LoadCreatorAnswers(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.CreatorAnswers = resultSlice
+		object.R.AnonymousPostAuthors = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &answerR{}
+				foreign.R = &anonymousPostAuthorR{}
 			}
-			foreign.R.Creator = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.CreatorID {
-				local.R.CreatorAnswers = append(local.R.CreatorAnswers, foreign)
+			if local.ID == foreign.UserID {
+				local.R.AnonymousPostAuthors = append(local.R.AnonymousPostAuthors, foreign)
 				if foreign.R == nil {
-					foreign.R = &answerR{}
+					foreign.R = &anonymousPostAuthorR{}
 				}
-				foreign.R.Creator = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1015,9 +1655,9 @@ func (userL) LoadCreatorAnswers(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadSenderComments allows an eager lookup of values, cached into the
+// LoadCreatorAnswers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSenderComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCreatorAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1070,8 +1710,8 @@ func (userL) LoadSenderComments(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`comments`),
-		qm.WhereIn(`comments.sender_id in ?`, argsSlice...),
+		qm.From(`answers`),
+		qm.WhereIn(`answers.creator_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1079,22 +1719,22 @@ func (userL) LoadSenderComments(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load comments")
+		return errors.Wrap(err, "failed to eager load answers")
 	}
 
-	var resultSlice []*Comment
+	var resultSlice []*Answer
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+		return errors.Wrap(err, "failed to bind eager loaded slice answers")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comments")
+		return errors.Wrap(err, "failed to close results in eager load on answers")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for answers")
 	}
 
-	if len(commentAfterSelectHooks) != 0 {
+	if len(answerAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1102,24 +1742,24 @@ func (userL) LoadSenderComments(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.SenderComments = resultSlice
+		object.R.CreatorAnswers = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &commentR{}
+				foreign.R = &answerR{}
 			}
-			foreign.R.Sender = object
+			foreign.R.Creator = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.SenderID {
-				local.R.SenderComments = append(local.R.SenderComments, foreign)
+			if local.ID == foreign.CreatorID {
+				local.R.CreatorAnswers = append(local.R.CreatorAnswers, foreign)
 				if foreign.R == nil {
-					foreign.R = &commentR{}
+					foreign.R = &answerR{}
 				}
-				foreign.R.Sender = local
+				foreign.R.Creator = local
 				break
 			}
 		}
@@ -1128,9 +1768,9 @@ func (userL) LoadSenderComments(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadCreatorPosts allows an eager lookup of values, cached into the
+// LoadSenderComments allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatorPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadSenderComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1183,8 +1823,8 @@ func (userL) LoadCreatorPosts(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`posts`),
-		qm.WhereIn(`posts.creator_id in ?`, argsSlice...),
+		qm.From(`comments`),
+		qm.WhereIn(`comments.sender_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1192,22 +1832,22 @@ func (userL) LoadCreatorPosts(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load posts")
+		return errors.Wrap(err, "failed to eager load comments")
 	}
 
-	var resultSlice []*Post
+	var resultSlice []*Comment
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on posts")
+		return errors.Wrap(err, "failed to close results in eager load on comments")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
 	}
 
-	if len(postAfterSelectHooks) != 0 {
+	if len(commentAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1215,24 +1855,24 @@ func (userL) LoadCreatorPosts(ctx context.Context, e boil.ContextExecutor, singu
 		}
 	}
 	if singular {
-		object.R.CreatorPosts = resultSlice
+		object.R.SenderComments = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postR{}
+				foreign.R = &commentR{}
 			}
-			foreign.R.Creator = object
+			foreign.R.Sender = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.CreatorID {
-				local.R.CreatorPosts = append(local.R.CreatorPosts, foreign)
+			if local.ID == foreign.SenderID {
+				local.R.SenderComments = append(local.R.SenderComments, foreign)
 				if foreign.R == nil {
-					foreign.R = &postR{}
+					foreign.R = &commentR{}
 				}
-				foreign.R.Creator = local
+				foreign.R.Sender = local
 				break
 			}
 		}
@@ -1241,9 +1881,9 @@ func (userL) LoadCreatorPosts(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadClaims allows an eager lookup of values, cached into the
+// LoadNotifications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1296,10 +1936,8 @@ func (userL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.Select("\"claims\".\"id\", \"claims\".\"name\", \"claims\".\"description\", \"claims\".\"tenant_id\", \"claims\".\"created_at\", \"claims\".\"updated_at\", \"a\".\"user_id\""),
-		qm.From("\"claims\""),
-		qm.InnerJoin("\"user_claims\" as \"a\" on \"claims\".\"id\" = \"a\".\"claim_id\""),
-		qm.WhereIn("\"a\".\"user_id\" in ?", argsSlice...),
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1307,36 +1945,22 @@ func (userL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load claims")
+		return errors.Wrap(err, "failed to eager load notifications")
 	}
 
-	var resultSlice []*Claim
-
-	var localJoinCols []int64
-	for results.Next() {
-		one := new(Claim)
-		var localJoinCol int64
-
-		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for claims")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice claims")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on claims")
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claims")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
 	}
 
-	if len(claimAfterSelectHooks) != 0 {
+	if len(notificationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1344,25 +1968,24 @@ func (userL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bo
 		}
 	}
 	if singular {
-		object.R.Claims = resultSlice
+		object.R.Notifications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &claimR{}
+				foreign.R = &notificationR{}
 			}
-			foreign.R.Users = append(foreign.R.Users, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Claims = append(local.R.Claims, foreign)
+			if local.ID == foreign.UserID {
+				local.R.Notifications = append(local.R.Notifications, foreign)
 				if foreign.R == nil {
-					foreign.R = &claimR{}
+					foreign.R = &notificationR{}
 				}
-				foreign.R.Users = append(foreign.R.Users, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1371,9 +1994,9 @@ func (userL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadVoterVotes allows an eager lookup of values, cached into the
+// LoadPasswordResetTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadVoterVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1426,8 +2049,8 @@ func (userL) LoadVoterVotes(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`votes`),
-		qm.WhereIn(`votes.voter_id in ?`, argsSlice...),
+		qm.From(`password_reset_tokens`),
+		qm.WhereIn(`password_reset_tokens.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1435,22 +2058,22 @@ func (userL) LoadVoterVotes(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load votes")
+		return errors.Wrap(err, "failed to eager load password_reset_tokens")
 	}
 
-	var resultSlice []*Vote
+	var resultSlice []*PasswordResetToken
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice votes")
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on votes")
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for votes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
 	}
 
-	if len(voteAfterSelectHooks) != 0 {
+	if len(passwordResetTokenAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1458,24 +2081,24 @@ func (userL) LoadVoterVotes(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.VoterVotes = resultSlice
+		object.R.PasswordResetTokens = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &voteR{}
+				foreign.R = &passwordResetTokenR{}
 			}
-			foreign.R.Voter = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.VoterID {
-				local.R.VoterVotes = append(local.R.VoterVotes, foreign)
+			if local.ID == foreign.UserID {
+				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
 				if foreign.R == nil {
-					foreign.R = &voteR{}
+					foreign.R = &passwordResetTokenR{}
 				}
-				foreign.R.Voter = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1484,170 +2107,3294 @@ func (userL) LoadVoterVotes(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// SetRole of the user to the related item.
-// Sets o.R.Role to related.
-// Adds o to related.R.Users.
-func (o *User) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadPollVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPollVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-	o.RoleID = related.ID
-	if o.R == nil {
-		o.R = &userR{
-			Role: related,
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		o.R.Role = related
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &roleR{
-			Users: UserSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		related.R.Users = append(related.R.Users, o)
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	return nil
-}
+	if len(args) == 0 {
+		return nil
+	}
 
-// SetTenant of the user to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.Users.
-func (o *User) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	query := NewQuery(
+		qm.From(`poll_votes`),
+		qm.WhereIn(`poll_votes.user_id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load poll_votes")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*PollVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice poll_votes")
 	}
 
-	o.TenantID = related.ID
-	if o.R == nil {
-		o.R = &userR{
-			Tenant: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on poll_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_votes")
+	}
+
+	if len(pollVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.Tenant = related
+	}
+	if singular {
+		object.R.PollVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pollVoteR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &tenantR{
-			Users: UserSlice{o},
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PollVotes = append(local.R.PollVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &pollVoteR{}
+				}
+				foreign.R.User = local
+				break
+			}
 		}
-	} else {
-		related.R.Users = append(related.R.Users, o)
 	}
 
 	return nil
 }
 
-// AddCreatorAnswers adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatorAnswers.
-// Sets related.R.Creator appropriately.
-func (o *User) AddCreatorAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Answer) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.CreatorID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+// LoadActorPostHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorPostHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"answers\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
-				strmangle.WhereClause("\"", "\"", 2, answerPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_histories`),
+		qm.WhereIn(`post_histories.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_histories")
+	}
+
+	var resultSlice []*PostHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_histories")
+	}
+
+	if len(postHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorPostHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postHistoryR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ActorID {
+				local.R.ActorPostHistories = append(local.R.ActorPostHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &postHistoryR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssigneeUserPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAssigneeUserPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.assignee_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssigneeUserPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.AssigneeUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AssigneeUserID) {
+				local.R.AssigneeUserPosts = append(local.R.AssigneeUserPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.AssigneeUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatorPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatorPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.creator_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatorPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.Creator = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatorID) {
+				local.R.CreatorPosts = append(local.R.CreatorPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Creator = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reactions`),
+		qm.WhereIn(`reactions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reactions")
+	}
+
+	var resultSlice []*Reaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reactions")
+	}
+
+	if len(reactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reactionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Reactions = append(local.R.Reactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &reactionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`recovery_codes`),
+		qm.WhereIn(`recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recovery_codes")
+	}
+
+	var resultSlice []*RecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recovery_codes")
+	}
+
+	if len(recoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RecoveryCodes = append(local.R.RecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &recoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if len(refreshTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSsoLoginCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSsoLoginCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`sso_login_codes`),
+		qm.WhereIn(`sso_login_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sso_login_codes")
+	}
+
+	var resultSlice []*SsoLoginCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sso_login_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sso_login_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sso_login_codes")
+	}
+
+	if len(ssoLoginCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SsoLoginCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &ssoLoginCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.SsoLoginCodes = append(local.R.SsoLoginCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &ssoLoginCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubTopics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSubTopics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"sub_topics\".\"id\", \"sub_topics\".\"name\", \"sub_topics\".\"topic_id\", \"sub_topics\".\"tenant_id\", \"sub_topics\".\"created_at\", \"sub_topics\".\"updated_at\", \"sub_topics\".\"first_reply_target_minutes\", \"sub_topics\".\"description\", \"sub_topics\".\"icon\", \"sub_topics\".\"color\", \"sub_topics\".\"archived\", \"sub_topics\".\"template_body\", \"a\".\"user_id\""),
+		qm.From("\"sub_topics\""),
+		qm.InnerJoin("\"sub_topic_responders\" as \"a\" on \"sub_topics\".\"id\" = \"a\".\"sub_topic_id\""),
+		qm.WhereIn("\"a\".\"user_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sub_topics")
+	}
+
+	var resultSlice []*SubTopic
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(SubTopic)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.TopicID, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &one.FirstReplyTargetMinutes, &one.Description, &one.Icon, &one.Color, &one.Archived, &one.TemplateBody, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for sub_topics")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice sub_topics")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sub_topics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sub_topics")
+	}
+
+	if len(subTopicAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubTopics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &subTopicR{}
+			}
+			foreign.R.Users = append(foreign.R.Users, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.SubTopics = append(local.R.SubTopics, foreign)
+				if foreign.R == nil {
+					foreign.R = &subTopicR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewerSuggestedEdits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReviewerSuggestedEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`suggested_edits`),
+		qm.WhereIn(`suggested_edits.reviewer_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load suggested_edits")
+	}
+
+	var resultSlice []*SuggestedEdit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice suggested_edits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on suggested_edits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for suggested_edits")
+	}
+
+	if len(suggestedEditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReviewerSuggestedEdits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &suggestedEditR{}
+			}
+			foreign.R.Reviewer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReviewerID) {
+				local.R.ReviewerSuggestedEdits = append(local.R.ReviewerSuggestedEdits, foreign)
+				if foreign.R == nil {
+					foreign.R = &suggestedEditR{}
+				}
+				foreign.R.Reviewer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSuggesterSuggestedEdits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSuggesterSuggestedEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`suggested_edits`),
+		qm.WhereIn(`suggested_edits.suggester_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load suggested_edits")
+	}
+
+	var resultSlice []*SuggestedEdit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice suggested_edits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on suggested_edits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for suggested_edits")
+	}
+
+	if len(suggestedEditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SuggesterSuggestedEdits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &suggestedEditR{}
+			}
+			foreign.R.Suggester = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SuggesterID {
+				local.R.SuggesterSuggestedEdits = append(local.R.SuggesterSuggestedEdits, foreign)
+				if foreign.R == nil {
+					foreign.R = &suggestedEditR{}
+				}
+				foreign.R.Suggester = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTopicModerators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTopicModerators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`topic_moderators`),
+		qm.WhereIn(`topic_moderators.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load topic_moderators")
+	}
+
+	var resultSlice []*TopicModerator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice topic_moderators")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on topic_moderators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for topic_moderators")
+	}
+
+	if len(topicModeratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TopicModerators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &topicModeratorR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TopicModerators = append(local.R.TopicModerators, foreign)
+				if foreign.R == nil {
+					foreign.R = &topicModeratorR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClaims allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadClaims(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"claims\".\"id\", \"claims\".\"name\", \"claims\".\"description\", \"claims\".\"tenant_id\", \"claims\".\"created_at\", \"claims\".\"updated_at\", \"a\".\"user_id\""),
+		qm.From("\"claims\""),
+		qm.InnerJoin("\"user_claims\" as \"a\" on \"claims\".\"id\" = \"a\".\"claim_id\""),
+		qm.WhereIn("\"a\".\"user_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load claims")
+	}
+
+	var resultSlice []*Claim
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Claim)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.TenantID, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for claims")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice claims")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on claims")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claims")
+	}
+
+	if len(claimAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Claims = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &claimR{}
+			}
+			foreign.R.Users = append(foreign.R.Users, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Claims = append(local.R.Claims, foreign)
+				if foreign.R == nil {
+					foreign.R = &claimR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
+
+	if len(userIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTotps allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserTotps(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_totps`),
+		qm.WhereIn(`user_totps.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_totps")
+	}
+
+	var resultSlice []*UserTotp
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_totps")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_totps")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_totps")
+	}
+
+	if len(userTotpAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTotps = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userTotpR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTotps = append(local.R.UserTotps, foreign)
+				if foreign.R == nil {
+					foreign.R = &userTotpR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVoterVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadVoterVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`votes`),
+		qm.WhereIn(`votes.voter_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load votes")
+	}
+
+	var resultSlice []*Vote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for votes")
+	}
+
+	if len(voteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.VoterVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &voteR{}
+			}
+			foreign.R.Voter = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VoterID {
+				local.R.VoterVotes = append(local.R.VoterVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &voteR{}
+				}
+				foreign.R.Voter = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCeremonies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCeremonies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_ceremonies`),
+		qm.WhereIn(`webauthn_ceremonies.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_ceremonies")
+	}
+
+	var resultSlice []*WebauthnCeremony
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_ceremonies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_ceremonies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_ceremonies")
+	}
+
+	if len(webauthnCeremonyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnCeremonies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCeremonyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.WebauthnCeremonies = append(local.R.WebauthnCeremonies, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCeremonyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_credentials`),
+		qm.WhereIn(`webauthn_credentials.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_credentials")
+	}
+
+	var resultSlice []*WebauthnCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCredentialR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WebauthnCredentials = append(local.R.WebauthnCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCredentialR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRole of the user to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.Users.
+func (o *User) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoleID = related.ID
+	if o.R == nil {
+		o.R = &userR{
+			Role: related,
+		}
+	} else {
+		o.R.Role = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// SetTenant of the user to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Users.
+func (o *User) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &userR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// AddAnonymousPostAuthors adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AnonymousPostAuthors.
+// Sets related.R.User appropriately.
+func (o *User) AddAnonymousPostAuthors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AnonymousPostAuthor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"anonymous_post_authors\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, anonymousPostAuthorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AnonymousPostAuthors: related,
+		}
+	} else {
+		o.R.AnonymousPostAuthors = append(o.R.AnonymousPostAuthors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &anonymousPostAuthorR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatorAnswers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorAnswers.
+// Sets related.R.Creator appropriately.
+func (o *User) AddCreatorAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Answer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"answers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, answerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorAnswers: related,
+		}
+	} else {
+		o.R.CreatorAnswers = append(o.R.CreatorAnswers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &answerR{
+				Creator: o,
+			}
+		} else {
+			rel.R.Creator = o
+		}
+	}
+	return nil
+}
+
+// AddSenderComments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SenderComments.
+// Sets related.R.Sender appropriately.
+func (o *User) AddSenderComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SenderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sender_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SenderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SenderComments: related,
+		}
+	} else {
+		o.R.SenderComments = append(o.R.SenderComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				Sender: o,
+			}
+		} else {
+			rel.R.Sender = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.User appropriately.
+func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPasswordResetTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordResetTokens: related,
+		}
+	} else {
+		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPollVotes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PollVotes.
+// Sets related.R.User appropriately.
+func (o *User) AddPollVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PollVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"poll_votes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pollVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OptionID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PollVotes: related,
+		}
+	} else {
+		o.R.PollVotes = append(o.R.PollVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pollVoteR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddActorPostHistories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorPostHistories.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorPostHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ActorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_histories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ActorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorPostHistories: related,
+		}
+	} else {
+		o.R.ActorPostHistories = append(o.R.ActorPostHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postHistoryR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// AddAssigneeUserPosts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AssigneeUserPosts.
+// Sets related.R.AssigneeUser appropriately.
+func (o *User) AddAssigneeUserPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AssigneeUserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"assignee_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AssigneeUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AssigneeUserPosts: related,
+		}
+	} else {
+		o.R.AssigneeUserPosts = append(o.R.AssigneeUserPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				AssigneeUser: o,
+			}
+		} else {
+			rel.R.AssigneeUser = o
+		}
+	}
+	return nil
+}
+
+// SetAssigneeUserPosts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AssigneeUser's AssigneeUserPosts accordingly.
+// Replaces o.R.AssigneeUserPosts with related.
+// Sets related.R.AssigneeUser's AssigneeUserPosts accordingly.
+func (o *User) SetAssigneeUserPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"assignee_user_id\" = null where \"assignee_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AssigneeUserPosts {
+			queries.SetScanner(&rel.AssigneeUserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AssigneeUser = nil
+		}
+		o.R.AssigneeUserPosts = nil
+	}
+
+	return o.AddAssigneeUserPosts(ctx, exec, insert, related...)
+}
+
+// RemoveAssigneeUserPosts relationships from objects passed in.
+// Removes related items from R.AssigneeUserPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.AssigneeUser.
+func (o *User) RemoveAssigneeUserPosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AssigneeUserID, nil)
+		if rel.R != nil {
+			rel.R.AssigneeUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("assignee_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AssigneeUserPosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AssigneeUserPosts)
+			if ln > 1 && i < ln-1 {
+				o.R.AssigneeUserPosts[i] = o.R.AssigneeUserPosts[ln-1]
+			}
+			o.R.AssigneeUserPosts = o.R.AssigneeUserPosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatorPosts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorPosts.
+// Sets related.R.Creator appropriately.
+func (o *User) AddCreatorPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorPosts: related,
+		}
+	} else {
+		o.R.CreatorPosts = append(o.R.CreatorPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Creator: o,
+			}
+		} else {
+			rel.R.Creator = o
+		}
+	}
+	return nil
+}
+
+// SetCreatorPosts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Creator's CreatorPosts accordingly.
+// Replaces o.R.CreatorPosts with related.
+// Sets related.R.Creator's CreatorPosts accordingly.
+func (o *User) SetCreatorPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"creator_id\" = null where \"creator_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatorPosts {
+			queries.SetScanner(&rel.CreatorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Creator = nil
+		}
+		o.R.CreatorPosts = nil
+	}
+
+	return o.AddCreatorPosts(ctx, exec, insert, related...)
+}
+
+// RemoveCreatorPosts relationships from objects passed in.
+// Removes related items from R.CreatorPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Creator.
+func (o *User) RemoveCreatorPosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatorID, nil)
+		if rel.R != nil {
+			rel.R.Creator = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("creator_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatorPosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatorPosts)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatorPosts[i] = o.R.CreatorPosts[ln-1]
+			}
+			o.R.CreatorPosts = o.R.CreatorPosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddReactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Reactions.
+// Sets related.R.User appropriately.
+func (o *User) AddReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Reactions: related,
+		}
+	} else {
+		o.R.Reactions = append(o.R.Reactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reactionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRecoveryCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, recoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RecoveryCodes: related,
+		}
+	} else {
+		o.R.RecoveryCodes = append(o.R.RecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &recoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
 			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.CreatorID = o.ID
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddSsoLoginCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SsoLoginCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddSsoLoginCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SsoLoginCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"sso_login_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, ssoLoginCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CodeHash}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SsoLoginCodes: related,
+		}
+	} else {
+		o.R.SsoLoginCodes = append(o.R.SsoLoginCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &ssoLoginCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddSubTopics adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SubTopics.
+// Sets related.R.Users appropriately.
+func (o *User) AddSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"sub_topic_responders\" (\"user_id\", \"sub_topic_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &userR{
+			SubTopics: related,
+		}
+	} else {
+		o.R.SubTopics = append(o.R.SubTopics, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &subTopicR{
+				Users: UserSlice{o},
+			}
+		} else {
+			rel.R.Users = append(rel.R.Users, o)
+		}
+	}
+	return nil
+}
+
+// SetSubTopics removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Users's SubTopics accordingly.
+// Replaces o.R.SubTopics with related.
+// Sets related.R.Users's SubTopics accordingly.
+func (o *User) SetSubTopics(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubTopic) error {
+	query := "delete from \"sub_topic_responders\" where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeSubTopicsFromUsersSlice(o, related)
+	if o.R != nil {
+		o.R.SubTopics = nil
+	}
+
+	return o.AddSubTopics(ctx, exec, insert, related...)
+}
+
+// RemoveSubTopics relationships from objects passed in.
+// Removes related items from R.SubTopics (uses pointer comparison, removal does not keep order)
+// Sets related.R.Users.
+func (o *User) RemoveSubTopics(ctx context.Context, exec boil.ContextExecutor, related ...*SubTopic) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"sub_topic_responders\" where \"user_id\" = $1 and \"sub_topic_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeSubTopicsFromUsersSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubTopics {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubTopics)
+			if ln > 1 && i < ln-1 {
+				o.R.SubTopics[i] = o.R.SubTopics[ln-1]
+			}
+			o.R.SubTopics = o.R.SubTopics[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeSubTopicsFromUsersSlice(o *User, related []*SubTopic) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Users {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Users)
+			if ln > 1 && i < ln-1 {
+				rel.R.Users[i] = rel.R.Users[ln-1]
+			}
+			rel.R.Users = rel.R.Users[:ln-1]
+			break
+		}
+	}
+}
+
+// AddReviewerSuggestedEdits adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReviewerSuggestedEdits.
+// Sets related.R.Reviewer appropriately.
+func (o *User) AddReviewerSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReviewerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"suggested_edits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reviewer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReviewerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReviewerSuggestedEdits: related,
+		}
+	} else {
+		o.R.ReviewerSuggestedEdits = append(o.R.ReviewerSuggestedEdits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &suggestedEditR{
+				Reviewer: o,
+			}
+		} else {
+			rel.R.Reviewer = o
+		}
+	}
+	return nil
+}
+
+// SetReviewerSuggestedEdits removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Reviewer's ReviewerSuggestedEdits accordingly.
+// Replaces o.R.ReviewerSuggestedEdits with related.
+// Sets related.R.Reviewer's ReviewerSuggestedEdits accordingly.
+func (o *User) SetReviewerSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
+	query := "update \"suggested_edits\" set \"reviewer_id\" = null where \"reviewer_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReviewerSuggestedEdits {
+			queries.SetScanner(&rel.ReviewerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Reviewer = nil
+		}
+		o.R.ReviewerSuggestedEdits = nil
+	}
+
+	return o.AddReviewerSuggestedEdits(ctx, exec, insert, related...)
+}
+
+// RemoveReviewerSuggestedEdits relationships from objects passed in.
+// Removes related items from R.ReviewerSuggestedEdits (uses pointer comparison, removal does not keep order)
+// Sets related.R.Reviewer.
+func (o *User) RemoveReviewerSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, related ...*SuggestedEdit) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReviewerID, nil)
+		if rel.R != nil {
+			rel.R.Reviewer = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("reviewer_id")); err != nil {
+			return err
 		}
 	}
-
 	if o.R == nil {
-		o.R = &userR{
-			CreatorAnswers: related,
-		}
-	} else {
-		o.R.CreatorAnswers = append(o.R.CreatorAnswers, related...)
+		return nil
 	}
 
 	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &answerR{
-				Creator: o,
+		for i, ri := range o.R.ReviewerSuggestedEdits {
+			if rel != ri {
+				continue
 			}
-		} else {
-			rel.R.Creator = o
+
+			ln := len(o.R.ReviewerSuggestedEdits)
+			if ln > 1 && i < ln-1 {
+				o.R.ReviewerSuggestedEdits[i] = o.R.ReviewerSuggestedEdits[ln-1]
+			}
+			o.R.ReviewerSuggestedEdits = o.R.ReviewerSuggestedEdits[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
-// AddSenderComments adds the given related objects to the existing relationships
+// AddSuggesterSuggestedEdits adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SenderComments.
-// Sets related.R.Sender appropriately.
-func (o *User) AddSenderComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+// Appends related to o.R.SuggesterSuggestedEdits.
+// Sets related.R.Suggester appropriately.
+func (o *User) AddSuggesterSuggestedEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SuggestedEdit) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.SenderID = o.ID
+			rel.SuggesterID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comments\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"sender_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+				"UPDATE \"suggested_edits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"suggester_id"}),
+				strmangle.WhereClause("\"", "\"", 2, suggestedEditPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1660,47 +5407,47 @@ func (o *User) AddSenderComments(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.SenderID = o.ID
+			rel.SuggesterID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			SenderComments: related,
+			SuggesterSuggestedEdits: related,
 		}
 	} else {
-		o.R.SenderComments = append(o.R.SenderComments, related...)
+		o.R.SuggesterSuggestedEdits = append(o.R.SuggesterSuggestedEdits, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
-				Sender: o,
+			rel.R = &suggestedEditR{
+				Suggester: o,
 			}
 		} else {
-			rel.R.Sender = o
+			rel.R.Suggester = o
 		}
 	}
 	return nil
 }
 
-// AddCreatorPosts adds the given related objects to the existing relationships
+// AddTopicModerators adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatorPosts.
-// Sets related.R.Creator appropriately.
-func (o *User) AddCreatorPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+// Appends related to o.R.TopicModerators.
+// Sets related.R.User appropriately.
+func (o *User) AddTopicModerators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TopicModerator) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.CreatorID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"posts\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
-				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+				"UPDATE \"topic_moderators\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, topicModeratorPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1713,25 +5460,25 @@ func (o *User) AddCreatorPosts(ctx context.Context, exec boil.ContextExecutor, i
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.CreatorID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatorPosts: related,
+			TopicModerators: related,
 		}
 	} else {
-		o.R.CreatorPosts = append(o.R.CreatorPosts, related...)
+		o.R.TopicModerators = append(o.R.TopicModerators, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &postR{
-				Creator: o,
+			rel.R = &topicModeratorR{
+				User: o,
 			}
 		} else {
-			rel.R.Creator = o
+			rel.R.User = o
 		}
 	}
 	return nil
@@ -1882,6 +5629,112 @@ func removeClaimsFromUsersSlice(o *User, related []*Claim) {
 	}
 }
 
+// AddUserIdentities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.User appropriately.
+func (o *User) AddUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_identities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserIdentities: related,
+		}
+	} else {
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userIdentityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserTotps adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserTotps.
+// Sets related.R.User appropriately.
+func (o *User) AddUserTotps(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserTotp) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_totps\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userTotpPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserTotps: related,
+		}
+	} else {
+		o.R.UserTotps = append(o.R.UserTotps, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userTotpR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddVoterVotes adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.VoterVotes.
@@ -1935,6 +5788,186 @@ func (o *User) AddVoterVotes(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddWebauthnCeremonies adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCeremonies.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCeremony) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webauthn_ceremonies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCeremonyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WebauthnCeremonies: related,
+		}
+	} else {
+		o.R.WebauthnCeremonies = append(o.R.WebauthnCeremonies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnCeremonyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetWebauthnCeremonies removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's WebauthnCeremonies accordingly.
+// Replaces o.R.WebauthnCeremonies with related.
+// Sets related.R.User's WebauthnCeremonies accordingly.
+func (o *User) SetWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCeremony) error {
+	query := "update \"webauthn_ceremonies\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.WebauthnCeremonies {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.WebauthnCeremonies = nil
+	}
+
+	return o.AddWebauthnCeremonies(ctx, exec, insert, related...)
+}
+
+// RemoveWebauthnCeremonies relationships from objects passed in.
+// Removes related items from R.WebauthnCeremonies (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, related ...*WebauthnCeremony) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.WebauthnCeremonies {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.WebauthnCeremonies)
+			if ln > 1 && i < ln-1 {
+				o.R.WebauthnCeremonies[i] = o.R.WebauthnCeremonies[ln-1]
+			}
+			o.R.WebauthnCeremonies = o.R.WebauthnCeremonies[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentials adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCredentials.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCredential) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WebauthnCredentials: related,
+		}
+	} else {
+		o.R.WebauthnCredentials = append(o.R.WebauthnCredentials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnCredentialR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
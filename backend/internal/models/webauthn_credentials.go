@@ -0,0 +1,1437 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebauthnCredential is an object representing the database table.
+type WebauthnCredential struct {
+	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TenantID        int64             `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	UserID          int64             `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Name            string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CredentialID    []byte            `boil:"credential_id" json:"credential_id" toml:"credential_id" yaml:"credential_id"`
+	PublicKey       []byte            `boil:"public_key" json:"public_key" toml:"public_key" yaml:"public_key"`
+	AttestationType string            `boil:"attestation_type" json:"attestation_type" toml:"attestation_type" yaml:"attestation_type"`
+	Transports      types.StringArray `boil:"transports" json:"transports" toml:"transports" yaml:"transports"`
+	Aaguid          []byte            `boil:"aaguid" json:"aaguid" toml:"aaguid" yaml:"aaguid"`
+	SignCount       int64             `boil:"sign_count" json:"sign_count" toml:"sign_count" yaml:"sign_count"`
+	BackupEligible  bool              `boil:"backup_eligible" json:"backup_eligible" toml:"backup_eligible" yaml:"backup_eligible"`
+	BackupState     bool              `boil:"backup_state" json:"backup_state" toml:"backup_state" yaml:"backup_state"`
+	LastUsedAt      null.Time         `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	CreatedAt       time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *webauthnCredentialR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webauthnCredentialL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebauthnCredentialColumns = struct {
+	ID              string
+	TenantID        string
+	UserID          string
+	Name            string
+	CredentialID    string
+	PublicKey       string
+	AttestationType string
+	Transports      string
+	Aaguid          string
+	SignCount       string
+	BackupEligible  string
+	BackupState     string
+	LastUsedAt      string
+	CreatedAt       string
+}{
+	ID:              "id",
+	TenantID:        "tenant_id",
+	UserID:          "user_id",
+	Name:            "name",
+	CredentialID:    "credential_id",
+	PublicKey:       "public_key",
+	AttestationType: "attestation_type",
+	Transports:      "transports",
+	Aaguid:          "aaguid",
+	SignCount:       "sign_count",
+	BackupEligible:  "backup_eligible",
+	BackupState:     "backup_state",
+	LastUsedAt:      "last_used_at",
+	CreatedAt:       "created_at",
+}
+
+var WebauthnCredentialTableColumns = struct {
+	ID              string
+	TenantID        string
+	UserID          string
+	Name            string
+	CredentialID    string
+	PublicKey       string
+	AttestationType string
+	Transports      string
+	Aaguid          string
+	SignCount       string
+	BackupEligible  string
+	BackupState     string
+	LastUsedAt      string
+	CreatedAt       string
+}{
+	ID:              "webauthn_credentials.id",
+	TenantID:        "webauthn_credentials.tenant_id",
+	UserID:          "webauthn_credentials.user_id",
+	Name:            "webauthn_credentials.name",
+	CredentialID:    "webauthn_credentials.credential_id",
+	PublicKey:       "webauthn_credentials.public_key",
+	AttestationType: "webauthn_credentials.attestation_type",
+	Transports:      "webauthn_credentials.transports",
+	Aaguid:          "webauthn_credentials.aaguid",
+	SignCount:       "webauthn_credentials.sign_count",
+	BackupEligible:  "webauthn_credentials.backup_eligible",
+	BackupState:     "webauthn_credentials.backup_state",
+	LastUsedAt:      "webauthn_credentials.last_used_at",
+	CreatedAt:       "webauthn_credentials.created_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var WebauthnCredentialWhere = struct {
+	ID              whereHelperint64
+	TenantID        whereHelperint64
+	UserID          whereHelperint64
+	Name            whereHelperstring
+	CredentialID    whereHelper__byte
+	PublicKey       whereHelper__byte
+	AttestationType whereHelperstring
+	Transports      whereHelpertypes_StringArray
+	Aaguid          whereHelper__byte
+	SignCount       whereHelperint64
+	BackupEligible  whereHelperbool
+	BackupState     whereHelperbool
+	LastUsedAt      whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"webauthn_credentials\".\"id\""},
+	TenantID:        whereHelperint64{field: "\"webauthn_credentials\".\"tenant_id\""},
+	UserID:          whereHelperint64{field: "\"webauthn_credentials\".\"user_id\""},
+	Name:            whereHelperstring{field: "\"webauthn_credentials\".\"name\""},
+	CredentialID:    whereHelper__byte{field: "\"webauthn_credentials\".\"credential_id\""},
+	PublicKey:       whereHelper__byte{field: "\"webauthn_credentials\".\"public_key\""},
+	AttestationType: whereHelperstring{field: "\"webauthn_credentials\".\"attestation_type\""},
+	Transports:      whereHelpertypes_StringArray{field: "\"webauthn_credentials\".\"transports\""},
+	Aaguid:          whereHelper__byte{field: "\"webauthn_credentials\".\"aaguid\""},
+	SignCount:       whereHelperint64{field: "\"webauthn_credentials\".\"sign_count\""},
+	BackupEligible:  whereHelperbool{field: "\"webauthn_credentials\".\"backup_eligible\""},
+	BackupState:     whereHelperbool{field: "\"webauthn_credentials\".\"backup_state\""},
+	LastUsedAt:      whereHelpernull_Time{field: "\"webauthn_credentials\".\"last_used_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"webauthn_credentials\".\"created_at\""},
+}
+
+// WebauthnCredentialRels is where relationship names are stored.
+var WebauthnCredentialRels = struct {
+	Tenant string
+	User   string
+}{
+	Tenant: "Tenant",
+	User:   "User",
+}
+
+// webauthnCredentialR is where relationships are stored.
+type webauthnCredentialR struct {
+	Tenant *Tenant `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	User   *User   `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webauthnCredentialR) NewStruct() *webauthnCredentialR {
+	return &webauthnCredentialR{}
+}
+
+func (o *WebauthnCredential) GetTenant() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenant()
+}
+
+func (r *webauthnCredentialR) GetTenant() *Tenant {
+	if r == nil {
+		return nil
+	}
+
+	return r.Tenant
+}
+
+func (o *WebauthnCredential) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *webauthnCredentialR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// webauthnCredentialL is where Load methods for each relationship are stored.
+type webauthnCredentialL struct{}
+
+var (
+	webauthnCredentialAllColumns            = []string{"id", "tenant_id", "user_id", "name", "credential_id", "public_key", "attestation_type", "transports", "aaguid", "sign_count", "backup_eligible", "backup_state", "last_used_at", "created_at"}
+	webauthnCredentialColumnsWithoutDefault = []string{"tenant_id", "user_id", "name", "credential_id", "public_key", "attestation_type", "aaguid"}
+	webauthnCredentialColumnsWithDefault    = []string{"id", "transports", "sign_count", "backup_eligible", "backup_state", "last_used_at", "created_at"}
+	webauthnCredentialPrimaryKeyColumns     = []string{"id"}
+	webauthnCredentialGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// WebauthnCredentialSlice is an alias for a slice of pointers to WebauthnCredential.
+	// This should almost always be used instead of []WebauthnCredential.
+	WebauthnCredentialSlice []*WebauthnCredential
+	// WebauthnCredentialHook is the signature for custom WebauthnCredential hook methods
+	WebauthnCredentialHook func(context.Context, boil.ContextExecutor, *WebauthnCredential) error
+
+	webauthnCredentialQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webauthnCredentialType                 = reflect.TypeOf(&WebauthnCredential{})
+	webauthnCredentialMapping              = queries.MakeStructMapping(webauthnCredentialType)
+	webauthnCredentialPrimaryKeyMapping, _ = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, webauthnCredentialPrimaryKeyColumns)
+	webauthnCredentialInsertCacheMut       sync.RWMutex
+	webauthnCredentialInsertCache          = make(map[string]insertCache)
+	webauthnCredentialUpdateCacheMut       sync.RWMutex
+	webauthnCredentialUpdateCache          = make(map[string]updateCache)
+	webauthnCredentialUpsertCacheMut       sync.RWMutex
+	webauthnCredentialUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webauthnCredentialAfterSelectMu sync.Mutex
+var webauthnCredentialAfterSelectHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeInsertMu sync.Mutex
+var webauthnCredentialBeforeInsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterInsertMu sync.Mutex
+var webauthnCredentialAfterInsertHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeUpdateMu sync.Mutex
+var webauthnCredentialBeforeUpdateHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpdateMu sync.Mutex
+var webauthnCredentialAfterUpdateHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeDeleteMu sync.Mutex
+var webauthnCredentialBeforeDeleteHooks []WebauthnCredentialHook
+var webauthnCredentialAfterDeleteMu sync.Mutex
+var webauthnCredentialAfterDeleteHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeUpsertMu sync.Mutex
+var webauthnCredentialBeforeUpsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpsertMu sync.Mutex
+var webauthnCredentialAfterUpsertHooks []WebauthnCredentialHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebauthnCredential) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebauthnCredential) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebauthnCredential) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebauthnCredential) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebauthnCredential) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebauthnCredential) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebauthnCredential) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebauthnCredential) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebauthnCredential) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentialHook registers your hook function for all future operations.
+func AddWebauthnCredentialHook(hookPoint boil.HookPoint, webauthnCredentialHook WebauthnCredentialHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webauthnCredentialAfterSelectMu.Lock()
+		webauthnCredentialAfterSelectHooks = append(webauthnCredentialAfterSelectHooks, webauthnCredentialHook)
+		webauthnCredentialAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webauthnCredentialBeforeInsertMu.Lock()
+		webauthnCredentialBeforeInsertHooks = append(webauthnCredentialBeforeInsertHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webauthnCredentialAfterInsertMu.Lock()
+		webauthnCredentialAfterInsertHooks = append(webauthnCredentialAfterInsertHooks, webauthnCredentialHook)
+		webauthnCredentialAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webauthnCredentialBeforeUpdateMu.Lock()
+		webauthnCredentialBeforeUpdateHooks = append(webauthnCredentialBeforeUpdateHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webauthnCredentialAfterUpdateMu.Lock()
+		webauthnCredentialAfterUpdateHooks = append(webauthnCredentialAfterUpdateHooks, webauthnCredentialHook)
+		webauthnCredentialAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webauthnCredentialBeforeDeleteMu.Lock()
+		webauthnCredentialBeforeDeleteHooks = append(webauthnCredentialBeforeDeleteHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webauthnCredentialAfterDeleteMu.Lock()
+		webauthnCredentialAfterDeleteHooks = append(webauthnCredentialAfterDeleteHooks, webauthnCredentialHook)
+		webauthnCredentialAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webauthnCredentialBeforeUpsertMu.Lock()
+		webauthnCredentialBeforeUpsertHooks = append(webauthnCredentialBeforeUpsertHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webauthnCredentialAfterUpsertMu.Lock()
+		webauthnCredentialAfterUpsertHooks = append(webauthnCredentialAfterUpsertHooks, webauthnCredentialHook)
+		webauthnCredentialAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single webauthnCredential record from the query.
+func (q webauthnCredentialQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebauthnCredential, error) {
+	o := &WebauthnCredential{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webauthn_credentials")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebauthnCredential records from the query.
+func (q webauthnCredentialQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebauthnCredentialSlice, error) {
+	var o []*WebauthnCredential
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebauthnCredential slice")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebauthnCredential records in the query.
+func (q webauthnCredentialQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webauthn_credentials rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webauthnCredentialQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webauthn_credentials exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tenant pointed to by the foreign key.
+func (o *WebauthnCredential) Tenant(mods ...qm.QueryMod) tenantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tenants(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *WebauthnCredential) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webauthnCredentialL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebauthnCredential interface{}, mods queries.Applicator) error {
+	var slice []*WebauthnCredential
+	var object *WebauthnCredential
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebauthnCredential.(*WebauthnCredential)
+		if !ok {
+			object = new(WebauthnCredential)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebauthnCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebauthnCredential))
+			}
+		}
+	} else {
+		s, ok := maybeWebauthnCredential.(*[]*WebauthnCredential)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebauthnCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebauthnCredential))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webauthnCredentialR{}
+		}
+		args[object.TenantID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webauthnCredentialR{}
+			}
+
+			args[obj.TenantID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tenants`),
+		qm.WhereIn(`tenants.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tenant")
+	}
+
+	var resultSlice []*Tenant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tenant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tenants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenants")
+	}
+
+	if len(tenantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantR{}
+		}
+		foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TenantID == foreign.ID {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &tenantR{}
+				}
+				foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webauthnCredentialL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebauthnCredential interface{}, mods queries.Applicator) error {
+	var slice []*WebauthnCredential
+	var object *WebauthnCredential
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebauthnCredential.(*WebauthnCredential)
+		if !ok {
+			object = new(WebauthnCredential)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebauthnCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebauthnCredential))
+			}
+		}
+	} else {
+		s, ok := maybeWebauthnCredential.(*[]*WebauthnCredential)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebauthnCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebauthnCredential))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webauthnCredentialR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webauthnCredentialR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the webauthnCredential to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.WebauthnCredentials.
+func (o *WebauthnCredential) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &webauthnCredentialR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			WebauthnCredentials: WebauthnCredentialSlice{o},
+		}
+	} else {
+		related.R.WebauthnCredentials = append(related.R.WebauthnCredentials, o)
+	}
+
+	return nil
+}
+
+// SetUser of the webauthnCredential to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WebauthnCredentials.
+func (o *WebauthnCredential) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &webauthnCredentialR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WebauthnCredentials: WebauthnCredentialSlice{o},
+		}
+	} else {
+		related.R.WebauthnCredentials = append(related.R.WebauthnCredentials, o)
+	}
+
+	return nil
+}
+
+// WebauthnCredentials retrieves all the records using an executor.
+func WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	mods = append(mods, qm.From("\"webauthn_credentials\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webauthn_credentials\".*"})
+	}
+
+	return webauthnCredentialQuery{q}
+}
+
+// FindWebauthnCredential retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebauthnCredential(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebauthnCredential, error) {
+	webauthnCredentialObj := &WebauthnCredential{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webauthn_credentials\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webauthnCredentialObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webauthn_credentials")
+	}
+
+	if err = webauthnCredentialObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webauthnCredentialObj, err
+	}
+
+	return webauthnCredentialObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebauthnCredential) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_credentials provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webauthnCredentialInsertCacheMut.RLock()
+	cache, cached := webauthnCredentialInsertCache[key]
+	webauthnCredentialInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, webauthnCredentialGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webauthn_credentials\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webauthn_credentials\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialInsertCacheMut.Lock()
+		webauthnCredentialInsertCache[key] = cache
+		webauthnCredentialInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebauthnCredential.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebauthnCredential) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webauthnCredentialUpdateCacheMut.RLock()
+	cache, cached := webauthnCredentialUpdateCache[key]
+	webauthnCredentialUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, webauthnCredentialGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webauthn_credentials, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webauthnCredentialPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, append(wl, webauthnCredentialPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webauthn_credentials row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpdateCacheMut.Lock()
+		webauthnCredentialUpdateCache[key] = cache
+		webauthnCredentialUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webauthnCredentialQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebauthnCredentialSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webauthnCredentialPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webauthnCredential")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebauthnCredential) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no webauthn_credentials provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webauthnCredentialUpsertCacheMut.RLock()
+	cache, cached := webauthnCredentialUpsertCache[key]
+	webauthnCredentialUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, webauthnCredentialGeneratedColumns)
+		update = strmangle.SetComplement(update, webauthnCredentialGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webauthn_credentials, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webauthnCredentialAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webauthnCredentialPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert webauthn_credentials, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webauthnCredentialPrimaryKeyColumns))
+			copy(conflict, webauthnCredentialPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webauthn_credentials\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpsertCacheMut.Lock()
+		webauthnCredentialUpsertCache[key] = cache
+		webauthnCredentialUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebauthnCredential record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebauthnCredential) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebauthnCredential provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webauthnCredentialPrimaryKeyMapping)
+	sql := "DELETE FROM \"webauthn_credentials\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webauthn_credentials")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webauthnCredentialQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webauthnCredentialQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebauthnCredentialSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webauthnCredentialBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webauthn_credentials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCredentialPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebauthnCredential) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebauthnCredential(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnCredentialSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebauthnCredentialSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webauthn_credentials\".* FROM \"webauthn_credentials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCredentialPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebauthnCredentialSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebauthnCredentialExists checks if the WebauthnCredential row exists.
+func WebauthnCredentialExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webauthn_credentials\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webauthn_credentials exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebauthnCredential row exists.
+func (o *WebauthnCredential) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebauthnCredentialExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,198 @@
+package access
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+)
+
+// A topic or sub topic without any roles or claims in its access list is
+// public. Otherwise a user sees it only when their role is listed or they hold
+// one of the listed claims, directly or through their role. Restricting a
+// category hides its whole subtree.
+const deniedCategoriesSQL = `
+	WITH user_claim_ids AS (
+		SELECT uc.claim_id FROM user_claims uc WHERE uc.user_id = $2
+		UNION
+		SELECT rc.claim_id FROM role_claims rc JOIN users ru ON ru.role_id = rc.role_id WHERE ru.id = $2
+	)
+	SELECT c.id, c.path, c.topic_id, c.sub_topic_id,
+		CASE WHEN c.topic_id IS NOT NULL THEN
+			(EXISTS (SELECT 1 FROM topic_roles x WHERE x.topic_id = c.topic_id)
+				OR EXISTS (SELECT 1 FROM topic_claims x WHERE x.topic_id = c.topic_id))
+			AND NOT EXISTS (SELECT 1 FROM topic_roles x WHERE x.topic_id = c.topic_id AND x.role_id = u.role_id)
+			AND NOT EXISTS (SELECT 1 FROM topic_claims x WHERE x.topic_id = c.topic_id AND x.claim_id IN (SELECT claim_id FROM user_claim_ids))
+		ELSE
+			(EXISTS (SELECT 1 FROM sub_topic_roles x WHERE x.sub_topic_id = c.sub_topic_id)
+				OR EXISTS (SELECT 1 FROM sub_topic_claims x WHERE x.sub_topic_id = c.sub_topic_id))
+			AND NOT EXISTS (SELECT 1 FROM sub_topic_roles x WHERE x.sub_topic_id = c.sub_topic_id AND x.role_id = u.role_id)
+			AND NOT EXISTS (SELECT 1 FROM sub_topic_claims x WHERE x.sub_topic_id = c.sub_topic_id AND x.claim_id IN (SELECT claim_id FROM user_claim_ids))
+		END AS denied
+	FROM categories c
+	LEFT JOIN users u ON u.id = $2 AND u.tenant_id = $1
+	WHERE c.tenant_id = $1`
+
+type categoryRow struct {
+	ID         int64      `boil:"id"`
+	Path       string     `boil:"path"`
+	TopicID    null.Int64 `boil:"topic_id"`
+	SubTopicID null.Int64 `boil:"sub_topic_id"`
+	Denied     bool       `boil:"denied"`
+}
+
+// Filter holds the topics and sub topics a user cannot see. Every read path
+// that returns topics, sub topics, posts or anything pointing to a post must
+// filter through it.
+type Filter struct {
+	hiddenTopics    map[int64]bool
+	hiddenSubTopics map[int64]bool
+}
+
+// ForUser resolves the access lists of every category of the tenant for the
+// user.
+func ForUser(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64) (*Filter, error) {
+	var rows []categoryRow
+	if err := queries.Raw(deniedCategoriesSQL, tenantID, userID).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+
+	return newFilter(rows), nil
+}
+
+// FromContext resolves the filter for the tenant and user of the request.
+func FromContext(ctx context.Context, exec boil.ContextExecutor) (*Filter, error) {
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ForUser(ctx, exec, tenantID, userID)
+}
+
+// NewFilter builds a filter hiding the given, already resolved, topics and sub
+// topics.
+func NewFilter(hiddenTopicIDs []int64, hiddenSubTopicIDs []int64) *Filter {
+	f := &Filter{
+		hiddenTopics:    make(map[int64]bool, len(hiddenTopicIDs)),
+		hiddenSubTopics: make(map[int64]bool, len(hiddenSubTopicIDs)),
+	}
+	for _, id := range hiddenTopicIDs {
+		f.hiddenTopics[id] = true
+	}
+	for _, id := range hiddenSubTopicIDs {
+		f.hiddenSubTopics[id] = true
+	}
+
+	return f
+}
+
+func newFilter(rows []categoryRow) *Filter {
+	denied := make(map[string]bool)
+	for _, row := range rows {
+		if row.Denied {
+			denied[strconv.FormatInt(row.ID, 10)] = true
+		}
+	}
+
+	f := NewFilter(nil, nil)
+	for _, row := range rows {
+		if !pathDenied(row.Path, denied) {
+			continue
+		}
+
+		if row.TopicID.Valid {
+			f.hiddenTopics[row.TopicID.Int64] = true
+		}
+		if row.SubTopicID.Valid {
+			f.hiddenSubTopics[row.SubTopicID.Int64] = true
+		}
+	}
+
+	return f
+}
+
+func pathDenied(path string, denied map[string]bool) bool {
+	for _, id := range strings.Split(path, "/") {
+		if denied[id] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *Filter) TopicVisible(topicID int64) bool {
+	return !f.hiddenTopics[topicID]
+}
+
+func (f *Filter) SubTopicVisible(subTopicID int64) bool {
+	return !f.hiddenSubTopics[subTopicID]
+}
+
+// HiddenTopicIDs returns the ids of the topics the user cannot see.
+func (f *Filter) HiddenTopicIDs() []int64 {
+	return keys(f.hiddenTopics)
+}
+
+// HiddenSubTopicIDs returns the ids of the sub topics the user cannot see.
+func (f *Filter) HiddenSubTopicIDs() []int64 {
+	return keys(f.hiddenSubTopics)
+}
+
+// Topics filters a topic query down to the visible topics.
+func (f *Filter) Topics() qm.QueryMod {
+	return models.TopicWhere.ID.NIN(f.HiddenTopicIDs())
+}
+
+// SubTopics filters a sub topic query down to the visible sub topics.
+func (f *Filter) SubTopics() qm.QueryMod {
+	return models.SubTopicWhere.ID.NIN(f.HiddenSubTopicIDs())
+}
+
+// Posts filters a post query down to the posts of visible sub topics.
+func (f *Filter) Posts() qm.QueryMod {
+	return models.PostWhere.SubtopicID.NIN(f.HiddenSubTopicIDs())
+}
+
+// Notifications filters a notification query down to the notifications
+// without a post or about a post of a visible sub topic.
+func (f *Filter) Notifications() qm.QueryMod {
+	return qm.Where(
+		"(notifications.post_id IS NULL OR NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = notifications.post_id AND p.subtopic_id = ANY(?)))",
+		pq.Array(f.HiddenSubTopicIDs()),
+	)
+}
+
+// CategoryVisible reports whether the topic or sub topic backing the category
+// is visible.
+func (f *Filter) CategoryVisible(category *models.Category) bool {
+	if category.TopicID.Valid {
+		return f.TopicVisible(category.TopicID.Int64)
+	}
+
+	return f.SubTopicVisible(category.SubTopicID.Int64)
+}
+
+func keys(set map[int64]bool) []int64 {
+	ids := make([]int64, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
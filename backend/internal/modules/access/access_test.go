@@ -0,0 +1,65 @@
+package access
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+)
+
+func topicRow(id int64, path string, topicID int64, denied bool) categoryRow {
+	return categoryRow{ID: id, Path: path, TopicID: null.Int64From(topicID), Denied: denied}
+}
+
+func subTopicRow(id int64, path string, subTopicID int64, denied bool) categoryRow {
+	return categoryRow{ID: id, Path: path, SubTopicID: null.Int64From(subTopicID), Denied: denied}
+}
+
+func TestNewFilter(t *testing.T) {
+	rows := []categoryRow{
+		// Restricted topic, its public sub topic inherits the restriction.
+		topicRow(1, "1", 100, true),
+		subTopicRow(2, "1/2", 200, false),
+		// Public topic with a restricted sub topic and a deeper sub topic below it.
+		topicRow(3, "3", 101, false),
+		subTopicRow(4, "3/4", 201, true),
+		subTopicRow(5, "3/4/5", 202, false),
+		subTopicRow(6, "3/6", 203, false),
+		// Path ids are matched whole, category 11 is not below category 1.
+		topicRow(11, "11", 102, false),
+		subTopicRow(12, "11/12", 204, false),
+	}
+
+	f := newFilter(rows)
+
+	if got, want := f.HiddenTopicIDs(), []int64{100}; !slices.Equal(got, want) {
+		t.Errorf("HiddenTopicIDs() = %v, want %v", got, want)
+	}
+
+	if got, want := f.HiddenSubTopicIDs(), []int64{200, 201, 202}; !slices.Equal(got, want) {
+		t.Errorf("HiddenSubTopicIDs() = %v, want %v", got, want)
+	}
+
+	for _, id := range []int64{101, 102} {
+		if !f.TopicVisible(id) {
+			t.Errorf("TopicVisible(%d) = false, want true", id)
+		}
+	}
+
+	for _, id := range []int64{203, 204} {
+		if !f.SubTopicVisible(id) {
+			t.Errorf("SubTopicVisible(%d) = false, want true", id)
+		}
+	}
+}
+
+func TestNewFilterWithoutRestrictions(t *testing.T) {
+	f := newFilter([]categoryRow{
+		topicRow(1, "1", 100, false),
+		subTopicRow(2, "1/2", 200, false),
+	})
+
+	if ids := f.HiddenSubTopicIDs(); len(ids) != 0 {
+		t.Errorf("HiddenSubTopicIDs() = %v, want none", ids)
+	}
+}
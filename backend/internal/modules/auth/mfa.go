@@ -0,0 +1,431 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/totp"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// mfaChallengeTTL is how long the second login step can be completed.
+	mfaChallengeTTL = 5 * time.Minute
+	// After maxMFAAttempts wrong codes in a row the second factor is locked
+	// for mfaLockDuration, which keeps six digit codes from being guessed.
+	maxMFAAttempts  = 5
+	mfaLockDuration = 15 * time.Minute
+
+	recoveryCodeCount = 10
+)
+
+// mfaChallenge is the response to a correct password of a user with two
+// factor authentication: no tokens yet, only the token for the second step.
+// The challenge is bound to the password hash, so a password change voids it.
+func (s *Service) mfaChallenge(user *models.User) dto.LoginResponse {
+	return dto.LoginResponse{
+		MFARequired: true,
+		MFAToken:    s.signToken(purposeMFAChallenge, user.ID, user.Password, time.Now().Add(mfaChallengeTTL)),
+	}
+}
+
+// VerifyMFA completes a login with a TOTP code or a recovery code.
+func (s *Service) VerifyMFA(ctx context.Context, request dto.VerifyMFARequest) (dto.LoginResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "VerifyMFA").Logger()
+
+	userID, expiresAt, ok := parseSignedToken(request.MFAToken)
+	if !ok || !time.Now().Before(expiresAt) {
+		log.Debug().Msg("MFA token is malformed or expired")
+		return dto.LoginResponse{}, httperrors.ErrInvalidMFAToken
+	}
+
+	user, err := models.FindUser(ctx, s.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Int64("userId", userID).Msg("User of MFA token not found")
+			return dto.LoginResponse{}, httperrors.ErrInvalidMFAToken
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.LoginResponse{}, err
+	}
+
+	expected := s.signToken(purposeMFAChallenge, user.ID, user.Password, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(request.MFAToken)) {
+		log.Debug().Int64("userId", userID).Msg("MFA token signature does not match")
+		return dto.LoginResponse{}, httperrors.ErrInvalidMFAToken
+	}
+
+	var result dto.LoginResponse
+	verified := false
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		verified, err = s.checkSecondFactor(ctx, ce, user.ID, request.Code, request.RecoveryCode)
+		if err != nil || !verified {
+			// A failed attempt is counted, so the transaction commits.
+			return err
+		}
+
+		result, err = s.issueTokens(ctx, ce, user, "")
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrMFALocked) || errors.Is(err, httperrors.ErrMFANotEnabled) {
+			log.Debug().Err(err).Msg("Second factor cannot be checked")
+			return dto.LoginResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to verify second factor")
+		return dto.LoginResponse{}, err
+	}
+
+	if !verified {
+		log.Debug().Int64("userId", user.ID).Msg("Wrong second factor")
+		return dto.LoginResponse{}, httperrors.ErrInvalidMFACode
+	}
+
+	log.Debug().Msg("VerifyMFA service successfully executed")
+
+	return result, nil
+}
+
+// EnrollTOTP creates a new TOTP secret for the current user. It is enabled
+// once confirmed with a code, until then enrolling again replaces it.
+func (s *Service) EnrollTOTP(ctx context.Context) (dto.TOTPEnrollmentDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "EnrollTOTP").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.TOTPEnrollmentDTO{}, err
+	}
+
+	user, err := models.Users(
+		models.UserWhere.ID.EQ(userID),
+		qm.Load(models.UserRels.Tenant),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("User not found")
+			return dto.TOTPEnrollmentDTO{}, httperrors.ErrUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.TOTPEnrollmentDTO{}, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Err(err).Msg("Failed to generate TOTP secret")
+		return dto.TOTPEnrollmentDTO{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		current, err := models.UserTotps(
+			models.UserTotpWhere.UserID.EQ(userID),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if current != nil && current.ConfirmedAt.Valid {
+			return httperrors.ErrMFAAlreadyEnabled
+		}
+
+		enrollment := models.UserTotp{
+			UserID:    userID,
+			Secret:    secret,
+			CreatedAt: time.Now().UTC(),
+		}
+		return enrollment.Upsert(ctx, ce, true, []string{models.UserTotpColumns.UserID},
+			boil.Whitelist(models.UserTotpColumns.Secret, models.UserTotpColumns.CreatedAt), boil.Infer())
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrMFAAlreadyEnabled) {
+			log.Debug().Msg("TOTP already enabled")
+			return dto.TOTPEnrollmentDTO{}, err
+		}
+
+		log.Err(err).Msg("Failed to store TOTP secret")
+		return dto.TOTPEnrollmentDTO{}, err
+	}
+
+	log.Debug().Msg("EnrollTOTP service successfully executed")
+
+	return dto.TOTPEnrollmentDTO{
+		Secret:          secret,
+		ProvisioningURI: totp.URI(user.R.Tenant.Name, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables the enrolled TOTP secret of the current user with a
+// first code and returns the recovery codes. They are only shown this once.
+func (s *Service) ConfirmTOTP(ctx context.Context, request dto.ConfirmTOTPRequest) (dto.RecoveryCodesDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ConfirmTOTP").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.RecoveryCodesDTO{}, err
+	}
+
+	var codes []string
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		enrollment, err := models.UserTotps(
+			models.UserTotpWhere.UserID.EQ(userID),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrMFANotEnrolled
+			}
+			return err
+		}
+		if enrollment.ConfirmedAt.Valid {
+			return httperrors.ErrMFAAlreadyEnabled
+		}
+
+		now := time.Now().UTC()
+		step, ok := totp.Validate(enrollment.Secret, request.Code, now, enrollment.LastUsedStep)
+		if !ok {
+			return httperrors.ErrInvalidMFACode
+		}
+
+		enrollment.ConfirmedAt = null.TimeFrom(now)
+		enrollment.LastUsedStep = step
+		if _, err := enrollment.Update(ctx, ce, boil.Whitelist(models.UserTotpColumns.ConfirmedAt, models.UserTotpColumns.LastUsedStep)); err != nil {
+			return err
+		}
+
+		codes, err = replaceRecoveryCodes(ctx, ce, userID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrMFANotEnrolled) || errors.Is(err, httperrors.ErrMFAAlreadyEnabled) || errors.Is(err, httperrors.ErrInvalidMFACode) {
+			log.Debug().Err(err).Msg("TOTP cannot be confirmed")
+			return dto.RecoveryCodesDTO{}, err
+		}
+
+		log.Err(err).Msg("Failed to confirm TOTP")
+		return dto.RecoveryCodesDTO{}, err
+	}
+
+	log.Debug().Msg("ConfirmTOTP service successfully executed")
+
+	return dto.RecoveryCodesDTO{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP turns two factor authentication off. Holding a session is not
+// enough, the user must enter the password and a second factor again. Users
+// whose role requires two factor authentication cannot turn it off.
+func (s *Service) DisableTOTP(ctx context.Context, request dto.DisableTOTPRequest) (dto.DisableTOTPResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "DisableTOTP").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.DisableTOTPResponse{}, err
+	}
+
+	user, err := models.Users(
+		models.UserWhere.ID.EQ(userID),
+		qm.Load(models.UserRels.Tenant),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("User not found")
+			return dto.DisableTOTPResponse{}, httperrors.ErrUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.DisableTOTPResponse{}, err
+	}
+
+	matches, err := util.ComparePasswordAndHash(request.Password, user.Password)
+	if err != nil {
+		log.Err(err).Msg("Failed to compare password with stored hash")
+		return dto.DisableTOTPResponse{}, err
+	}
+
+	if !matches {
+		log.Debug().Msg("Provided password does not match stored hash")
+		return dto.DisableTOTPResponse{}, echo.ErrUnauthorized
+	}
+
+	if slices.Contains(user.R.Tenant.MfaRequiredRoleIds, user.RoleID) {
+		log.Debug().Int64("roleId", user.RoleID).Msg("Role requires two factor authentication")
+		return dto.DisableTOTPResponse{}, httperrors.ErrMFARequired
+	}
+
+	verified := false
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		verified, err = s.checkSecondFactor(ctx, ce, user.ID, request.Code, request.RecoveryCode)
+		if err != nil || !verified {
+			return err
+		}
+
+		if _, err := models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(user.ID)).DeleteAll(ctx, ce); err != nil {
+			return err
+		}
+
+		_, err = models.UserTotps(models.UserTotpWhere.UserID.EQ(user.ID)).DeleteAll(ctx, ce)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrMFALocked) || errors.Is(err, httperrors.ErrMFANotEnabled) {
+			log.Debug().Err(err).Msg("Second factor cannot be checked")
+			return dto.DisableTOTPResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to disable TOTP")
+		return dto.DisableTOTPResponse{}, err
+	}
+
+	if !verified {
+		log.Debug().Int64("userId", user.ID).Msg("Wrong second factor")
+		return dto.DisableTOTPResponse{}, httperrors.ErrInvalidMFACode
+	}
+
+	log.Debug().Msg("DisableTOTP service successfully executed")
+
+	return dto.DisableTOTPResponse{ID: user.ID}, nil
+}
+
+// checkSecondFactor checks a TOTP code or, when no code is given, a recovery
+// code of the user. A wrong one counts as a failed attempt and too many lock
+// the second factor; the caller must commit for the count to stick.
+func (s *Service) checkSecondFactor(ctx context.Context, exec boil.ContextExecutor, userID int64, code *string, recoveryCode *string) (bool, error) {
+	enrollment, err := models.UserTotps(
+		models.UserTotpWhere.UserID.EQ(userID),
+		models.UserTotpWhere.ConfirmedAt.IsNotNull(),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, httperrors.ErrMFANotEnabled
+		}
+		return false, err
+	}
+
+	now := time.Now().UTC()
+	if enrollment.LockedUntil.Valid && now.Before(enrollment.LockedUntil.Time) {
+		return false, httperrors.ErrMFALocked
+	}
+
+	verified := false
+	switch {
+	case code != nil:
+		var step int64
+		step, verified = totp.Validate(enrollment.Secret, *code, now, enrollment.LastUsedStep)
+		if verified {
+			enrollment.LastUsedStep = step
+		}
+	case recoveryCode != nil:
+		verified, err = useRecoveryCode(ctx, exec, userID, *recoveryCode, now)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if verified {
+		enrollment.FailedAttempts = 0
+		enrollment.LockedUntil = null.Time{}
+	} else {
+		enrollment.FailedAttempts++
+		if enrollment.FailedAttempts >= maxMFAAttempts {
+			enrollment.FailedAttempts = 0
+			enrollment.LockedUntil = null.TimeFrom(now.Add(mfaLockDuration))
+		}
+	}
+
+	_, err = enrollment.Update(ctx, exec, boil.Whitelist(
+		models.UserTotpColumns.LastUsedStep,
+		models.UserTotpColumns.FailedAttempts,
+		models.UserTotpColumns.LockedUntil,
+	))
+	return verified, err
+}
+
+// mfaEnabled reports whether the user confirmed a TOTP secret.
+func mfaEnabled(ctx context.Context, exec boil.ContextExecutor, userID int64) (bool, error) {
+	return models.UserTotps(
+		models.UserTotpWhere.UserID.EQ(userID),
+		models.UserTotpWhere.ConfirmedAt.IsNotNull(),
+	).Exists(ctx, exec)
+}
+
+// mfaEnrollmentRequired reports whether the role of the user requires two
+// factor authentication the user has not enabled yet.
+func mfaEnrollmentRequired(ctx context.Context, exec boil.ContextExecutor, user *models.User, tenant *models.Tenant) (bool, error) {
+	if !slices.Contains(tenant.MfaRequiredRoleIds, user.RoleID) {
+		return false, nil
+	}
+
+	enabled, err := mfaEnabled(ctx, exec, user.ID)
+	return !enabled, err
+}
+
+// replaceRecoveryCodes invalidates the recovery codes of the user and returns
+// a new set.
+func replaceRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, userID int64) ([]string, error) {
+	if _, err := models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, 8)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(raw))[:10]
+		codes[i] = code[:5] + "-" + code[5:]
+
+		recoveryCode := models.RecoveryCode{
+			UserID:   userID,
+			CodeHash: hashToken(normalizeRecoveryCode(codes[i])),
+		}
+		if err := recoveryCode.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+
+	return codes, nil
+}
+
+// useRecoveryCode marks the matching unused recovery code of the user as used
+// and reports whether there was one.
+func useRecoveryCode(ctx context.Context, exec boil.ContextExecutor, userID int64, code string, now time.Time) (bool, error) {
+	updated, err := models.RecoveryCodes(
+		models.RecoveryCodeWhere.UserID.EQ(userID),
+		models.RecoveryCodeWhere.CodeHash.EQ(hashToken(normalizeRecoveryCode(code))),
+		models.RecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{models.RecoveryCodeColumns.UsedAt: null.TimeFrom(now)})
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+// normalizeRecoveryCode drops the separator and case, which users may type
+// either way.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
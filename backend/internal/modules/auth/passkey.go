@@ -0,0 +1,510 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/passkey"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+const (
+	ceremonyRegistration = "registration"
+	ceremonyLogin        = "login"
+
+	webauthnHandleBytes = 32
+	ceremonyIDBytes     = 32
+)
+
+// takeCeremonySQL deletes the ceremony while reading it, so each can only be
+// finished once even by concurrent requests.
+const takeCeremonySQL = `
+	DELETE FROM webauthn_ceremonies
+	WHERE id = $1 AND kind = $2 AND expires_at > $3
+	RETURNING *`
+
+// errUnknownPasskey is returned by the user lookup of a login when the
+// credential is not registered in the tenant of the user handle.
+var errUnknownPasskey = errors.New("unknown passkey")
+
+// BeginPasskeyRegistration returns the options to create a passkey for the
+// current user.
+func (s *Service) BeginPasskeyRegistration(ctx context.Context) (dto.PasskeyOptionsDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "BeginPasskeyRegistration").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	user, err := models.FindUser(ctx, s.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("User not found")
+			return dto.PasskeyOptionsDTO{}, httperrors.ErrUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	if !user.WebauthnHandle.Valid {
+		handle := make([]byte, webauthnHandleBytes)
+		if _, err := rand.Read(handle); err != nil {
+			log.Err(err).Msg("Failed to generate user handle")
+			return dto.PasskeyOptionsDTO{}, err
+		}
+
+		user.WebauthnHandle = null.BytesFrom(handle)
+		if _, err := user.Update(ctx, s.db, boil.Whitelist(models.UserColumns.WebauthnHandle)); err != nil {
+			log.Err(err).Msg("Failed to store user handle")
+			return dto.PasskeyOptionsDTO{}, err
+		}
+	}
+
+	passkeyUser, _, err := loadPasskeyUser(ctx, s.db, user)
+	if err != nil {
+		log.Err(err).Msg("Failed to load passkeys")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	options, session, err := s.passkeys.BeginRegistration(passkeyUser)
+	if err != nil {
+		log.Err(err).Msg("Failed to begin passkey registration")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	ceremonyID, err := s.startCeremony(ctx, ceremonyRegistration, null.Int64From(user.ID), session)
+	if err != nil {
+		log.Err(err).Msg("Failed to store passkey ceremony")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	log.Debug().Msg("BeginPasskeyRegistration service successfully executed")
+
+	return dto.PasskeyOptionsDTO{CeremonyID: ceremonyID, Options: options}, nil
+}
+
+// RegisterPasskey stores the passkey the authenticator created for the
+// registration options.
+func (s *Service) RegisterPasskey(ctx context.Context, request dto.RegisterPasskeyRequest) (dto.PasskeyDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "RegisterPasskey").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.PasskeyDTO{}, err
+	}
+
+	ceremony, err := s.takeCeremony(ctx, request.CeremonyID, ceremonyRegistration)
+	if err != nil {
+		log.Err(err).Msg("Failed to load passkey ceremony")
+		return dto.PasskeyDTO{}, err
+	}
+	if ceremony == nil || ceremony.UserID.Int64 != userID {
+		log.Debug().Msg("Passkey ceremony is unknown, expired or of another user")
+		return dto.PasskeyDTO{}, httperrors.ErrInvalidPasskeyCeremony
+	}
+
+	response, err := json.Marshal(request.Credential)
+	if err != nil {
+		return dto.PasskeyDTO{}, err
+	}
+
+	var credential *models.WebauthnCredential
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		user, err := models.FindUser(ctx, ce, userID)
+		if err != nil {
+			return err
+		}
+
+		passkeyUser, _, err := loadPasskeyUser(ctx, ce, user)
+		if err != nil {
+			return err
+		}
+
+		created, err := s.passkeys.FinishRegistration(passkeyUser, ceremony.Session, response)
+		if err != nil {
+			return err
+		}
+
+		// The authenticator chooses the credential id, so a taken one is
+		// refused instead of shadowing the passkey of another user.
+		taken, err := models.WebauthnCredentials(
+			models.WebauthnCredentialWhere.TenantID.EQ(user.TenantID),
+			models.WebauthnCredentialWhere.CredentialID.EQ(created.ID),
+		).Exists(ctx, ce)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return passkey.ErrInvalidResponse
+		}
+
+		transports := make(types.StringArray, len(created.Transport))
+		for i, transport := range created.Transport {
+			transports[i] = string(transport)
+		}
+
+		credential = &models.WebauthnCredential{
+			TenantID:        user.TenantID,
+			UserID:          user.ID,
+			Name:            request.Name,
+			CredentialID:    created.ID,
+			PublicKey:       created.PublicKey,
+			AttestationType: created.AttestationType,
+			Transports:      transports,
+			Aaguid:          created.Authenticator.AAGUID,
+			SignCount:       int64(created.Authenticator.SignCount),
+			BackupEligible:  created.Flags.BackupEligible,
+			BackupState:     created.Flags.BackupState,
+		}
+		return credential.Insert(ctx, ce, boil.Infer())
+	})
+	if err != nil {
+		if errors.Is(err, passkey.ErrInvalidResponse) {
+			log.Debug().Err(err).Msg("Passkey registration response rejected")
+			return dto.PasskeyDTO{}, httperrors.ErrInvalidPasskey
+		}
+
+		log.Err(err).Msg("Failed to register passkey")
+		return dto.PasskeyDTO{}, err
+	}
+
+	log.Debug().Msg("RegisterPasskey service successfully executed")
+
+	return passkeyDTO(credential), nil
+}
+
+// GetPasskeys returns the passkeys of the current user in its tenant.
+func (s *Service) GetPasskeys(ctx context.Context) ([]dto.PasskeyDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetPasskeys").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return nil, err
+	}
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	credentials, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.UserID.EQ(userID),
+		models.WebauthnCredentialWhere.TenantID.EQ(tenantID),
+		qm.OrderBy(models.WebauthnCredentialColumns.CreatedAt),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to load passkeys")
+		return nil, err
+	}
+
+	result := make([]dto.PasskeyDTO, len(credentials))
+	for i, credential := range credentials {
+		result[i] = passkeyDTO(credential)
+	}
+
+	log.Debug().Msg("GetPasskeys service successfully executed")
+
+	return result, nil
+}
+
+// UpdatePasskey renames a passkey of the current user.
+func (s *Service) UpdatePasskey(ctx context.Context, request dto.UpdatePasskeyRequest) (dto.PasskeyDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "UpdatePasskey").Logger()
+
+	credential, err := s.findOwnPasskey(ctx, request.ID)
+	if err != nil {
+		log.Err(err).Msg("Failed to load passkey")
+		return dto.PasskeyDTO{}, err
+	}
+
+	credential.Name = request.Name
+	if _, err := credential.Update(ctx, s.db, boil.Whitelist(models.WebauthnCredentialColumns.Name)); err != nil {
+		log.Err(err).Msg("Failed to rename passkey")
+		return dto.PasskeyDTO{}, err
+	}
+
+	log.Debug().Msg("UpdatePasskey service successfully executed")
+
+	return passkeyDTO(credential), nil
+}
+
+// DeletePasskey removes a passkey of the current user, so it cannot be used
+// to log in anymore.
+func (s *Service) DeletePasskey(ctx context.Context, request dto.DeletePasskeyRequest) (dto.DeletePasskeyResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "DeletePasskey").Logger()
+
+	credential, err := s.findOwnPasskey(ctx, request.ID)
+	if err != nil {
+		log.Err(err).Msg("Failed to load passkey")
+		return dto.DeletePasskeyResponse{}, err
+	}
+
+	if _, err := credential.Delete(ctx, s.db); err != nil {
+		log.Err(err).Msg("Failed to delete passkey")
+		return dto.DeletePasskeyResponse{}, err
+	}
+
+	log.Debug().Msg("DeletePasskey service successfully executed")
+
+	return dto.DeletePasskeyResponse{ID: credential.ID}, nil
+}
+
+// BeginPasskeyLogin returns the options to log in with a passkey. The
+// authenticator lets the user pick one, so no email is needed.
+func (s *Service) BeginPasskeyLogin(ctx context.Context) (dto.PasskeyOptionsDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "BeginPasskeyLogin").Logger()
+
+	options, session, err := s.passkeys.BeginLogin()
+	if err != nil {
+		log.Err(err).Msg("Failed to begin passkey login")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	ceremonyID, err := s.startCeremony(ctx, ceremonyLogin, null.Int64{}, session)
+	if err != nil {
+		log.Err(err).Msg("Failed to store passkey ceremony")
+		return dto.PasskeyOptionsDTO{}, err
+	}
+
+	log.Debug().Msg("BeginPasskeyLogin service successfully executed")
+
+	return dto.PasskeyOptionsDTO{CeremonyID: ceremonyID, Options: options}, nil
+}
+
+// PasskeyLogin logs in the user of the passkey the authenticator signed the
+// login options with. The passkey verified the user itself, so no second
+// factor is asked for.
+func (s *Service) PasskeyLogin(ctx context.Context, request dto.PasskeyLoginRequest) (dto.LoginResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "PasskeyLogin").Logger()
+
+	ceremony, err := s.takeCeremony(ctx, request.CeremonyID, ceremonyLogin)
+	if err != nil {
+		log.Err(err).Msg("Failed to load passkey ceremony")
+		return dto.LoginResponse{}, err
+	}
+	if ceremony == nil {
+		log.Debug().Msg("Passkey ceremony is unknown or expired")
+		return dto.LoginResponse{}, httperrors.ErrInvalidPasskeyCeremony
+	}
+
+	response, err := json.Marshal(request.Credential)
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	var (
+		user        *models.User
+		credentials models.WebauthnCredentialSlice
+	)
+	lookup := func(credentialID []byte, userHandle []byte) (*passkey.User, error) {
+		found, err := models.Users(models.UserWhere.WebauthnHandle.EQ(null.BytesFrom(userHandle))).One(ctx, s.db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, errUnknownPasskey
+			}
+			return nil, err
+		}
+
+		passkeyUser, foundCredentials, err := loadPasskeyUser(ctx, s.db, found)
+		if err != nil {
+			return nil, err
+		}
+
+		user, credentials = found, foundCredentials
+		return passkeyUser, nil
+	}
+
+	_, used, err := s.passkeys.FinishLogin(ceremony.Session, response, lookup)
+	if err != nil {
+		switch {
+		case errors.Is(err, passkey.ErrClonedAuthenticator):
+			log.Warn().Int64("userId", user.ID).Msg("Passkey signature counter went backwards, the passkey may be cloned")
+			return dto.LoginResponse{}, httperrors.ErrInvalidPasskey
+		case errors.Is(err, passkey.ErrInvalidResponse), errors.Is(err, errUnknownPasskey):
+			log.Debug().Err(err).Msg("Passkey login response rejected")
+			return dto.LoginResponse{}, httperrors.ErrInvalidPasskey
+		}
+
+		log.Err(err).Msg("Failed to check passkey login")
+		return dto.LoginResponse{}, err
+	}
+
+	var result dto.LoginResponse
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		for _, credential := range credentials {
+			if !bytes.Equal(credential.CredentialID, used.ID) {
+				continue
+			}
+
+			credential.SignCount = int64(used.Authenticator.SignCount)
+			credential.BackupState = used.Flags.BackupState
+			credential.LastUsedAt = null.TimeFrom(time.Now().UTC())
+			if _, err := credential.Update(ctx, ce, boil.Whitelist(
+				models.WebauthnCredentialColumns.SignCount,
+				models.WebauthnCredentialColumns.BackupState,
+				models.WebauthnCredentialColumns.LastUsedAt,
+			)); err != nil {
+				return err
+			}
+		}
+
+		result, err = s.issueTokens(ctx, ce, user, "")
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrEmailNotVerified) {
+			log.Debug().Msg("Email of user not verified")
+			return dto.LoginResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to issue tokens")
+		return dto.LoginResponse{}, err
+	}
+
+	log.Debug().Msg("PasskeyLogin service successfully executed")
+
+	return result, nil
+}
+
+// startCeremony keeps the session of a ceremony until it is finished and
+// returns its id. Expired ceremonies are cleaned up on the way.
+func (s *Service) startCeremony(ctx context.Context, kind string, userID null.Int64, session []byte) (string, error) {
+	now := time.Now().UTC()
+	if _, err := models.WebauthnCeremonies(models.WebauthnCeremonyWhere.ExpiresAt.LT(now)).DeleteAll(ctx, s.db); err != nil {
+		return "", err
+	}
+
+	id, err := randomToken(ceremonyIDBytes)
+	if err != nil {
+		return "", err
+	}
+
+	ceremony := models.WebauthnCeremony{
+		ID:        id,
+		UserID:    userID,
+		Kind:      kind,
+		Session:   session,
+		ExpiresAt: now.Add(s.config.Auth.WebAuthnCeremonyTTL),
+	}
+	if err := ceremony.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// takeCeremony removes the ceremony and returns it, or nil when it is
+// unknown, of another kind or expired.
+func (s *Service) takeCeremony(ctx context.Context, id string, kind string) (*models.WebauthnCeremony, error) {
+	var ceremony models.WebauthnCeremony
+	if err := queries.Raw(takeCeremonySQL, id, kind, time.Now().UTC()).Bind(ctx, s.db, &ceremony); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &ceremony, nil
+}
+
+// findOwnPasskey loads a passkey of the current user in its tenant.
+func (s *Service) findOwnPasskey(ctx context.Context, id int64) (*models.WebauthnCredential, error) {
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.ID.EQ(id),
+		models.WebauthnCredentialWhere.UserID.EQ(userID),
+		models.WebauthnCredentialWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrPasskeyNotFound
+		}
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// loadPasskeyUser returns the user with the passkeys registered in its
+// tenant, as the relying party needs it.
+func loadPasskeyUser(ctx context.Context, exec boil.ContextExecutor, user *models.User) (*passkey.User, models.WebauthnCredentialSlice, error) {
+	credentials, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.UserID.EQ(user.ID),
+		models.WebauthnCredentialWhere.TenantID.EQ(user.TenantID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	passkeyUser := &passkey.User{
+		ID:          user.ID,
+		Handle:      user.WebauthnHandle.Bytes,
+		Name:        user.Email,
+		DisplayName: user.Name,
+		Credentials: make([]webauthn.Credential, len(credentials)),
+	}
+
+	for i, credential := range credentials {
+		transports := make([]protocol.AuthenticatorTransport, len(credential.Transports))
+		for j, transport := range credential.Transports {
+			transports[j] = protocol.AuthenticatorTransport(transport)
+		}
+
+		passkeyUser.Credentials[i] = webauthn.Credential{
+			ID:              credential.CredentialID,
+			PublicKey:       credential.PublicKey,
+			AttestationType: credential.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: credential.BackupEligible,
+				BackupState:    credential.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    credential.Aaguid,
+				SignCount: uint32(credential.SignCount),
+			},
+		}
+	}
+
+	return passkeyUser, credentials, nil
+}
+
+func passkeyDTO(credential *models.WebauthnCredential) dto.PasskeyDTO {
+	return dto.PasskeyDTO{
+		ID:         credential.ID,
+		Name:       credential.Name,
+		CreatedAt:  credential.CreatedAt,
+		LastUsedAt: credential.LastUsedAt.Ptr(),
+	}
+}
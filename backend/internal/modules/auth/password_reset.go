@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/mailer"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const passwordResetTokenBytes = 32
+
+// lockEmailSQL serializes the requests of one email, so concurrent requests
+// cannot slip past the rate limit together.
+const lockEmailSQL = `SELECT pg_advisory_xact_lock(hashtext($1))`
+
+// ForgotPassword mails a password reset link to the user with the email. The
+// response is the same whether a user has the email or not, and the mail is
+// sent in the background so the response time does not tell either.
+func (s *Service) ForgotPassword(ctx context.Context, request dto.ForgotPasswordRequest) (dto.ForgotPasswordResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ForgotPassword").Logger()
+
+	email := strings.ToLower(strings.TrimSpace(request.Email))
+
+	var message *mailer.Message
+	err := db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := queries.Raw(lockEmailSQL, email).ExecContext(ctx, ce); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		windowStart := now.Add(-s.config.Auth.PasswordResetWindow)
+
+		if _, err := models.PasswordResetRequests(
+			models.PasswordResetRequestWhere.Email.EQ(email),
+			models.PasswordResetRequestWhere.CreatedAt.LT(windowStart),
+		).DeleteAll(ctx, ce); err != nil {
+			return err
+		}
+
+		count, err := models.PasswordResetRequests(
+			models.PasswordResetRequestWhere.Email.EQ(email),
+		).Count(ctx, ce)
+		if err != nil {
+			return err
+		}
+		if count >= int64(s.config.Auth.PasswordResetMaxRequests) {
+			return httperrors.ErrTooManyPasswordResetRequests
+		}
+
+		resetRequest := models.PasswordResetRequest{Email: email, CreatedAt: now}
+		if err := resetRequest.Insert(ctx, ce, boil.Infer()); err != nil {
+			return err
+		}
+
+		user, err := models.Users(
+			qm.Where("LOWER("+models.UserColumns.Email+") = ?", email),
+		).One(ctx, ce)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		token, err := randomToken(passwordResetTokenBytes)
+		if err != nil {
+			return err
+		}
+
+		resetToken := models.PasswordResetToken{
+			UserID:    user.ID,
+			TokenHash: hashToken(token),
+			ExpiresAt: now.Add(s.config.Auth.PasswordResetTokenTTL),
+		}
+		if err := resetToken.Insert(ctx, ce, boil.Infer()); err != nil {
+			return err
+		}
+
+		message = &mailer.Message{
+			To:      user.Email,
+			Subject: "Reset your password",
+			Body:    s.passwordResetBody(user.Name, token),
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrTooManyPasswordResetRequests) {
+			log.Debug().Msg("Too many password reset requests")
+			return dto.ForgotPasswordResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to create password reset token")
+		return dto.ForgotPasswordResponse{}, err
+	}
+
+	if message != nil {
+		sendCtx := util.DetachContext(ctx)
+		go func() {
+			if err := s.mailer.Send(sendCtx, *message); err != nil {
+				util.LogFromContext(sendCtx).Err(err).Msg("Failed to send password reset mail")
+			}
+		}()
+	} else {
+		log.Debug().Msg("No user with the email, no password reset mail sent")
+	}
+
+	log.Debug().Msg("ForgotPassword service successfully executed")
+
+	return dto.ForgotPasswordResponse{Email: request.Email}, nil
+}
+
+// ResetPassword sets a new password with a mailed reset token. The token can
+// only be used once, and every session of the user is revoked.
+func (s *Service) ResetPassword(ctx context.Context, request dto.ResetPasswordRequest) (dto.ResetPasswordResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ResetPassword").Logger()
+
+	hash, err := util.HashPassword(request.NewPassword, util.DefaultArgon2Params)
+	if err != nil {
+		log.Err(err).Msg("Failed to hash user password")
+		return dto.ResetPasswordResponse{}, err
+	}
+
+	var userID int64
+	var mirrorRevocation func()
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		token, err := models.PasswordResetTokens(
+			models.PasswordResetTokenWhere.TokenHash.EQ(hashToken(request.Token)),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrInvalidPasswordResetToken
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		if token.UsedAt.Valid || !token.ExpiresAt.After(now) {
+			return httperrors.ErrInvalidPasswordResetToken
+		}
+
+		user, err := models.FindUser(ctx, ce, token.UserID)
+		if err != nil {
+			return err
+		}
+
+		user.Password = hash
+		if _, err := user.Update(ctx, ce, boil.Whitelist(models.UserColumns.Password)); err != nil {
+			return err
+		}
+
+		// Other links mailed before stop working as well.
+		if _, err := models.PasswordResetTokens(
+			models.PasswordResetTokenWhere.UserID.EQ(user.ID),
+			models.PasswordResetTokenWhere.UsedAt.IsNull(),
+		).UpdateAll(ctx, ce, models.M{models.PasswordResetTokenColumns.UsedAt: null.TimeFrom(now)}); err != nil {
+			return err
+		}
+
+		userID = user.ID
+		mirrorRevocation, err = s.revocations.RevokeUser(ctx, ce, user.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrInvalidPasswordResetToken) {
+			log.Debug().Msg("Password reset token is unknown, used or expired")
+			return dto.ResetPasswordResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to reset password")
+		return dto.ResetPasswordResponse{}, err
+	}
+	mirrorRevocation()
+
+	log.Debug().Msg("ResetPassword service successfully executed")
+
+	return dto.ResetPasswordResponse{ID: userID}, nil
+}
+
+// passwordResetBody is the text of the reset mail, linking to the password
+// reset page of the frontend.
+func (s *Service) passwordResetBody(name string, token string) string {
+	link := strings.TrimRight(s.config.Frontend.BaseURL, "/") + s.config.Frontend.PasswordResetEndpoint +
+		"?token=" + url.QueryEscape(token)
+
+	return fmt.Sprintf(
+		"Hello %s,\n\nsomeone asked to reset the password of your account. Open the link below to set a new password:\n\n%s\n\nThe link works once and expires in %d minutes. If you did not ask for it, ignore this mail.\n",
+		name, link, int(s.config.Auth.PasswordResetTokenTTL.Minutes()),
+	)
+}
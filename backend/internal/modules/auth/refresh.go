@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	tenant "cuhara.qua.go/internal/modules/tennant"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/golang-jwt/jwt"
+)
+
+const refreshTokenBytes = 32
+
+// Refresh exchanges a refresh token for a new access token and rotates the
+// refresh token. A refresh token that was already rotated has leaked or was
+// replayed, so its whole family is revoked and the user must log in again.
+func (s *Service) Refresh(ctx context.Context, request dto.RefreshRequest) (dto.LoginResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Refresh").Logger()
+
+	var result dto.LoginResponse
+	reused := false
+	err := db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		token, err := models.RefreshTokens(
+			models.RefreshTokenWhere.TokenHash.EQ(hashToken(request.RefreshToken)),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrInvalidRefreshToken
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		switch {
+		case token.RevokedAt.Valid:
+			return httperrors.ErrInvalidRefreshToken
+		case token.RotatedAt.Valid:
+			// The revocation must be committed, so the transaction succeeds
+			// and the error is returned afterwards.
+			reused = true
+			log.Warn().Int64("userId", token.UserID).Str("familyId", token.FamilyID).Msg("Refresh token reused, revoking token family")
+			return revokeFamily(ctx, ce, token.FamilyID, now)
+		case !token.ExpiresAt.After(now):
+			return httperrors.ErrInvalidRefreshToken
+		}
+
+		token.RotatedAt = null.TimeFrom(now)
+		if _, err := token.Update(ctx, ce, boil.Whitelist(models.RefreshTokenColumns.RotatedAt)); err != nil {
+			return err
+		}
+
+		user, err := models.FindUser(ctx, ce, token.UserID)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.issueTokens(ctx, ce, user, token.FamilyID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrInvalidRefreshToken) {
+			log.Debug().Msg("Refresh token is unknown, revoked or expired")
+			return dto.LoginResponse{}, err
+		}
+
+		log.Error().Err(err).Msg("Failed to refresh tokens")
+		return dto.LoginResponse{}, err
+	}
+
+	if reused {
+		return dto.LoginResponse{}, httperrors.ErrRefreshTokenReused
+	}
+
+	log.Debug().Msg("Refresh service successfully executed")
+
+	return result, nil
+}
+
+// issueTokens creates an access token for the user and a refresh token of the
+// family using exec. An empty family starts a new one, as on login. Users
+// with an unverified email get no tokens or read only ones, depending on
+// their tenant, and users who must enable two factor authentication get
+// tokens that can only do so.
+func (s *Service) issueTokens(ctx context.Context, exec boil.ContextExecutor, user *models.User, familyID string) (dto.LoginResponse, error) {
+	t, err := models.FindTenant(ctx, exec, user.TenantID)
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	access := unverifiedAccess(user, t)
+	if access == tenant.UnverifiedAccessNone {
+		return dto.LoginResponse{}, httperrors.ErrEmailNotVerified
+	}
+
+	enrollMFA, err := mfaEnrollmentRequired(ctx, exec, user, t)
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	if familyID == "" {
+		familyID, err = randomToken(16)
+		if err != nil {
+			return dto.LoginResponse{}, err
+		}
+	}
+
+	refreshToken, err := randomToken(refreshTokenBytes)
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	token := models.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: time.Now().UTC().Add(s.config.Auth.RefreshTokenTTL),
+	}
+	if err := token.Insert(ctx, exec, boil.Infer()); err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	claims := jwt.MapClaims{
+		"sub":   user.ID,
+		"email": user.Email,
+	}
+	if access == tenant.UnverifiedAccessReadOnly {
+		claims[util.ClaimReadOnly] = true
+	}
+	if enrollMFA {
+		claims[util.ClaimMFAEnrollment] = true
+	}
+
+	accessToken, err := s.keys.Sign(ctx, claims)
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	return dto.LoginResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.config.Auth.JWTTTLMinutes.Seconds()),
+	}, nil
+}
+
+func revokeFamily(ctx context.Context, exec boil.ContextExecutor, familyID string, now time.Time) error {
+	_, err := models.RefreshTokens(
+		models.RefreshTokenWhere.FamilyID.EQ(familyID),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{models.RefreshTokenColumns.RevokedAt: now})
+	return err
+}
+
+func randomToken(size int) (string, error) {
+	raw := make([]byte, size)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// hashToken returns the hex encoded SHA-256 of a refresh or reset token. The
+// tokens are random, so an unsalted fast hash is enough to keep a database
+// leak from yielding usable tokens.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
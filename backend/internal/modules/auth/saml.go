@@ -0,0 +1,285 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/saml"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+const ssoLoginCodeBytes = 32
+
+// takeSAMLRequestSQL deletes the authentication request while reading it, so
+// each request can only be answered once.
+const takeSAMLRequestSQL = `
+	DELETE FROM saml_login_requests
+	WHERE relay_state = $1 AND expires_at > $2
+	RETURNING *`
+
+// takeSSOLoginCodeSQL deletes the login code while reading it, so each code
+// can only be traded once.
+const takeSSOLoginCodeSQL = `
+	DELETE FROM sso_login_codes
+	WHERE code_hash = $1 AND expires_at > $2
+	RETURNING *`
+
+// SAMLMetadata returns the metadata of the service provider of the tenant,
+// which its identity provider is set up with.
+func (s *Service) SAMLMetadata(ctx context.Context, request dto.GetSAMLMetadataRequest) ([]byte, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SAMLMetadata").Logger()
+
+	provider, err := models.FindTenantSamlProvider(ctx, s.db, request.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrSSONotConfigured
+		}
+
+		log.Err(err).Msg("Failed to load SAML provider")
+		return nil, err
+	}
+
+	metadata, err := saml.Metadata(s.samlConfig(provider))
+	if err != nil {
+		log.Err(err).Msg("Failed to build SAML metadata")
+		return nil, err
+	}
+
+	log.Debug().Msg("SAMLMetadata service successfully executed")
+
+	return metadata, nil
+}
+
+// StartSAMLLogin starts a login at the SAML identity provider of the tenant
+// and returns the URL to send the user to. The request is kept until the
+// identity provider posts its response back.
+func (s *Service) StartSAMLLogin(ctx context.Context, request dto.StartSSOLoginRequest) (dto.SSOAuthorizationDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "StartSAMLLogin").Logger()
+
+	provider, err := s.findSAMLProvider(ctx, request.TenantID)
+	if err != nil {
+		log.Err(err).Msg("Failed to load SAML provider")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	now := time.Now().UTC()
+	if _, err := models.SamlLoginRequests(models.SamlLoginRequestWhere.ExpiresAt.LT(now)).DeleteAll(ctx, s.db); err != nil {
+		log.Err(err).Msg("Failed to delete expired login requests")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	relayState, err := randomToken(oidcStateBytes)
+	if err != nil {
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	authorizationURL, requestID, err := saml.AuthnRequestURL(s.samlConfig(provider), relayState)
+	if err != nil {
+		log.Err(err).Msg("Failed to build SAML authentication request")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	loginRequest := models.SamlLoginRequest{
+		RelayState: hashToken(relayState),
+		TenantID:   provider.TenantID,
+		RequestID:  requestID,
+		ExpiresAt:  now.Add(s.config.Auth.SAMLRequestTTL),
+	}
+	if err := loginRequest.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert login request")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	log.Debug().Msg("StartSAMLLogin service successfully executed")
+
+	return dto.SSOAuthorizationDTO{AuthorizationURL: authorizationURL, State: relayState}, nil
+}
+
+// SAMLAssertion finishes a login with the response the identity provider
+// posted to the assertion consumer service. Only responses to requests
+// started here, by the browser posting the response, are taken. Users are
+// created on their first login and found again by their NameID later on. The browser arrives here from the identity
+// provider, so the login is handed to the frontend as a short lived code
+// instead of tokens.
+func (s *Service) SAMLAssertion(ctx context.Context, request dto.SAMLAssertionRequest) (dto.SSOLoginCodeDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SAMLAssertion").Logger()
+
+	if !sameState(request.RelayState, request.BrowserState) {
+		log.Debug().Msg("Login request was not started by this browser")
+		return dto.SSOLoginCodeDTO{}, httperrors.ErrInvalidSSOState
+	}
+
+	var loginRequest models.SamlLoginRequest
+	if err := queries.Raw(takeSAMLRequestSQL, hashToken(request.RelayState), time.Now().UTC()).Bind(ctx, s.db, &loginRequest); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("Login request is unknown or expired")
+			return dto.SSOLoginCodeDTO{}, httperrors.ErrInvalidSSOState
+		}
+
+		log.Err(err).Msg("Failed to load login request")
+		return dto.SSOLoginCodeDTO{}, err
+	}
+
+	provider, err := s.findSAMLProvider(ctx, loginRequest.TenantID)
+	if err != nil {
+		log.Err(err).Msg("Failed to load SAML provider")
+		return dto.SSOLoginCodeDTO{}, err
+	}
+
+	assertion, err := saml.ParseResponse(s.samlConfig(provider), request.SAMLResponse, loginRequest.RequestID)
+	if err != nil {
+		if errors.Is(err, saml.ErrInvalidResponse) {
+			log.Debug().Err(err).Msg("SAML login rejected")
+			return dto.SSOLoginCodeDTO{}, httperrors.ErrSSOLoginFailed
+		}
+
+		log.Err(err).Msg("Failed to read SAML response")
+		return dto.SSOLoginCodeDTO{}, err
+	}
+
+	// A NameID is only an email when its format says so; an opaque or
+	// persistent NameID is never taken as one.
+	email := assertion.First(provider.EmailAttribute)
+	if provider.EmailAttribute == "" && assertion.NameIDFormat == saml.EmailAddressFormat {
+		email = assertion.NameID
+	}
+	if email == "" {
+		log.Debug().Str("subject", assertion.NameID).Msg("Identity provider sent no email for the user")
+		return dto.SSOLoginCodeDTO{}, httperrors.ErrSSOEmailNotVerified
+	}
+
+	var mappings []saml.RoleMapping
+	if err := json.Unmarshal(provider.RoleMappings, &mappings); err != nil {
+		log.Err(err).Msg("Failed to read role mappings")
+		return dto.SSOLoginCodeDTO{}, err
+	}
+	roleID, mapped := saml.MapRole(assertion.Attributes, provider.RoleAttribute, mappings)
+
+	// Unlike OIDC, SAML does not say whether the email was verified, so
+	// existing users are only linked by it when the tenant trusts the
+	// identity provider to.
+	identity := ssoIdentity{
+		TenantID:  provider.TenantID,
+		Issuer:    provider.IdpEntityID,
+		Subject:   assertion.NameID,
+		Email:     email,
+		Name:      assertion.First(provider.NameAttribute),
+		Username:  assertion.First(provider.UsernameAttribute),
+		LinkEmail: provider.LinkExistingUsers,
+	}
+
+	code, err := randomToken(ssoLoginCodeBytes)
+	if err != nil {
+		return dto.SSOLoginCodeDTO{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		user, err := s.loginSSOUser(ctx, ce, identity, roleID, mapped, provider.DefaultRoleID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if _, err := models.SsoLoginCodes(models.SsoLoginCodeWhere.ExpiresAt.LT(now)).DeleteAll(ctx, ce); err != nil {
+			return err
+		}
+
+		loginCode := models.SsoLoginCode{
+			CodeHash:  hashToken(code),
+			UserID:    user.ID,
+			ExpiresAt: now.Add(s.config.Auth.SSOLoginCodeTTL),
+		}
+		return loginCode.Insert(ctx, ce, boil.Infer())
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, httperrors.ErrSSOUserNotAllowed):
+			log.Debug().Str("subject", assertion.NameID).Msg("No role for the user of the identity provider")
+			return dto.SSOLoginCodeDTO{}, err
+		case errors.Is(err, httperrors.ErrConflictUserAlreadyExists):
+			log.Debug().Str("subject", assertion.NameID).Msg("Email of the user belongs to a user that cannot be linked")
+			return dto.SSOLoginCodeDTO{}, err
+		}
+
+		log.Err(err).Msg("Failed to log in user of the identity provider")
+		return dto.SSOLoginCodeDTO{}, err
+	}
+
+	log.Debug().Msg("SAMLAssertion service successfully executed")
+
+	return dto.SSOLoginCodeDTO{Code: code}, nil
+}
+
+// RedeemSSOLoginCode trades the code of a finished single sign-on login for
+// tokens. Each code can be used once.
+func (s *Service) RedeemSSOLoginCode(ctx context.Context, request dto.SSOLoginCodeRequest) (dto.LoginResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "RedeemSSOLoginCode").Logger()
+
+	var result dto.LoginResponse
+	err := db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		var loginCode models.SsoLoginCode
+		if err := queries.Raw(takeSSOLoginCodeSQL, hashToken(request.Code), time.Now().UTC()).Bind(ctx, ce, &loginCode); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrInvalidSSOState
+			}
+			return err
+		}
+
+		user, err := models.FindUser(ctx, ce, loginCode.UserID)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.issueTokens(ctx, ce, user, "")
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrInvalidSSOState) {
+			log.Debug().Msg("Login code is unknown or expired")
+			return dto.LoginResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to redeem login code")
+		return dto.LoginResponse{}, err
+	}
+
+	log.Debug().Msg("RedeemSSOLoginCode service successfully executed")
+
+	return result, nil
+}
+
+// findSAMLProvider loads the enabled identity provider of the tenant.
+func (s *Service) findSAMLProvider(ctx context.Context, tenantID int64) (*models.TenantSamlProvider, error) {
+	provider, err := models.FindTenantSamlProvider(ctx, s.db, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrSSONotConfigured
+		}
+		return nil, err
+	}
+
+	if !provider.Enabled {
+		return nil, httperrors.ErrSSONotConfigured
+	}
+
+	return provider, nil
+}
+
+func (s *Service) samlConfig(provider *models.TenantSamlProvider) saml.Config {
+	return saml.Config{
+		EntityID:       saml.EntityID(s.config.Echo.BaseURL, provider.TenantID),
+		ACSURL:         saml.ACSURL(s.config.Echo.BaseURL),
+		IDPEntityID:    provider.IdpEntityID,
+		IDPSSOURL:      provider.IdpSsoURL,
+		IDPCertificate: provider.IdpCertificate,
+	}
+}
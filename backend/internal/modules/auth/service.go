@@ -4,70 +4,166 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sync"
+	"time"
 
 	"cuhara.qua.go/internal/api/httperrors"
 	"cuhara.qua.go/internal/config"
 	"cuhara.qua.go/internal/data/dto"
 	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/jwtkeys"
+	"cuhara.qua.go/internal/modules/lockout"
+	"cuhara.qua.go/internal/modules/mailer"
+	"cuhara.qua.go/internal/modules/oidc"
+	"cuhara.qua.go/internal/modules/passkey"
+	"cuhara.qua.go/internal/modules/revocation"
 	"cuhara.qua.go/internal/util"
 	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
-	"github.com/golang-jwt/jwt"
-	"github.com/labstack/echo/v4"
 )
 
 type Service struct {
-	config config.Server
-	db     *sql.DB
+	config      config.Server
+	db          *sql.DB
+	revocations *revocation.Store
+	mailer      mailer.Mailer
+	passkeys    *passkey.RelyingParty
+	oidc        *oidc.Client
+	keys        *jwtkeys.Store
 }
 
-func NewService(config config.Server, db *sql.DB) *Service {
+func NewService(config config.Server, db *sql.DB, revocations *revocation.Store, mailer mailer.Mailer, passkeys *passkey.RelyingParty, oidcClient *oidc.Client, keys *jwtkeys.Store) *Service {
 	return &Service{
-		config: config,
-		db:     db,
+		config:      config,
+		db:          db,
+		revocations: revocations,
+		mailer:      mailer,
+		passkeys:    passkeys,
+		oidc:        oidcClient,
+		keys:        keys,
 	}
 }
 
-// Login implements infra.AuthService.
+// dummyPasswordHash is compared against when no user has the email, so
+// unknown emails take as long to reject as wrong passwords.
+var dummyPasswordHash = sync.OnceValues(func() (string, error) {
+	password, err := randomToken(ssoPasswordBytes)
+	if err != nil {
+		return "", err
+	}
+	return util.HashPassword(password, util.DefaultArgon2Params)
+})
+
+// Login implements infra.AuthService. Failed logins are counted per account
+// and per client IP; past a few of them the account or IP is locked for a
+// growing time. The lockout is checked, the password compared and the
+// failure counted in one transaction holding the account and IP, so
+// parallel guesses wait for each other instead of slipping past the check.
+// Unknown emails and wrong passwords fail alike.
 func (s *Service) Login(ctx context.Context, request dto.LoginRequest) (dto.LoginResponse, error) {
 	log := util.LogFromContext(ctx).With().Str("function", "Login").Logger()
 
-	user, err := models.Users(
-		models.UserWhere.Email.EQ(request.Email),
-	).One(ctx, s.db)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Debug().Err(err).Msg("User not found")
+	keys := []lockout.Key{lockout.Account(request.Email)}
+	if request.IPAddress != "" {
+		keys = append(keys, lockout.IP(request.IPAddress))
+	}
+
+	var (
+		user        *models.User
+		lockedUntil time.Time
+		failed      bool
+	)
+	err := db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		now := time.Now().UTC()
+		until, locked, err := lockout.Hold(ctx, ce, now, keys...)
+		if err != nil {
+			log.Err(err).Msg("Failed to check login lockout")
+			return err
 		}
 
-		log.Err(err).Msg("Failed to load user")
-	}
+		if locked {
+			lockedUntil = until
+			return nil
+		}
+
+		found, err := models.Users(
+			models.UserWhere.Email.EQ(request.Email),
+		).One(ctx, ce)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to load user")
+			return err
+		}
+
+		hash, err := dummyPasswordHash()
+		if err != nil {
+			log.Err(err).Msg("Failed to hash dummy password")
+			return err
+		}
+		if found != nil {
+			hash = found.Password
+		}
+
+		matches, err := util.ComparePasswordAndHash(request.Password, hash)
+		if err != nil {
+			log.Err(err).Msg("Failed to compare password with stored hash")
+			return err
+		}
 
-	matches, err := util.ComparePasswordAndHash(request.Password, user.Password)
+		if found == nil || !matches {
+			log.Debug().Bool("userFound", found != nil).Msg("Invalid credentials")
+
+			// The failure has to be committed, so it is not returned as an
+			// error of the transaction.
+			failed = true
+			if err := s.recordLoginFailure(ctx, ce, now, keys); err != nil {
+				log.Err(err).Msg("Failed to record failed login")
+				return err
+			}
+			return nil
+		}
+
+		if err := lockout.Reset(ctx, ce, lockout.Account(request.Email)); err != nil {
+			log.Err(err).Msg("Failed to reset failed logins")
+			return err
+		}
+
+		user = found
+		return nil
+	})
 	if err != nil {
-		log.Err(err).Msg("Failed to compare password with stored hash")
 		return dto.LoginResponse{}, err
 	}
 
-	if !matches {
-		log.Debug().Msg("Provided password does not match stored hash")
-		return dto.LoginResponse{}, echo.ErrUnauthorized
+	if !lockedUntil.IsZero() {
+		log.Debug().Time("lockedUntil", lockedUntil).Msg("Login is locked")
+		return dto.LoginResponse{}, httperrors.ErrLoginLocked
+	}
+
+	if failed {
+		return dto.LoginResponse{}, httperrors.ErrInvalidCredentials
+	}
+
+	mfa, err := mfaEnabled(ctx, s.db, user.ID)
+	if err != nil {
+		log.Err(err).Msg("Failed to check two factor authentication")
+		return dto.LoginResponse{}, err
 	}
 
-	claims := jwt.MapClaims{
-		"sub":   user.ID,
-		"email": user.Email,
+	if mfa {
+		log.Debug().Msg("Login needs a second factor")
+		return s.mfaChallenge(user), nil
 	}
 
-	token, err := util.GenerateJWT(claims)
+	result, err := s.issueTokens(ctx, s.db, user, "")
 	if err != nil {
-		log.Err(err).Msg("Failed to generate JWT")
+		log.Err(err).Msg("Failed to issue tokens")
 		return dto.LoginResponse{}, err
 	}
 
 	log.Debug().Msg("Login service successfully executed")
 
-	return dto.LoginResponse{Token: token}, nil
+	return result, nil
 }
 
 // Register implements infra.AuthService.
@@ -101,14 +197,16 @@ func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dt
 	}
 
 	var result dto.LoginResponse
-	db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
-		user := &models.User{
-			Email:      request.Email,
-			Name:       request.Name,
-			VSCAccount: request.VscAccount,
-			Password:   hash,
-			RoleID:     request.RoleID,
-			TenantID:   tenantID,
+	var user *models.User
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		user = &models.User{
+			Email:                   request.Email,
+			Name:                    request.Name,
+			VSCAccount:              request.VscAccount,
+			Password:                hash,
+			RoleID:                  request.RoleID,
+			TenantID:                tenantID,
+			EmailVerificationSentAt: null.TimeFrom(time.Now().UTC()),
 		}
 
 		if err := user.Insert(ctx, ce, boil.Infer()); err != nil {
@@ -116,21 +214,59 @@ func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dt
 			return err
 		}
 
-		claims := jwt.MapClaims{
-			"sub":   user.ID,
-			"email": user.Email,
-		}
-
-		result.Token, err = util.GenerateJWT(claims)
-		if err != nil {
-			log.Err(err).Msg("Failed to generate JWT")
+		// Tenants that keep unverified users out get the user without
+		// tokens, the user logs in after verifying the email.
+		result, err = s.issueTokens(ctx, ce, user, "")
+		if err != nil && !errors.Is(err, httperrors.ErrEmailNotVerified) {
+			log.Err(err).Msg("Failed to issue tokens")
 			return err
 		}
 
 		return nil
 	})
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	s.sendVerification(ctx, user)
 
 	log.Debug().Msg("Register service successfully executed")
 
 	return result, nil
 }
+
+// recordLoginFailure counts a failed login against each of the keys. The
+// account and the IP are locked by their own policy.
+func (s *Service) recordLoginFailure(ctx context.Context, exec boil.ContextExecutor, now time.Time, keys []lockout.Key) error {
+	for _, key := range keys {
+		policy := lockout.Policy{
+			FreeAttempts: s.config.Auth.LoginFreeAttempts,
+			MaxAttempts:  s.config.Auth.LoginMaxAttempts,
+			LockDuration: s.config.Auth.LoginLockDuration,
+		}
+		if key.Scope == lockout.ScopeIP {
+			policy.MaxAttempts = s.config.Auth.LoginMaxIPAttempts
+		}
+
+		if err := lockout.RecordFailure(ctx, exec, policy, now, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteStaleLoginThrottles forgets the failed logins of accounts and IPs
+// that have not failed for the lock duration.
+func (s *Service) DeleteStaleLoginThrottles(ctx context.Context) error {
+	log := util.LogFromContext(ctx).With().Str("function", "DeleteStaleLoginThrottles").Logger()
+
+	deleted, err := lockout.DeleteStale(ctx, s.db, time.Now().UTC().Add(-s.config.Auth.LoginLockDuration))
+	if err != nil {
+		log.Err(err).Msg("Failed to delete stale login throttles")
+		return err
+	}
+
+	log.Debug().Int64("deleted", deleted).Msg("DeleteStaleLoginThrottles service successfully executed")
+
+	return nil
+}
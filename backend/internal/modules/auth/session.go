@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/labstack/echo/v4"
+)
+
+// Logout revokes the access token of the request until it expires. When the
+// refresh token of the session is given, its family is revoked as well so the
+// session cannot be refreshed anymore.
+func (s *Service) Logout(ctx context.Context, request dto.LogoutRequest) (dto.LogoutResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Logout").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.LogoutResponse{}, err
+	}
+
+	if request.RefreshToken != nil {
+		token, err := models.RefreshTokens(
+			models.RefreshTokenWhere.TokenHash.EQ(hashToken(*request.RefreshToken)),
+			models.RefreshTokenWhere.UserID.EQ(userID),
+		).One(ctx, s.db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Msg("Refresh token not found")
+				return dto.LogoutResponse{}, httperrors.ErrInvalidRefreshToken
+			}
+
+			log.Err(err).Msg("Failed to load refresh token")
+			return dto.LogoutResponse{}, err
+		}
+
+		if err := revokeFamily(ctx, s.db, token.FamilyID, time.Now().UTC()); err != nil {
+			log.Err(err).Msg("Failed to revoke refresh token family")
+			return dto.LogoutResponse{}, err
+		}
+	}
+
+	// Tokens issued before they carried an id cannot be revoked on their
+	// own and simply run out.
+	jti, err := util.TokenIDFromContext(ctx)
+	if err == nil {
+		expiresAt, err := util.TokenExpiryFromContext(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get token expiry from context")
+			return dto.LogoutResponse{}, err
+		}
+
+		if err := s.revocations.RevokeToken(ctx, jti, userID, expiresAt); err != nil {
+			log.Err(err).Msg("Failed to revoke access token")
+			return dto.LogoutResponse{}, err
+		}
+	}
+
+	log.Debug().Msg("Logout service successfully executed")
+
+	return dto.LogoutResponse{ID: userID}, nil
+}
+
+// ChangePassword replaces the password of the current user. Everyone holding
+// a token of the user may know the old password, so every session is revoked
+// and the caller gets a fresh token pair.
+func (s *Service) ChangePassword(ctx context.Context, request dto.ChangePasswordRequest) (dto.LoginResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ChangePassword").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.LoginResponse{}, err
+	}
+
+	user, err := models.FindUser(ctx, s.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("User not found")
+			return dto.LoginResponse{}, httperrors.ErrUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.LoginResponse{}, err
+	}
+
+	matches, err := util.ComparePasswordAndHash(request.CurrentPassword, user.Password)
+	if err != nil {
+		log.Err(err).Msg("Failed to compare password with stored hash")
+		return dto.LoginResponse{}, err
+	}
+
+	if !matches {
+		log.Debug().Msg("Provided password does not match stored hash")
+		return dto.LoginResponse{}, echo.ErrUnauthorized
+	}
+
+	hash, err := util.HashPassword(request.NewPassword, util.DefaultArgon2Params)
+	if err != nil {
+		log.Err(err).Msg("Failed to hash user password")
+		return dto.LoginResponse{}, err
+	}
+
+	var result dto.LoginResponse
+	var mirrorRevocation func()
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		user.Password = hash
+		if _, err := user.Update(ctx, ce, boil.Whitelist(models.UserColumns.Password)); err != nil {
+			return err
+		}
+
+		mirrorRevocation, err = s.revocations.RevokeUser(ctx, ce, user.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.issueTokens(ctx, ce, user, "")
+		return err
+	})
+	if err != nil {
+		log.Err(err).Msg("Failed to change password")
+		return dto.LoginResponse{}, err
+	}
+	mirrorRevocation()
+
+	log.Debug().Msg("ChangePassword service successfully executed")
+
+	return result, nil
+}
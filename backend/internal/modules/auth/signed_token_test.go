@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignedToken(t *testing.T) {
+	s := &Service{}
+	s.config.Auth.JWTSecret = "secret"
+
+	expiresAt := time.Unix(1767225600, 0)
+	token := s.signToken(purposeEmailVerification, 42, "user@example.com", expiresAt)
+
+	userID, parsedExpiresAt, ok := parseSignedToken(token)
+	if !ok || userID != 42 || !parsedExpiresAt.Equal(expiresAt) {
+		t.Fatalf("token %q parsed as user %d expiring %v", token, userID, parsedExpiresAt)
+	}
+
+	if s.signToken(purposeEmailVerification, 42, "other@example.com", expiresAt) == token {
+		t.Error("token does not depend on the binding")
+	}
+	if s.signToken(purposeEmailVerification, 43, "user@example.com", expiresAt) == token {
+		t.Error("token does not depend on the user")
+	}
+	if s.signToken(purposeMFAChallenge, 42, "user@example.com", expiresAt) == token {
+		t.Error("token does not depend on the purpose")
+	}
+
+	s.config.Auth.JWTSecret = "other"
+	if s.signToken(purposeEmailVerification, 42, "user@example.com", expiresAt) == token {
+		t.Error("token does not depend on the secret")
+	}
+
+	for _, malformed := range []string{"", "42", "42.abc.sig", "x.1767225600.sig", "42.1767225600"} {
+		if _, _, ok := parseSignedToken(malformed); ok {
+			t.Errorf("malformed token %q parsed", malformed)
+		}
+	}
+}
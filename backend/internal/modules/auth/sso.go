@@ -0,0 +1,350 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/oidc"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+const (
+	oidcStateBytes   = 32
+	ssoPasswordBytes = 32
+)
+
+// takeOIDCStateSQL deletes the login state while reading it, so each
+// redirect of the provider can only be used once.
+const takeOIDCStateSQL = `
+	DELETE FROM oidc_login_states
+	WHERE state = $1 AND expires_at > $2
+	RETURNING *`
+
+// StartOIDCLogin starts a login at the OpenID Connect provider of the tenant
+// and returns the URL to send the user to. The state, nonce and PKCE verifier
+// of the login are kept until the provider redirects back.
+func (s *Service) StartOIDCLogin(ctx context.Context, request dto.StartSSOLoginRequest) (dto.SSOAuthorizationDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "StartOIDCLogin").Logger()
+
+	provider, err := s.findOIDCProvider(ctx, request.TenantID)
+	if err != nil {
+		log.Err(err).Msg("Failed to load OIDC provider")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	now := time.Now().UTC()
+	if _, err := models.OidcLoginStates(models.OidcLoginStateWhere.ExpiresAt.LT(now)).DeleteAll(ctx, s.db); err != nil {
+		log.Err(err).Msg("Failed to delete expired login states")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	state, err := randomToken(oidcStateBytes)
+	if err != nil {
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	nonce, err := randomToken(oidcStateBytes)
+	if err != nil {
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	verifier := oidc.GenerateVerifier()
+
+	authorizationURL, err := s.oidc.AuthCodeURL(ctx, s.oidcConfig(provider), state, nonce, verifier)
+	if err != nil {
+		log.Warn().Err(err).Str("issuer", provider.Issuer).Msg("Failed to discover OIDC provider")
+		return dto.SSOAuthorizationDTO{}, httperrors.ErrSSOProviderUnavailable
+	}
+
+	loginState := models.OidcLoginState{
+		State:        hashToken(state),
+		TenantID:     provider.TenantID,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    now.Add(s.config.Auth.OIDCStateTTL),
+	}
+	if err := loginState.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Err(err).Msg("Failed to insert login state")
+		return dto.SSOAuthorizationDTO{}, err
+	}
+
+	log.Debug().Msg("StartOIDCLogin service successfully executed")
+
+	return dto.SSOAuthorizationDTO{AuthorizationURL: authorizationURL, State: state}, nil
+}
+
+// OIDCCallback finishes a login with the code the provider redirected back
+// with. The state has to come back to the browser that started the login,
+// otherwise anyone could log a victim in to their own account. Users are
+// created on their first login and found again by the subject of the
+// provider later on. The provider authenticated the user, so no second
+// factor is asked for.
+func (s *Service) OIDCCallback(ctx context.Context, request dto.OIDCCallbackRequest) (dto.LoginResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "OIDCCallback").Logger()
+
+	if !sameState(request.State, request.BrowserState) {
+		log.Debug().Msg("Login state was not started by this browser")
+		return dto.LoginResponse{}, httperrors.ErrInvalidSSOState
+	}
+
+	var loginState models.OidcLoginState
+	if err := queries.Raw(takeOIDCStateSQL, hashToken(request.State), time.Now().UTC()).Bind(ctx, s.db, &loginState); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("Login state is unknown or expired")
+			return dto.LoginResponse{}, httperrors.ErrInvalidSSOState
+		}
+
+		log.Err(err).Msg("Failed to load login state")
+		return dto.LoginResponse{}, err
+	}
+
+	provider, err := s.findOIDCProvider(ctx, loginState.TenantID)
+	if err != nil {
+		log.Err(err).Msg("Failed to load OIDC provider")
+		return dto.LoginResponse{}, err
+	}
+
+	claims, err := s.oidc.Exchange(ctx, s.oidcConfig(provider), request.Code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrExchangeFailed) || errors.Is(err, oidc.ErrInvalidIDToken) {
+			log.Debug().Err(err).Msg("OIDC login rejected")
+			return dto.LoginResponse{}, httperrors.ErrSSOLoginFailed
+		}
+
+		log.Warn().Err(err).Str("issuer", provider.Issuer).Msg("Failed to reach OIDC provider")
+		return dto.LoginResponse{}, httperrors.ErrSSOProviderUnavailable
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		log.Debug().Str("subject", claims.Subject).Msg("Provider did not verify the email of the user")
+		return dto.LoginResponse{}, httperrors.ErrSSOEmailNotVerified
+	}
+
+	var mappings []oidc.RoleMapping
+	if err := json.Unmarshal(provider.RoleMappings, &mappings); err != nil {
+		log.Err(err).Msg("Failed to read role mappings")
+		return dto.LoginResponse{}, err
+	}
+	roleID, mapped := oidc.MapRole(claims.All, provider.RoleClaim, mappings)
+
+	identity := ssoIdentity{
+		TenantID:  provider.TenantID,
+		Issuer:    provider.Issuer,
+		Subject:   claims.Subject,
+		Email:     claims.Email,
+		Name:      claims.Name,
+		Username:  claims.Username,
+		LinkEmail: true,
+	}
+
+	var result dto.LoginResponse
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		user, err := s.loginSSOUser(ctx, ce, identity, roleID, mapped, provider.DefaultRoleID)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.issueTokens(ctx, ce, user, "")
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, httperrors.ErrSSOUserNotAllowed):
+			log.Debug().Str("subject", claims.Subject).Msg("No role for the user of the provider")
+			return dto.LoginResponse{}, err
+		case errors.Is(err, httperrors.ErrConflictUserAlreadyExists):
+			log.Debug().Str("subject", claims.Subject).Msg("Email of the user belongs to a user that cannot be linked")
+			return dto.LoginResponse{}, err
+		}
+
+		log.Err(err).Msg("Failed to log in user of the provider")
+		return dto.LoginResponse{}, err
+	}
+
+	log.Debug().Msg("OIDCCallback service successfully executed")
+
+	return result, nil
+}
+
+// findOIDCProvider loads the enabled provider of the tenant.
+func (s *Service) findOIDCProvider(ctx context.Context, tenantID int64) (*models.TenantOidcProvider, error) {
+	provider, err := models.FindTenantOidcProvider(ctx, s.db, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrSSONotConfigured
+		}
+		return nil, err
+	}
+
+	if !provider.Enabled {
+		return nil, httperrors.ErrSSONotConfigured
+	}
+
+	return provider, nil
+}
+
+func (s *Service) oidcConfig(provider *models.TenantOidcProvider) oidc.Config {
+	return oidc.Config{
+		Issuer:       provider.Issuer,
+		ClientID:     provider.ClientID,
+		ClientSecret: provider.ClientSecret,
+		Scopes:       provider.Scopes,
+		RedirectURL:  strings.TrimSuffix(s.config.Frontend.BaseURL, "/") + s.config.Frontend.OIDCCallbackEndpoint,
+	}
+}
+
+// ssoIdentity is a user as asserted by the single sign-on provider of a
+// tenant, known by its subject at the issuer. LinkEmail tells whether the
+// provider may take over an existing user of the tenant with its email.
+type ssoIdentity struct {
+	TenantID  int64
+	Issuer    string
+	Subject   string
+	Email     string
+	Name      string
+	Username  string
+	LinkEmail bool
+}
+
+// loginSSOUser finds or creates the user of the identity. The provider is
+// the source of the role of its users; users no mapping matches keep the
+// role they have, new ones get the default role or are turned away.
+func (s *Service) loginSSOUser(ctx context.Context, exec boil.ContextExecutor, identity ssoIdentity, roleID int64, mapped bool, defaultRoleID null.Int64) (*models.User, error) {
+	user, err := s.findSSOUser(ctx, exec, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		if !mapped && !defaultRoleID.Valid {
+			return nil, httperrors.ErrSSOUserNotAllowed
+		}
+		if !mapped {
+			roleID = defaultRoleID.Int64
+		}
+
+		return s.provisionSSOUser(ctx, exec, identity, roleID)
+	}
+
+	whitelist := []string{}
+	if mapped && user.RoleID != roleID {
+		user.RoleID = roleID
+		whitelist = append(whitelist, models.UserColumns.RoleID)
+	}
+	if !user.EmailVerifiedAt.Valid {
+		user.EmailVerifiedAt = null.TimeFrom(time.Now().UTC())
+		whitelist = append(whitelist, models.UserColumns.EmailVerifiedAt)
+	}
+	if len(whitelist) > 0 {
+		user.UpdatedAt = null.TimeFrom(time.Now().UTC())
+		whitelist = append(whitelist, models.UserColumns.UpdatedAt)
+		if _, err := user.Update(ctx, exec, boil.Whitelist(whitelist...)); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// findSSOUser returns the user linked to the subject of the provider. A user
+// of the tenant with the email of the subject is linked on the first login
+// when the identity allows it, users of other tenants are never taken over.
+// It returns nil when the user does not exist yet.
+func (s *Service) findSSOUser(ctx context.Context, exec boil.ContextExecutor, identity ssoIdentity) (*models.User, error) {
+	linked, err := models.UserIdentities(
+		models.UserIdentityWhere.TenantID.EQ(identity.TenantID),
+		models.UserIdentityWhere.Issuer.EQ(identity.Issuer),
+		models.UserIdentityWhere.Subject.EQ(identity.Subject),
+	).One(ctx, exec)
+	if err == nil {
+		return models.FindUser(ctx, exec, linked.UserID)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	user, err := models.Users(models.UserWhere.Email.EQ(identity.Email)).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if user.TenantID != identity.TenantID || !identity.LinkEmail {
+		return nil, httperrors.ErrConflictUserAlreadyExists
+	}
+
+	if err := linkIdentity(ctx, exec, identity, user.ID); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// provisionSSOUser creates the user of a subject on its first login. The
+// user gets a random password nobody knows, it logs in through the provider
+// until it resets the password.
+func (s *Service) provisionSSOUser(ctx context.Context, exec boil.ContextExecutor, identity ssoIdentity, roleID int64) (*models.User, error) {
+	password, err := randomToken(ssoPasswordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := util.HashPassword(password, util.DefaultArgon2Params)
+	if err != nil {
+		return nil, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = identity.Email
+	}
+
+	user := &models.User{
+		Email:           identity.Email,
+		Name:            name,
+		VSCAccount:      identity.Username,
+		Password:        hash,
+		RoleID:          roleID,
+		TenantID:        identity.TenantID,
+		EmailVerifiedAt: null.TimeFrom(time.Now().UTC()),
+	}
+	if err := user.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	if err := linkIdentity(ctx, exec, identity, user.ID); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func linkIdentity(ctx context.Context, exec boil.ContextExecutor, identity ssoIdentity, userID int64) error {
+	linked := models.UserIdentity{
+		TenantID: identity.TenantID,
+		UserID:   userID,
+		Issuer:   identity.Issuer,
+		Subject:  identity.Subject,
+	}
+	return linked.Insert(ctx, exec, boil.Infer())
+}
+
+// sameState reports whether the state a provider sent back matches the one
+// kept in the browser that started the login.
+func sameState(state string, browserState string) bool {
+	return browserState != "" && subtle.ConstantTimeCompare([]byte(state), []byte(browserState)) == 1
+}
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/mailer"
+	tenant "cuhara.qua.go/internal/modules/tennant"
+	"cuhara.qua.go/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+const claimVerificationMailSQL = `
+	UPDATE users SET email_verification_sent_at = $2
+	WHERE id = $1 AND email_verified_at IS NULL
+	AND (email_verification_sent_at IS NULL OR email_verification_sent_at < $3)`
+
+// VerifyEmail marks the email of a user as verified with the token of a
+// mailed verification link. Verifying twice is not an error.
+func (s *Service) VerifyEmail(ctx context.Context, request dto.VerifyEmailRequest) (dto.VerifyEmailResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "VerifyEmail").Logger()
+
+	userID, expiresAt, ok := parseSignedToken(request.Token)
+	if !ok || !time.Now().Before(expiresAt) {
+		log.Debug().Msg("Verification token is malformed or expired")
+		return dto.VerifyEmailResponse{}, httperrors.ErrInvalidVerificationToken
+	}
+
+	user, err := models.FindUser(ctx, s.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Int64("userId", userID).Msg("User of verification token not found")
+			return dto.VerifyEmailResponse{}, httperrors.ErrInvalidVerificationToken
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.VerifyEmailResponse{}, err
+	}
+
+	// The signature covers the email, so links mailed to a previous email of
+	// the user stop working once it changes.
+	expected := s.signToken(purposeEmailVerification, user.ID, user.Email, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(request.Token)) {
+		log.Debug().Int64("userId", userID).Msg("Verification token signature does not match")
+		return dto.VerifyEmailResponse{}, httperrors.ErrInvalidVerificationToken
+	}
+
+	if !user.EmailVerifiedAt.Valid {
+		user.EmailVerifiedAt = null.TimeFrom(time.Now().UTC())
+		if _, err := user.Update(ctx, s.db, boil.Whitelist(models.UserColumns.EmailVerifiedAt)); err != nil {
+			log.Err(err).Msg("Failed to mark email as verified")
+			return dto.VerifyEmailResponse{}, err
+		}
+	}
+
+	log.Debug().Msg("VerifyEmail service successfully executed")
+
+	return dto.VerifyEmailResponse{ID: user.ID}, nil
+}
+
+// ResendVerification mails a new verification link to the current user, at
+// most once per resend interval of the config.
+func (s *Service) ResendVerification(ctx context.Context) (dto.ResendVerificationResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ResendVerification").Logger()
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.ResendVerificationResponse{}, err
+	}
+
+	// Claiming the send slot with a single conditional update keeps
+	// concurrent requests from sending more than one mail.
+	now := time.Now().UTC()
+	result, err := queries.Raw(claimVerificationMailSQL, userID, now, now.Add(-s.config.Auth.EmailVerificationResendInterval)).ExecContext(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to claim verification mail")
+		return dto.ResendVerificationResponse{}, err
+	}
+
+	claimed, err := result.RowsAffected()
+	if err != nil {
+		log.Err(err).Msg("Failed to claim verification mail")
+		return dto.ResendVerificationResponse{}, err
+	}
+
+	user, err := models.FindUser(ctx, s.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("User not found")
+			return dto.ResendVerificationResponse{}, httperrors.ErrUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.ResendVerificationResponse{}, err
+	}
+
+	if claimed == 0 {
+		if user.EmailVerifiedAt.Valid {
+			log.Debug().Msg("Email already verified")
+			return dto.ResendVerificationResponse{}, httperrors.ErrEmailAlreadyVerified
+		}
+
+		log.Debug().Msg("Verification mail sent too recently")
+		return dto.ResendVerificationResponse{}, httperrors.ErrVerificationMailThrottled
+	}
+
+	if err := s.mailer.Send(ctx, s.verificationMessage(user)); err != nil {
+		log.Err(err).Msg("Failed to send verification mail")
+		return dto.ResendVerificationResponse{}, err
+	}
+
+	log.Debug().Msg("ResendVerification service successfully executed")
+
+	return dto.ResendVerificationResponse{ID: user.ID}, nil
+}
+
+// unverifiedAccess returns what the user can do in the tenant before
+// verifying the email, or full access once it is verified.
+func unverifiedAccess(user *models.User, t *models.Tenant) string {
+	if user.EmailVerifiedAt.Valid {
+		return tenant.UnverifiedAccessFull
+	}
+
+	return t.UnverifiedUserAccess
+}
+
+// sendVerification mails a verification link to the user in the background,
+// so the request that created the user does not wait for the mail server.
+func (s *Service) sendVerification(ctx context.Context, user *models.User) {
+	message := s.verificationMessage(user)
+	sendCtx := util.DetachContext(ctx)
+	go func() {
+		if err := s.mailer.Send(sendCtx, message); err != nil {
+			util.LogFromContext(sendCtx).Err(err).Msg("Failed to send verification mail")
+		}
+	}()
+}
+
+func (s *Service) verificationMessage(user *models.User) mailer.Message {
+	token := s.signToken(purposeEmailVerification, user.ID, user.Email, time.Now().Add(s.config.Auth.EmailVerificationTTL))
+	link := strings.TrimRight(s.config.Frontend.BaseURL, "/") + s.config.Frontend.EmailVerificationEndpoint +
+		"?token=" + url.QueryEscape(token)
+
+	return mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf(
+			"Hello %s,\n\nplease confirm that this is your email by opening the link below:\n\n%s\n\nThe link expires in %d hours.\n",
+			user.Name, link, int(s.config.Auth.EmailVerificationTTL.Hours()),
+		),
+	}
+}
@@ -0,0 +1,664 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type Service struct {
+	db     *sql.DB
+	config config.Server
+}
+
+func NewService(config config.Server, db *sql.DB) *Service {
+	return &Service{
+		config: config,
+		db:     db,
+	}
+}
+
+func (s *Service) GetTree(ctx context.Context) ([]dto.CategoryDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetTree").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	categories, err := models.Categories(
+		models.CategoryWhere.TenantID.EQ(tenantID),
+		qm.OrderBy(models.CategoryColumns.Depth+" ASC, "+models.CategoryColumns.Position+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get categories")
+		return nil, err
+	}
+
+	log.Debug().Msg("Category tree fetched successfully")
+
+	return buildTree(visible(filter, categories), null.Int64{}), nil
+}
+
+// GetBreadcrumb returns the ancestors of a category ordered from the root down
+// to the category itself.
+func (s *Service) GetBreadcrumb(ctx context.Context, request dto.GetCategoryRequest) ([]dto.CategoryDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetBreadcrumb").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	node, err := findNode(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find category")
+		return nil, err
+	}
+
+	if !filter.CategoryVisible(node) {
+		log.Debug().Int64("id", node.ID).Msg("Category is not visible to the user")
+		return nil, httperrors.ErrCategoryNotFound
+	}
+
+	ids, err := pathIDs(node.Path)
+	if err != nil {
+		log.Error().Err(err).Str("path", node.Path).Msg("Failed to parse category path")
+		return nil, err
+	}
+
+	ancestors, err := models.Categories(
+		models.CategoryWhere.ID.IN(ids),
+		models.CategoryWhere.TenantID.EQ(tenantID),
+		qm.OrderBy(models.CategoryColumns.Depth+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get category ancestors")
+		return nil, err
+	}
+
+	breadcrumb := make([]dto.CategoryDTO, len(ancestors))
+	for i, ancestor := range ancestors {
+		breadcrumb[i] = toDTO(ancestor)
+	}
+
+	log.Debug().Msg("Category breadcrumb fetched successfully")
+
+	return breadcrumb, nil
+}
+
+func (s *Service) GetDescendants(ctx context.Context, request dto.GetCategoryRequest) ([]dto.CategoryDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetDescendants").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	node, err := findNode(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find category")
+		return nil, err
+	}
+
+	if !filter.CategoryVisible(node) {
+		log.Debug().Int64("id", node.ID).Msg("Category is not visible to the user")
+		return nil, httperrors.ErrCategoryNotFound
+	}
+
+	descendants, err := models.Categories(
+		models.CategoryWhere.TenantID.EQ(tenantID),
+		models.CategoryWhere.Path.LIKE(node.Path+pathSeparator+"%"),
+		qm.OrderBy(models.CategoryColumns.Depth+" ASC, "+models.CategoryColumns.Position+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get category descendants")
+		return nil, err
+	}
+
+	log.Debug().Msg("Category descendants fetched successfully")
+
+	return buildTree(visible(filter, descendants), null.Int64From(node.ID)), nil
+}
+
+func (s *Service) Create(ctx context.Context, request dto.CreateCategoryRequest) (dto.CreateCategoryResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Create").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.CreateCategoryResponse{}, err
+	}
+
+	var parent *models.Category
+	if request.ParentID != nil {
+		parent, err = findNode(ctx, s.db, tenantID, *request.ParentID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find parent category")
+			return dto.CreateCategoryResponse{}, err
+		}
+
+		archived, err := Archived(ctx, s.db, models.CategoryWhere.ID.EQ(parent.ID))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check whether parent category is archived")
+			return dto.CreateCategoryResponse{}, err
+		}
+
+		if archived {
+			log.Debug().Int64("parentId", parent.ID).Msg("Parent category is archived")
+			return dto.CreateCategoryResponse{}, httperrors.ErrTopicArchived
+		}
+	}
+
+	if err := s.checkSiblingName(ctx, tenantID, request.ParentID, request.Name, 0); err != nil {
+		return dto.CreateCategoryResponse{}, err
+	}
+
+	var node *models.Category
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if parent == nil {
+			topic := models.Topic{
+				Name:     request.Name,
+				TenantID: tenantID,
+			}
+			if err := topic.Insert(ctx, ce, boil.Infer()); err != nil {
+				return err
+			}
+
+			node = &models.Category{
+				Name:     topic.Name,
+				TopicID:  null.Int64From(topic.ID),
+				TenantID: tenantID,
+			}
+			return insertNode(ctx, ce, node, nil)
+		}
+
+		rootTopicID, err := rootTopicID(ctx, ce, parent)
+		if err != nil {
+			return err
+		}
+
+		subTopic := models.SubTopic{
+			Name:     request.Name,
+			TopicID:  rootTopicID,
+			TenantID: tenantID,
+		}
+		if err := subTopic.Insert(ctx, ce, boil.Infer()); err != nil {
+			return err
+		}
+
+		node = &models.Category{
+			Name:       subTopic.Name,
+			SubTopicID: null.Int64From(subTopic.ID),
+			TenantID:   tenantID,
+		}
+		return insertNode(ctx, ce, node, parent)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create category")
+		return dto.CreateCategoryResponse{}, err
+	}
+
+	log.Debug().Msg("Category created successfully")
+
+	return dto.CreateCategoryResponse{ID: node.ID}, nil
+}
+
+func (s *Service) Update(ctx context.Context, request dto.UpdateCategoryRequest) (dto.UpdateCategoryResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Update").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UpdateCategoryResponse{}, err
+	}
+
+	node, err := findNode(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find category")
+		return dto.UpdateCategoryResponse{}, err
+	}
+
+	if request.Name == nil || node.Name == *request.Name {
+		return dto.UpdateCategoryResponse{ID: node.ID}, nil
+	}
+
+	if err := s.checkSiblingName(ctx, tenantID, node.ParentID.Ptr(), *request.Name, node.ID); err != nil {
+		return dto.UpdateCategoryResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	node.Name = *request.Name
+	node.UpdatedAt = null.TimeFrom(now)
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		_, err := node.Update(ctx, ce, boil.Whitelist(
+			models.CategoryColumns.Name,
+			models.CategoryColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		columns := models.M{"name": node.Name, "updated_at": now}
+		if node.TopicID.Valid {
+			_, err = models.Topics(models.TopicWhere.ID.EQ(node.TopicID.Int64)).UpdateAll(ctx, ce, columns)
+		} else {
+			_, err = models.SubTopics(models.SubTopicWhere.ID.EQ(node.SubTopicID.Int64)).UpdateAll(ctx, ce, columns)
+		}
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update category")
+		return dto.UpdateCategoryResponse{}, err
+	}
+
+	log.Debug().Msg("Category updated successfully")
+
+	return dto.UpdateCategoryResponse{ID: node.ID}, nil
+}
+
+func (s *Service) Delete(ctx context.Context, request dto.DeleteCategoryRequest) (dto.DeleteCategoryResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Delete").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.DeleteCategoryResponse{}, err
+	}
+
+	node, err := findNode(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find category")
+		return dto.DeleteCategoryResponse{}, err
+	}
+
+	hasChildren, err := models.Categories(
+		models.CategoryWhere.ParentID.EQ(null.Int64From(node.ID)),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether category has children")
+		return dto.DeleteCategoryResponse{}, err
+	}
+
+	if hasChildren {
+		log.Debug().Msg("Category still has children")
+		return dto.DeleteCategoryResponse{}, httperrors.ErrCategoryHasChildren
+	}
+
+	if node.SubTopicID.Valid {
+		hasPosts, err := models.Posts(
+			models.PostWhere.SubtopicID.EQ(node.SubTopicID.Int64),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check whether category has posts")
+			return dto.DeleteCategoryResponse{}, err
+		}
+
+		if hasPosts {
+			log.Debug().Msg("Category still has posts")
+			return dto.DeleteCategoryResponse{}, httperrors.ErrSubTopicHasPosts
+		}
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		// Deleting the backing topic or sub topic cascades to the category.
+		if node.TopicID.Valid {
+			_, err := models.Topics(models.TopicWhere.ID.EQ(node.TopicID.Int64)).DeleteAll(ctx, ce)
+			if err != nil {
+				return err
+			}
+		} else {
+			subTopic, err := models.FindSubTopic(ctx, ce, node.SubTopicID.Int64)
+			if err != nil {
+				return err
+			}
+
+			if err := subTopic.SetUsers(ctx, ce, false); err != nil {
+				return err
+			}
+
+			if _, err := subTopic.Delete(ctx, ce); err != nil {
+				return err
+			}
+		}
+
+		return closeGap(ctx, ce, tenantID, node.ParentID, node.Position, node.ID)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete category")
+		return dto.DeleteCategoryResponse{}, err
+	}
+
+	log.Debug().Msg("Category deleted successfully")
+
+	return dto.DeleteCategoryResponse{ID: node.ID}, nil
+}
+
+// Move moves a category with all of its descendants below a new parent. Root
+// categories are topics and stay at the root.
+func (s *Service) Move(ctx context.Context, request dto.MoveCategoryRequest) (dto.MoveCategoryResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Move").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.MoveCategoryResponse{}, err
+	}
+
+	node, err := findNode(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find category")
+		return dto.MoveCategoryResponse{}, err
+	}
+
+	parent, err := findNode(ctx, s.db, tenantID, request.ParentID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find parent category")
+		return dto.MoveCategoryResponse{}, err
+	}
+
+	if !node.ParentID.Valid || parent.Path == node.Path || strings.HasPrefix(parent.Path, node.Path+pathSeparator) {
+		log.Debug().Int64("id", node.ID).Int64("parentId", parent.ID).Msg("Invalid category move")
+		return dto.MoveCategoryResponse{}, httperrors.ErrInvalidCategoryMove
+	}
+
+	if err := s.checkSiblingName(ctx, tenantID, &parent.ID, node.Name, node.ID); err != nil {
+		return dto.MoveCategoryResponse{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		rootTopicID, err := rootTopicID(ctx, ce, parent)
+		if err != nil {
+			return err
+		}
+
+		if err := closeGap(ctx, ce, tenantID, node.ParentID, node.Position, node.ID); err != nil {
+			return err
+		}
+
+		siblings, err := models.Categories(
+			models.CategoryWhere.ParentID.EQ(null.Int64From(parent.ID)),
+			models.CategoryWhere.ID.NEQ(node.ID),
+		).Count(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		position := int(siblings)
+		if request.Position != nil && *request.Position < position {
+			position = *request.Position
+		}
+
+		_, err = queries.Raw(
+			"UPDATE categories SET position = position + 1 WHERE parent_id = $1 AND id <> $2 AND position >= $3",
+			parent.ID, node.ID, position,
+		).ExecContext(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		oldPath := node.Path
+		newPath := parent.Path + pathSeparator + strconv.FormatInt(node.ID, 10)
+		depthDelta := parent.Depth + 1 - node.Depth
+
+		_, err = queries.Raw(`
+			UPDATE sub_topics SET topic_id = $1
+			WHERE id IN (SELECT c.sub_topic_id FROM categories c WHERE c.tenant_id = $2 AND (c.id = $3 OR c.path LIKE $4))`,
+			rootTopicID, tenantID, node.ID, oldPath+pathSeparator+"%",
+		).ExecContext(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		_, err = queries.Raw(`
+			UPDATE categories SET path = $1 || substr(path, $2), depth = depth + $3
+			WHERE tenant_id = $4 AND (id = $5 OR path LIKE $6)`,
+			newPath, len(oldPath)+1, depthDelta, tenantID, node.ID, oldPath+pathSeparator+"%",
+		).ExecContext(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		node.ParentID = null.Int64From(parent.ID)
+		node.Position = position
+		node.UpdatedAt = null.TimeFrom(time.Now().UTC())
+		_, err = node.Update(ctx, ce, boil.Whitelist(
+			models.CategoryColumns.ParentID,
+			models.CategoryColumns.Position,
+			models.CategoryColumns.UpdatedAt,
+		))
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to move category")
+		return dto.MoveCategoryResponse{}, err
+	}
+
+	log.Debug().Msg("Category moved successfully")
+
+	return dto.MoveCategoryResponse{ID: node.ID}, nil
+}
+
+// Reorder sets the positions of the children of a parent, or of the roots,
+// to the order of the given ids.
+func (s *Service) Reorder(ctx context.Context, request dto.ReorderCategoriesRequest) (dto.ReorderCategoriesResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Reorder").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.ReorderCategoriesResponse{}, err
+	}
+
+	siblings, err := models.Categories(
+		models.CategoryWhere.TenantID.EQ(tenantID),
+		parentMod(null.Int64FromPtr(request.ParentID)),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get categories")
+		return dto.ReorderCategoriesResponse{}, err
+	}
+
+	if request.ParentID != nil && len(siblings) == 0 {
+		if _, err := findNode(ctx, s.db, tenantID, *request.ParentID); err != nil {
+			log.Error().Err(err).Msg("Failed to find parent category")
+			return dto.ReorderCategoriesResponse{}, err
+		}
+	}
+
+	var ordered bool
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		ordered, err = SetPositions(ctx, ce, siblings, request.IDs)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to reorder categories")
+		return dto.ReorderCategoriesResponse{}, err
+	}
+
+	if !ordered {
+		log.Debug().Ints64("ids", request.IDs).Msg("Ordered ids do not match the children")
+		return dto.ReorderCategoriesResponse{}, httperrors.ErrInvalidCategoryOrder
+	}
+
+	log.Debug().Msg("Categories reordered successfully")
+
+	return dto.ReorderCategoriesResponse{ParentID: request.ParentID}, nil
+}
+
+func (s *Service) checkSiblingName(ctx context.Context, tenantID int64, parentID *int64, name string, excludeID int64) error {
+	log := util.LogFromContext(ctx)
+
+	exists, err := models.Categories(
+		models.CategoryWhere.TenantID.EQ(tenantID),
+		parentMod(null.Int64FromPtr(parentID)),
+		models.CategoryWhere.Name.EQ(name),
+		models.CategoryWhere.ID.NEQ(excludeID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether category exists")
+		return err
+	}
+
+	if exists {
+		log.Debug().Str("name", name).Msg("Category already exists")
+		return httperrors.ErrConflictCategoryAlreadyExists
+	}
+
+	return nil
+}
+
+// visible drops the categories hidden by the filter. Hidden categories take
+// their whole subtree with them, so the result is still a valid tree.
+func visible(filter *access.Filter, categories models.CategorySlice) models.CategorySlice {
+	var result models.CategorySlice
+	for _, category := range categories {
+		if filter.CategoryVisible(category) {
+			result = append(result, category)
+		}
+	}
+
+	return result
+}
+
+func findNode(ctx context.Context, exec boil.ContextExecutor, tenantID int64, id int64) (*models.Category, error) {
+	node, err := models.Categories(
+		models.CategoryWhere.ID.EQ(id),
+		models.CategoryWhere.TenantID.EQ(tenantID),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func rootTopicID(ctx context.Context, exec boil.ContextExecutor, node *models.Category) (int64, error) {
+	if node.TopicID.Valid {
+		return node.TopicID.Int64, nil
+	}
+
+	ids, err := pathIDs(node.Path)
+	if err != nil {
+		return 0, err
+	}
+
+	root, err := models.FindCategory(ctx, exec, ids[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return root.TopicID.Int64, nil
+}
+
+// closeGap shifts the siblings after a removed position one step up.
+func closeGap(ctx context.Context, exec boil.ContextExecutor, tenantID int64, parentID null.Int64, position int, excludeID int64) error {
+	_, err := queries.Raw(
+		"UPDATE categories SET position = position - 1 WHERE tenant_id = $1 AND parent_id IS NOT DISTINCT FROM $2 AND id <> $3 AND position > $4",
+		tenantID, parentID, excludeID, position,
+	).ExecContext(ctx, exec)
+
+	return err
+}
+
+func parentMod(parentID null.Int64) qm.QueryMod {
+	if !parentID.Valid {
+		return models.CategoryWhere.ParentID.IsNull()
+	}
+
+	return models.CategoryWhere.ParentID.EQ(parentID)
+}
+
+func pathIDs(path string) ([]int64, error) {
+	parts := strings.Split(path, pathSeparator)
+	ids := make([]int64, len(parts))
+	for i, part := range parts {
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
+func toDTO(category *models.Category) dto.CategoryDTO {
+	return dto.CategoryDTO{
+		ID:         category.ID,
+		ParentID:   category.ParentID.Ptr(),
+		Name:       category.Name,
+		Path:       category.Path,
+		Depth:      category.Depth,
+		Position:   category.Position,
+		TopicID:    category.TopicID.Ptr(),
+		SubTopicID: category.SubTopicID.Ptr(),
+		Children:   []dto.CategoryDTO{},
+	}
+}
+
+// buildTree nests the categories below root, expecting them ordered by depth
+// and position.
+func buildTree(categories models.CategorySlice, root null.Int64) []dto.CategoryDTO {
+	children := make(map[int64][]*models.Category, len(categories))
+	var roots []*models.Category
+	for _, category := range categories {
+		if category.ParentID == root {
+			roots = append(roots, category)
+			continue
+		}
+		children[category.ParentID.Int64] = append(children[category.ParentID.Int64], category)
+	}
+
+	var build func(nodes []*models.Category) []dto.CategoryDTO
+	build = func(nodes []*models.Category) []dto.CategoryDTO {
+		result := make([]dto.CategoryDTO, len(nodes))
+		for i, node := range nodes {
+			result[i] = toDTO(node)
+			result[i].Children = build(children[node.ID])
+		}
+		return result
+	}
+
+	return build(roots)
+}
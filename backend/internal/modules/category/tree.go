@@ -0,0 +1,149 @@
+package category
+
+import (
+	"context"
+	"strconv"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const pathSeparator = "/"
+
+// AddTopic inserts the root category backing a newly created topic.
+func AddTopic(ctx context.Context, exec boil.ContextExecutor, topic *models.Topic) error {
+	node := &models.Category{
+		Name:     topic.Name,
+		TopicID:  null.Int64From(topic.ID),
+		TenantID: topic.TenantID,
+	}
+
+	return insertNode(ctx, exec, node, nil)
+}
+
+// AddSubTopic inserts the category backing a newly created sub topic as a
+// child of the root category of its topic.
+func AddSubTopic(ctx context.Context, exec boil.ContextExecutor, subTopic *models.SubTopic) error {
+	parent, err := models.Categories(
+		models.CategoryWhere.TopicID.EQ(null.Int64From(subTopic.TopicID)),
+		models.CategoryWhere.TenantID.EQ(subTopic.TenantID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	node := &models.Category{
+		Name:       subTopic.Name,
+		SubTopicID: null.Int64From(subTopic.ID),
+		TenantID:   subTopic.TenantID,
+	}
+
+	return insertNode(ctx, exec, node, parent)
+}
+
+// Rename keeps the name of the category backing a topic or sub topic in sync.
+func Rename(ctx context.Context, exec boil.ContextExecutor, mod qm.QueryMod, name string) error {
+	_, err := models.Categories(mod).UpdateAll(ctx, exec, models.M{
+		models.CategoryColumns.Name: name,
+	})
+
+	return err
+}
+
+// HasChildren reports whether the category backing the sub topic has child
+// categories.
+func HasChildren(ctx context.Context, exec boil.ContextExecutor, subTopicID int64) (bool, error) {
+	return models.Categories(
+		qm.Where("parent_id = (SELECT c.id FROM categories c WHERE c.sub_topic_id = ?)", subTopicID),
+	).Exists(ctx, exec)
+}
+
+// Archived reports whether the category selected by mod, or any of its
+// ancestors, is backed by an archived topic or sub topic.
+func Archived(ctx context.Context, exec boil.ContextExecutor, mod qm.QueryMod) (bool, error) {
+	node, err := models.Categories(mod).One(ctx, exec)
+	if err != nil {
+		return false, err
+	}
+
+	ids, err := pathIDs(node.Path)
+	if err != nil {
+		return false, err
+	}
+
+	return models.Categories(
+		qm.LeftOuterJoin("topics t ON t.id = categories.topic_id"),
+		qm.LeftOuterJoin("sub_topics st ON st.id = categories.sub_topic_id"),
+		models.CategoryWhere.ID.IN(ids),
+		qm.Where("(t.archived OR st.archived)"),
+	).Exists(ctx, exec)
+}
+
+// SetPositions orders the siblings by the given category ids. It reports
+// false without writing anything when ids do not name every sibling exactly
+// once.
+func SetPositions(ctx context.Context, exec boil.ContextExecutor, siblings models.CategorySlice, ids []int64) (bool, error) {
+	byID := make(map[int64]*models.Category, len(siblings))
+	for _, sibling := range siblings {
+		byID[sibling.ID] = sibling
+	}
+
+	if len(ids) != len(siblings) {
+		return false, nil
+	}
+
+	for _, id := range ids {
+		if _, ok := byID[id]; !ok {
+			return false, nil
+		}
+	}
+
+	for position, id := range ids {
+		sibling := byID[id]
+		if sibling.Position == position {
+			continue
+		}
+
+		sibling.Position = position
+		if _, err := sibling.Update(ctx, exec, boil.Whitelist(models.CategoryColumns.Position)); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// insertNode appends node to the children of parent, or to the roots when
+// parent is nil, and fills in its depth, position and path.
+func insertNode(ctx context.Context, exec boil.ContextExecutor, node *models.Category, parent *models.Category) error {
+	siblings := []qm.QueryMod{
+		models.CategoryWhere.TenantID.EQ(node.TenantID),
+		models.CategoryWhere.ParentID.IsNull(),
+	}
+	if parent != nil {
+		node.ParentID = null.Int64From(parent.ID)
+		node.Depth = parent.Depth + 1
+		siblings[1] = models.CategoryWhere.ParentID.EQ(node.ParentID)
+	}
+
+	count, err := models.Categories(siblings...).Count(ctx, exec)
+	if err != nil {
+		return err
+	}
+	node.Position = int(count)
+
+	if err := node.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	node.Path = strconv.FormatInt(node.ID, 10)
+	if parent != nil {
+		node.Path = parent.Path + pathSeparator + node.Path
+	}
+
+	_, err = node.Update(ctx, exec, boil.Whitelist(models.CategoryColumns.Path))
+
+	return err
+}
@@ -0,0 +1,254 @@
+package customfield
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+type Service struct {
+	db     *sql.DB
+	config config.Server
+}
+
+func NewService(config config.Server, db *sql.DB) *Service {
+	return &Service{
+		config: config,
+		db:     db,
+	}
+}
+
+func (s *Service) GetAll(ctx context.Context) ([]dto.CustomFieldDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAll").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	fields, err := Fields(ctx, s.db, tenantID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get custom fields")
+		return nil, err
+	}
+
+	fieldDTOs := make([]dto.CustomFieldDTO, len(fields))
+	for i, field := range fields {
+		fieldDTOs[i] = toDTO(field)
+	}
+
+	log.Debug().Msg("Custom fields fetched successfully")
+
+	return fieldDTOs, nil
+}
+
+func (s *Service) Create(ctx context.Context, request dto.CreateCustomFieldRequest) (dto.CreateCustomFieldResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Create").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.CreateCustomFieldResponse{}, err
+	}
+
+	if message := ValidDefinition(request.Name, request.Type, request.Options); message != "" {
+		log.Debug().Str("name", request.Name).Msg("Custom field is invalid")
+		return dto.CreateCustomFieldResponse{}, definitionError(message)
+	}
+
+	exists, err := models.CustomFields(
+		models.CustomFieldWhere.Name.EQ(request.Name),
+		models.CustomFieldWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether custom field exists")
+		return dto.CreateCustomFieldResponse{}, err
+	}
+
+	if exists {
+		log.Debug().Str("name", request.Name).Msg("Custom field already exists")
+		return dto.CreateCustomFieldResponse{}, httperrors.ErrConflictCustomFieldAlreadyExists
+	}
+
+	position := 0
+	if request.Position != nil {
+		position = *request.Position
+	} else {
+		count, err := models.CustomFields(models.CustomFieldWhere.TenantID.EQ(tenantID)).Count(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to count custom fields")
+			return dto.CreateCustomFieldResponse{}, err
+		}
+		position = int(count)
+	}
+
+	field := models.CustomField{
+		Name:     request.Name,
+		Label:    request.Label,
+		Type:     request.Type,
+		Required: request.Required,
+		Options:  request.Options,
+		Position: position,
+		TenantID: tenantID,
+	}
+	if err := field.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Error().Err(err).Msg("Failed to create custom field")
+		return dto.CreateCustomFieldResponse{}, err
+	}
+
+	log.Debug().Msg("Custom field created successfully")
+
+	return dto.CreateCustomFieldResponse{ID: field.ID}, nil
+}
+
+// Update changes how a custom field is shown and validated. The name and type
+// are fixed because stored post values depend on them.
+func (s *Service) Update(ctx context.Context, request dto.UpdateCustomFieldRequest) (dto.UpdateCustomFieldResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Update").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UpdateCustomFieldResponse{}, err
+	}
+
+	field, err := findField(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find custom field")
+		return dto.UpdateCustomFieldResponse{}, err
+	}
+
+	if request.Label != nil {
+		field.Label = *request.Label
+	}
+	if request.Required != nil {
+		field.Required = *request.Required
+	}
+	if request.Options != nil {
+		field.Options = *request.Options
+	}
+	if request.Position != nil {
+		field.Position = *request.Position
+	}
+
+	if message := ValidDefinition(field.Name, field.Type, field.Options); message != "" {
+		log.Debug().Str("name", field.Name).Msg("Custom field is invalid")
+		return dto.UpdateCustomFieldResponse{}, definitionError(message)
+	}
+
+	field.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	_, err = field.Update(ctx, s.db, boil.Whitelist(
+		models.CustomFieldColumns.Label,
+		models.CustomFieldColumns.Required,
+		models.CustomFieldColumns.Options,
+		models.CustomFieldColumns.Position,
+		models.CustomFieldColumns.UpdatedAt,
+	))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update custom field")
+		return dto.UpdateCustomFieldResponse{}, err
+	}
+
+	log.Debug().Msg("Custom field updated successfully")
+
+	return dto.UpdateCustomFieldResponse{ID: field.ID}, nil
+}
+
+// Delete removes a custom field together with its values on every post of the
+// tenant.
+func (s *Service) Delete(ctx context.Context, request dto.DeleteCustomFieldRequest) (dto.DeleteCustomFieldResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Delete").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.DeleteCustomFieldResponse{}, err
+	}
+
+	field, err := findField(ctx, s.db, tenantID, request.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find custom field")
+		return dto.DeleteCustomFieldResponse{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		_, err := queries.Raw(
+			`UPDATE posts SET custom_fields = custom_fields - $1 WHERE tenant_id = $2 AND custom_fields ? $1`,
+			field.Name, tenantID,
+		).ExecContext(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		_, err = field.Delete(ctx, ce)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete custom field")
+		return dto.DeleteCustomFieldResponse{}, err
+	}
+
+	log.Debug().Msg("Custom field deleted successfully")
+
+	return dto.DeleteCustomFieldResponse{ID: field.ID}, nil
+}
+
+func findField(ctx context.Context, exec boil.ContextExecutor, tenantID int64, id int64) (*models.CustomField, error) {
+	field, err := models.CustomFields(
+		models.CustomFieldWhere.ID.EQ(id),
+		models.CustomFieldWhere.TenantID.EQ(tenantID),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrCustomFieldNotFound
+		}
+		return nil, err
+	}
+
+	return field, nil
+}
+
+func definitionError(message string) error {
+	return httperrors.NewHTTPValidationError(
+		http.StatusBadRequest,
+		httperrors.HTTPErrorTypeGeneric,
+		"Custom field validation failed",
+		[]types.HttpValidationErrorDetail{{
+			Key:   "customField",
+			In:    "body",
+			Error: message,
+		}},
+	)
+}
+
+func toDTO(field *models.CustomField) dto.CustomFieldDTO {
+	options := []string(field.Options)
+	if options == nil {
+		options = []string{}
+	}
+
+	return dto.CustomFieldDTO{
+		ID:       field.ID,
+		Name:     field.Name,
+		Label:    field.Label,
+		Type:     field.Type,
+		Required: field.Required,
+		Options:  options,
+		Position: field.Position,
+	}
+}
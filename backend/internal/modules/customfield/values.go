@@ -0,0 +1,282 @@
+package customfield
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+)
+
+// Types of custom fields. Strings, enums and dates are stored as JSON strings,
+// numbers and user references as JSON numbers.
+const (
+	TypeString = "STRING"
+	TypeNumber = "NUMBER"
+	TypeEnum   = "ENUM"
+	TypeDate   = "DATE"
+	TypeUser   = "USER"
+)
+
+const dateLayout = "2006-01-02"
+
+var fieldTypes = []string{TypeString, TypeNumber, TypeEnum, TypeDate, TypeUser}
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// Fields returns the custom fields of the tenant in order.
+func Fields(ctx context.Context, exec boil.ContextExecutor, tenantID int64) (models.CustomFieldSlice, error) {
+	return models.CustomFields(
+		models.CustomFieldWhere.TenantID.EQ(tenantID),
+		qm.OrderBy(models.CustomFieldColumns.Position+" ASC, "+models.CustomFieldColumns.ID+" ASC"),
+	).All(ctx, exec)
+}
+
+// Validate checks the custom field values of a post. Every value must belong
+// to a field of the tenant, every required field must be set and user
+// references must point to users of the tenant.
+func Validate(ctx context.Context, exec boil.ContextExecutor, tenantID int64, fields models.CustomFieldSlice, values map[string]any) ([]types.HttpValidationErrorDetail, error) {
+	var details []types.HttpValidationErrorDetail
+	invalid := func(name string, message string) {
+		details = append(details, types.HttpValidationErrorDetail{
+			Key:   "customFields." + name,
+			In:    "body",
+			Error: message,
+		})
+	}
+
+	userIDs := make(map[string]int64)
+	known := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		known[field.Name] = true
+
+		value, ok := values[field.Name]
+		if !ok || value == nil {
+			if field.Required {
+				invalid(field.Name, field.Label+" is required")
+			}
+			continue
+		}
+
+		if _, err := typedValue(field, value); err != nil {
+			invalid(field.Name, field.Label+" "+err.Error())
+			continue
+		}
+
+		if field.Type == TypeUser {
+			userIDs[field.Name] = int64(value.(float64))
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		invalid(name, "is not a custom field")
+	}
+
+	for _, field := range fields {
+		userID, ok := userIDs[field.Name]
+		if !ok {
+			continue
+		}
+
+		exists, err := models.Users(
+			models.UserWhere.ID.EQ(userID),
+			models.UserWhere.TenantID.EQ(tenantID),
+		).Exists(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			invalid(field.Name, field.Label+" must reference a user of the tenant")
+		}
+	}
+
+	return details, nil
+}
+
+// FilterMods matches posts whose custom fields have the given values. Values
+// are converted to the type of their field so the containment query can use
+// the GIN index on posts.custom_fields.
+func FilterMods(fields models.CustomFieldSlice, filters map[string]string) ([]qm.QueryMod, error) {
+	var details []types.HttpValidationErrorDetail
+	invalid := func(name string, message string) {
+		details = append(details, types.HttpValidationErrorDetail{
+			Key:   "customField",
+			In:    "query",
+			Error: name + " " + message,
+		})
+	}
+
+	byName := make(map[string]*models.CustomField, len(fields))
+	for _, field := range fields {
+		byName[field.Name] = field
+	}
+
+	names := make([]string, 0, len(filters))
+	for name := range filters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	mods := make([]qm.QueryMod, 0, len(names))
+	for _, name := range names {
+		field, ok := byName[name]
+		if !ok {
+			invalid(name, "is not a custom field")
+			continue
+		}
+
+		value, err := parseFilter(field, filters[name])
+		if err != nil {
+			invalid(name, err.Error())
+			continue
+		}
+
+		raw, err := json.Marshal(map[string]any{name: value})
+		if err != nil {
+			invalid(name, err.Error())
+			continue
+		}
+
+		mods = append(mods, qm.Where("posts.custom_fields @> ?::jsonb", string(raw)))
+	}
+
+	if len(details) > 0 {
+		return nil, httperrors.NewHTTPValidationError(
+			http.StatusBadRequest,
+			httperrors.HTTPErrorTypeGeneric,
+			"Custom field filter validation failed",
+			details,
+		)
+	}
+
+	return mods, nil
+}
+
+// OrderBy returns the order clause sorting posts by a custom field, descending
+// when the sort starts with a minus. Posts without a value come last.
+func OrderBy(fields models.CustomFieldSlice, sort string) (string, bool) {
+	name, descending := strings.CutPrefix(sort, "-")
+
+	for _, field := range fields {
+		if field.Name != name {
+			continue
+		}
+
+		direction := "ASC"
+		if descending {
+			direction = "DESC"
+		}
+
+		// Dates are ISO 8601 strings, so they sort chronologically as text.
+		return fmt.Sprintf("posts.custom_fields -> %s %s NULLS LAST", pq.QuoteLiteral(field.Name), direction), true
+	}
+
+	return "", false
+}
+
+// Format renders the stored value of a custom field for exports.
+func Format(field *models.CustomField, values map[string]any) string {
+	value, ok := values[field.Name]
+	if !ok || value == nil {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ValidDefinition reports what is wrong with a custom field definition, or
+// an empty string when it can be stored.
+func ValidDefinition(name string, fieldType string, options []string) string {
+	switch {
+	case !namePattern.MatchString(name):
+		return "name must start with a letter and contain only letters, digits and underscores"
+	case !slices.Contains(fieldTypes, fieldType):
+		return "type must be one of " + strings.Join(fieldTypes, ", ")
+	case fieldType == TypeEnum && len(options) == 0:
+		return "options are required for enum fields"
+	case fieldType != TypeEnum && len(options) > 0:
+		return "options are only allowed for enum fields"
+	}
+
+	return ""
+}
+
+func typedValue(field *models.CustomField, value any) (any, error) {
+	switch field.Type {
+	case TypeString:
+		text, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a text")
+		}
+		return text, nil
+	case TypeNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return number, nil
+	case TypeEnum:
+		option, ok := value.(string)
+		if !ok || !slices.Contains(field.Options, option) {
+			return nil, fmt.Errorf("must be one of %s", strings.Join(field.Options, ", "))
+		}
+		return option, nil
+	case TypeDate:
+		date, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a date formatted as YYYY-MM-DD")
+		}
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			return nil, fmt.Errorf("must be a date formatted as YYYY-MM-DD")
+		}
+		return date, nil
+	case TypeUser:
+		id, ok := value.(float64)
+		if !ok || id <= 0 || id != math.Trunc(id) {
+			return nil, fmt.Errorf("must be a user id")
+		}
+		return id, nil
+	}
+
+	return nil, fmt.Errorf("has an unknown type")
+}
+
+func parseFilter(field *models.CustomField, value string) (any, error) {
+	switch field.Type {
+	case TypeNumber, TypeUser:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return typedValue(field, number)
+	default:
+		return typedValue(field, value)
+	}
+}
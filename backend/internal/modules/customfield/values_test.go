@@ -0,0 +1,69 @@
+package customfield
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+var testFields = models.CustomFieldSlice{
+	{Name: "severity", Label: "Severity", Type: TypeEnum, Required: true, Options: []string{"low", "high"}},
+	{Name: "due", Label: "Due", Type: TypeDate},
+	{Name: "estimate", Label: "Estimate", Type: TypeNumber},
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]any
+		keys   []string
+	}{
+		{"valid", map[string]any{"severity": "low", "due": "2025-01-31", "estimate": float64(3)}, nil},
+		{"missing required", map[string]any{"due": "2025-01-31"}, []string{"customFields.severity"}},
+		{"unknown option", map[string]any{"severity": "medium"}, []string{"customFields.severity"}},
+		{"invalid date", map[string]any{"severity": "low", "due": "31.01.2025"}, []string{"customFields.due"}},
+		{"number as text", map[string]any{"severity": "low", "estimate": "3"}, []string{"customFields.estimate"}},
+		{"unknown field", map[string]any{"severity": "low", "owner": "me"}, []string{"customFields.owner"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Without user reference fields no query is run.
+			details, err := Validate(context.Background(), nil, 1, testFields, tt.values)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(details) != len(tt.keys) {
+				t.Fatalf("got %d errors, want %d: %v", len(details), len(tt.keys), details)
+			}
+			for i, key := range tt.keys {
+				if details[i].Key != key {
+					t.Errorf("error %d has key %s, want %s", i, details[i].Key, key)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterModsUseTypedContainment(t *testing.T) {
+	mods, err := FilterMods(testFields, map[string]string{"estimate": "3", "severity": "high"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, args := queries.BuildQuery(models.Posts(mods...).Query)
+	if strings.Count(sql, "posts.custom_fields @> ") != 2 {
+		t.Fatalf("filters do not use containment: %s", sql)
+	}
+	if args[0] != `{"estimate":3}` || args[1] != `{"severity":"high"}` {
+		t.Errorf("filters are not typed or not in name order: %v", args)
+	}
+
+	if _, err := FilterMods(testFields, map[string]string{"estimate": "many"}); err == nil {
+		t.Error("filter accepts a text for a number field")
+	}
+}
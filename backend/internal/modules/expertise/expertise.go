@@ -0,0 +1,128 @@
+package expertise
+
+import (
+	"context"
+	"fmt"
+
+	"cuhara.qua.go/internal/data/dto"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/lib/pq"
+)
+
+// Expertise is earned per tag of the answered post: an accepted answer is
+// worth AcceptedAnswerScore and every vote on an answer is worth UpvoteScore.
+// Community wiki answers earn their author nothing.
+const (
+	AcceptedAnswerScore = 10
+	UpvoteScore         = 1
+)
+
+var answerScoreSQL = fmt.Sprintf(
+	"(CASE WHEN a.wiki THEN 0 ELSE CASE WHEN a.is_accepted THEN %d ELSE 0 END + %d * (SELECT COUNT(*) FROM votes v WHERE v.answer_id = a.id) END)",
+	AcceptedAnswerScore, UpvoteScore,
+)
+
+type expertRow struct {
+	UserID int64  `boil:"user_id"`
+	Name   string `boil:"name"`
+	Score  int64  `boil:"score"`
+}
+
+type tagExpertiseRow struct {
+	TagID           int64  `boil:"tag_id"`
+	TagName         string `boil:"tag_name"`
+	AcceptedAnswers int64  `boil:"accepted_answers"`
+	Upvotes         int64  `boil:"upvotes"`
+	Score           int64  `boil:"score"`
+}
+
+// TopExperts returns up to limit users of the tenant ordered by their expertise
+// in the given tags. excludeUserID is left out, usually the author of the post.
+func TopExperts(ctx context.Context, exec boil.ContextExecutor, tenantID int64, tagIDs []int64, excludeUserID int64, limit int) ([]dto.ExpertDTO, error) {
+	if len(tagIDs) == 0 {
+		return []dto.ExpertDTO{}, nil
+	}
+
+	var rows []expertRow
+	err := queries.Raw(`
+		SELECT u.id AS user_id, u.name AS name, SUM(`+answerScoreSQL+`)::BIGINT AS score
+		FROM answers a
+		JOIN post_tags pt ON pt.post_id = a.post_id
+		JOIN users u ON u.id = a.creator_id
+		WHERE a.tenant_id = $1 AND pt.tag_id = ANY($2) AND a.creator_id <> $3
+		GROUP BY u.id, u.name
+		HAVING SUM(`+answerScoreSQL+`) > 0
+		ORDER BY score DESC, u.id
+		LIMIT $4`,
+		tenantID, pq.Array(tagIDs), excludeUserID, limit,
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	experts := make([]dto.ExpertDTO, len(rows))
+	for i, row := range rows {
+		experts[i] = dto.ExpertDTO{
+			UserID: row.UserID,
+			Name:   row.Name,
+			Score:  row.Score,
+		}
+	}
+
+	return experts, nil
+}
+
+// ForUser returns the expertise of a user for every tag they have answered.
+// Answers to posts in hiddenSubTopicIDs are left out.
+func ForUser(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64, hiddenSubTopicIDs []int64) ([]dto.TagExpertiseDTO, error) {
+	var rows []tagExpertiseRow
+	err := queries.Raw(`
+		SELECT t.id AS tag_id, t.name AS tag_name,
+			COUNT(*) FILTER (WHERE a.is_accepted) AS accepted_answers,
+			SUM((SELECT COUNT(*) FROM votes v WHERE v.answer_id = a.id))::BIGINT AS upvotes,
+			SUM(`+answerScoreSQL+`)::BIGINT AS score
+		FROM answers a
+		JOIN post_tags pt ON pt.post_id = a.post_id
+		JOIN tags t ON t.id = pt.tag_id
+		JOIN posts p ON p.id = a.post_id
+		WHERE a.tenant_id = $1 AND a.creator_id = $2 AND NOT p.subtopic_id = ANY($3)
+		GROUP BY t.id, t.name
+		ORDER BY score DESC, t.name`,
+		tenantID, userID, pq.Array(hiddenSubTopicIDs),
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	expertise := make([]dto.TagExpertiseDTO, len(rows))
+	for i, row := range rows {
+		expertise[i] = dto.TagExpertiseDTO{
+			TagID:           row.TagID,
+			TagName:         row.TagName,
+			AcceptedAnswers: row.AcceptedAnswers,
+			Upvotes:         row.Upvotes,
+			Score:           row.Score,
+		}
+	}
+
+	return expertise, nil
+}
+
+// Reputation returns the expertise of a user summed over all tags.
+func Reputation(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64) (int64, error) {
+	var row struct {
+		Score int64 `boil:"score"`
+	}
+	err := queries.Raw(`
+		SELECT COALESCE(SUM(`+answerScoreSQL+`), 0)::BIGINT AS score
+		FROM answers a
+		WHERE a.tenant_id = $1 AND a.creator_id = $2`,
+		tenantID, userID,
+	).Bind(ctx, exec, &row)
+	if err != nil {
+		return 0, err
+	}
+
+	return row.Score, nil
+}
@@ -0,0 +1,191 @@
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// Algorithms access tokens can be signed with.
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	rsaKeyBits = 3072
+	kidBytes   = 16
+)
+
+var (
+	// ErrInvalidKey is returned for PEM that holds no RSA or Ed25519 key.
+	ErrInvalidKey = errors.New("invalid key")
+	// ErrUnsupportedAlgorithm is returned for algorithms other than RS256
+	// and EdDSA.
+	ErrUnsupportedAlgorithm = errors.New("unsupported algorithm")
+)
+
+// Key is a key access tokens are signed or verified with. Keys read from a
+// public key only verify.
+type Key struct {
+	ID        string
+	Algorithm string
+	private   crypto.PrivateKey
+	public    crypto.PublicKey
+}
+
+// JWK is the public part of a key as published in the JWKS, RFC 7517.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X are the curve and public key of Ed25519 keys.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS is the set of keys tokens can be verified with.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// GenerateKey creates a key for the algorithm with a random kid.
+func GenerateKey(algorithm string) (*Key, error) {
+	id := make([]byte, kidBytes)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	var private crypto.PrivateKey
+	switch algorithm {
+	case AlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		private = key
+	case AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		private = key
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+
+	return newKey(hex.EncodeToString(id), private, nil)
+}
+
+// ParsePEM reads a private key in PKCS #8 or PKCS #1 PEM, or a public key
+// in PKIX PEM. The algorithm follows from the type of the key.
+func ParsePEM(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block", ErrInvalidKey)
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+		}
+		return newKey(id, private, nil)
+	case "RSA PRIVATE KEY":
+		private, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+		}
+		return newKey(id, private, nil)
+	case "PUBLIC KEY":
+		public, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+		}
+		return newKey(id, nil, public)
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrInvalidKey, block.Type)
+	}
+}
+
+func newKey(id string, private crypto.PrivateKey, public crypto.PublicKey) (*Key, error) {
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		public = &k.PublicKey
+	case ed25519.PrivateKey:
+		public = k.Public()
+	case nil:
+	default:
+		return nil, fmt.Errorf("%w: unsupported private key %T", ErrInvalidKey, private)
+	}
+
+	key := &Key{ID: id, private: private, public: public}
+	switch public.(type) {
+	case *rsa.PublicKey:
+		key.Algorithm = AlgorithmRS256
+	case ed25519.PublicKey:
+		key.Algorithm = AlgorithmEdDSA
+	default:
+		return nil, fmt.Errorf("%w: unsupported public key %T", ErrInvalidKey, public)
+	}
+
+	return key, nil
+}
+
+// CanSign reports whether the key holds its private key.
+func (k *Key) CanSign() bool {
+	return k.private != nil
+}
+
+// MarshalPEM returns the private key in PKCS #8 PEM.
+func (k *Key) MarshalPEM() ([]byte, error) {
+	if k.private == nil {
+		return nil, fmt.Errorf("%w: no private key", ErrInvalidKey)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(k.private)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// JWK returns the public part of the key.
+func (k *Key) JWK() JWK {
+	jwk := JWK{Use: "sig", KeyID: k.ID, Algorithm: k.Algorithm}
+
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+
+	return jwk
+}
+
+func (k *Key) method() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
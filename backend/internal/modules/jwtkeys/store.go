@@ -0,0 +1,372 @@
+package jwtkeys
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/golang-jwt/jwt"
+)
+
+// Sources the keys can be loaded from.
+const (
+	SourceDatabase = "database"
+	SourceFile     = "file"
+)
+
+// minResyncInterval bounds the reloads forced by tokens of unknown keys, so
+// made up kids cannot flood the database.
+const minResyncInterval = 5 * time.Second
+
+// lockRotationSQL keeps instances from rotating at the same time.
+const lockRotationSQL = `SELECT pg_advisory_xact_lock(hashtext('jwt_signing_keys'))`
+
+var (
+	// ErrInvalidToken is returned for tokens that are malformed, expired or
+	// not signed by a key of the store.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrNoSigningKey is returned when no key of the store can sign.
+	ErrNoSigningKey = errors.New("no signing key")
+)
+
+// Store signs access tokens and verifies them by the kid in their header.
+// Keys come from PEM files or from the database. Keys in the database are
+// reloaded once they are older than the sync interval of the config and
+// rotated once the signing key is older than the rotation interval. Retired
+// keys keep verifying until the tokens they signed have expired, and a token
+// of an unknown key forces a reload, so instances pick up the key of another
+// instance right away.
+type Store struct {
+	db     *sql.DB
+	config config.Server
+	// syncMu serializes reloads, mu guards the keys.
+	syncMu   sync.Mutex
+	mu       sync.RWMutex
+	signing  *Key
+	keys     map[string]*Key
+	syncedAt time.Time
+}
+
+// NewStore loads the keys from the source of the config. In the database a
+// first key is generated when there is none.
+func NewStore(ctx context.Context, config config.Server, db *sql.DB) (*Store, error) {
+	s := &Store{config: config}
+
+	switch config.Auth.JWTKeySource {
+	case SourceFile:
+		if err := s.loadFiles(); err != nil {
+			return nil, err
+		}
+	case SourceDatabase:
+		s.db = db
+		if err := s.reload(ctx, 0); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown jwt key source %q", config.Auth.JWTKeySource)
+	}
+
+	return s, nil
+}
+
+// Sign fills in the issuer, lifetime and jti of the claims and signs them
+// with the current key.
+func (s *Store) Sign(ctx context.Context, claims jwt.MapClaims) (string, error) {
+	if err := s.sync(ctx); err != nil {
+		return "", err
+	}
+
+	s.mu.RLock()
+	key := s.signing
+	s.mu.RUnlock()
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+
+	now := time.Now().UTC()
+	claims["iss"] = s.config.Auth.JWTIssuer
+	// Revocations are kept to the microsecond, so is the issue time; tokens
+	// issued right after a revocation in the same second stay valid.
+	claims["iat"] = float64(now.UnixMicro()) / 1e6
+	claims["exp"] = now.Add(s.config.Auth.JWTTTLMinutes).Unix()
+
+	// The jti identifies the token so it can be revoked on its own.
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	claims["jti"] = hex.EncodeToString(jti)
+
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.private)
+}
+
+// Verify checks the signature and lifetime of the token and returns its
+// claims. The token must name its key and use the algorithm of the key.
+func (s *Store) Verify(ctx context.Context, token string) (jwt.MapClaims, error) {
+	if err := s.sync(ctx); err != nil {
+		return nil, err
+	}
+
+	parser := &jwt.Parser{ValidMethods: []string{AlgorithmRS256, AlgorithmEdDSA}}
+	t, err := parser.Parse(token, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := s.find(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.Method.Alg() != key.Algorithm {
+			return nil, ErrInvalidToken
+		}
+		return key.public, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if !t.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// JWKS returns the public keys tokens are verified with, the signing key
+// first.
+func (s *Store) JWKS(ctx context.Context) (JWKS, error) {
+	if err := s.sync(ctx); err != nil {
+		return JWKS{}, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	set := JWKS{Keys: make([]JWK, 0, len(s.keys))}
+	for _, key := range s.keys {
+		set.Keys = append(set.Keys, key.JWK())
+	}
+
+	signingID := ""
+	if s.signing != nil {
+		signingID = s.signing.ID
+	}
+	sort.Slice(set.Keys, func(i, j int) bool {
+		if (set.Keys[i].KeyID == signingID) != (set.Keys[j].KeyID == signingID) {
+			return set.Keys[i].KeyID == signingID
+		}
+		return set.Keys[i].KeyID < set.Keys[j].KeyID
+	})
+
+	return set, nil
+}
+
+// find returns the key of the kid, reloading the keys of the database first
+// when it is unknown.
+func (s *Store) find(ctx context.Context, kid string) (*Key, error) {
+	s.mu.RLock()
+	key, ok := s.keys[kid]
+	s.mu.RUnlock()
+
+	if !ok && kid != "" && s.db != nil {
+		if err := s.reload(ctx, minResyncInterval); err != nil {
+			return nil, err
+		}
+
+		s.mu.RLock()
+		key, ok = s.keys[kid]
+		s.mu.RUnlock()
+	}
+
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	return key, nil
+}
+
+// loadFiles reads the PEM files of the keys directory, each named by the kid
+// of its key.
+func (s *Store) loadFiles() error {
+	dir := s.config.Auth.JWTKeysDir
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*Key)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+
+		id := strings.TrimSuffix(entry.Name(), ".pem")
+		key, err := ParsePEM(id, data)
+		if err != nil {
+			return fmt.Errorf("jwt key %s: %w", entry.Name(), err)
+		}
+		keys[id] = key
+	}
+
+	signing, ok := keys[s.config.Auth.JWTSigningKeyID]
+	if !ok || !signing.CanSign() {
+		return fmt.Errorf("%w: no private key %q in %s", ErrNoSigningKey, s.config.Auth.JWTSigningKeyID, dir)
+	}
+
+	s.mu.Lock()
+	s.signing = signing
+	s.keys = keys
+	s.syncedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
+
+// sync reloads the keys of the database when they are stale.
+func (s *Store) sync(ctx context.Context) error {
+	if s.db == nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	fresh := time.Since(s.syncedAt) < s.config.Auth.JWTKeySyncInterval
+	s.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	return s.reload(ctx, s.config.Auth.JWTKeySyncInterval)
+}
+
+// reload rotates the keys of the database when due, prunes keys retired
+// longer than tokens live and loads the others. Keys loaded less than maxAge
+// ago are kept.
+func (s *Store) reload(ctx context.Context, maxAge time.Duration) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	// Another request may have reloaded while this one waited.
+	s.mu.RLock()
+	fresh := time.Since(s.syncedAt) < maxAge
+	s.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	err := db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := queries.Raw(lockRotationSQL).ExecContext(ctx, ce); err != nil {
+			return err
+		}
+
+		current, err := models.JWTSigningKeys(
+			models.JWTSigningKeyWhere.RetiredAt.IsNull(),
+			qm.OrderBy(models.JWTSigningKeyColumns.CreatedAt+" DESC"),
+		).One(ctx, ce)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		interval := s.config.Auth.JWTKeyRotationInterval
+		if current != nil && (interval <= 0 || now.Sub(current.CreatedAt) < interval) {
+			return nil
+		}
+
+		return s.rotate(ctx, ce, now)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Tokens signed before the cutoff have expired.
+	cutoff := now.Add(-s.config.Auth.JWTTTLMinutes)
+	if _, err := models.JWTSigningKeys(models.JWTSigningKeyWhere.RetiredAt.LT(null.TimeFrom(cutoff))).DeleteAll(ctx, s.db); err != nil {
+		return err
+	}
+
+	rows, err := models.JWTSigningKeys(qm.OrderBy(models.JWTSigningKeyColumns.CreatedAt+" DESC")).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	var signing *Key
+	keys := make(map[string]*Key, len(rows))
+	for _, row := range rows {
+		key, err := ParsePEM(row.Kid, []byte(row.PrivateKey))
+		if err != nil {
+			return fmt.Errorf("jwt key %s: %w", row.Kid, err)
+		}
+		keys[row.Kid] = key
+
+		if signing == nil && !row.RetiredAt.Valid {
+			signing = key
+		}
+	}
+
+	if signing == nil {
+		return ErrNoSigningKey
+	}
+
+	s.mu.Lock()
+	s.signing = signing
+	s.keys = keys
+	s.syncedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
+
+// rotate generates the next signing key and retires the ones before it.
+func (s *Store) rotate(ctx context.Context, exec boil.ContextExecutor, now time.Time) error {
+	key, err := GenerateKey(s.config.Auth.JWTAlgorithm)
+	if err != nil {
+		return err
+	}
+
+	private, err := key.MarshalPEM()
+	if err != nil {
+		return err
+	}
+
+	if _, err := models.JWTSigningKeys(models.JWTSigningKeyWhere.RetiredAt.IsNull()).UpdateAll(ctx, exec, models.M{
+		models.JWTSigningKeyColumns.RetiredAt: now,
+	}); err != nil {
+		return err
+	}
+
+	row := models.JWTSigningKey{
+		Kid:        key.ID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: string(private),
+		CreatedAt:  now,
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
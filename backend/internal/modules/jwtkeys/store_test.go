@@ -0,0 +1,224 @@
+package jwtkeys
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cuhara.qua.go/internal/config"
+	"github.com/golang-jwt/jwt"
+)
+
+func testConfig(dir string, signingKeyID string) config.Server {
+	var cfg config.Server
+	cfg.Auth.JWTKeySource = SourceFile
+	cfg.Auth.JWTKeysDir = dir
+	cfg.Auth.JWTSigningKeyID = signingKeyID
+	cfg.Auth.JWTIssuer = "test"
+	cfg.Auth.JWTTTLMinutes = 15 * time.Minute
+	return cfg
+}
+
+func writePrivate(t *testing.T, dir string, key *Key) {
+	t.Helper()
+
+	data, err := key.MarshalPEM()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, key.ID+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writePublic(t *testing.T, dir string, key *Key) {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key.public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, key.ID+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustGenerate(t *testing.T, algorithm string) *Key {
+	t.Helper()
+
+	key, err := GenerateKey(algorithm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newFileStore(t *testing.T, signing *Key, others ...*Key) *Store {
+	t.Helper()
+
+	dir := t.TempDir()
+	writePrivate(t, dir, signing)
+	for _, key := range others {
+		writePublic(t, dir, key)
+	}
+
+	store, err := NewStore(context.Background(), testConfig(dir, signing.ID), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestSignAndVerify(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			key := mustGenerate(t, algorithm)
+			store := newFileStore(t, key)
+			ctx := context.Background()
+
+			token, err := store.Sign(ctx, jwt.MapClaims{"sub": "42"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.Header["kid"] != key.ID || parsed.Header["alg"] != algorithm {
+				t.Errorf("unexpected header %v", parsed.Header)
+			}
+
+			claims, err := store.Verify(ctx, token)
+			if err != nil {
+				t.Fatalf("verify failed: %v", err)
+			}
+			if claims["sub"] != "42" || claims["iss"] != "test" || claims["jti"] == "" {
+				t.Errorf("unexpected claims %v", claims)
+			}
+		})
+	}
+}
+
+func TestVerifyRetiredKey(t *testing.T) {
+	retired := mustGenerate(t, AlgorithmRS256)
+	token, err := newFileStore(t, retired).Sign(context.Background(), jwt.MapClaims{"sub": "42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The next key signs, the retired one is left as a public key.
+	store := newFileStore(t, mustGenerate(t, AlgorithmEdDSA), retired)
+	if _, err := store.Verify(context.Background(), token); err != nil {
+		t.Fatalf("token of retired key rejected: %v", err)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	key := mustGenerate(t, AlgorithmRS256)
+	other := mustGenerate(t, AlgorithmEdDSA)
+	store := newFileStore(t, key)
+
+	sign := func(method jwt.SigningMethod, kid any, claims jwt.MapClaims, signingKey any) string {
+		t.Helper()
+
+		token := jwt.NewWithClaims(method, claims)
+		if kid != nil {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(signingKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	valid := jwt.MapClaims{"sub": "42", "exp": time.Now().Add(time.Minute).Unix()}
+	publicPEM, err := x509.MarshalPKIXPublicKey(key.public)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"unknown kid":      sign(jwt.SigningMethodEdDSA, other.ID, valid, other.private),
+		"missing kid":      sign(jwt.SigningMethodRS256, nil, valid, key.private),
+		"other key":        sign(jwt.SigningMethodEdDSA, key.ID, valid, other.private),
+		"hmac with public": sign(jwt.SigningMethodHS256, key.ID, valid, publicPEM),
+		"expired":          sign(jwt.SigningMethodRS256, key.ID, jwt.MapClaims{"sub": "42", "exp": time.Now().Add(-time.Minute).Unix()}, key.private),
+	}
+
+	for name, token := range tests {
+		if _, err := store.Verify(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", name, err)
+		}
+	}
+}
+
+func TestNewStoreNeedsSigningKey(t *testing.T) {
+	key := mustGenerate(t, AlgorithmEdDSA)
+	dir := t.TempDir()
+	writePublic(t, dir, key)
+
+	for _, id := range []string{key.ID, "missing"} {
+		if _, err := NewStore(context.Background(), testConfig(dir, id), nil); !errors.Is(err, ErrNoSigningKey) {
+			t.Errorf("%s: expected ErrNoSigningKey, got %v", id, err)
+		}
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	signing := mustGenerate(t, AlgorithmRS256)
+	retired := mustGenerate(t, AlgorithmEdDSA)
+	store := newFileStore(t, signing, retired)
+
+	set, err := store.JWKS(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set.Keys) != 2 || set.Keys[0].KeyID != signing.ID || set.Keys[1].KeyID != retired.ID {
+		t.Fatalf("unexpected keys %+v", set.Keys)
+	}
+
+	// Other services rebuild the keys from the published values.
+	rsaJWK := set.Keys[0]
+	n, err := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(rsaJWK.E)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rebuilt := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	if rsaJWK.KeyType != "RSA" || rsaJWK.Algorithm != AlgorithmRS256 || !rebuilt.Equal(signing.public) {
+		t.Errorf("unexpected RSA key %+v", rsaJWK)
+	}
+
+	edJWK := set.Keys[1]
+	x, err := base64.RawURLEncoding.DecodeString(edJWK.X)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edJWK.KeyType != "OKP" || edJWK.Curve != "Ed25519" || !ed25519.PublicKey(x).Equal(retired.public) {
+		t.Errorf("unexpected Ed25519 key %+v", edJWK)
+	}
+}
+
+func TestParsePEMRejectsOtherKeys(t *testing.T) {
+	if _, err := ParsePEM("a", []byte("not pem")); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected ErrInvalidKey, got %v", err)
+	}
+	if _, err := GenerateKey("HS256"); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("expected ErrUnsupportedAlgorithm, got %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package lockout
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Scopes failed logins are counted in.
+const (
+	ScopeAccount = "account"
+	ScopeIP      = "ip"
+)
+
+// maxDelayShift keeps the doubling delay from overflowing before it is
+// capped by the lock duration.
+const maxDelayShift = 30
+
+// holdSQL makes sure the key has a row and locks it until the transaction
+// ends, without touching its count.
+const holdSQL = `
+	INSERT INTO login_throttles (scope, subject, updated_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (scope, subject) DO UPDATE SET scope = EXCLUDED.scope
+	RETURNING *`
+
+// recordFailureSQL counts a failed login of the key. Failures older than the
+// window are forgotten, the count starts over.
+const recordFailureSQL = `
+	INSERT INTO login_throttles (scope, subject, failed_attempts, updated_at)
+	VALUES ($1, $2, 1, $3)
+	ON CONFLICT (scope, subject) DO UPDATE SET
+		failed_attempts = CASE
+			WHEN login_throttles.updated_at < $4 THEN 1
+			ELSE login_throttles.failed_attempts + 1
+		END,
+		updated_at = EXCLUDED.updated_at
+	RETURNING *`
+
+// Key is what failed logins are counted by.
+type Key struct {
+	Scope   string
+	Subject string
+}
+
+// Account is the key of the account with the email. Emails are compared
+// case insensitively and need not belong to an account.
+func Account(email string) Key {
+	return Key{Scope: ScopeAccount, Subject: strings.ToLower(email)}
+}
+
+// IP is the key of the client IP.
+func IP(address string) Key {
+	return Key{Scope: ScopeIP, Subject: address}
+}
+
+// Policy decides how long a key is locked after its failures.
+type Policy struct {
+	// FreeAttempts failures in a row go without delay.
+	FreeAttempts int
+	// MaxAttempts failures lock the key for LockDuration and start the
+	// count over.
+	MaxAttempts  int
+	LockDuration time.Duration
+}
+
+// Delay returns how long the key is locked after the failures in a row. Each
+// failure past the free ones doubles the delay, starting at a second.
+func (p Policy) Delay(failures int) time.Duration {
+	if failures >= p.MaxAttempts {
+		return p.LockDuration
+	}
+
+	extra := failures - p.FreeAttempts
+	if extra <= 0 {
+		return 0
+	}
+	if extra > maxDelayShift {
+		return p.LockDuration
+	}
+
+	return min(time.Second<<(extra-1), p.LockDuration)
+}
+
+// Hold returns the latest time any of the keys is locked until, and false
+// when none is locked at now. The rows of the keys stay locked until the
+// transaction exec belongs to ends, so logins of the same keys check,
+// compare and count one after another; parallel guesses cannot all pass the
+// check before the first failure is counted. Keys have to be given in the
+// same order on every call.
+func Hold(ctx context.Context, exec boil.ContextExecutor, now time.Time, keys ...Key) (time.Time, bool, error) {
+	var until time.Time
+	for _, key := range keys {
+		var throttle models.LoginThrottle
+		if err := queries.Raw(holdSQL, key.Scope, key.Subject, now).Bind(ctx, exec, &throttle); err != nil {
+			return time.Time{}, false, err
+		}
+
+		if throttle.LockedUntil.Valid && throttle.LockedUntil.Time.After(now) && throttle.LockedUntil.Time.After(until) {
+			until = throttle.LockedUntil.Time
+		}
+	}
+
+	return until, !until.IsZero(), nil
+}
+
+// RecordFailure counts a failed login of the key and locks it as the policy
+// says.
+func RecordFailure(ctx context.Context, exec boil.ContextExecutor, policy Policy, now time.Time, key Key) error {
+	window := now.Add(-policy.LockDuration)
+
+	var throttle models.LoginThrottle
+	if err := queries.Raw(recordFailureSQL, key.Scope, key.Subject, now, window).Bind(ctx, exec, &throttle); err != nil {
+		return err
+	}
+
+	delay := policy.Delay(throttle.FailedAttempts)
+	if delay == 0 {
+		return nil
+	}
+
+	if throttle.FailedAttempts >= policy.MaxAttempts {
+		throttle.FailedAttempts = 0
+	}
+	throttle.LockedUntil = null.TimeFrom(now.Add(delay))
+
+	_, err := throttle.Update(ctx, exec, boil.Whitelist(
+		models.LoginThrottleColumns.FailedAttempts,
+		models.LoginThrottleColumns.LockedUntil,
+	))
+	return err
+}
+
+// Reset forgets the failures of the key and lifts its lock.
+func Reset(ctx context.Context, exec boil.ContextExecutor, key Key) error {
+	_, err := models.LoginThrottles(
+		models.LoginThrottleWhere.Scope.EQ(key.Scope),
+		models.LoginThrottleWhere.Subject.EQ(key.Subject),
+	).DeleteAll(ctx, exec)
+	return err
+}
+
+// DeleteStale deletes the keys without a failure since before, whose locks
+// have run out by then.
+func DeleteStale(ctx context.Context, exec boil.ContextExecutor, before time.Time) (int64, error) {
+	return models.LoginThrottles(
+		models.LoginThrottleWhere.UpdatedAt.LT(before),
+		qm.Expr(
+			models.LoginThrottleWhere.LockedUntil.IsNull(),
+			qm.Or2(models.LoginThrottleWhere.LockedUntil.LT(null.TimeFrom(before))),
+		),
+	).DeleteAll(ctx, exec)
+}
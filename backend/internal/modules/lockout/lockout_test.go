@@ -0,0 +1,150 @@
+package lockout
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	policy := Policy{FreeAttempts: 3, MaxAttempts: 10, LockDuration: 15 * time.Minute}
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{3, 0},
+		{4, time.Second},
+		{5, 2 * time.Second},
+		{9, 32 * time.Second},
+		{10, 15 * time.Minute},
+		{11, 15 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Delay(tt.failures); got != tt.want {
+			t.Errorf("Delay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestPolicyDelayIsCapped(t *testing.T) {
+	policy := Policy{FreeAttempts: 0, MaxAttempts: 1000, LockDuration: time.Minute}
+
+	for _, failures := range []int{7, 8, 40, 999} {
+		if got := policy.Delay(failures); got != time.Minute {
+			t.Errorf("Delay(%d) = %v, want %v", failures, got, time.Minute)
+		}
+	}
+}
+
+func TestAccountIgnoresCase(t *testing.T) {
+	if Account("Ada@Example.com") != Account("ada@example.com") {
+		t.Error("expected emails differing in case to share a key")
+	}
+	if Account("ada@example.com") == IP("ada@example.com") {
+		t.Error("expected scopes to be told apart")
+	}
+}
+
+// throttleDB stands in for the database. It records every statement and
+// answers each with the row of the throttle.
+type throttleDB struct {
+	mu         sync.Mutex
+	statements []string
+	row        []driver.Value
+}
+
+func (d *throttleDB) Connect(context.Context) (driver.Conn, error) { return throttleConn{d}, nil }
+func (d *throttleDB) Driver() driver.Driver                        { return nil }
+
+type throttleConn struct{ db *throttleDB }
+
+func (c throttleConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+func (c throttleConn) Close() error { return nil }
+func (c throttleConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c throttleConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	c.db.statements = append(c.db.statements, query)
+	return &throttleRows{row: c.db.row}, nil
+}
+
+func (c throttleConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	c.db.statements = append(c.db.statements, query)
+	return driver.RowsAffected(1), nil
+}
+
+type throttleRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *throttleRows) Columns() []string {
+	return []string{"scope", "subject", "failed_attempts", "locked_until", "updated_at"}
+}
+func (r *throttleRows) Close() error { return nil }
+
+func (r *throttleRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+func TestHoldLocksRowsBeforeChecking(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	recorder := &throttleDB{row: []driver.Value{ScopeAccount, "ada@example.com", int64(10), now.Add(time.Minute), now}}
+	db := sql.OpenDB(recorder)
+	t.Cleanup(func() { _ = db.Close() })
+
+	until, locked, err := Hold(context.Background(), db, now, Account("ada@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !locked || !until.Equal(now.Add(time.Minute)) {
+		t.Errorf("got %v %v, want locked until %v", until, locked, now.Add(time.Minute))
+	}
+
+	// Only an upsert takes the row lock even when the key has no row yet,
+	// a plain select would let parallel first guesses through.
+	if len(recorder.statements) != 1 || !strings.Contains(recorder.statements[0], "ON CONFLICT") {
+		t.Errorf("Hold ran %q, want a single upsert", recorder.statements)
+	}
+}
+
+func TestRecordFailureLeavesCleanupToTheJob(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	recorder := &throttleDB{row: []driver.Value{ScopeIP, "203.0.113.7", int64(1), nil, now}}
+	db := sql.OpenDB(recorder)
+	t.Cleanup(func() { _ = db.Close() })
+
+	policy := Policy{FreeAttempts: 3, MaxAttempts: 10, LockDuration: 15 * time.Minute}
+	if err := RecordFailure(context.Background(), db, policy, now, IP("203.0.113.7")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, statement := range recorder.statements {
+		if strings.Contains(statement, "DELETE") {
+			t.Errorf("RecordFailure deleted throttles: %s", statement)
+		}
+	}
+}
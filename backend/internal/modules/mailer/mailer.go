@@ -0,0 +1,85 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/util"
+)
+
+// Message is a plain text mail to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// New returns a mailer sending through the SMTP server of the config. Without
+// a configured host mails are only logged, which is enough for development.
+func New(config config.Server) Mailer {
+	if config.Mailer.Host == "" {
+		return logMailer{}
+	}
+
+	return &smtpMailer{config: config.Mailer}
+}
+
+type smtpMailer struct {
+	config config.MailerServer
+}
+
+func (m *smtpMailer) Send(ctx context.Context, message Message) error {
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+
+	if err := smtp.SendMail(addr, auth, m.config.From, []string{message.To}, format(m.config.From, message)); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", message.To, err)
+	}
+
+	return nil
+}
+
+type logMailer struct{}
+
+func (logMailer) Send(ctx context.Context, message Message) error {
+	util.LogFromContext(ctx).Info().
+		Str("to", message.To).
+		Str("subject", message.Subject).
+		Str("body", message.Body).
+		Msg("Mail not sent, no SMTP host configured")
+
+	return nil
+}
+
+// format builds the RFC 5322 message. Header values come from the server or
+// from stored emails, line breaks are stripped anyway so none can inject
+// further headers.
+func format(from string, message Message) []byte {
+	header := strings.NewReplacer("\r", "", "\n", "")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", header.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", header.Replace(message.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", header.Replace(message.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatStripsHeaderLineBreaks(t *testing.T) {
+	raw := string(format("no-reply@example.com", Message{
+		To:      "user@example.com\r\nBcc: other@example.com",
+		Subject: "Reset\nyour password",
+		Body:    "line one\nline two",
+	}))
+
+	header, body, ok := strings.Cut(raw, "\r\n\r\n")
+	if !ok {
+		t.Fatalf("message has no header separator: %q", raw)
+	}
+	if strings.Contains(header, "\r\nBcc:") {
+		t.Errorf("recipient injected a header: %q", header)
+	}
+	if !strings.Contains(header, "Subject: Resetyour password\r\n") {
+		t.Errorf("subject line breaks are not stripped: %q", header)
+	}
+	if body != "line one\r\nline two" {
+		t.Errorf("body lines do not end in CRLF: %q", body)
+	}
+}
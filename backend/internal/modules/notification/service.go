@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	TypePostOverdue  = "POST_OVERDUE"
+	TypePostAssigned = "POST_ASSIGNED"
+)
+
+type Service struct {
+	db     *sql.DB
+	config config.Server
+}
+
+func NewService(config config.Server, db *sql.DB) *Service {
+	return &Service{
+		config: config,
+		db:     db,
+	}
+}
+
+// Notify inserts the same notification for every given user using exec,
+// so callers can raise notifications inside their own transaction.
+func Notify(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userIDs []int64, notificationType, message string, postID null.Int64) error {
+	for _, userID := range userIDs {
+		notification := models.Notification{
+			Type:     notificationType,
+			Message:  message,
+			UserID:   userID,
+			PostID:   postID,
+			TenantID: tenantID,
+		}
+
+		if err := notification.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) GetAll(ctx context.Context) ([]dto.NotificationDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAll").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return nil, err
+	}
+
+	filter, err := access.ForUser(ctx, s.db, tenantID, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	notifications, err := models.Notifications(
+		models.NotificationWhere.UserID.EQ(userID),
+		models.NotificationWhere.TenantID.EQ(tenantID),
+		filter.Notifications(),
+		qm.OrderBy(models.NotificationColumns.CreatedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get notifications")
+		return nil, err
+	}
+
+	notificationDTOs := make([]dto.NotificationDTO, len(notifications))
+	for i, notification := range notifications {
+		notificationDTOs[i] = dto.NotificationDTO{
+			ID:        notification.ID,
+			Type:      notification.Type,
+			Message:   notification.Message,
+			PostID:    notification.PostID.Ptr(),
+			ReadAt:    notification.ReadAt.Ptr(),
+			CreatedAt: notification.CreatedAt,
+		}
+	}
+
+	log.Debug().Msg("Notifications fetched successfully")
+
+	return notificationDTOs, nil
+}
+
+func (s *Service) Read(ctx context.Context, request dto.ReadNotificationRequest) (dto.ReadNotificationResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Read").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.ReadNotificationResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.ReadNotificationResponse{}, err
+	}
+
+	notification, err := models.Notifications(
+		models.NotificationWhere.ID.EQ(request.ID),
+		models.NotificationWhere.UserID.EQ(userID),
+		models.NotificationWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Notification not found")
+			return dto.ReadNotificationResponse{}, httperrors.ErrNotificationNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find notification")
+		return dto.ReadNotificationResponse{}, err
+	}
+
+	if notification.ReadAt.Valid {
+		return dto.ReadNotificationResponse{ID: notification.ID}, nil
+	}
+
+	now := time.Now().UTC()
+	notification.ReadAt = null.TimeFrom(now)
+	notification.UpdatedAt = null.TimeFrom(now)
+	_, err = notification.Update(ctx, s.db, boil.Whitelist(
+		models.NotificationColumns.ReadAt,
+		models.NotificationColumns.UpdatedAt,
+	))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update notification")
+		return dto.ReadNotificationResponse{}, err
+	}
+
+	log.Debug().Msg("Notification marked as read successfully")
+
+	return dto.ReadNotificationResponse{ID: notification.ID}, nil
+}
@@ -0,0 +1,230 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// ErrExchangeFailed is returned when the provider does not trade the
+// authorization code for tokens, e.g. because the code or the PKCE verifier
+// is wrong.
+var ErrExchangeFailed = errors.New("authorization code exchange failed")
+
+// ErrInvalidIDToken is returned when the ID token is missing, not signed by
+// the provider, meant for another client, expired or of another login.
+var ErrInvalidIDToken = errors.New("invalid id token")
+
+// Config is the identity provider of a tenant and the URL it sends users
+// back to with the authorization code.
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	RedirectURL  string
+}
+
+// Claims are the verified claims of an ID token. All holds every claim, for
+// the role mappings.
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Username      string
+	All           map[string]any
+}
+
+// RoleMapping gives the role to users whose role claim contains the value.
+type RoleMapping struct {
+	Value  string `json:"value"`
+	RoleID int64  `json:"roleId"`
+}
+
+// Client runs the authorization code flow with PKCE against the providers of
+// the tenants. The discovery document and keys of each issuer are fetched
+// once and kept.
+type Client struct {
+	httpClient *http.Client
+
+	// mu only guards the map; discoveries run without holding it, so a slow
+	// issuer does not hold up logins at the others.
+	mu        sync.Mutex
+	providers map[string]*discovery
+}
+
+// discovery is the discovery of an issuer, running or done. Requests for the
+// same issuer wait for the one discovery instead of starting their own.
+type discovery struct {
+	done     chan struct{}
+	provider *gooidc.Provider
+	err      error
+}
+
+func New(timeout time.Duration) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: timeout},
+		providers:  map[string]*discovery{},
+	}
+}
+
+// GenerateVerifier returns a new PKCE code verifier.
+func GenerateVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
+// AuthCodeURL returns the URL of the provider to send the user to. The state
+// comes back with the code, the nonce inside the ID token.
+func (c *Client) AuthCodeURL(ctx context.Context, config Config, state string, nonce string, verifier string) (string, error) {
+	oauthConfig, _, err := c.oauthConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+
+	return oauthConfig.AuthCodeURL(state, gooidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Exchange trades the authorization code for the tokens of the user and
+// returns the claims of the verified ID token.
+func (c *Client) Exchange(ctx context.Context, config Config, code string, verifier string, nonce string) (Claims, error) {
+	oauthConfig, provider, err := c.oauthConfig(ctx, config)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	ctx = gooidc.ClientContext(ctx, c.httpClient)
+
+	token, err := oauthConfig.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrExchangeFailed, err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return Claims{}, fmt.Errorf("%w: no id token in the token response", ErrInvalidIDToken)
+	}
+
+	idToken, err := provider.Verifier(&gooidc.Config{ClientID: config.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(nonce)) != 1 {
+		return Claims{}, fmt.Errorf("%w: nonce does not match", ErrInvalidIDToken)
+	}
+
+	var all map[string]any
+	if err := idToken.Claims(&all); err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	email, _ := all["email"].(string)
+	name, _ := all["name"].(string)
+	username, _ := all["preferred_username"].(string)
+
+	return Claims{
+		Subject:       idToken.Subject,
+		Email:         email,
+		EmailVerified: truthy(all["email_verified"]),
+		Name:          name,
+		Username:      username,
+		All:           all,
+	}, nil
+}
+
+// MapRole returns the role of the first mapping whose value is in the claim,
+// which may be a single string or a list of them.
+func MapRole(claims map[string]any, claim string, mappings []RoleMapping) (int64, bool) {
+	if claim == "" {
+		return 0, false
+	}
+
+	var values []string
+	switch value := claims[claim].(type) {
+	case string:
+		values = []string{value}
+	case []any:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	for _, mapping := range mappings {
+		for _, value := range values {
+			if value == mapping.Value {
+				return mapping.RoleID, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func (c *Client) oauthConfig(ctx context.Context, config Config) (*oauth2.Config, *gooidc.Provider, error) {
+	provider, err := c.provider(ctx, config.Issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  config.RedirectURL,
+		Scopes:       config.Scopes,
+	}, provider, nil
+}
+
+// provider returns the provider of the issuer, running the discovery the
+// first time. Failed discoveries are not kept, so they are retried.
+func (c *Client) provider(ctx context.Context, issuer string) (*gooidc.Provider, error) {
+	c.mu.Lock()
+	d, ok := c.providers[issuer]
+	if !ok {
+		d = &discovery{done: make(chan struct{})}
+		c.providers[issuer] = d
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		// Others may be waiting for this discovery, so it must not end with
+		// the request that started it; the client timeout bounds it.
+		discoveryCtx := gooidc.ClientContext(context.WithoutCancel(ctx), c.httpClient)
+		d.provider, d.err = gooidc.NewProvider(discoveryCtx, issuer)
+		if d.err != nil {
+			c.mu.Lock()
+			delete(c.providers, issuer)
+			c.mu.Unlock()
+		}
+		close(d.done)
+	}
+
+	select {
+	case <-d.done:
+		return d.provider, d.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// truthy reads the email_verified claim, which some providers send as
+// string.
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
@@ -0,0 +1,367 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+const (
+	testClientID     = "cuhara"
+	testClientSecret = "secret"
+	testRedirectURL  = "https://app.example.com/sso/callback"
+)
+
+// mockProvider is a minimal OpenID Connect provider: discovery, keys and a
+// token endpoint that checks the PKCE verifier of the code.
+type mockProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu          sync.Mutex
+	codes       map[string]authorization
+	discoveries int
+
+	// signingKey signs the ID tokens, the published key unless a test
+	// forges them.
+	signingKey *rsa.PrivateKey
+	audience   string
+}
+
+type authorization struct {
+	challenge string
+	nonce     string
+	claims    map[string]any
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &mockProvider{key: key, signingKey: key, audience: testClientID, codes: map[string]authorization{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		p.discoveries++
+		p.mu.Unlock()
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"issuer":                                p.server.URL,
+			"authorization_endpoint":                p.server.URL + "/authorize",
+			"token_endpoint":                        p.server.URL + "/token",
+			"jwks_uri":                              p.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &p.key.PublicKey, KeyID: "key-1", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", p.token)
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+// authorize plays the login of the user at the provider and returns the
+// code it redirects back with.
+func (p *mockProvider) authorize(t *testing.T, authURL string, claims map[string]any) (code string, state string) {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := u.Query()
+	if query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("expected S256 PKCE challenge, got %q", query.Get("code_challenge_method"))
+	}
+	if query.Get("redirect_uri") != testRedirectURL || query.Get("client_id") != testClientID {
+		t.Fatalf("unexpected client in authorization URL %s", authURL)
+	}
+
+	code = rand.Text()
+
+	p.mu.Lock()
+	p.codes[code] = authorization{challenge: query.Get("code_challenge"), nonce: query.Get("nonce"), claims: claims}
+	p.mu.Unlock()
+
+	return code, query.Get("state")
+}
+
+func (p *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != testClientID || clientSecret != testClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	auth, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	claims := map[string]any{
+		"iss":   p.server.URL,
+		"aud":   p.audience,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": auth.nonce,
+	}
+	for name, value := range auth.claims {
+		claims[name] = value
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: p.signingKey, KeyID: "key-1"}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+
+	payload, _ := json.Marshal(claims)
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	idToken, _ := signed.CompactSerialize()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (p *mockProvider) config() Config {
+	return Config{
+		Issuer:       p.server.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		Scopes:       []string{"openid", "email", "profile"},
+		RedirectURL:  testRedirectURL,
+	}
+}
+
+var userClaims = map[string]any{
+	"sub":            "user-1",
+	"email":          "ada@example.com",
+	"email_verified": true,
+	"name":           "Ada",
+	"groups":         []string{"staff", "admins"},
+}
+
+// login runs the flow up to the exchange and returns its result.
+func login(t *testing.T, p *mockProvider, client *Client, exchangeNonce func(nonce string) string) (Claims, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	verifier := GenerateVerifier()
+	nonce := rand.Text()
+
+	authURL, err := client.AuthCodeURL(ctx, p.config(), "state-1", nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, state := p.authorize(t, authURL, userClaims)
+	if state != "state-1" {
+		t.Fatalf("state %q, want state-1", state)
+	}
+
+	if exchangeNonce != nil {
+		nonce = exchangeNonce(nonce)
+	}
+	return client.Exchange(ctx, p.config(), code, verifier, nonce)
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	p := newMockProvider(t)
+
+	claims, err := login(t, p, New(time.Second), nil)
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	if claims.Subject != "user-1" || claims.Email != "ada@example.com" || claims.Name != "Ada" || !claims.EmailVerified {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+	if role, ok := MapRole(claims.All, "groups", []RoleMapping{{Value: "admins", RoleID: 3}}); !ok || role != 3 {
+		t.Errorf("role %d %v, want 3 true", role, ok)
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	p := newMockProvider(t)
+	client := New(time.Second)
+	ctx := context.Background()
+
+	authURL, err := client.AuthCodeURL(ctx, p.config(), "state-1", "nonce", GenerateVerifier())
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _ := p.authorize(t, authURL, userClaims)
+
+	if _, err := client.Exchange(ctx, p.config(), code, GenerateVerifier(), "nonce"); !errors.Is(err, ErrExchangeFailed) {
+		t.Fatalf("expected ErrExchangeFailed, got %v", err)
+	}
+}
+
+func TestExchangeRejectsOtherNonce(t *testing.T) {
+	p := newMockProvider(t)
+
+	_, err := login(t, p, New(time.Second), func(string) string { return "other" })
+	if !errors.Is(err, ErrInvalidIDToken) {
+		t.Fatalf("expected ErrInvalidIDToken, got %v", err)
+	}
+}
+
+func TestExchangeRejectsForgedIDToken(t *testing.T) {
+	p := newMockProvider(t)
+
+	forger, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.signingKey = forger
+
+	if _, err := login(t, p, New(time.Second), nil); !errors.Is(err, ErrInvalidIDToken) {
+		t.Fatalf("expected ErrInvalidIDToken, got %v", err)
+	}
+}
+
+func TestExchangeRejectsIDTokenOfOtherClient(t *testing.T) {
+	p := newMockProvider(t)
+	p.audience = "other-client"
+
+	if _, err := login(t, p, New(time.Second), nil); !errors.Is(err, ErrInvalidIDToken) {
+		t.Fatalf("expected ErrInvalidIDToken, got %v", err)
+	}
+}
+
+func TestMapRole(t *testing.T) {
+	mappings := []RoleMapping{{Value: "admins", RoleID: 1}, {Value: "staff", RoleID: 2}}
+
+	tests := []struct {
+		name   string
+		claims map[string]any
+		claim  string
+		want   int64
+		ok     bool
+	}{
+		{"first mapping wins", map[string]any{"groups": []any{"staff", "admins"}}, "groups", 1, true},
+		{"single string", map[string]any{"role": "staff"}, "role", 2, true},
+		{"no match", map[string]any{"groups": []any{"guests"}}, "groups", 0, false},
+		{"missing claim", map[string]any{}, "groups", 0, false},
+		{"no role claim configured", map[string]any{"groups": []any{"admins"}}, "", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := MapRole(tt.claims, tt.claim, mappings)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: got %d %v, want %d %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSlowDiscoveryDoesNotBlockOtherIssuers(t *testing.T) {
+	release := make(chan struct{})
+	var discoveries sync.WaitGroup
+	discoveries.Add(1)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		discoveries.Done()
+		<-release
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(release) })
+
+	p := newMockProvider(t)
+	client := New(5 * time.Second)
+
+	go func() { _, _ = client.provider(context.Background(), slow.URL) }()
+	discoveries.Wait()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.provider(context.Background(), p.server.URL)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("discovery of another issuer waited for the slow one")
+	}
+}
+
+func TestDiscoveryRunsOncePerIssuer(t *testing.T) {
+	p := newMockProvider(t)
+	client := New(time.Second)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.provider(context.Background(), p.server.URL); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discoveries != 1 {
+		t.Errorf("discovery ran %d times, want once", p.discoveries)
+	}
+}
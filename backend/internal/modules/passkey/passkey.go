@@ -0,0 +1,176 @@
+package passkey
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cuhara.qua.go/internal/config"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// ErrInvalidResponse is returned when the response of an authenticator does
+// not complete the ceremony, whatever the reason.
+var ErrInvalidResponse = errors.New("invalid passkey response")
+
+// ErrClonedAuthenticator is returned when the signature counter of a
+// credential went backwards, which hints at a copied private key.
+var ErrClonedAuthenticator = errors.New("passkey signature counter went backwards")
+
+// RelyingParty runs the WebAuthn registration and login ceremonies. The
+// options of a ceremony go to the browser and its session is kept by the
+// caller until the response of the authenticator comes back.
+type RelyingParty struct {
+	webauthn *webauthn.WebAuthn
+}
+
+// User is the account a passkey is registered for or logs in to.
+type User struct {
+	ID          int64
+	Handle      []byte
+	Name        string
+	DisplayName string
+	Credentials []webauthn.Credential
+}
+
+func (u *User) WebAuthnID() []byte                         { return u.Handle }
+func (u *User) WebAuthnName() string                       { return u.Name }
+func (u *User) WebAuthnDisplayName() string                { return u.DisplayName }
+func (u *User) WebAuthnCredentials() []webauthn.Credential { return u.Credentials }
+
+// UserLookup finds the user of a credential by the user handle the
+// authenticator returned, with the credentials registered in its tenant.
+type UserLookup func(credentialID []byte, userHandle []byte) (*User, error)
+
+func New(config config.Server) (*RelyingParty, error) {
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          config.Auth.WebAuthnRPID,
+		RPDisplayName: config.Auth.WebAuthnRPDisplayName,
+		RPOrigins:     config.Auth.WebAuthnOrigins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        webauthn.TimeoutConfig{Enforce: true, Timeout: config.Auth.WebAuthnCeremonyTTL, TimeoutUVD: config.Auth.WebAuthnCeremonyTTL},
+			Registration: webauthn.TimeoutConfig{Enforce: true, Timeout: config.Auth.WebAuthnCeremonyTTL, TimeoutUVD: config.Auth.WebAuthnCeremonyTTL},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RelyingParty{webauthn: w}, nil
+}
+
+// BeginRegistration returns the options for navigator.credentials.create and
+// the session of the ceremony. Passkeys are discoverable and verify the user,
+// and the credentials the user already has are excluded.
+func (rp *RelyingParty) BeginRegistration(user *User) (map[string]any, []byte, error) {
+	creation, session, err := rp.webauthn.BeginRegistration(
+		user,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		}),
+		webauthn.WithExclusions(webauthn.Credentials(user.Credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCeremony(creation, session)
+}
+
+// FinishRegistration checks the response of the authenticator to the
+// registration options and returns the new credential.
+func (rp *RelyingParty) FinishRegistration(user *User, session []byte, response []byte) (*webauthn.Credential, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	credential, err := rp.webauthn.CreateCredential(user, data, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return credential, nil
+}
+
+// BeginLogin returns the options for navigator.credentials.get and the
+// session of the ceremony. The authenticator picks the passkey, so no user
+// needs to be known yet.
+func (rp *RelyingParty) BeginLogin() (map[string]any, []byte, error) {
+	assertion, session, err := rp.webauthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCeremony(assertion, session)
+}
+
+// FinishLogin checks the response of the authenticator to the login options
+// and returns the user with the credential it used, its signature counter
+// updated. Errors of the lookup are returned as they are.
+func (rp *RelyingParty) FinishLogin(session []byte, response []byte, lookup UserLookup) (*User, *webauthn.Credential, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	var lookupErr error
+	handler := func(rawID []byte, userHandle []byte) (webauthn.User, error) {
+		user, err := lookup(rawID, userHandle)
+		if err != nil {
+			lookupErr = err
+			return nil, err
+		}
+		return user, nil
+	}
+
+	user, credential, err := rp.webauthn.ValidatePasskeyLogin(handler, data, parsed)
+	if err != nil {
+		if lookupErr != nil {
+			return nil, nil, lookupErr
+		}
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	if credential.Authenticator.CloneWarning {
+		return nil, nil, ErrClonedAuthenticator
+	}
+
+	return user.(*User), credential, nil
+}
+
+// encodeCeremony turns the options into the JSON object handed to the
+// browser and the session into the bytes to keep.
+func encodeCeremony(options any, session *webauthn.SessionData) (map[string]any, []byte, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, nil, err
+	}
+
+	encoded, err := json.Marshal(session)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return object, encoded, nil
+}
@@ -0,0 +1,368 @@
+package passkey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"cuhara.qua.go/internal/config"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+const testOrigin = "https://app.example.com"
+
+// softAuthenticator is a passkey authenticator in software, answering the
+// options of the relying party the way a browser would hand them over.
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	counter      uint32
+	origin       string
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		t.Fatal(err)
+	}
+
+	return &softAuthenticator{key: key, credentialID: credentialID, origin: testOrigin}
+}
+
+// create answers registration options with a credential using the "none"
+// attestation format.
+func (a *softAuthenticator) create(t *testing.T, options map[string]any) []byte {
+	t.Helper()
+
+	publicKey := options["publicKey"].(map[string]any)
+	rpID := publicKey["rp"].(map[string]any)["id"].(string)
+	a.userHandle = decode(t, publicKey["user"].(map[string]any)["id"].(string))
+
+	coseKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attested := make([]byte, 16, 16+2+len(a.credentialID)+len(coseKey))
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, coseKey...)
+
+	// user present, user verified and attested credential data
+	authData := a.authenticatorData(rpID, 0x01|0x04|0x40, attested)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.response(t, map[string]any{
+		"clientDataJSON":    encode(a.clientData(t, "webauthn.create", publicKey["challenge"].(string))),
+		"attestationObject": encode(attestation),
+		"transports":        []string{"internal"},
+	})
+}
+
+// get answers login options with an assertion signed by the credential.
+func (a *softAuthenticator) get(t *testing.T, options map[string]any) []byte {
+	t.Helper()
+
+	publicKey := options["publicKey"].(map[string]any)
+	a.counter++
+
+	// user present and user verified
+	authData := a.authenticatorData(publicKey["rpId"].(string), 0x01|0x04, nil)
+	clientData := a.clientData(t, "webauthn.get", publicKey["challenge"].(string))
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.response(t, map[string]any{
+		"clientDataJSON":    encode(clientData),
+		"authenticatorData": encode(authData),
+		"signature":         encode(signature),
+		"userHandle":        encode(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) authenticatorData(rpID string, flags byte, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.counter)
+	return append(data, attested...)
+}
+
+func (a *softAuthenticator) clientData(t *testing.T, kind string, challenge string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"type":        kind,
+		"challenge":   challenge,
+		"origin":      a.origin,
+		"crossOrigin": false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func (a *softAuthenticator) response(t *testing.T, response map[string]any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"id":       encode(a.credentialID),
+		"rawId":    encode(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decode(t *testing.T, data string) []byte {
+	t.Helper()
+
+	raw, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func newRelyingParty(t *testing.T) *RelyingParty {
+	t.Helper()
+
+	var cfg config.Server
+	cfg.Auth.WebAuthnRPID = "app.example.com"
+	cfg.Auth.WebAuthnRPDisplayName = "Example"
+	cfg.Auth.WebAuthnOrigins = []string{testOrigin}
+	cfg.Auth.WebAuthnCeremonyTTL = time.Minute
+
+	rp, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rp
+}
+
+// register runs a registration ceremony and stores the credential with the
+// user.
+func register(t *testing.T, rp *RelyingParty, user *User, authenticator *softAuthenticator) {
+	t.Helper()
+
+	options, session, err := rp.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credential, err := rp.FinishRegistration(user, session, authenticator.create(t, options))
+	if err != nil {
+		t.Fatalf("registration failed: %v", err)
+	}
+	user.Credentials = append(user.Credentials, *credential)
+}
+
+func lookupOf(user *User) UserLookup {
+	return func(credentialID []byte, userHandle []byte) (*User, error) {
+		if !bytes.Equal(userHandle, user.Handle) {
+			return nil, errors.New("unknown user handle")
+		}
+		return user, nil
+	}
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com", DisplayName: "Admin"}
+
+	register(t, rp, user, authenticator)
+
+	if !bytes.Equal(user.Credentials[0].ID, authenticator.credentialID) {
+		t.Fatalf("registered credential id %x, want %x", user.Credentials[0].ID, authenticator.credentialID)
+	}
+
+	for want := uint32(1); want <= 2; want++ {
+		options, session, err := rp.BeginLogin()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, credential, err := rp.FinishLogin(session, authenticator.get(t, options), lookupOf(user))
+		if err != nil {
+			t.Fatalf("login failed: %v", err)
+		}
+		if got.ID != user.ID {
+			t.Errorf("logged in user %d, want %d", got.ID, user.ID)
+		}
+		if credential.Authenticator.SignCount != want {
+			t.Errorf("sign count %d, want %d", credential.Authenticator.SignCount, want)
+		}
+		user.Credentials[0] = *credential
+	}
+}
+
+func TestRegistrationRejectsOtherOrigin(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	authenticator.origin = "https://phishing.example.net"
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com"}
+
+	options, session, err := rp.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rp.FinishRegistration(user, session, authenticator.create(t, options)); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestLoginRejectsOtherOrigin(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com"}
+	register(t, rp, user, authenticator)
+
+	options, session, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authenticator.origin = "https://phishing.example.net"
+	if _, _, err := rp.FinishLogin(session, authenticator.get(t, options), lookupOf(user)); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestLoginRejectsResponseToOtherChallenge(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com"}
+	register(t, rp, user, authenticator)
+
+	options, _, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherSession, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := rp.FinishLogin(otherSession, authenticator.get(t, options), lookupOf(user)); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestLoginDetectsClonedAuthenticator(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com"}
+	register(t, rp, user, authenticator)
+
+	user.Credentials[0].Authenticator.SignCount = 10
+
+	options, session, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := rp.FinishLogin(session, authenticator.get(t, options), lookupOf(user)); !errors.Is(err, ErrClonedAuthenticator) {
+		t.Fatalf("expected ErrClonedAuthenticator, got %v", err)
+	}
+}
+
+func TestLoginReturnsLookupError(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com"}
+	register(t, rp, user, authenticator)
+
+	options, session, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lookupErr := errors.New("database down")
+	lookup := func([]byte, []byte) (*User, error) { return nil, lookupErr }
+	if _, _, err := rp.FinishLogin(session, authenticator.get(t, options), lookup); !errors.Is(err, lookupErr) {
+		t.Fatalf("expected lookup error, got %v", err)
+	}
+}
+
+// stored credentials are rebuilt from their columns, the way the auth
+// service keeps them.
+func TestLoginWithRebuiltCredential(t *testing.T) {
+	rp := newRelyingParty(t)
+	authenticator := newSoftAuthenticator(t)
+	user := &User{ID: 7, Handle: []byte("handle-7"), Name: "admin@example.com"}
+	register(t, rp, user, authenticator)
+
+	registered := user.Credentials[0]
+	user.Credentials[0] = webauthn.Credential{
+		ID:              registered.ID,
+		PublicKey:       registered.PublicKey,
+		AttestationType: registered.AttestationType,
+		Transport:       registered.Transport,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: registered.Flags.BackupEligible,
+			BackupState:    registered.Flags.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    registered.Authenticator.AAGUID,
+			SignCount: registered.Authenticator.SignCount,
+		},
+	}
+
+	options, session, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := rp.FinishLogin(session, authenticator.get(t, options), lookupOf(user)); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+}
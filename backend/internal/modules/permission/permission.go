@@ -0,0 +1,48 @@
+package permission
+
+import (
+	"context"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Claims checked by the modules. They are granted per tenant either directly
+// to a user or to the role of the user.
+const (
+	ClaimModeratePosts       = "MODERATE_POSTS"
+	ClaimManageModerators    = "MANAGE_MODERATORS"
+	ClaimAuditAnonymousPosts = "AUDIT_ANONYMOUS_POSTS"
+	ClaimEditCommunityWiki   = "EDIT_COMMUNITY_WIKI"
+	ClaimRevokeSessions      = "REVOKE_SESSIONS"
+	ClaimManageSSO           = "MANAGE_SSO"
+	ClaimUnlockUsers         = "UNLOCK_USERS"
+)
+
+// HasClaim reports whether the user holds the named claim of the tenant.
+func HasClaim(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64, name string) (bool, error) {
+	return models.Claims(
+		models.ClaimWhere.Name.EQ(name),
+		models.ClaimWhere.TenantID.EQ(tenantID),
+		qm.Where(`(EXISTS (SELECT 1 FROM user_claims uc WHERE uc.claim_id = claims.id AND uc.user_id = ?)
+			OR EXISTS (SELECT 1 FROM role_claims rc JOIN users u ON u.role_id = rc.role_id WHERE rc.claim_id = claims.id AND u.id = ?))`, userID, userID),
+	).Exists(ctx, exec)
+}
+
+// CanModerate reports whether the user may moderate posts of the sub topic,
+// either tenant wide through ClaimModeratePosts or as a moderator of the sub
+// topic, its topic or any sub topic above it.
+func CanModerate(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64, subTopicID int64) (bool, error) {
+	allowed, err := HasClaim(ctx, exec, tenantID, userID, ClaimModeratePosts)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	return models.TopicModerators(
+		models.TopicModeratorWhere.UserID.EQ(userID),
+		models.TopicModeratorWhere.TenantID.EQ(tenantID),
+		qm.Where(`EXISTS (SELECT 1 FROM categories c JOIN categories a ON a.id = ANY(string_to_array(c.path, '/')::BIGINT[])
+			WHERE c.sub_topic_id = ? AND (a.topic_id = topic_moderators.topic_id OR a.sub_topic_id = topic_moderators.sub_topic_id))`, subTopicID),
+	).Exists(ctx, exec)
+}
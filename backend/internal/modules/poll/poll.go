@@ -0,0 +1,311 @@
+package poll
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/types"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+)
+
+// Visibility of the results of a poll. Results of closed polls are visible
+// to everyone.
+const (
+	VisibilityAlways     = "ALWAYS"
+	VisibilityAfterVote  = "AFTER_VOTE"
+	VisibilityAfterClose = "AFTER_CLOSE"
+)
+
+const maxOptions = 20
+
+var visibilities = []string{VisibilityAlways, VisibilityAfterVote, VisibilityAfterClose}
+
+// countSQL counts the votes of every option of the polls and marks the
+// options the user chose.
+const countSQL = `
+	SELECT o.id AS option_id, COUNT(v.user_id) AS votes, COALESCE(BOOL_OR(v.user_id = $2), false) AS voted
+	FROM poll_options o
+	LEFT JOIN poll_votes v ON v.option_id = o.id
+	WHERE o.poll_id = ANY($1)
+	GROUP BY o.id`
+
+const voterSQL = `
+	SELECT poll_id, COUNT(DISTINCT user_id) AS voters
+	FROM poll_votes
+	WHERE poll_id = ANY($1)
+	GROUP BY poll_id`
+
+type countRow struct {
+	OptionID int64 `boil:"option_id"`
+	Votes    int   `boil:"votes"`
+	Voted    bool  `boil:"voted"`
+}
+
+type voterRow struct {
+	PollID int64 `boil:"poll_id"`
+	Voters int   `boil:"voters"`
+}
+
+// Validate checks a poll of a new post. Keys of the returned details are
+// prefixed with poll.
+func Validate(request dto.CreatePollRequest, now time.Time) []types.HttpValidationErrorDetail {
+	var details []types.HttpValidationErrorDetail
+	invalid := func(key string, message string) {
+		details = append(details, types.HttpValidationErrorDetail{
+			Key:   "poll." + key,
+			In:    "body",
+			Error: message,
+		})
+	}
+
+	if strings.TrimSpace(request.Question) == "" {
+		invalid("question", "is required")
+	}
+
+	seen := make(map[string]bool, len(request.Options))
+	for _, option := range request.Options {
+		label := strings.TrimSpace(option)
+		if label == "" {
+			invalid("options", "must not be empty")
+			break
+		}
+		if seen[label] {
+			invalid("options", "must be unique")
+			break
+		}
+		seen[label] = true
+	}
+	if len(request.Options) < 2 || len(request.Options) > maxOptions {
+		invalid("options", "must contain between 2 and 20 options")
+	}
+
+	if request.ResultsVisibility != "" && !slices.Contains(visibilities, request.ResultsVisibility) {
+		invalid("resultsVisibility", "must be one of "+strings.Join(visibilities, ", "))
+	}
+
+	if request.ClosesAt != nil && !request.ClosesAt.After(now) {
+		invalid("closesAt", "must be in the future")
+	}
+
+	return details
+}
+
+// Create stores a validated poll of the post using exec.
+func Create(ctx context.Context, exec boil.ContextExecutor, tenantID int64, postID int64, request dto.CreatePollRequest) error {
+	visibility := request.ResultsVisibility
+	if visibility == "" {
+		visibility = VisibilityAlways
+	}
+
+	var closesAt null.Time
+	if request.ClosesAt != nil {
+		closesAt = null.TimeFrom(request.ClosesAt.UTC())
+	}
+
+	poll := models.Poll{
+		PostID:            postID,
+		Question:          strings.TrimSpace(request.Question),
+		MultipleChoice:    request.MultipleChoice,
+		ResultsVisibility: visibility,
+		ClosesAt:          closesAt,
+		TenantID:          tenantID,
+	}
+	if err := poll.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	for i, label := range request.Options {
+		option := models.PollOption{
+			PollID:   poll.ID,
+			Label:    strings.TrimSpace(label),
+			Position: i,
+			TenantID: tenantID,
+		}
+		if err := option.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Find returns the poll of the post, or httperrors.ErrPollNotFound.
+func Find(ctx context.Context, exec boil.ContextExecutor, postID int64, mods ...qm.QueryMod) (*models.Poll, error) {
+	poll, err := models.Polls(append([]qm.QueryMod{models.PollWhere.PostID.EQ(postID)}, mods...)...).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, httperrors.ErrPollNotFound
+	}
+
+	return poll, err
+}
+
+// ForPosts returns the polls of the given posts as seen by the user, keyed by
+// post id. Posts without a poll are left out.
+func ForPosts(ctx context.Context, exec boil.ContextExecutor, userID int64, postIDs []int64, now time.Time) (map[int64]*dto.PollDTO, error) {
+	polls := make(map[int64]*dto.PollDTO)
+	if len(postIDs) == 0 {
+		return polls, nil
+	}
+
+	found, err := models.Polls(
+		models.PollWhere.PostID.IN(postIDs),
+		qm.Load(models.PollRels.PollOptions, qm.OrderBy(models.PollOptionColumns.Position)),
+	).All(ctx, exec)
+	if err != nil || len(found) == 0 {
+		return polls, err
+	}
+
+	pollIDs := make([]int64, len(found))
+	for i, poll := range found {
+		pollIDs[i] = poll.ID
+	}
+
+	var counts []countRow
+	if err := queries.Raw(countSQL, pq.Array(pollIDs), userID).Bind(ctx, exec, &counts); err != nil {
+		return nil, err
+	}
+
+	var voters []voterRow
+	if err := queries.Raw(voterSQL, pq.Array(pollIDs)).Bind(ctx, exec, &voters); err != nil {
+		return nil, err
+	}
+
+	countsByOption := make(map[int64]countRow, len(counts))
+	for _, row := range counts {
+		countsByOption[row.OptionID] = row
+	}
+	votersByPoll := make(map[int64]int, len(voters))
+	for _, row := range voters {
+		votersByPoll[row.PollID] = row.Voters
+	}
+
+	for _, poll := range found {
+		polls[poll.PostID] = toDTO(poll, countsByOption, votersByPoll[poll.ID], now)
+	}
+
+	return polls, nil
+}
+
+// Vote replaces the options the user chose on the poll. Callers lock the poll
+// so concurrent votes of a user cannot add up on single choice polls.
+func Vote(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64, poll *models.Poll, optionIDs []int64, now time.Time) error {
+	if closed(poll, now) {
+		return httperrors.ErrPollClosed
+	}
+
+	options, err := models.PollOptions(models.PollOptionWhere.PollID.EQ(poll.ID)).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	if details := validateChoice(poll, options, optionIDs); len(details) > 0 {
+		return httperrors.NewHTTPValidationError(
+			http.StatusBadRequest,
+			httperrors.HTTPErrorTypeGeneric,
+			"Poll vote validation failed",
+			details,
+		)
+	}
+
+	_, err = models.PollVotes(
+		models.PollVoteWhere.PollID.EQ(poll.ID),
+		models.PollVoteWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, optionID := range optionIDs {
+		vote := models.PollVote{
+			PollID:   poll.ID,
+			OptionID: optionID,
+			UserID:   userID,
+			TenantID: tenantID,
+		}
+		if err := vote.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateChoice(poll *models.Poll, options models.PollOptionSlice, optionIDs []int64) []types.HttpValidationErrorDetail {
+	invalid := func(message string) []types.HttpValidationErrorDetail {
+		return []types.HttpValidationErrorDetail{{Key: "optionIds", In: "body", Error: message}}
+	}
+
+	if !poll.MultipleChoice && len(optionIDs) > 1 {
+		return invalid("only one option can be chosen")
+	}
+
+	chosen := make(map[int64]bool, len(optionIDs))
+	for _, optionID := range optionIDs {
+		if chosen[optionID] {
+			return invalid("must not contain an option twice")
+		}
+		chosen[optionID] = true
+
+		if !slices.ContainsFunc(options, func(option *models.PollOption) bool { return option.ID == optionID }) {
+			return invalid("must only contain options of the poll")
+		}
+	}
+
+	return nil
+}
+
+func closed(poll *models.Poll, now time.Time) bool {
+	return poll.ClosesAt.Valid && !poll.ClosesAt.Time.After(now)
+}
+
+// toDTO hides the vote counts unless the results are visible to the user.
+func toDTO(poll *models.Poll, counts map[int64]countRow, voters int, now time.Time) *dto.PollDTO {
+	options := make([]dto.PollOptionDTO, len(poll.R.PollOptions))
+	voted := false
+	for i, option := range poll.R.PollOptions {
+		options[i] = dto.PollOptionDTO{
+			ID:    option.ID,
+			Label: option.Label,
+			Voted: counts[option.ID].Voted,
+		}
+		voted = voted || counts[option.ID].Voted
+	}
+
+	isClosed := closed(poll, now)
+	visible := isClosed ||
+		poll.ResultsVisibility == VisibilityAlways ||
+		poll.ResultsVisibility == VisibilityAfterVote && voted
+
+	pollDTO := &dto.PollDTO{
+		ID:                poll.ID,
+		Question:          poll.Question,
+		MultipleChoice:    poll.MultipleChoice,
+		ResultsVisibility: poll.ResultsVisibility,
+		ClosesAt:          poll.ClosesAt.Ptr(),
+		Closed:            isClosed,
+		Voted:             voted,
+		ResultsVisible:    visible,
+		Options:           options,
+	}
+	if visible {
+		pollDTO.VoterCount = &voters
+		for i := range options {
+			votes := counts[options[i].ID].Votes
+			options[i].Votes = &votes
+		}
+	}
+
+	return pollDTO
+}
@@ -0,0 +1,88 @@
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/null/v8"
+)
+
+var now = time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+func TestValidate(t *testing.T) {
+	past := now.Add(-time.Hour)
+	tests := []struct {
+		name    string
+		request dto.CreatePollRequest
+		keys    []string
+	}{
+		{"valid", dto.CreatePollRequest{Question: "Which?", Options: []string{"a", "b"}}, nil},
+		{"missing question", dto.CreatePollRequest{Options: []string{"a", "b"}}, []string{"poll.question"}},
+		{"single option", dto.CreatePollRequest{Question: "Which?", Options: []string{"a"}}, []string{"poll.options"}},
+		{"duplicate option", dto.CreatePollRequest{Question: "Which?", Options: []string{"a", " a"}}, []string{"poll.options"}},
+		{"unknown visibility", dto.CreatePollRequest{Question: "Which?", Options: []string{"a", "b"}, ResultsVisibility: "NEVER"}, []string{"poll.resultsVisibility"}},
+		{"closed", dto.CreatePollRequest{Question: "Which?", Options: []string{"a", "b"}, ClosesAt: &past}, []string{"poll.closesAt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := Validate(tt.request, now)
+
+			if len(details) != len(tt.keys) {
+				t.Fatalf("Validate() = %v, want keys %v", details, tt.keys)
+			}
+			for i, detail := range details {
+				if detail.Key != tt.keys[i] {
+					t.Errorf("detail %d has key %s, want %s", i, detail.Key, tt.keys[i])
+				}
+			}
+		})
+	}
+}
+
+func TestResultsVisibility(t *testing.T) {
+	counts := map[int64]countRow{
+		1: {OptionID: 1, Votes: 3, Voted: true},
+		2: {OptionID: 2, Votes: 1},
+	}
+	other := map[int64]countRow{
+		1: {OptionID: 1, Votes: 3},
+		2: {OptionID: 2, Votes: 1},
+	}
+
+	tests := []struct {
+		name       string
+		visibility string
+		closesAt   null.Time
+		counts     map[int64]countRow
+		visible    bool
+	}{
+		{"always", VisibilityAlways, null.Time{}, other, true},
+		{"after vote without vote", VisibilityAfterVote, null.Time{}, other, false},
+		{"after vote with vote", VisibilityAfterVote, null.Time{}, counts, true},
+		{"after close while open", VisibilityAfterClose, null.TimeFrom(now.Add(time.Hour)), counts, false},
+		{"after close once closed", VisibilityAfterClose, null.TimeFrom(now), other, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll := &models.Poll{ID: 1, ResultsVisibility: tt.visibility, ClosesAt: tt.closesAt}
+			poll.R = poll.R.NewStruct()
+			poll.R.PollOptions = models.PollOptionSlice{{ID: 1, Label: "a"}, {ID: 2, Label: "b"}}
+
+			got := toDTO(poll, tt.counts, 4, now)
+
+			if got.ResultsVisible != tt.visible {
+				t.Fatalf("ResultsVisible = %v, want %v", got.ResultsVisible, tt.visible)
+			}
+			if tt.visible && (got.VoterCount == nil || got.Options[0].Votes == nil || *got.Options[0].Votes != 3) {
+				t.Errorf("visible results are missing vote counts: %+v", got)
+			}
+			if !tt.visible && (got.VoterCount != nil || got.Options[0].Votes != nil) {
+				t.Errorf("hidden results expose vote counts: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package post
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/util"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+// hiddenSubTopicID is restricted to a role the test user does not have.
+const hiddenSubTopicID = 20
+
+var placeholders = regexp.MustCompile(`\$\d+`)
+
+// recordedQuery is a statement run against the recording database.
+type recordedQuery struct {
+	sql  string
+	args []any
+}
+
+// recordingDB stands in for the database. It records every statement, hides
+// hiddenSubTopicID from the access lists and answers statements containing a
+// key of rows with its rows. It finds nothing else.
+type recordingDB struct {
+	mu      sync.Mutex
+	queries []recordedQuery
+	rows    map[string]recordingRows
+}
+
+func (r *recordingDB) Connect(context.Context) (driver.Conn, error) { return recordingConn{r}, nil }
+func (r *recordingDB) Driver() driver.Driver                        { return nil }
+
+// postQueries returns the recorded statements reading posts.
+func (r *recordingDB) postQueries() []recordedQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var found []recordedQuery
+	for _, query := range r.queries {
+		if strings.Contains(query.sql, `FROM "posts"`) {
+			found = append(found, query)
+		}
+	}
+
+	return found
+}
+
+type recordingConn struct{ db *recordingDB }
+
+func (c recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+func (c recordingConn) Close() error              { return nil }
+func (c recordingConn) Begin() (driver.Tx, error) { return recordingTx{}, nil }
+
+// recordingTx is a transaction of the recording database, which keeps
+// nothing to commit or roll back.
+type recordingTx struct{}
+
+func (recordingTx) Commit() error   { return nil }
+func (recordingTx) Rollback() error { return nil }
+
+func (c recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	c.db.mu.Lock()
+	c.db.queries = append(c.db.queries, recordedQuery{sql: query, args: values})
+	c.db.mu.Unlock()
+
+	for match, rows := range c.db.rows {
+		if strings.Contains(query, match) {
+			return &recordingRows{columns: rows.columns, values: rows.values}, nil
+		}
+	}
+
+	if strings.Contains(query, "FROM categories c") {
+		return &recordingRows{
+			columns: []string{"id", "path", "topic_id", "sub_topic_id", "denied"},
+			values:  [][]driver.Value{{int64(1), "1", nil, int64(hiddenSubTopicID), true}},
+		}, nil
+	}
+
+	return &recordingRows{}, nil
+}
+
+type recordingRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string { return r.columns }
+func (r *recordingRows) Close() error      { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+
+	return nil
+}
+
+// newRecordingService returns a service on a recording database and the
+// context of a request by user 2 of tenant 1.
+func newRecordingService(t *testing.T) (*Service, *recordingDB, context.Context) {
+	t.Helper()
+
+	recorder := &recordingDB{}
+	db := sql.OpenDB(recorder)
+	t.Cleanup(func() { _ = db.Close() })
+
+	ctx := util.DisableLogger(context.Background(), true)
+	ctx = util.SaveContextValue(ctx, util.CTXKeyTenant, 1)
+	ctx = util.SaveContextValue(ctx, util.CTXKeyUser, 2)
+
+	return &Service{db: db}, recorder, ctx
+}
+
+// postsFilterClause returns the where clause the filter adds to post queries,
+// with its placeholders blanked out.
+func postsFilterClause(filter *access.Filter) string {
+	sql, _ := queries.BuildQuery(models.Posts(filter.Posts()).Query)
+	clause := sql[strings.Index(sql, "WHERE ")+len("WHERE ") : strings.LastIndex(sql, ";")]
+
+	return placeholders.ReplaceAllString(clause, "$")
+}
+
+// assertFiltered checks that the query holds the where clause of the filter
+// and is given the ids of every sub topic it hides.
+func assertFiltered(t *testing.T, filter *access.Filter, sql string, args []any) {
+	t.Helper()
+
+	if clause := postsFilterClause(filter); !strings.Contains(placeholders.ReplaceAllString(sql, "$"), clause) {
+		t.Errorf("query does not hold the access filter %s: %s", clause, sql)
+	}
+
+	for _, id := range filter.HiddenSubTopicIDs() {
+		if !slices.Contains(args, any(id)) {
+			t.Errorf("query args %v do not contain hidden sub topic %d", args, id)
+		}
+	}
+}
+
+func TestReadPathsFilterHiddenSubTopics(t *testing.T) {
+	postID := int64(5)
+	filter := access.NewFilter(nil, []int64{hiddenSubTopicID})
+
+	tests := []struct {
+		name string
+		read func(context.Context, *Service) error
+	}{
+		{"findPost", func(ctx context.Context, s *Service) error {
+			_, err := s.findPost(ctx, 1, postID)
+			return err
+		}},
+		{"GetHistory", func(ctx context.Context, s *Service) error {
+			_, err := s.GetHistory(ctx, dto.GetPostHistoryRequest{ID: postID})
+			return err
+		}},
+		{"GetAnswers", func(ctx context.Context, s *Service) error {
+			_, err := s.GetAnswers(ctx, dto.GetAnswersRequest{PostID: postID})
+			return err
+		}},
+		{"GetSuggestedEdits", func(ctx context.Context, s *Service) error {
+			_, err := s.GetSuggestedEdits(ctx, dto.GetSuggestedEditsRequest{PostID: &postID})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, recorder, ctx := newRecordingService(t)
+
+			if err := tt.read(ctx, s); !errors.Is(err, httperrors.ErrPostNotFound) {
+				t.Fatalf("%s returned %v, want post not found", tt.name, err)
+			}
+
+			postQueries := recorder.postQueries()
+			if len(postQueries) != 1 {
+				t.Fatalf("%s ran %d post queries, want 1", tt.name, len(postQueries))
+			}
+
+			assertFiltered(t, filter, postQueries[0].sql, postQueries[0].args)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package post
+
+import (
+	"context"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// AuthorID returns the user who wrote the post, looking up the hidden author
+// of anonymous posts. It must only be exposed to auditors.
+func AuthorID(ctx context.Context, exec boil.ContextExecutor, post *models.Post) (int64, error) {
+	if post.CreatorID.Valid {
+		return post.CreatorID.Int64, nil
+	}
+
+	author, err := models.AnonymousPostAuthors(
+		models.AnonymousPostAuthorWhere.PostID.EQ(post.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+
+	return author.UserID, nil
+}
@@ -0,0 +1,54 @@
+package post
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"cuhara.qua.go/internal/data/dto"
+)
+
+func TestGetExpertsKeepsAuthorOfAnonymousPost(t *testing.T) {
+	const authorID = 7
+
+	s, recorder, ctx := newRecordingService(t)
+	now := time.Now().UTC()
+	recorder.rows = map[string]recordingRows{
+		`FROM "posts"`: {
+			columns: []string{"id", "tenant_id", "subtopic_id", "creator_id", "anonymous"},
+			values:  [][]driver.Value{{int64(5), int64(1), int64(10), nil, true}},
+		},
+		`"a"."post_id"`: {
+			columns: []string{"id", "name", "tenant_id", "created_at", "updated_at", "post_id"},
+			values:  [][]driver.Value{{int64(3), "go", int64(1), now, now, int64(5)}},
+		},
+		"FROM answers a": {
+			columns: []string{"user_id", "name", "score"},
+			values: [][]driver.Value{
+				{int64(authorID), "Ada", int64(40)},
+				{int64(8), "Grace", int64(12)},
+			},
+		},
+	}
+
+	experts, err := s.GetExperts(ctx, dto.GetPostExpertsRequest{ID: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without the author the list would differ from the public ranking by
+	// exactly the author.
+	if len(experts) != 2 || experts[0].UserID != authorID {
+		t.Errorf("got experts %+v, want the author on top", experts)
+	}
+
+	for _, query := range recorder.queries {
+		if strings.Contains(query.sql, "anonymous_post_authors") {
+			t.Errorf("looked up the author of the anonymous post: %s", query.sql)
+		}
+		if strings.Contains(query.sql, "FROM answers a") && query.args[2] != int64(0) {
+			t.Errorf("excluded user %v from the experts, want nobody", query.args[2])
+		}
+	}
+}
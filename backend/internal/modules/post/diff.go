@@ -0,0 +1,70 @@
+package post
+
+import (
+	"strings"
+
+	"cuhara.qua.go/internal/data/dto"
+)
+
+// Operations of a diff line.
+const (
+	DiffOpEqual  = "EQUAL"
+	DiffOpInsert = "INSERT"
+	DiffOpDelete = "DELETE"
+)
+
+// diffLines compares two texts line by line along their longest common
+// subsequence. Deleted lines are listed before the lines inserted in their
+// place.
+func diffLines(old string, new string) []dto.DiffLineDTO {
+	a, b := splitLines(old), splitLines(new)
+
+	// common[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]dto.DiffLineDTO, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, dto.DiffLineDTO{Op: DiffOpEqual, Text: a[i]})
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			lines = append(lines, dto.DiffLineDTO{Op: DiffOpDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, dto.DiffLineDTO{Op: DiffOpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, dto.DiffLineDTO{Op: DiffOpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, dto.DiffLineDTO{Op: DiffOpInsert, Text: b[j]})
+	}
+
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
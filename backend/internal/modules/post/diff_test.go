@@ -0,0 +1,35 @@
+package post
+
+import (
+	"slices"
+	"testing"
+
+	"cuhara.qua.go/internal/data/dto"
+)
+
+func TestDiffLines(t *testing.T) {
+	got := diffLines("first\nsecond\nthird", "first\nchanged\nthird\nfourth")
+	want := []dto.DiffLineDTO{
+		{Op: DiffOpEqual, Text: "first"},
+		{Op: DiffOpDelete, Text: "second"},
+		{Op: DiffOpInsert, Text: "changed"},
+		{Op: DiffOpEqual, Text: "third"},
+		{Op: DiffOpInsert, Text: "fourth"},
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("diffLines() = %v, want %v", got, want)
+	}
+}
+
+func TestDiffLinesOfEmptyText(t *testing.T) {
+	if got := diffLines("", ""); len(got) != 0 {
+		t.Errorf("diff of empty texts = %v, want no lines", got)
+	}
+
+	got := diffLines("", "new")
+	want := []dto.DiffLineDTO{{Op: DiffOpInsert, Text: "new"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("diff from empty text = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+const (
+	HistoryActionMergedInto   = "MERGED_INTO"
+	HistoryActionMergedFrom   = "MERGED_FROM"
+	HistoryActionMoved        = "MOVED"
+	HistoryActionEdited       = "EDITED"
+	HistoryActionClosed       = "CLOSED"
+	HistoryActionReopened     = "REOPENED"
+	HistoryActionLocked       = "LOCKED"
+	HistoryActionUnlocked     = "UNLOCKED"
+	HistoryActionWikiEnabled  = "WIKI_ENABLED"
+	HistoryActionWikiDisabled = "WIKI_DISABLED"
+	HistoryActionAnswerEdited = "ANSWER_EDITED"
+)
+
+// RecordHistory appends an entry to the history of a post using exec, so it
+// is committed together with the change it describes.
+func RecordHistory(ctx context.Context, exec boil.ContextExecutor, tenantID int64, postID int64, actorID int64, action string, data map[string]any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	history := models.PostHistory{
+		PostID:   postID,
+		ActorID:  actorID,
+		Action:   action,
+		Data:     raw,
+		TenantID: tenantID,
+	}
+
+	return history.Insert(ctx, exec, boil.Infer())
+}
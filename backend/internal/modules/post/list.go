@@ -0,0 +1,103 @@
+package post
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/modules/customfield"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	defaultListLimit   = 50
+	defaultExportLimit = 1000
+)
+
+const newestFirst = "posts.created_at DESC, posts.id DESC"
+
+// listMods matches the visible, not merged posts of the tenant in the given
+// order.
+func listMods(tenantID int64, filter *access.Filter, request dto.GetPostsRequest, orderBy string, limit int) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		models.PostWhere.MergedIntoID.IsNull(),
+		qm.OrderBy(orderBy),
+		qm.Limit(limit),
+	}
+	if request.SubTopicID != nil {
+		mods = append(mods, models.PostWhere.SubtopicID.EQ(*request.SubTopicID))
+	}
+
+	return append(mods, fieldMods(request.Fields)...)
+}
+
+// listOrder turns the sort of a list request into an order clause. Posts with
+// equal sort values stay newest first. It reports false for unknown custom
+// fields.
+func listOrder(fields models.CustomFieldSlice, sort string) (string, bool) {
+	switch sort {
+	case "", "-createdAt":
+		return newestFirst, true
+	case "createdAt":
+		return "posts.created_at ASC, posts.id ASC", true
+	}
+
+	descending := strings.HasPrefix(sort, "-")
+	name, ok := strings.CutPrefix(strings.TrimPrefix(sort, "-"), "custom.")
+	if !ok {
+		return "", false
+	}
+	if descending {
+		name = "-" + name
+	}
+
+	orderBy, ok := customfield.OrderBy(fields, name)
+	if !ok {
+		return "", false
+	}
+
+	return orderBy + ", " + newestFirst, true
+}
+
+// fieldMods matches posts whose question template fields have the given
+// values. Values that read as JSON numbers or booleans also match fields
+// holding them, since filters carry no type. Containment lets the GIN index
+// on posts.fields serve the filters.
+func fieldMods(fields map[string]string) []qm.QueryMod {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	mods := make([]qm.QueryMod, len(names))
+	for i, name := range names {
+		values := []any{fields[name]}
+
+		var decoded any
+		if err := json.Unmarshal([]byte(fields[name]), &decoded); err == nil {
+			switch decoded.(type) {
+			case float64, bool:
+				values = append(values, json.RawMessage(fields[name]))
+			}
+		}
+
+		clauses := make([]string, len(values))
+		args := make([]any, len(values))
+		for j, value := range values {
+			// Marshalling a string or a valid raw message cannot fail.
+			raw, _ := json.Marshal(map[string]any{name: value})
+			clauses[j] = "posts.fields @> ?::jsonb"
+			args[j] = string(raw)
+		}
+
+		mods[i] = qm.Where(strings.Join(clauses, " OR "), args...)
+	}
+
+	return mods
+}
@@ -0,0 +1,69 @@
+package post
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+func TestListFiltersByFields(t *testing.T) {
+	request := dto.GetPostsRequest{
+		Fields: map[string]string{"version": "1.2", "environment": "prod"},
+	}
+
+	sql, args := queries.BuildQuery(models.Posts(listMods(1, access.NewFilter(nil, nil), request, newestFirst, defaultListLimit)...).Query)
+
+	// Containment can be served by the GIN index on posts.fields; the number
+	// is matched both as text and as a JSON number.
+	if strings.Contains(sql, "->>") || strings.Count(sql, "posts.fields @> ") != 3 {
+		t.Fatalf("list does not filter every field by containment: %s", sql)
+	}
+
+	// Fields are filtered in name order so the query is stable.
+	environment := slices.Index(args, any(`{"environment":"prod"}`))
+	version := slices.Index(args, any(`{"version":"1.2"}`))
+	if environment < 0 || version < 0 || environment > version {
+		t.Errorf("list args %v do not contain the field filters in order", args)
+	}
+	if !slices.Contains(args, any(`{"version":1.2}`)) {
+		t.Errorf("list args %v do not match the version as a number", args)
+	}
+}
+
+func TestListExcludesHiddenSubTopics(t *testing.T) {
+	subTopicID := int64(20)
+	request := dto.GetPostsRequest{SubTopicID: &subTopicID}
+	filter := access.NewFilter(nil, []int64{21})
+
+	sql, args := queries.BuildQuery(models.Posts(listMods(1, filter, request, newestFirst, defaultListLimit)...).Query)
+
+	assertFiltered(t, filter, sql, args)
+
+	if !strings.Contains(sql, `"posts"."subtopic_id" = `) || !slices.Contains(args, any(subTopicID)) {
+		t.Errorf("list does not filter by the requested sub topic: %s", sql)
+	}
+}
+
+func TestListOrderByCustomField(t *testing.T) {
+	fields := models.CustomFieldSlice{{Name: "due", Type: "DATE"}}
+
+	orderBy, ok := listOrder(fields, "-custom.due")
+	if !ok {
+		t.Fatal("list cannot be sorted by an existing custom field")
+	}
+	if !strings.HasPrefix(orderBy, "posts.custom_fields -> 'due' DESC NULLS LAST") || !strings.HasSuffix(orderBy, newestFirst) {
+		t.Errorf("unexpected custom field order: %s", orderBy)
+	}
+
+	if _, ok := listOrder(fields, "custom.missing"); ok {
+		t.Error("list can be sorted by an unknown custom field")
+	}
+	if orderBy, _ := listOrder(fields, ""); orderBy != newestFirst {
+		t.Errorf("list is not newest first by default: %s", orderBy)
+	}
+}
@@ -0,0 +1,44 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// MovePosts moves the posts to the sub topic and records the move in the
+// history of every post. Posts already in the sub topic are left untouched.
+// The caller is responsible for checking that the sub topic belongs to the
+// tenant of the posts.
+func MovePosts(ctx context.Context, exec boil.ContextExecutor, tenantID int64, actorID int64, posts models.PostSlice, subTopicID int64) (int64, error) {
+	var ids []int64
+	for _, post := range posts {
+		if post.SubtopicID == subTopicID {
+			continue
+		}
+
+		err := RecordHistory(ctx, exec, tenantID, post.ID, actorID, HistoryActionMoved, map[string]any{
+			"fromSubTopicId": post.SubtopicID,
+			"toSubTopicId":   subTopicID,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		ids = append(ids, post.ID)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	return models.Posts(
+		models.PostWhere.ID.IN(ids),
+		models.PostWhere.TenantID.EQ(tenantID),
+	).UpdateAll(ctx, exec, models.M{
+		models.PostColumns.SubtopicID: subTopicID,
+		models.PostColumns.UpdatedAt:  time.Now().UTC(),
+	})
+}
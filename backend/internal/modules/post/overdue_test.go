@@ -0,0 +1,64 @@
+package post
+
+import (
+	"database/sql/driver"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNotifyOverdueSkipsRespondersWhoCannotSeePost(t *testing.T) {
+	const (
+		visiblePostID = int64(5)
+		hiddenPostID  = int64(6)
+		responderID   = int64(3)
+	)
+
+	s, recorder, ctx := newRecordingService(t)
+	recorder.rows = map[string]recordingRows{
+		`FROM "posts"`: {
+			columns: []string{"id", "tenant_id", "subtopic_id"},
+			values: [][]driver.Value{
+				{visiblePostID, int64(1), int64(10)},
+				{hiddenPostID, int64(1), int64(hiddenSubTopicID)},
+			},
+		},
+		`FROM "sub_topics"`: {
+			columns: []string{"id", "name", "first_reply_target_minutes"},
+			values: [][]driver.Value{
+				{int64(10), "Open", int64(30)},
+				{int64(hiddenSubTopicID), "Restricted", int64(30)},
+			},
+		},
+		"overdue_notified_at = $2": {
+			columns: []string{"id"},
+			values:  [][]driver.Value{{visiblePostID}, {hiddenPostID}},
+		},
+		`FROM "users"`: {
+			columns: []string{"id", "tenant_id"},
+			values:  [][]driver.Value{{responderID, int64(1)}},
+		},
+		`INSERT INTO "notifications"`: {
+			columns: []string{"id", "read_at"},
+			values:  [][]driver.Value{{int64(1), nil}},
+		},
+	}
+
+	if err := s.NotifyOverdue(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var notified []any
+	for _, query := range recorder.queries {
+		if strings.Contains(query.sql, `INSERT INTO "notifications"`) {
+			notified = append(notified, query.args...)
+		}
+	}
+
+	if !slices.Contains(notified, any(visiblePostID)) {
+		t.Errorf("responder was not told about visible post %d", visiblePostID)
+	}
+	if slices.Contains(notified, any(hiddenPostID)) {
+		t.Errorf("responder was told about post %d they cannot see", hiddenPostID)
+	}
+}
@@ -0,0 +1,114 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/modules/poll"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+func (s *Service) GetPoll(ctx context.Context, request dto.GetPollRequest) (dto.PollDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetPoll").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.PollDTO{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return dto.PollDTO{}, err
+	}
+
+	pollDTO, err := s.pollOf(ctx, post.ID)
+	if err != nil {
+		return dto.PollDTO{}, err
+	}
+
+	log.Debug().Msg("Poll fetched successfully")
+
+	return pollDTO, nil
+}
+
+// Vote replaces the vote of the user on the poll of a post and returns the
+// poll as the user now sees it.
+func (s *Service) Vote(ctx context.Context, request dto.VotePollRequest) (dto.PollDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Vote").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.PollDTO{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.PollDTO{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return dto.PollDTO{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		found, err := poll.Find(ctx, ce, post.ID, qm.For("UPDATE"))
+		if err != nil {
+			return err
+		}
+
+		return poll.Vote(ctx, ce, tenantID, userID, found, request.OptionIDs, time.Now().UTC())
+	})
+	if err != nil {
+		var validationErr *httperrors.HTTPValidationError
+		if errors.Is(err, httperrors.ErrPollNotFound) || errors.Is(err, httperrors.ErrPollClosed) || errors.As(err, &validationErr) {
+			log.Debug().Err(err).Int64("postId", post.ID).Msg("Vote rejected")
+			return dto.PollDTO{}, err
+		}
+
+		log.Error().Err(err).Msg("Failed to vote")
+		return dto.PollDTO{}, err
+	}
+
+	pollDTO, err := s.pollOf(ctx, post.ID)
+	if err != nil {
+		return dto.PollDTO{}, err
+	}
+
+	log.Debug().Msg("Voted successfully")
+
+	return pollDTO, nil
+}
+
+func (s *Service) pollOf(ctx context.Context, postID int64) (dto.PollDTO, error) {
+	log := util.LogFromContext(ctx)
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.PollDTO{}, err
+	}
+
+	polls, err := poll.ForPosts(ctx, s.db, userID, []int64{postID}, time.Now().UTC())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get poll")
+		return dto.PollDTO{}, err
+	}
+
+	pollDTO, ok := polls[postID]
+	if !ok {
+		log.Debug().Int64("postId", postID).Msg("Post has no poll")
+		return dto.PollDTO{}, httperrors.ErrPollNotFound
+	}
+
+	return *pollDTO, nil
+}
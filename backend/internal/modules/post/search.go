@@ -0,0 +1,30 @@
+package post
+
+import (
+	"strings"
+
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const defaultSearchLimit = 20
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchMods matches posts of the tenant whose title, body or tags contain
+// the query. Posts in sub topics hidden by the filter never match.
+func searchMods(tenantID int64, filter *access.Filter, query string, limit int) []qm.QueryMod {
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+
+	return []qm.QueryMod{
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		models.PostWhere.MergedIntoID.IsNull(),
+		qm.Where(`(posts.title ILIKE ? OR posts.body ILIKE ?
+			OR EXISTS (SELECT 1 FROM post_tags pt JOIN tags t ON t.id = pt.tag_id WHERE pt.post_id = posts.id AND t.name ILIKE ?))`,
+			pattern, pattern, pattern),
+		qm.OrderBy(models.PostColumns.CreatedAt + " DESC, " + models.PostColumns.ID + " DESC"),
+		qm.Limit(limit),
+	}
+}
@@ -0,0 +1,50 @@
+package post
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+func buildSearch(filter *access.Filter, query string) (string, []any) {
+	return queries.BuildQuery(models.Posts(searchMods(1, filter, query, defaultSearchLimit)...).Query)
+}
+
+func TestSearchExcludesHiddenSubTopics(t *testing.T) {
+	filter := access.NewFilter([]int64{7}, []int64{20, 21})
+
+	sql, args := buildSearch(filter, "incident")
+
+	assertFiltered(t, filter, sql, args)
+
+	// sqlboiler wraps every where clause in parentheses and ANDs them, so the
+	// access filter holds no matter what the text match is.
+	if !strings.Contains(placeholders.ReplaceAllString(sql, "$"), "AND "+postsFilterClause(filter)+" AND") {
+		t.Errorf("search does not AND the access filter with the text match: %s", sql)
+	}
+}
+
+func TestSearchWithoutHiddenSubTopics(t *testing.T) {
+	filter := access.NewFilter(nil, nil)
+	if ids := filter.HiddenSubTopicIDs(); len(ids) != 0 {
+		t.Fatalf("HiddenSubTopicIDs() = %v, want none", ids)
+	}
+
+	sql, _ := buildSearch(filter, "incident")
+
+	if strings.Contains(sql, "NOT IN") {
+		t.Errorf("search filters sub topics although none are hidden: %s", sql)
+	}
+}
+
+func TestSearchEscapesLikePatterns(t *testing.T) {
+	_, args := buildSearch(access.NewFilter(nil, nil), `100%_\`)
+
+	if !slices.Contains(args, any(`%100\%\_\\%`)) {
+		t.Errorf("search args %v do not contain the escaped pattern", args)
+	}
+}
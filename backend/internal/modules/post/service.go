@@ -0,0 +1,2009 @@
+package post
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/modules/category"
+	"cuhara.qua.go/internal/modules/customfield"
+	"cuhara.qua.go/internal/modules/expertise"
+	"cuhara.qua.go/internal/modules/notification"
+	"cuhara.qua.go/internal/modules/permission"
+	"cuhara.qua.go/internal/modules/poll"
+	"cuhara.qua.go/internal/modules/reaction"
+	"cuhara.qua.go/internal/modules/template"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/lib/pq"
+)
+
+const defaultExpertLimit = 5
+
+// claimOverduePostsSQL marks the posts as notified and returns those no other
+// run got to first.
+const claimOverduePostsSQL = `
+	UPDATE posts SET overdue_notified_at = $2
+	WHERE id = ANY($1) AND overdue_notified_at IS NULL
+	RETURNING id`
+
+type Service struct {
+	db     *sql.DB
+	config config.Server
+}
+
+func NewService(config config.Server, db *sql.DB) *Service {
+	return &Service{
+		config: config,
+		db:     db,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, request dto.CreatePostRequest) (dto.CreatePostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Create").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.CreatePostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.CreatePostResponse{}, err
+	}
+
+	if err := s.requireSubTopic(ctx, tenantID, request.SubTopicID); err != nil {
+		return dto.CreatePostResponse{}, err
+	}
+
+	if request.Anonymous {
+		tenant, err := models.FindTenant(ctx, s.db, tenantID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find tenant")
+			return dto.CreatePostResponse{}, err
+		}
+
+		if !tenant.AllowAnonymousPosts {
+			log.Debug().Int64("tenantId", tenantID).Msg("Anonymous posts are disabled")
+			return dto.CreatePostResponse{}, httperrors.ErrAnonymousPostsDisabled
+		}
+	}
+
+	fields, err := s.validateFields(ctx, request.SubTopicID, request.Fields)
+	if err != nil {
+		return dto.CreatePostResponse{}, err
+	}
+
+	customFields, err := s.validateCustomFields(ctx, tenantID, request.CustomFields)
+	if err != nil {
+		return dto.CreatePostResponse{}, err
+	}
+
+	if request.Poll != nil {
+		if details := poll.Validate(*request.Poll, time.Now().UTC()); len(details) > 0 {
+			log.Debug().Msg("Poll validation failed")
+			return dto.CreatePostResponse{}, httperrors.NewHTTPValidationError(
+				http.StatusBadRequest,
+				httperrors.HTTPErrorTypeGeneric,
+				"Poll validation failed",
+				details,
+			)
+		}
+	}
+
+	post := models.Post{
+		Title:        request.Title,
+		Body:         request.Body,
+		SubtopicID:   request.SubTopicID,
+		TenantID:     tenantID,
+		Anonymous:    request.Anonymous,
+		Fields:       fields,
+		CustomFields: customFields,
+	}
+	if !request.Anonymous {
+		post.CreatorID = null.Int64From(userID)
+	}
+
+	var tags models.TagSlice
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if err := post.Insert(ctx, ce, boil.Infer()); err != nil {
+			return err
+		}
+
+		if request.Anonymous {
+			author := models.AnonymousPostAuthor{
+				PostID:   post.ID,
+				UserID:   userID,
+				TenantID: tenantID,
+			}
+			if err := author.Insert(ctx, ce, boil.Infer()); err != nil {
+				return err
+			}
+		}
+
+		if request.Poll != nil {
+			if err := poll.Create(ctx, ce, tenantID, post.ID, *request.Poll); err != nil {
+				return err
+			}
+		}
+
+		found, err := findOrCreateTags(ctx, ce, tenantID, request.Tags)
+		if err != nil {
+			return err
+		}
+		tags = found
+
+		return post.AddTags(ctx, ce, false, tags...)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create post")
+		return dto.CreatePostResponse{}, err
+	}
+
+	tagIDs := make([]int64, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+
+	// The post is committed already, failing here would only make the client
+	// create it again.
+	experts, err := expertise.TopExperts(ctx, s.db, tenantID, tagIDs, userID, defaultExpertLimit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to suggest experts")
+		experts = []dto.ExpertDTO{}
+	}
+
+	log.Debug().Msg("Post created successfully")
+
+	return dto.CreatePostResponse{ID: post.ID, SuggestedExperts: experts}, nil
+}
+
+func (s *Service) GetExperts(ctx context.Context, request dto.GetPostExpertsRequest) ([]dto.ExpertDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetExperts").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	post, err := models.Posts(
+		models.PostWhere.ID.EQ(request.ID),
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		qm.Load(models.PostRels.Tags),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Post not found")
+			return nil, httperrors.ErrPostNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find post")
+		return nil, err
+	}
+
+	tagIDs := make([]int64, len(post.R.Tags))
+	for i, tag := range post.R.Tags {
+		tagIDs[i] = tag.ID
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultExpertLimit
+	}
+
+	// The author of an anonymous post stays on the list, leaving them out
+	// would tell who wrote it.
+	var excludeUserID int64
+	if !post.Anonymous {
+		excludeUserID = post.CreatorID.Int64
+	}
+
+	experts, err := expertise.TopExperts(ctx, s.db, tenantID, tagIDs, excludeUserID, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to suggest experts")
+		return nil, err
+	}
+
+	log.Debug().Msg("Post experts fetched successfully")
+
+	return experts, nil
+}
+
+// Assign assigns a post to a user or to every user of a role and notifies
+// them. Only moderators of the sub topic may assign, and only to users who can
+// see the post.
+func (s *Service) Assign(ctx context.Context, request dto.AssignPostRequest) (dto.AssignPostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Assign").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.AssignPostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.AssignPostResponse{}, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return dto.AssignPostResponse{}, err
+	}
+
+	if (request.UserID == nil) == (request.RoleID == nil) {
+		log.Debug().Msg("Post assignment needs exactly one of user and role")
+		return dto.AssignPostResponse{}, httperrors.ErrInvalidPostAssignment
+	}
+
+	post, err := models.Posts(
+		models.PostWhere.ID.EQ(request.ID),
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Int64("postId", request.ID).Msg("Post not found")
+			return dto.AssignPostResponse{}, httperrors.ErrPostNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find post")
+		return dto.AssignPostResponse{}, err
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID); err != nil {
+		return dto.AssignPostResponse{}, err
+	}
+
+	var assigneeIDs []int64
+	if request.UserID != nil {
+		exists, err := models.Users(
+			models.UserWhere.ID.EQ(*request.UserID),
+			models.UserWhere.TenantID.EQ(tenantID),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check whether user exists")
+			return dto.AssignPostResponse{}, err
+		}
+
+		if !exists {
+			log.Debug().Int64("userId", *request.UserID).Msg("User not found")
+			return dto.AssignPostResponse{}, httperrors.ErrUserNotFound
+		}
+
+		visible, err := s.canSeePost(ctx, tenantID, *request.UserID, post)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to resolve topic access of assignee")
+			return dto.AssignPostResponse{}, err
+		}
+
+		if !visible {
+			log.Debug().Int64("userId", *request.UserID).Msg("Assignee cannot see the post")
+			return dto.AssignPostResponse{}, httperrors.ErrAssigneeCannotSeePost
+		}
+
+		assigneeIDs = []int64{*request.UserID}
+	} else {
+		exists, err := models.Roles(
+			models.RoleWhere.ID.EQ(*request.RoleID),
+			models.RoleWhere.TenantID.EQ(tenantID),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check whether role exists")
+			return dto.AssignPostResponse{}, err
+		}
+
+		if !exists {
+			log.Debug().Int64("roleId", *request.RoleID).Msg("Role not found")
+			return dto.AssignPostResponse{}, httperrors.ErrRoleNotFound
+		}
+
+		users, err := models.Users(
+			models.UserWhere.RoleID.EQ(*request.RoleID),
+			models.UserWhere.TenantID.EQ(tenantID),
+		).All(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get role users")
+			return dto.AssignPostResponse{}, err
+		}
+
+		for _, user := range users {
+			visible, err := s.canSeePost(ctx, tenantID, user.ID, post)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to resolve topic access of assignee")
+				return dto.AssignPostResponse{}, err
+			}
+
+			if visible {
+				assigneeIDs = append(assigneeIDs, user.ID)
+			}
+		}
+
+		if len(assigneeIDs) == 0 {
+			log.Debug().Int64("roleId", *request.RoleID).Msg("No user of the role can see the post")
+			return dto.AssignPostResponse{}, httperrors.ErrAssigneeCannotSeePost
+		}
+	}
+
+	now := time.Now().UTC()
+	post.AssigneeUserID = null.Int64FromPtr(request.UserID)
+	post.AssigneeRoleID = null.Int64FromPtr(request.RoleID)
+	post.AssignedAt = null.TimeFrom(now)
+	post.UpdatedAt = null.TimeFrom(now)
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		_, err := post.Update(ctx, ce, boil.Whitelist(
+			models.PostColumns.AssigneeUserID,
+			models.PostColumns.AssigneeRoleID,
+			models.PostColumns.AssignedAt,
+			models.PostColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		message := fmt.Sprintf("Post #%d was assigned to you: %s", post.ID, post.Title)
+		return notification.Notify(ctx, ce, tenantID, assigneeIDs, notification.TypePostAssigned, message, null.Int64From(post.ID))
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to assign post")
+		return dto.AssignPostResponse{}, err
+	}
+
+	log.Debug().Msg("Post assigned successfully")
+
+	return dto.AssignPostResponse{ID: post.ID}, nil
+}
+
+// canSeePost reports whether the user passes the access filter of the sub
+// topic of the post.
+func (s *Service) canSeePost(ctx context.Context, tenantID int64, userID int64, post *models.Post) (bool, error) {
+	filter, err := access.ForUser(ctx, s.db, tenantID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return filter.SubTopicVisible(post.SubtopicID), nil
+}
+
+// GetAssigned lists posts assigned to the current user, either directly or
+// through their role.
+func (s *Service) GetAssigned(ctx context.Context) ([]dto.PostDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAssigned").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return nil, err
+	}
+
+	user, err := models.Users(
+		models.UserWhere.ID.EQ(userID),
+		models.UserWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("User not found")
+			return nil, httperrors.ErrUserNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find user")
+		return nil, err
+	}
+
+	posts, err := models.Posts(
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		models.PostWhere.MergedIntoID.IsNull(),
+		qm.Expr(
+			models.PostWhere.AssigneeUserID.EQ(null.Int64From(user.ID)),
+			qm.Or2(models.PostWhere.AssigneeRoleID.EQ(null.Int64From(user.RoleID))),
+		),
+		qm.Load(qm.Rels(models.PostRels.Subtopic, models.SubTopicRels.Topic)),
+		qm.Load(models.PostRels.Tags),
+		qm.OrderBy(models.PostColumns.AssignedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get assigned posts")
+		return nil, err
+	}
+
+	postDTOs, err := s.postsToDTO(ctx, posts)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get post reactions")
+		return nil, err
+	}
+
+	log.Debug().Msg("Assigned posts fetched successfully")
+
+	return postDTOs, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, request dto.GetPostRequest) (dto.PostDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetByID").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.PostDTO{}, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return dto.PostDTO{}, err
+	}
+
+	post, err := models.Posts(
+		models.PostWhere.ID.EQ(request.ID),
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		qm.Load(qm.Rels(models.PostRels.Subtopic, models.SubTopicRels.Topic)),
+		qm.Load(models.PostRels.Tags),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Post not found")
+			return dto.PostDTO{}, httperrors.ErrPostNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find post")
+		return dto.PostDTO{}, err
+	}
+
+	postDTOs, err := s.postsToDTO(ctx, models.PostSlice{post})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get post reactions")
+		return dto.PostDTO{}, err
+	}
+
+	log.Debug().Msg("Post fetched successfully")
+
+	return postDTOs[0], nil
+}
+
+// Merge moves the answers, with their comments and votes, and the tags of a
+// duplicate post into the target post and leaves the duplicate behind as a
+// stub pointing to the target.
+func (s *Service) Merge(ctx context.Context, request dto.MergePostRequest) (dto.MergePostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Merge").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.MergePostResponse{}, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return dto.MergePostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.MergePostResponse{}, err
+	}
+
+	if request.ID == request.TargetPostID {
+		log.Debug().Int64("id", request.ID).Msg("Post cannot be merged into itself")
+		return dto.MergePostResponse{}, httperrors.ErrInvalidPostMerge
+	}
+
+	posts, err := models.Posts(
+		models.PostWhere.ID.IN([]int64{request.ID, request.TargetPostID}),
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		qm.Load(models.PostRels.Tags),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find posts")
+		return dto.MergePostResponse{}, err
+	}
+
+	if len(posts) != 2 {
+		log.Debug().Int64("id", request.ID).Int64("targetPostId", request.TargetPostID).Msg("Post not found")
+		return dto.MergePostResponse{}, httperrors.ErrPostNotFound
+	}
+
+	source, target := posts[0], posts[1]
+	if source.ID != request.ID {
+		source, target = target, source
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, source.SubtopicID, target.SubtopicID); err != nil {
+		return dto.MergePostResponse{}, err
+	}
+
+	if source.MergedIntoID.Valid || target.MergedIntoID.Valid {
+		log.Debug().Msg("Post has already been merged")
+		return dto.MergePostResponse{}, httperrors.ErrPostAlreadyMerged
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		// Lock both posts, the lower id first so concurrent merges cannot
+		// deadlock, and check again that neither was merged or deleted
+		// meanwhile.
+		locked, err := models.Posts(
+			models.PostWhere.ID.IN([]int64{source.ID, target.ID}),
+			qm.Load(models.PostRels.Tags),
+			qm.OrderBy(models.PostColumns.ID+" ASC"),
+			qm.For("UPDATE"),
+		).All(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		if len(locked) != 2 {
+			return httperrors.ErrPostNotFound
+		}
+
+		source, target = locked[0], locked[1]
+		if source.ID != request.ID {
+			source, target = target, source
+		}
+
+		if source.MergedIntoID.Valid || target.MergedIntoID.Valid {
+			return httperrors.ErrPostAlreadyMerged
+		}
+
+		answers, err := source.Answers().All(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		answerIDs := make([]int64, len(answers))
+		for i, answer := range answers {
+			answerIDs[i] = answer.ID
+		}
+
+		// A post keeps at most one accepted answer, the target one wins.
+		targetHasAccepted, err := target.Answers(
+			models.AnswerWhere.IsAccepted.EQ(null.BoolFrom(true)),
+		).Exists(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		if targetHasAccepted {
+			_, err = source.Answers().UpdateAll(ctx, ce, models.M{models.AnswerColumns.IsAccepted: false})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = source.Answers().UpdateAll(ctx, ce, models.M{models.AnswerColumns.PostID: target.ID})
+		if err != nil {
+			return err
+		}
+
+		if err := recomputeFirstReply(ctx, ce, target); err != nil {
+			return err
+		}
+
+		targetTagIDs := make(map[int64]bool, len(target.R.Tags))
+		for _, tag := range target.R.Tags {
+			targetTagIDs[tag.ID] = true
+		}
+
+		var missingTags models.TagSlice
+		sourceTagIDs := make([]int64, len(source.R.Tags))
+		for i, tag := range source.R.Tags {
+			sourceTagIDs[i] = tag.ID
+			if !targetTagIDs[tag.ID] {
+				missingTags = append(missingTags, tag)
+			}
+		}
+
+		if err := target.AddTags(ctx, ce, false, missingTags...); err != nil {
+			return err
+		}
+
+		if err := source.SetTags(ctx, ce, false); err != nil {
+			return err
+		}
+
+		// Stubs of earlier merges into the source now point to the target.
+		_, err = models.Posts(
+			models.PostWhere.MergedIntoID.EQ(null.Int64From(source.ID)),
+		).UpdateAll(ctx, ce, models.M{models.PostColumns.MergedIntoID: target.ID})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		source.MergedIntoID = null.Int64From(target.ID)
+		source.UpdatedAt = null.TimeFrom(now)
+		_, err = source.Update(ctx, ce, boil.Whitelist(
+			models.PostColumns.MergedIntoID,
+			models.PostColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		target.UpdatedAt = null.TimeFrom(now)
+		_, err = target.Update(ctx, ce, boil.Whitelist(
+			models.PostColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		err = RecordHistory(ctx, ce, tenantID, source.ID, userID, HistoryActionMergedInto, map[string]any{
+			"targetPostId": target.ID,
+			"answerIds":    answerIDs,
+			"tagIds":       sourceTagIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		return RecordHistory(ctx, ce, tenantID, target.ID, userID, HistoryActionMergedFrom, map[string]any{
+			"sourcePostId": source.ID,
+			"answerIds":    answerIDs,
+			"tagIds":       sourceTagIDs,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrPostNotFound) || errors.Is(err, httperrors.ErrPostAlreadyMerged) {
+			log.Debug().Err(err).Msg("Post changed before it was merged")
+			return dto.MergePostResponse{}, err
+		}
+
+		log.Error().Err(err).Msg("Failed to merge posts")
+		return dto.MergePostResponse{}, err
+	}
+
+	log.Debug().Msg("Posts merged successfully")
+
+	return dto.MergePostResponse{ID: target.ID}, nil
+}
+
+func (s *Service) GetHistory(ctx context.Context, request dto.GetPostHistoryRequest) ([]dto.PostHistoryDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetHistory").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The author of an anonymous post must not show up as an actor either.
+	var hiddenActorID int64
+	if post.Anonymous {
+		hiddenActorID, err = AuthorID(ctx, s.db, post)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find post author")
+			return nil, err
+		}
+	}
+
+	histories, err := models.PostHistories(
+		models.PostHistoryWhere.PostID.EQ(request.ID),
+		models.PostHistoryWhere.TenantID.EQ(tenantID),
+		qm.OrderBy(models.PostHistoryColumns.CreatedAt+" ASC, "+models.PostHistoryColumns.ID+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get post history")
+		return nil, err
+	}
+
+	historyDTOs := make([]dto.PostHistoryDTO, len(histories))
+	for i, history := range histories {
+		var data map[string]any
+		if err := history.Data.Unmarshal(&data); err != nil {
+			log.Error().Err(err).Msg("Failed to decode post history data")
+			return nil, err
+		}
+
+		var actorID *int64
+		if history.ActorID != hiddenActorID {
+			actorID = &history.ActorID
+		}
+
+		historyDTOs[i] = dto.PostHistoryDTO{
+			ID:        history.ID,
+			PostID:    history.PostID,
+			ActorID:   actorID,
+			Action:    history.Action,
+			Data:      data,
+			CreatedAt: history.CreatedAt,
+		}
+	}
+
+	log.Debug().Msg("Post history fetched successfully")
+
+	return historyDTOs, nil
+}
+
+func (s *Service) Move(ctx context.Context, request dto.MovePostRequest) (dto.MovePostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Move").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.MovePostResponse{}, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return dto.MovePostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.MovePostResponse{}, err
+	}
+
+	post, err := models.Posts(
+		models.PostWhere.ID.EQ(request.ID),
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Post not found")
+			return dto.MovePostResponse{}, httperrors.ErrPostNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find post")
+		return dto.MovePostResponse{}, err
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID, request.SubTopicID); err != nil {
+		return dto.MovePostResponse{}, err
+	}
+
+	if err := s.requireSubTopic(ctx, tenantID, request.SubTopicID); err != nil {
+		return dto.MovePostResponse{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		_, err := MovePosts(ctx, ce, tenantID, userID, models.PostSlice{post}, request.SubTopicID)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to move post")
+		return dto.MovePostResponse{}, err
+	}
+
+	log.Debug().Msg("Post moved successfully")
+
+	return dto.MovePostResponse{ID: post.ID}, nil
+}
+
+// MoveMany moves every post of the tenant matching all of the given ids and
+// filters to the destination sub topic.
+func (s *Service) MoveMany(ctx context.Context, request dto.MovePostsRequest) (dto.MovePostsResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "MoveMany").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.MovePostsResponse{}, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return dto.MovePostsResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.MovePostsResponse{}, err
+	}
+
+	if len(request.PostIDs) == 0 && request.FromSubTopicID == nil && request.FromTopicID == nil && request.Tag == nil {
+		log.Debug().Msg("No post ids or filters given")
+		return dto.MovePostsResponse{}, httperrors.ErrInvalidPostMoveFilter
+	}
+
+	if err := s.requireSubTopic(ctx, tenantID, request.SubTopicID); err != nil {
+		return dto.MovePostsResponse{}, err
+	}
+
+	mods := []qm.QueryMod{
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		models.PostWhere.SubtopicID.NEQ(request.SubTopicID),
+	}
+	if len(request.PostIDs) > 0 {
+		mods = append(mods, models.PostWhere.ID.IN(request.PostIDs))
+	}
+	if request.FromSubTopicID != nil {
+		mods = append(mods, models.PostWhere.SubtopicID.EQ(*request.FromSubTopicID))
+	}
+	if request.FromTopicID != nil {
+		mods = append(mods, qm.Where("posts.subtopic_id IN (SELECT st.id FROM sub_topics st WHERE st.topic_id = ? AND st.tenant_id = ?)", *request.FromTopicID, tenantID))
+	}
+	if request.Tag != nil {
+		mods = append(mods, qm.Where("EXISTS (SELECT 1 FROM post_tags pt JOIN tags t ON t.id = pt.tag_id WHERE pt.post_id = posts.id AND t.name = ? AND t.tenant_id = ?)", *request.Tag, tenantID))
+	}
+
+	posts, err := models.Posts(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find posts to move")
+		return dto.MovePostsResponse{}, err
+	}
+
+	// Scoped moderators may only move posts between sub topics they moderate.
+	subTopicIDs := []int64{request.SubTopicID}
+	for _, post := range posts {
+		subTopicIDs = append(subTopicIDs, post.SubtopicID)
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, subTopicIDs...); err != nil {
+		return dto.MovePostsResponse{}, err
+	}
+
+	var moved int64
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		moved, err = MovePosts(ctx, ce, tenantID, userID, posts, request.SubTopicID)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to move posts")
+		return dto.MovePostsResponse{}, err
+	}
+
+	log.Debug().Int64("movedCount", moved).Msg("Posts moved successfully")
+
+	return dto.MovePostsResponse{MovedCount: moved}, nil
+}
+
+// GetAll lists the posts visible to the user, newest first.
+func (s *Service) GetAll(ctx context.Context, request dto.GetPostsRequest) ([]dto.PostDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAll").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	mods, _, err := s.listQuery(ctx, tenantID, request, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := models.Posts(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get posts")
+		return nil, err
+	}
+
+	postDTOs, err := s.postsToDTO(ctx, posts)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get post reactions")
+		return nil, err
+	}
+
+	log.Debug().Int("resultCount", len(posts)).Msg("Posts fetched successfully")
+
+	return postDTOs, nil
+}
+
+// Export lists posts like GetAll as a table with one column per custom field
+// of the tenant.
+func (s *Service) Export(ctx context.Context, request dto.GetPostsRequest) (dto.PostExportDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Export").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.PostExportDTO{}, err
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultExportLimit
+	}
+
+	mods, fields, err := s.listQuery(ctx, tenantID, request, limit)
+	if err != nil {
+		return dto.PostExportDTO{}, err
+	}
+
+	posts, err := models.Posts(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get posts")
+		return dto.PostExportDTO{}, err
+	}
+
+	header := []string{"id", "title", "topic", "subTopic", "tags", "creatorId", "createdAt"}
+	for _, field := range fields {
+		header = append(header, field.Name)
+	}
+
+	rows := make([][]string, len(posts))
+	for i, post := range posts {
+		postDTO := postToDTO(post)
+
+		creatorID := ""
+		if postDTO.CreatorID != nil {
+			creatorID = strconv.FormatInt(*postDTO.CreatorID, 10)
+		}
+
+		row := []string{
+			strconv.FormatInt(postDTO.ID, 10),
+			postDTO.Title,
+			postDTO.SubTopic.Topic.Name,
+			postDTO.SubTopic.Name,
+			strings.Join(postDTO.Tags, ","),
+			creatorID,
+			postDTO.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		for _, field := range fields {
+			row = append(row, customfield.Format(field, postDTO.CustomFields))
+		}
+		rows[i] = row
+	}
+
+	log.Debug().Int("resultCount", len(posts)).Msg("Posts exported successfully")
+
+	return dto.PostExportDTO{Header: header, Rows: rows}, nil
+}
+
+// listQuery builds the query of GetAll and Export. It resolves the custom
+// fields of the tenant to filter and sort by them and returns them for the
+// export columns.
+func (s *Service) listQuery(ctx context.Context, tenantID int64, request dto.GetPostsRequest, limit int) ([]qm.QueryMod, models.CustomFieldSlice, error) {
+	log := util.LogFromContext(ctx)
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, nil, err
+	}
+
+	fields, err := customfield.Fields(ctx, s.db, tenantID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get custom fields")
+		return nil, nil, err
+	}
+
+	orderBy, ok := listOrder(fields, request.Sort)
+	if !ok {
+		log.Debug().Str("sort", request.Sort).Msg("Invalid post sort")
+		return nil, nil, httperrors.ErrInvalidPostSort
+	}
+
+	customMods, err := customfield.FilterMods(fields, request.CustomFields)
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid custom field filter")
+		return nil, nil, err
+	}
+
+	mods := append(listMods(tenantID, filter, request, orderBy, limit), customMods...)
+	mods = append(mods,
+		qm.Load(qm.Rels(models.PostRels.Subtopic, models.SubTopicRels.Topic)),
+		qm.Load(models.PostRels.Tags),
+	)
+
+	return mods, fields, nil
+}
+
+// Search lists the posts visible to the user whose title, body or tags
+// contain request.Query, newest first.
+func (s *Service) Search(ctx context.Context, request dto.SearchPostsRequest) ([]dto.PostDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Search").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	mods := append(searchMods(tenantID, filter, request.Query, limit), fieldMods(request.Fields)...)
+	mods = append(mods,
+		qm.Load(qm.Rels(models.PostRels.Subtopic, models.SubTopicRels.Topic)),
+		qm.Load(models.PostRels.Tags),
+	)
+
+	posts, err := models.Posts(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to search posts")
+		return nil, err
+	}
+
+	postDTOs, err := s.postsToDTO(ctx, posts)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get post reactions")
+		return nil, err
+	}
+
+	log.Debug().Int("resultCount", len(posts)).Msg("Posts searched successfully")
+
+	return postDTOs, nil
+}
+
+// GetUnanswered lists posts without any answer, or without an accepted answer
+// after request.AcceptanceDays. Posts that missed the first reply target of
+// their sub topic are flagged; NotifyOverdue tells its responders.
+func (s *Service) GetUnanswered(ctx context.Context, request dto.GetUnansweredPostsRequest) ([]dto.UnansweredPostDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetUnanswered").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	acceptanceCutoff := now.AddDate(0, 0, -request.AcceptanceDays)
+
+	posts, err := models.Posts(
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+		models.PostWhere.MergedIntoID.IsNull(),
+		qm.Where(`(NOT EXISTS (SELECT 1 FROM answers a WHERE a.post_id = posts.id)
+			OR (posts.created_at < ? AND NOT EXISTS (SELECT 1 FROM answers a WHERE a.post_id = posts.id AND a.is_accepted)))`, acceptanceCutoff),
+		qm.Load(qm.Rels(models.PostRels.Subtopic, models.SubTopicRels.Topic)),
+		qm.Load(models.PostRels.Answers),
+		qm.OrderBy(models.PostColumns.CreatedAt+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get unanswered posts")
+		return nil, err
+	}
+
+	postDTOs := make([]dto.UnansweredPostDTO, len(posts))
+	for i, post := range posts {
+		subTopic := post.R.Subtopic
+
+		postDTO := dto.UnansweredPostDTO{
+			ID:            post.ID,
+			CreatorID:     post.CreatorID.Ptr(),
+			Anonymous:     post.Anonymous,
+			AnswerCount:   len(post.R.Answers),
+			CreatedAt:     post.CreatedAt,
+			SubTopic:      subTopicToDTO(subTopic),
+			TargetMinutes: subTopic.FirstReplyTargetMinutes.Ptr(),
+		}
+
+		var firstReply *models.Answer
+		for _, answer := range post.R.Answers {
+			if answer.IsFirstReply.Valid && answer.IsFirstReply.Bool {
+				firstReply = answer
+				break
+			}
+		}
+
+		if firstReply != nil {
+			responseMinutes := int(firstReply.CreatedAt.Sub(post.CreatedAt).Minutes())
+			postDTO.FirstReplyAt = &firstReply.CreatedAt
+			postDTO.ResponseMinutes = &responseMinutes
+		}
+
+		if subTopic.FirstReplyTargetMinutes.Valid {
+			deadline := post.CreatedAt.Add(time.Duration(subTopic.FirstReplyTargetMinutes.Int) * time.Minute)
+
+			if firstReply != nil {
+				postDTO.Overdue = firstReply.CreatedAt.After(deadline)
+			} else {
+				postDTO.Overdue = now.After(deadline)
+			}
+		}
+
+		postDTOs[i] = postDTO
+	}
+
+	log.Debug().Msg("Unanswered posts fetched successfully")
+
+	return postDTOs, nil
+}
+
+// NotifyOverdue tells the responders of each sub topic about its posts that
+// missed the first reply target, once per post. It runs as a background job
+// over all tenants; posts are claimed before notifying, so concurrent runs
+// never notify twice.
+func (s *Service) NotifyOverdue(ctx context.Context) error {
+	log := util.LogFromContext(ctx).With().Str("function", "NotifyOverdue").Logger()
+
+	now := time.Now().UTC()
+
+	posts, err := models.Posts(
+		models.PostWhere.OverdueNotifiedAt.IsNull(),
+		models.PostWhere.MergedIntoID.IsNull(),
+		qm.InnerJoin("sub_topics st ON st.id = posts.subtopic_id"),
+		qm.Where("st.first_reply_target_minutes IS NOT NULL"),
+		qm.Where("posts.created_at + st.first_reply_target_minutes * interval '1 minute' < ?", now),
+		qm.Where("NOT EXISTS (SELECT 1 FROM answers a WHERE a.post_id = posts.id AND a.is_first_reply)"),
+		qm.Load(models.PostRels.Subtopic),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get overdue posts")
+		return err
+	}
+
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]int64, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		var claimed []struct {
+			ID int64 `boil:"id"`
+		}
+		if err := queries.Raw(claimOverduePostsSQL, pq.Array(postIDs), now).Bind(ctx, ce, &claimed); err != nil {
+			return err
+		}
+
+		claimedIDs := make(map[int64]bool, len(claimed))
+		for _, row := range claimed {
+			claimedIDs[row.ID] = true
+		}
+
+		for _, post := range posts {
+			if !claimedIDs[post.ID] {
+				continue
+			}
+
+			responders, err := post.R.Subtopic.Users().All(ctx, ce)
+			if err != nil {
+				return err
+			}
+
+			// Responders who cannot see the post must not learn of it.
+			userIDs := make([]int64, 0, len(responders))
+			for _, responder := range responders {
+				visible, err := s.canSeePost(ctx, post.TenantID, responder.ID, post)
+				if err != nil {
+					return err
+				}
+				if visible {
+					userIDs = append(userIDs, responder.ID)
+				}
+			}
+
+			message := fmt.Sprintf("Post #%d in %s missed its first reply target of %d minutes",
+				post.ID, post.R.Subtopic.Name, post.R.Subtopic.FirstReplyTargetMinutes.Int)
+
+			err = notification.Notify(ctx, ce, post.TenantID, userIDs, notification.TypePostOverdue, message, null.Int64From(post.ID))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to notify responders of overdue posts")
+		return err
+	}
+
+	log.Debug().Msg("NotifyOverdue service successfully executed")
+
+	return nil
+}
+
+// Update edits the title, body or tags of a post. Authors may edit their own
+// posts until a moderator locks them, moderators of the sub topic may edit any
+// post.
+func (s *Service) Update(ctx context.Context, request dto.UpdatePostRequest) (dto.UpdatePostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Update").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UpdatePostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.UpdatePostResponse{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.ID, qm.Load(models.PostRels.Tags))
+	if err != nil {
+		return dto.UpdatePostResponse{}, err
+	}
+
+	if post.MergedIntoID.Valid {
+		log.Debug().Int64("postId", post.ID).Msg("Post has been merged")
+		return dto.UpdatePostResponse{}, httperrors.ErrPostAlreadyMerged
+	}
+
+	authorID, err := AuthorID(ctx, s.db, post)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find post author")
+		return dto.UpdatePostResponse{}, err
+	}
+
+	if err := s.requireEditor(ctx, tenantID, userID, authorID, post.SubtopicID, post.LockedAt.Valid, post.Wiki); err != nil {
+		return dto.UpdatePostResponse{}, err
+	}
+
+	if err := requireVersion(post.Wiki, request.Version); err != nil {
+		return dto.UpdatePostResponse{}, err
+	}
+
+	// The history keeps the previous value of every changed field.
+	previous := make(map[string]any)
+	whitelist := []string{models.PostColumns.UpdatedAt}
+	if request.Title != nil && *request.Title != post.Title {
+		previous["title"] = post.Title
+		post.Title = *request.Title
+		whitelist = append(whitelist, models.PostColumns.Title)
+	}
+	if request.Body != nil && *request.Body != post.Body {
+		previous["body"] = post.Body
+		post.Body = *request.Body
+		whitelist = append(whitelist, models.PostColumns.Body)
+	}
+	if request.Tags != nil {
+		tags := make([]string, len(post.R.Tags))
+		for i, tag := range post.R.Tags {
+			tags[i] = tag.Name
+		}
+		previous["tags"] = tags
+	}
+	if request.Fields != nil {
+		fields, err := s.validateFields(ctx, post.SubtopicID, *request.Fields)
+		if err != nil {
+			return dto.UpdatePostResponse{}, err
+		}
+
+		previous["fields"] = post.Fields
+		post.Fields = fields
+		whitelist = append(whitelist, models.PostColumns.Fields)
+	}
+	if request.CustomFields != nil {
+		customFields, err := s.validateCustomFields(ctx, tenantID, *request.CustomFields)
+		if err != nil {
+			return dto.UpdatePostResponse{}, err
+		}
+
+		previous["customFields"] = post.CustomFields
+		post.CustomFields = customFields
+		whitelist = append(whitelist, models.PostColumns.CustomFields)
+	}
+
+	if len(previous) == 0 {
+		if err := checkVersion(post.Version, request.Version); err != nil {
+			log.Debug().Int64("postId", post.ID).Msg("Post was edited concurrently")
+			return dto.UpdatePostResponse{}, err
+		}
+
+		log.Debug().Msg("Post unchanged")
+		return dto.UpdatePostResponse{ID: post.ID, Version: post.Version}, nil
+	}
+
+	post.UpdatedAt = null.TimeFrom(time.Now().UTC())
+	whitelist = append(whitelist, models.PostColumns.Version)
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		// The version is read under a row lock so that two edits based on the
+		// same version cannot both succeed.
+		current, err := models.Posts(
+			qm.Select(models.PostColumns.Version),
+			models.PostWhere.ID.EQ(post.ID),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		if err := checkVersion(current.Version, request.Version); err != nil {
+			return err
+		}
+
+		post.Version = current.Version + 1
+		if _, err := post.Update(ctx, ce, boil.Whitelist(whitelist...)); err != nil {
+			return err
+		}
+
+		if request.Tags != nil {
+			tags, err := findOrCreateTags(ctx, ce, tenantID, *request.Tags)
+			if err != nil {
+				return err
+			}
+
+			if err := post.SetTags(ctx, ce, false, tags...); err != nil {
+				return err
+			}
+		}
+
+		return RecordHistory(ctx, ce, tenantID, post.ID, userID, HistoryActionEdited, previous)
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrEditConflict) {
+			log.Debug().Int64("postId", post.ID).Msg("Post was edited concurrently")
+			return dto.UpdatePostResponse{}, err
+		}
+
+		log.Error().Err(err).Msg("Failed to update post")
+		return dto.UpdatePostResponse{}, err
+	}
+
+	log.Debug().Msg("Post updated successfully")
+
+	return dto.UpdatePostResponse{ID: post.ID, Version: post.Version}, nil
+}
+
+// Delete removes a post together with its answers, comments and history.
+func (s *Service) Delete(ctx context.Context, request dto.DeletePostRequest) (dto.DeletePostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Delete").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.DeletePostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.DeletePostResponse{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.ID)
+	if err != nil {
+		return dto.DeletePostResponse{}, err
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID); err != nil {
+		return dto.DeletePostResponse{}, err
+	}
+
+	if _, err := post.Delete(ctx, s.db); err != nil {
+		log.Error().Err(err).Msg("Failed to delete post")
+		return dto.DeletePostResponse{}, err
+	}
+
+	log.Debug().Msg("Post deleted successfully")
+
+	return dto.DeletePostResponse{ID: post.ID}, nil
+}
+
+// Close closes or reopens a post.
+func (s *Service) Close(ctx context.Context, request dto.ClosePostRequest) (dto.ClosePostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Close").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.ClosePostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.ClosePostResponse{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.ID)
+	if err != nil {
+		return dto.ClosePostResponse{}, err
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID); err != nil {
+		return dto.ClosePostResponse{}, err
+	}
+
+	if post.ClosedAt.Valid == request.Closed {
+		log.Debug().Msg("Post already in requested state")
+		return dto.ClosePostResponse{ID: post.ID}, nil
+	}
+
+	action := HistoryActionReopened
+	post.ClosedAt = null.Time{}
+	if request.Closed {
+		action = HistoryActionClosed
+		post.ClosedAt = null.TimeFrom(time.Now().UTC())
+	}
+
+	data := map[string]any{}
+	if request.Reason != nil {
+		data["reason"] = *request.Reason
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := post.Update(ctx, ce, boil.Whitelist(models.PostColumns.ClosedAt)); err != nil {
+			return err
+		}
+
+		return RecordHistory(ctx, ce, tenantID, post.ID, userID, action, data)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to close post")
+		return dto.ClosePostResponse{}, err
+	}
+
+	log.Debug().Msg("Post closed successfully")
+
+	return dto.ClosePostResponse{ID: post.ID}, nil
+}
+
+// Lock locks or unlocks a post. Locked posts can only be edited by moderators.
+func (s *Service) Lock(ctx context.Context, request dto.LockPostRequest) (dto.LockPostResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "Lock").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.LockPostResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.LockPostResponse{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.ID)
+	if err != nil {
+		return dto.LockPostResponse{}, err
+	}
+
+	if err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID); err != nil {
+		return dto.LockPostResponse{}, err
+	}
+
+	if post.LockedAt.Valid == request.Locked {
+		log.Debug().Msg("Post already in requested state")
+		return dto.LockPostResponse{ID: post.ID}, nil
+	}
+
+	action := HistoryActionUnlocked
+	post.LockedAt = null.Time{}
+	if request.Locked {
+		action = HistoryActionLocked
+		post.LockedAt = null.TimeFrom(time.Now().UTC())
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := post.Update(ctx, ce, boil.Whitelist(models.PostColumns.LockedAt)); err != nil {
+			return err
+		}
+
+		return RecordHistory(ctx, ce, tenantID, post.ID, userID, action, map[string]any{})
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to lock post")
+		return dto.LockPostResponse{}, err
+	}
+
+	log.Debug().Msg("Post locked successfully")
+
+	return dto.LockPostResponse{ID: post.ID}, nil
+}
+
+// React adds or removes a reaction of the user to a visible post or to one of
+// its answers or comments, and returns the reactions of what was reacted to.
+func (s *Service) React(ctx context.Context, request dto.ReactRequest) ([]dto.ReactionDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "React").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return nil, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, targetID := reaction.TargetPost, post.ID
+	switch {
+	case request.AnswerID != nil:
+		exists, err := models.Answers(
+			models.AnswerWhere.ID.EQ(*request.AnswerID),
+			models.AnswerWhere.PostID.EQ(post.ID),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find answer")
+			return nil, err
+		}
+
+		if !exists {
+			log.Debug().Int64("answerId", *request.AnswerID).Msg("Answer not found")
+			return nil, httperrors.ErrAnswerNotFound
+		}
+
+		target, targetID = reaction.TargetAnswer, *request.AnswerID
+	case request.CommentID != nil:
+		exists, err := models.Comments(
+			models.CommentWhere.ID.EQ(*request.CommentID),
+			qm.Where("EXISTS (SELECT 1 FROM answers a WHERE a.id = comments.answer_id AND a.post_id = ?)", post.ID),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find comment")
+			return nil, err
+		}
+
+		if !exists {
+			log.Debug().Int64("commentId", *request.CommentID).Msg("Comment not found")
+			return nil, httperrors.ErrCommentNotFound
+		}
+
+		target, targetID = reaction.TargetComment, *request.CommentID
+	}
+
+	if err := reaction.Set(ctx, s.db, tenantID, userID, target, targetID, request.Emoji, request.Reacted); err != nil {
+		if errors.Is(err, httperrors.ErrReactionNotAllowed) {
+			log.Debug().Str("emoji", request.Emoji).Msg("Reaction not allowed")
+		} else {
+			log.Error().Err(err).Msg("Failed to set reaction")
+		}
+		return nil, err
+	}
+
+	summaries, err := reaction.Summaries(ctx, s.db, target, userID, []int64{targetID})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get reactions")
+		return nil, err
+	}
+
+	log.Debug().Msg("Reaction set successfully")
+
+	return summaries[targetID], nil
+}
+
+// GetAuthor returns the user who wrote the post, including the hidden author
+// of anonymous posts. Requires the AUDIT_ANONYMOUS_POSTS claim.
+func (s *Service) GetAuthor(ctx context.Context, request dto.GetPostRequest) (dto.UserDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAuthor").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UserDTO{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.UserDTO{}, err
+	}
+
+	allowed, err := permission.HasClaim(ctx, s.db, tenantID, userID, permission.ClaimAuditAnonymousPosts)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check audit claim")
+		return dto.UserDTO{}, err
+	}
+
+	if !allowed {
+		log.Debug().Int64("userId", userID).Msg("User may not audit anonymous posts")
+		return dto.UserDTO{}, httperrors.ErrForbidden
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.ID)
+	if err != nil {
+		return dto.UserDTO{}, err
+	}
+
+	authorID, err := AuthorID(ctx, s.db, post)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find post author")
+		return dto.UserDTO{}, err
+	}
+
+	author, err := models.Users(
+		models.UserWhere.ID.EQ(authorID),
+		qm.Load(models.UserRels.Role),
+	).One(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find author")
+		return dto.UserDTO{}, err
+	}
+
+	log.Info().Int64("postId", post.ID).Int64("auditorId", userID).Bool("anonymous", post.Anonymous).Msg("Post author disclosed to auditor")
+
+	return dto.UserDTO{
+		ID:         author.ID,
+		Name:       author.Name,
+		Email:      author.Email,
+		VscAccount: author.VSCAccount,
+		RoleDTO: dto.RoleDTO{
+			ID:   author.R.Role.ID,
+			Name: author.R.Role.Name,
+		},
+	}, nil
+}
+
+// findPost fetches a post of the tenant that is visible to the user.
+func (s *Service) findPost(ctx context.Context, tenantID int64, postID int64, mods ...qm.QueryMod) (*models.Post, error) {
+	log := util.LogFromContext(ctx)
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	mods = append([]qm.QueryMod{
+		models.PostWhere.ID.EQ(postID),
+		models.PostWhere.TenantID.EQ(tenantID),
+		filter.Posts(),
+	}, mods...)
+
+	post, err := models.Posts(mods...).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Int64("postId", postID).Msg("Post not found")
+			return nil, httperrors.ErrPostNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find post")
+		return nil, err
+	}
+
+	return post, nil
+}
+
+// validateFields checks the question template field values of a post of the
+// sub topic and encodes them for storage.
+func (s *Service) validateFields(ctx context.Context, subTopicID int64, values map[string]any) (types.JSON, error) {
+	log := util.LogFromContext(ctx)
+
+	fields, err := template.Fields(ctx, s.db, subTopicID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get question template fields")
+		return nil, err
+	}
+
+	if details := template.Validate(fields, values); len(details) > 0 {
+		log.Debug().Int("invalidFields", len(details)).Msg("Post fields are invalid")
+		return nil, httperrors.NewHTTPValidationError(
+			http.StatusBadRequest,
+			httperrors.HTTPErrorTypeGeneric,
+			"Fields validation failed",
+			details,
+		)
+	}
+
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to encode post fields")
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+// validateCustomFields checks the custom field values of a post of the tenant
+// and encodes them for storage.
+func (s *Service) validateCustomFields(ctx context.Context, tenantID int64, values map[string]any) (types.JSON, error) {
+	log := util.LogFromContext(ctx)
+
+	fields, err := customfield.Fields(ctx, s.db, tenantID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get custom fields")
+		return nil, err
+	}
+
+	details, err := customfield.Validate(ctx, s.db, tenantID, fields, values)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to validate custom fields")
+		return nil, err
+	}
+
+	if len(details) > 0 {
+		log.Debug().Int("invalidFields", len(details)).Msg("Post custom fields are invalid")
+		return nil, httperrors.NewHTTPValidationError(
+			http.StatusBadRequest,
+			httperrors.HTTPErrorTypeGeneric,
+			"Custom fields validation failed",
+			details,
+		)
+	}
+
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to encode post custom fields")
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+// requireModerator checks that the user may moderate every given sub topic.
+func (s *Service) requireModerator(ctx context.Context, tenantID int64, userID int64, subTopicIDs ...int64) error {
+	log := util.LogFromContext(ctx)
+
+	checked := make(map[int64]bool, len(subTopicIDs))
+	for _, subTopicID := range subTopicIDs {
+		if checked[subTopicID] {
+			continue
+		}
+		checked[subTopicID] = true
+
+		allowed, err := permission.CanModerate(ctx, s.db, tenantID, userID, subTopicID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check moderator scope")
+			return err
+		}
+
+		if !allowed {
+			log.Debug().Int64("userId", userID).Int64("subTopicId", subTopicID).Msg("User is not a moderator of the sub topic")
+			return httperrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// requireSubTopic checks that posts can be added to the sub topic: it must
+// exist, be visible to the user and neither it nor any of its ancestors may
+// be archived.
+func (s *Service) requireSubTopic(ctx context.Context, tenantID int64, subTopicID int64) error {
+	log := util.LogFromContext(ctx)
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return err
+	}
+
+	exists, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(subTopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+		filter.SubTopics(),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether sub topic exists")
+		return err
+	}
+
+	if !exists {
+		log.Debug().Int64("subTopicId", subTopicID).Msg("Sub topic not found")
+		return httperrors.ErrSubTopicNotFound
+	}
+
+	archived, err := category.Archived(ctx, s.db, models.CategoryWhere.SubTopicID.EQ(null.Int64From(subTopicID)))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether sub topic is archived")
+		return err
+	}
+
+	if archived {
+		log.Debug().Int64("subTopicId", subTopicID).Msg("Sub topic is archived")
+		return httperrors.ErrSubTopicArchived
+	}
+
+	return nil
+}
+
+// recomputeFirstReply flags the earliest answer of the post as its first reply.
+func recomputeFirstReply(ctx context.Context, exec boil.ContextExecutor, post *models.Post) error {
+	_, err := post.Answers().UpdateAll(ctx, exec, models.M{models.AnswerColumns.IsFirstReply: false})
+	if err != nil {
+		return err
+	}
+
+	first, err := post.Answers(
+		qm.OrderBy(models.AnswerColumns.CreatedAt+" ASC, "+models.AnswerColumns.ID+" ASC"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	first.IsFirstReply = null.BoolFrom(true)
+	_, err = first.Update(ctx, exec, boil.Whitelist(models.AnswerColumns.IsFirstReply))
+	return err
+}
+
+func findOrCreateTags(ctx context.Context, exec boil.ContextExecutor, tenantID int64, names []string) (models.TagSlice, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags, err := models.Tags(
+		models.TagWhere.TenantID.EQ(tenantID),
+		models.TagWhere.Name.IN(names),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		existing[tag.Name] = true
+	}
+
+	for _, name := range names {
+		if existing[name] {
+			continue
+		}
+
+		tag := &models.Tag{
+			Name:     name,
+			TenantID: tenantID,
+		}
+		if err := tag.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+
+		existing[name] = true
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func subTopicToDTO(subTopic *models.SubTopic) dto.SubTopicDTO {
+	return dto.SubTopicDTO{
+		ID:                      subTopic.ID,
+		Name:                    subTopic.Name,
+		Description:             subTopic.Description,
+		Icon:                    subTopic.Icon.Ptr(),
+		Color:                   subTopic.Color.Ptr(),
+		Archived:                subTopic.Archived,
+		FirstReplyTargetMinutes: subTopic.FirstReplyTargetMinutes.Ptr(),
+		Topic: dto.TopicDTO{
+			ID:          subTopic.R.Topic.ID,
+			Name:        subTopic.R.Topic.Name,
+			Description: subTopic.R.Topic.Description,
+			Icon:        subTopic.R.Topic.Icon.Ptr(),
+			Color:       subTopic.R.Topic.Color.Ptr(),
+			Archived:    subTopic.R.Topic.Archived,
+		},
+	}
+}
+
+// postsToDTO converts posts and adds their reactions with a single query.
+func (s *Service) postsToDTO(ctx context.Context, posts models.PostSlice) ([]dto.PostDTO, error) {
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+
+	reactions, err := reaction.Summaries(ctx, s.db, reaction.TargetPost, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	polls, err := poll.ForPosts(ctx, s.db, userID, ids, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	postDTOs := make([]dto.PostDTO, len(posts))
+	for i, post := range posts {
+		postDTOs[i] = postToDTO(post)
+		postDTOs[i].Reactions = reactions[post.ID]
+		postDTOs[i].Poll = polls[post.ID]
+	}
+
+	return postDTOs, nil
+}
+
+func postToDTO(post *models.Post) dto.PostDTO {
+	tags := make([]string, len(post.R.Tags))
+	for i, tag := range post.R.Tags {
+		tags[i] = tag.Name
+	}
+
+	// Fields and custom fields are JSONB objects, they always decode.
+	fields := map[string]any{}
+	if len(post.Fields) > 0 {
+		_ = json.Unmarshal(post.Fields, &fields)
+	}
+	customFields := map[string]any{}
+	if len(post.CustomFields) > 0 {
+		_ = json.Unmarshal(post.CustomFields, &customFields)
+	}
+
+	return dto.PostDTO{
+		ID:             post.ID,
+		Title:          post.Title,
+		Body:           post.Body,
+		CreatorID:      post.CreatorID.Ptr(),
+		Anonymous:      post.Anonymous,
+		SubTopic:       subTopicToDTO(post.R.Subtopic),
+		Tags:           tags,
+		Fields:         fields,
+		CustomFields:   customFields,
+		Wiki:           post.Wiki,
+		Version:        post.Version,
+		AssigneeUserID: post.AssigneeUserID.Ptr(),
+		AssigneeRoleID: post.AssigneeRoleID.Ptr(),
+		AssignedAt:     post.AssignedAt.Ptr(),
+		MergedIntoID:   post.MergedIntoID.Ptr(),
+		ClosedAt:       post.ClosedAt.Ptr(),
+		LockedAt:       post.LockedAt.Ptr(),
+		CreatedAt:      post.CreatedAt,
+	}
+}
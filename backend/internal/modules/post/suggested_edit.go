@@ -0,0 +1,561 @@
+package post
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/modules/permission"
+	apitypes "cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	SuggestedEditStatusPending  = "PENDING"
+	SuggestedEditStatusApproved = "APPROVED"
+	SuggestedEditStatusRejected = "REJECTED"
+)
+
+// SuggestEdit proposes an edit of content the user may not edit directly.
+// The suggestion keeps the version it is based on and waits for the author or
+// a moderator to review it.
+func (s *Service) SuggestEdit(ctx context.Context, request dto.SuggestEditRequest) (dto.SuggestedEditDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SuggestEdit").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	if post.MergedIntoID.Valid {
+		log.Debug().Int64("postId", post.ID).Msg("Post has been merged")
+		return dto.SuggestedEditDTO{}, httperrors.ErrPostAlreadyMerged
+	}
+
+	if post.LockedAt.Valid {
+		log.Debug().Int64("postId", post.ID).Msg("Post is locked")
+		return dto.SuggestedEditDTO{}, httperrors.ErrPostLocked
+	}
+
+	target, err := s.editTarget(ctx, post, request.AnswerID)
+	if err != nil {
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	if err := suggestionContent(target, request.Title, request.Body); err != nil {
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	err = s.requireEditor(ctx, tenantID, userID, target.authorID, post.SubtopicID, false, target.wiki)
+	if err == nil {
+		log.Debug().Int64("userId", userID).Msg("User can edit the content directly")
+		return dto.SuggestedEditDTO{}, httperrors.ErrSuggestedEditNotNeeded
+	}
+	if !errors.Is(err, httperrors.ErrForbidden) {
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	pending, err := models.SuggestedEdits(
+		models.SuggestedEditWhere.PostID.EQ(post.ID),
+		models.SuggestedEditWhere.AnswerID.EQ(null.Int64FromPtr(request.AnswerID)),
+		models.SuggestedEditWhere.SuggesterID.EQ(userID),
+		models.SuggestedEditWhere.Status.EQ(SuggestedEditStatusPending),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check pending suggested edits")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	if pending {
+		log.Debug().Int64("postId", post.ID).Msg("User already suggested an edit of the content")
+		return dto.SuggestedEditDTO{}, httperrors.ErrSuggestedEditPending
+	}
+
+	edit := models.SuggestedEdit{
+		PostID:      post.ID,
+		AnswerID:    null.Int64FromPtr(request.AnswerID),
+		SuggesterID: userID,
+		Title:       null.StringFromPtr(request.Title),
+		Body:        request.Body,
+		Comment:     null.StringFromPtr(request.Comment),
+		BaseVersion: target.version,
+		Status:      SuggestedEditStatusPending,
+		TenantID:    tenantID,
+	}
+	if err := edit.Insert(ctx, s.db, boil.Infer()); err != nil {
+		log.Error().Err(err).Msg("Failed to insert suggested edit")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	log.Debug().Int64("suggestedEditId", edit.ID).Msg("Edit suggested successfully")
+
+	return suggestedEditToDTO(&edit), nil
+}
+
+// GetSuggestedEdits lists the pending suggestions the user may review, that
+// is suggestions for their own content and for posts they moderate. Listing
+// the suggestions of a post the user cannot see fails as if it did not exist.
+func (s *Service) GetSuggestedEdits(ctx context.Context, request dto.GetSuggestedEditsRequest) ([]dto.SuggestedEditDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetSuggestedEdits").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return nil, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{
+		models.SuggestedEditWhere.TenantID.EQ(tenantID),
+		models.SuggestedEditWhere.Status.EQ(SuggestedEditStatusPending),
+		qm.Load(models.SuggestedEditRels.Post),
+		qm.Load(models.SuggestedEditRels.Answer),
+		qm.OrderBy(models.SuggestedEditColumns.CreatedAt + " ASC, " + models.SuggestedEditColumns.ID + " ASC"),
+	}
+	if request.PostID != nil {
+		if _, err := s.findPost(ctx, tenantID, *request.PostID); err != nil {
+			return nil, err
+		}
+
+		mods = append(mods, models.SuggestedEditWhere.PostID.EQ(*request.PostID))
+	}
+
+	edits, err := models.SuggestedEdits(mods...).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get suggested edits")
+		return nil, err
+	}
+
+	moderated := make(map[int64]bool)
+	editDTOs := make([]dto.SuggestedEditDTO, 0, len(edits))
+	for _, edit := range edits {
+		post := edit.R.Post
+		if !filter.SubTopicVisible(post.SubtopicID) {
+			continue
+		}
+
+		allowed, ok := moderated[post.SubtopicID]
+		if !ok {
+			allowed, err = permission.CanModerate(ctx, s.db, tenantID, userID, post.SubtopicID)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to check moderator scope")
+				return nil, err
+			}
+			moderated[post.SubtopicID] = allowed
+		}
+
+		if !allowed {
+			authorID, err := s.suggestedEditAuthorID(ctx, edit)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to find author of suggested edit target")
+				return nil, err
+			}
+
+			if authorID != userID {
+				continue
+			}
+		}
+
+		editDTOs = append(editDTOs, suggestedEditToDTO(edit))
+	}
+
+	log.Debug().Int("resultCount", len(editDTOs)).Msg("Suggested edits fetched successfully")
+
+	return editDTOs, nil
+}
+
+// GetSuggestedEditDiff compares a suggestion with the current version of its
+// post or answer. Reviewers and the suggester may look at it.
+func (s *Service) GetSuggestedEditDiff(ctx context.Context, request dto.GetSuggestedEditDiffRequest) (dto.SuggestedEditDiffDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetSuggestedEditDiff").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.SuggestedEditDiffDTO{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.SuggestedEditDiffDTO{}, err
+	}
+
+	edit, post, target, err := s.findSuggestedEdit(ctx, tenantID, request.ID)
+	if err != nil {
+		return dto.SuggestedEditDiffDTO{}, err
+	}
+
+	if edit.SuggesterID != userID {
+		if err := s.requireReviewer(ctx, tenantID, userID, post, target); err != nil {
+			return dto.SuggestedEditDiffDTO{}, err
+		}
+	}
+
+	diff := dto.SuggestedEditDiffDTO{
+		ID:             edit.ID,
+		BaseVersion:    edit.BaseVersion,
+		CurrentVersion: target.version,
+		Outdated:       edit.BaseVersion != target.version,
+		Body:           diffLines(target.body, edit.Body),
+	}
+	if edit.Title.Valid {
+		diff.Title = diffLines(target.title, edit.Title.String)
+	}
+
+	log.Debug().Msg("Suggested edit diff fetched successfully")
+
+	return diff, nil
+}
+
+// ReviewSuggestedEdit approves or rejects a pending suggestion. Approval
+// applies the suggestion, improved by the reviewer when title or body are
+// given, as a new version credited to the suggester in the history of the
+// post. Unimproved suggestions based on an outdated version are refused.
+func (s *Service) ReviewSuggestedEdit(ctx context.Context, request dto.ReviewSuggestedEditRequest) (dto.SuggestedEditDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ReviewSuggestedEdit").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	edit, post, target, err := s.findSuggestedEdit(ctx, tenantID, request.ID)
+	if err != nil {
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	if edit.Status != SuggestedEditStatusPending {
+		log.Debug().Int64("suggestedEditId", edit.ID).Msg("Suggested edit has already been reviewed")
+		return dto.SuggestedEditDTO{}, httperrors.ErrSuggestedEditReviewed
+	}
+
+	if err := s.requireReviewer(ctx, tenantID, userID, post, target); err != nil {
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	title, body := edit.Title.Ptr(), edit.Body
+	improved := request.Title != nil || request.Body != nil
+	if request.Approved {
+		if post.MergedIntoID.Valid {
+			log.Debug().Int64("postId", post.ID).Msg("Post has been merged")
+			return dto.SuggestedEditDTO{}, httperrors.ErrPostAlreadyMerged
+		}
+
+		if request.Title != nil {
+			title = request.Title
+		}
+		if request.Body != nil {
+			body = *request.Body
+		}
+
+		if err := suggestionContent(target, title, body); err != nil {
+			return dto.SuggestedEditDTO{}, err
+		}
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		// Lock the suggestion so it is applied at most once.
+		current, err := models.SuggestedEdits(
+			models.SuggestedEditWhere.ID.EQ(edit.ID),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		if current.Status != SuggestedEditStatusPending {
+			return httperrors.ErrSuggestedEditReviewed
+		}
+
+		if request.Approved {
+			if err := s.applySuggestedEdit(ctx, ce, tenantID, userID, edit, post, target, title, body, improved); err != nil {
+				return err
+			}
+			edit.Status = SuggestedEditStatusApproved
+		} else {
+			edit.Status = SuggestedEditStatusRejected
+		}
+
+		edit.ReviewerID = null.Int64From(userID)
+		edit.ReviewComment = null.StringFromPtr(request.Comment)
+		edit.ReviewedAt = null.TimeFrom(time.Now().UTC())
+		_, err = edit.Update(ctx, ce, boil.Whitelist(
+			models.SuggestedEditColumns.Status,
+			models.SuggestedEditColumns.ReviewerID,
+			models.SuggestedEditColumns.ReviewComment,
+			models.SuggestedEditColumns.ReviewedAt,
+		))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrEditConflict) || errors.Is(err, httperrors.ErrSuggestedEditReviewed) {
+			log.Debug().Err(err).Int64("suggestedEditId", edit.ID).Msg("Suggested edit cannot be applied")
+			return dto.SuggestedEditDTO{}, err
+		}
+
+		log.Error().Err(err).Msg("Failed to review suggested edit")
+		return dto.SuggestedEditDTO{}, err
+	}
+
+	log.Debug().Str("status", edit.Status).Msg("Suggested edit reviewed successfully")
+
+	return suggestedEditToDTO(edit), nil
+}
+
+// applySuggestedEdit writes an approved suggestion to its post or answer
+// using exec. The new version is recorded in the history with the suggester
+// as actor.
+func (s *Service) applySuggestedEdit(ctx context.Context, exec boil.ContextExecutor, tenantID int64, reviewerID int64, edit *models.SuggestedEdit, post *models.Post, target editTarget, title *string, body string, improved bool) error {
+	data := map[string]any{
+		"suggestedEditId": edit.ID,
+		"reviewerId":      reviewerID,
+	}
+
+	if target.answer != nil {
+		answer, err := models.Answers(
+			models.AnswerWhere.ID.EQ(target.answer.ID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		if answer.Version != edit.BaseVersion && !improved {
+			return httperrors.ErrEditConflict
+		}
+
+		data["answerId"] = answer.ID
+		data["body"] = answer.Body
+
+		answer.Body = body
+		answer.Version++
+		answer.UpdatedAt = null.TimeFrom(time.Now().UTC())
+		_, err = answer.Update(ctx, exec, boil.Whitelist(
+			models.AnswerColumns.Body,
+			models.AnswerColumns.Version,
+			models.AnswerColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		return RecordHistory(ctx, exec, tenantID, post.ID, edit.SuggesterID, HistoryActionAnswerEdited, data)
+	}
+
+	current, err := models.Posts(
+		models.PostWhere.ID.EQ(post.ID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	if current.Version != edit.BaseVersion && !improved {
+		return httperrors.ErrEditConflict
+	}
+
+	whitelist := []string{models.PostColumns.Body, models.PostColumns.Version, models.PostColumns.UpdatedAt}
+	data["body"] = current.Body
+	current.Body = body
+	if title != nil && *title != current.Title {
+		data["title"] = current.Title
+		current.Title = *title
+		whitelist = append(whitelist, models.PostColumns.Title)
+	}
+
+	current.Version++
+	current.UpdatedAt = null.TimeFrom(time.Now().UTC())
+	if _, err := current.Update(ctx, exec, boil.Whitelist(whitelist...)); err != nil {
+		return err
+	}
+
+	return RecordHistory(ctx, exec, tenantID, post.ID, edit.SuggesterID, HistoryActionEdited, data)
+}
+
+// editTarget is the post or answer an edit applies to.
+type editTarget struct {
+	answer   *models.Answer
+	authorID int64
+	wiki     bool
+	version  int
+	title    string
+	body     string
+}
+
+func (s *Service) editTarget(ctx context.Context, post *models.Post, answerID *int64) (editTarget, error) {
+	if answerID != nil {
+		answer, err := s.findAnswer(ctx, post.ID, *answerID)
+		if err != nil {
+			return editTarget{}, err
+		}
+
+		return editTarget{
+			answer:   answer,
+			authorID: answer.CreatorID,
+			wiki:     answer.Wiki,
+			version:  answer.Version,
+			body:     answer.Body,
+		}, nil
+	}
+
+	authorID, err := AuthorID(ctx, s.db, post)
+	if err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Msg("Failed to find post author")
+		return editTarget{}, err
+	}
+
+	return editTarget{
+		authorID: authorID,
+		wiki:     post.Wiki,
+		version:  post.Version,
+		title:    post.Title,
+		body:     post.Body,
+	}, nil
+}
+
+func (s *Service) findSuggestedEdit(ctx context.Context, tenantID int64, id int64) (*models.SuggestedEdit, *models.Post, editTarget, error) {
+	log := util.LogFromContext(ctx)
+
+	edit, err := models.SuggestedEdits(
+		models.SuggestedEditWhere.ID.EQ(id),
+		models.SuggestedEditWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Int64("suggestedEditId", id).Msg("Suggested edit not found")
+			return nil, nil, editTarget{}, httperrors.ErrSuggestedEditNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find suggested edit")
+		return nil, nil, editTarget{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, edit.PostID)
+	if err != nil {
+		if errors.Is(err, httperrors.ErrPostNotFound) {
+			return nil, nil, editTarget{}, httperrors.ErrSuggestedEditNotFound
+		}
+		return nil, nil, editTarget{}, err
+	}
+
+	target, err := s.editTarget(ctx, post, edit.AnswerID.Ptr())
+	if err != nil {
+		return nil, nil, editTarget{}, err
+	}
+
+	return edit, post, target, nil
+}
+
+// suggestedEditAuthorID returns the author of the post or answer a loaded
+// suggestion applies to.
+func (s *Service) suggestedEditAuthorID(ctx context.Context, edit *models.SuggestedEdit) (int64, error) {
+	if edit.R.Answer != nil {
+		return edit.R.Answer.CreatorID, nil
+	}
+
+	return AuthorID(ctx, s.db, edit.R.Post)
+}
+
+// requireReviewer checks that the user wrote the target of a suggestion or
+// moderates its post. Locked posts are reviewed by moderators only.
+func (s *Service) requireReviewer(ctx context.Context, tenantID int64, userID int64, post *models.Post, target editTarget) error {
+	if target.authorID == userID && !post.LockedAt.Valid {
+		return nil
+	}
+
+	err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID)
+	if errors.Is(err, httperrors.ErrForbidden) && target.authorID == userID {
+		return httperrors.ErrPostLocked
+	}
+
+	return err
+}
+
+// suggestionContent checks that a suggestion changes its target. Titles can
+// only be suggested for posts.
+func suggestionContent(target editTarget, title *string, body string) error {
+	var details []apitypes.HttpValidationErrorDetail
+	if title != nil && target.answer != nil {
+		details = append(details, apitypes.HttpValidationErrorDetail{
+			Key:   "title",
+			In:    "body",
+			Error: "can only be suggested for posts",
+		})
+	} else if body == target.body && (title == nil || *title == target.title) {
+		details = append(details, apitypes.HttpValidationErrorDetail{
+			Key:   "body",
+			In:    "body",
+			Error: "must change the content",
+		})
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	return httperrors.NewHTTPValidationError(
+		http.StatusBadRequest,
+		httperrors.HTTPErrorTypeGeneric,
+		"Suggested edit validation failed",
+		details,
+	)
+}
+
+func suggestedEditToDTO(edit *models.SuggestedEdit) dto.SuggestedEditDTO {
+	return dto.SuggestedEditDTO{
+		ID:            edit.ID,
+		PostID:        edit.PostID,
+		AnswerID:      edit.AnswerID.Ptr(),
+		SuggesterID:   edit.SuggesterID,
+		Title:         edit.Title.Ptr(),
+		Body:          edit.Body,
+		Comment:       edit.Comment.Ptr(),
+		BaseVersion:   edit.BaseVersion,
+		Status:        edit.Status,
+		ReviewerID:    edit.ReviewerID.Ptr(),
+		ReviewComment: edit.ReviewComment.Ptr(),
+		ReviewedAt:    edit.ReviewedAt.Ptr(),
+		CreatedAt:     edit.CreatedAt,
+	}
+}
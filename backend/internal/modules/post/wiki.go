@@ -0,0 +1,371 @@
+package post
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/expertise"
+	"cuhara.qua.go/internal/modules/permission"
+	"cuhara.qua.go/internal/modules/reaction"
+	apitypes "cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// SetWiki turns a post or one of its answers into a community wiki or back.
+// Authors may turn their own content into a wiki, everything else is left to
+// moderators.
+func (s *Service) SetWiki(ctx context.Context, request dto.SetWikiRequest) (dto.SetWikiResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetWiki").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.SetWikiResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.SetWikiResponse{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return dto.SetWikiResponse{}, err
+	}
+
+	var answer *models.Answer
+	authorID, wiki := int64(0), post.Wiki
+	if request.AnswerID != nil {
+		answer, err = s.findAnswer(ctx, post.ID, *request.AnswerID)
+		if err != nil {
+			return dto.SetWikiResponse{}, err
+		}
+		authorID, wiki = answer.CreatorID, answer.Wiki
+	} else {
+		authorID, err = AuthorID(ctx, s.db, post)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find post author")
+			return dto.SetWikiResponse{}, err
+		}
+	}
+
+	id := post.ID
+	if answer != nil {
+		id = answer.ID
+	}
+
+	if wiki == request.Wiki {
+		log.Debug().Msg("Community wiki already in requested state")
+		return dto.SetWikiResponse{ID: id, Wiki: wiki}, nil
+	}
+
+	if !request.Wiki || authorID != userID {
+		if err := s.requireModerator(ctx, tenantID, userID, post.SubtopicID); err != nil {
+			return dto.SetWikiResponse{}, err
+		}
+	}
+
+	action := HistoryActionWikiDisabled
+	if request.Wiki {
+		action = HistoryActionWikiEnabled
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		data := map[string]any{}
+		if answer != nil {
+			answer.Wiki = request.Wiki
+			if _, err := answer.Update(ctx, ce, boil.Whitelist(models.AnswerColumns.Wiki)); err != nil {
+				return err
+			}
+			data["answerId"] = answer.ID
+		} else {
+			post.Wiki = request.Wiki
+			if _, err := post.Update(ctx, ce, boil.Whitelist(models.PostColumns.Wiki)); err != nil {
+				return err
+			}
+		}
+
+		return RecordHistory(ctx, ce, tenantID, post.ID, userID, action, data)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to set community wiki")
+		return dto.SetWikiResponse{}, err
+	}
+
+	log.Debug().Msg("Community wiki set successfully")
+
+	return dto.SetWikiResponse{ID: id, Wiki: request.Wiki}, nil
+}
+
+func (s *Service) GetAnswers(ctx context.Context, request dto.GetAnswersRequest) ([]dto.AnswerDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAnswers").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return nil, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := models.Answers(
+		models.AnswerWhere.PostID.EQ(post.ID),
+		qm.OrderBy(models.AnswerColumns.CreatedAt+" ASC, "+models.AnswerColumns.ID+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get answers")
+		return nil, err
+	}
+
+	ids := make([]int64, len(answers))
+	for i, answer := range answers {
+		ids[i] = answer.ID
+	}
+
+	reactions, err := reaction.Summaries(ctx, s.db, reaction.TargetAnswer, userID, ids)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get answer reactions")
+		return nil, err
+	}
+
+	answerDTOs := make([]dto.AnswerDTO, len(answers))
+	for i, answer := range answers {
+		answerDTOs[i] = dto.AnswerDTO{
+			ID:        answer.ID,
+			PostID:    answer.PostID,
+			Body:      answer.Body,
+			CreatorID: answer.CreatorID,
+			Accepted:  answer.IsAccepted.Bool,
+			Wiki:      answer.Wiki,
+			Version:   answer.Version,
+			Reactions: reactions[answer.ID],
+			CreatedAt: answer.CreatedAt,
+			UpdatedAt: answer.UpdatedAt.Ptr(),
+		}
+	}
+
+	log.Debug().Int("resultCount", len(answers)).Msg("Answers fetched successfully")
+
+	return answerDTOs, nil
+}
+
+// UpdateAnswer edits the body of an answer and records the previous body and
+// the editor in the history of the post.
+func (s *Service) UpdateAnswer(ctx context.Context, request dto.UpdateAnswerRequest) (dto.UpdateAnswerResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "UpdateAnswer").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	post, err := s.findPost(ctx, tenantID, request.PostID)
+	if err != nil {
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	answer, err := s.findAnswer(ctx, post.ID, request.ID)
+	if err != nil {
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	if err := s.requireEditor(ctx, tenantID, userID, answer.CreatorID, post.SubtopicID, post.LockedAt.Valid, answer.Wiki); err != nil {
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	if err := requireVersion(answer.Wiki, request.Version); err != nil {
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		current, err := models.Answers(
+			models.AnswerWhere.ID.EQ(answer.ID),
+			qm.For("UPDATE"),
+		).One(ctx, ce)
+		if err != nil {
+			return err
+		}
+
+		if err := checkVersion(current.Version, request.Version); err != nil {
+			return err
+		}
+
+		if current.Body == request.Body {
+			answer = current
+			return nil
+		}
+
+		previous := current.Body
+		answer = current
+		answer.Body = request.Body
+		answer.Version++
+		answer.UpdatedAt = null.TimeFrom(time.Now().UTC())
+		_, err = answer.Update(ctx, ce, boil.Whitelist(
+			models.AnswerColumns.Body,
+			models.AnswerColumns.Version,
+			models.AnswerColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		return RecordHistory(ctx, ce, tenantID, post.ID, userID, HistoryActionAnswerEdited, map[string]any{
+			"answerId": answer.ID,
+			"body":     previous,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, httperrors.ErrEditConflict) {
+			log.Debug().Int64("answerId", answer.ID).Msg("Answer was edited concurrently")
+			return dto.UpdateAnswerResponse{}, err
+		}
+
+		log.Error().Err(err).Msg("Failed to update answer")
+		return dto.UpdateAnswerResponse{}, err
+	}
+
+	log.Debug().Msg("Answer updated successfully")
+
+	return dto.UpdateAnswerResponse{ID: answer.ID, Version: answer.Version}, nil
+}
+
+func (s *Service) findAnswer(ctx context.Context, postID int64, answerID int64) (*models.Answer, error) {
+	log := util.LogFromContext(ctx)
+
+	answer, err := models.Answers(
+		models.AnswerWhere.ID.EQ(answerID),
+		models.AnswerWhere.PostID.EQ(postID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Int64("answerId", answerID).Msg("Answer not found")
+			return nil, httperrors.ErrAnswerNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find answer")
+		return nil, err
+	}
+
+	return answer, nil
+}
+
+// requireEditor checks that the user may edit content written by authorID in
+// the sub topic. Authors edit their own content, users above the reputation
+// threshold of the tenant edit community wikis and moderators edit everything.
+// Locked posts are left to moderators.
+func (s *Service) requireEditor(ctx context.Context, tenantID int64, userID int64, authorID int64, subTopicID int64, locked bool, wiki bool) error {
+	log := util.LogFromContext(ctx)
+
+	if !locked {
+		if authorID == userID {
+			return nil
+		}
+
+		if wiki {
+			allowed, err := s.canEditWiki(ctx, tenantID, userID)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to check community wiki permission")
+				return err
+			}
+
+			if allowed {
+				return nil
+			}
+		}
+	}
+
+	allowed, err := permission.CanModerate(ctx, s.db, tenantID, userID, subTopicID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check moderator scope")
+		return err
+	}
+
+	if allowed {
+		return nil
+	}
+
+	if locked && authorID == userID {
+		log.Debug().Msg("Post is locked")
+		return httperrors.ErrPostLocked
+	}
+
+	log.Debug().Int64("userId", userID).Msg("User may not edit the content")
+	return httperrors.ErrForbidden
+}
+
+// canEditWiki reports whether the user holds the EDIT_COMMUNITY_WIKI claim or
+// has earned the expertise the tenant requires for editing wikis.
+func (s *Service) canEditWiki(ctx context.Context, tenantID int64, userID int64) (bool, error) {
+	allowed, err := permission.HasClaim(ctx, s.db, tenantID, userID, permission.ClaimEditCommunityWiki)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	tenant, err := models.FindTenant(ctx, s.db, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	reputation, err := expertise.Reputation(ctx, s.db, tenantID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return reputation >= int64(tenant.WikiEditMinReputation), nil
+}
+
+// requireVersion makes edits of community wikis state the version they are
+// based on, so concurrent editors cannot overwrite each other.
+func requireVersion(wiki bool, version *int) error {
+	if !wiki || version != nil {
+		return nil
+	}
+
+	return httperrors.NewHTTPValidationError(
+		http.StatusBadRequest,
+		httperrors.HTTPErrorTypeGeneric,
+		"Version validation failed",
+		[]apitypes.HttpValidationErrorDetail{{
+			Key:   "version",
+			In:    "body",
+			Error: "is required when editing a community wiki",
+		}},
+	)
+}
+
+// checkVersion fails when the edit is based on another version than the
+// current one. Callers read current under a row lock.
+func checkVersion(current int, version *int) error {
+	if version != nil && *version != current {
+		return httperrors.ErrEditConflict
+	}
+
+	return nil
+}
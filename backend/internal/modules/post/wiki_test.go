@@ -0,0 +1,36 @@
+package post
+
+import (
+	"errors"
+	"testing"
+
+	"cuhara.qua.go/internal/api/httperrors"
+)
+
+func TestCheckVersion(t *testing.T) {
+	stale, current := 2, 3
+
+	if err := checkVersion(3, &current); err != nil {
+		t.Errorf("edit based on the current version failed: %v", err)
+	}
+	if err := checkVersion(3, nil); err != nil {
+		t.Errorf("edit without version failed: %v", err)
+	}
+	if err := checkVersion(3, &stale); !errors.Is(err, httperrors.ErrEditConflict) {
+		t.Errorf("edit based on a stale version returned %v, want edit conflict", err)
+	}
+}
+
+func TestRequireVersionForWikis(t *testing.T) {
+	version := 1
+
+	if err := requireVersion(false, nil); err != nil {
+		t.Errorf("edit of regular content without version failed: %v", err)
+	}
+	if err := requireVersion(true, &version); err != nil {
+		t.Errorf("wiki edit with version failed: %v", err)
+	}
+	if err := requireVersion(true, nil); err == nil {
+		t.Error("wiki edit without version succeeded")
+	}
+}
@@ -0,0 +1,106 @@
+package reaction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/lib/pq"
+)
+
+// Target is the column of the reactions table pointing to what was reacted to.
+type Target string
+
+const (
+	TargetPost    Target = "post_id"
+	TargetAnswer  Target = "answer_id"
+	TargetComment Target = "comment_id"
+)
+
+// summarySQL counts the reactions per target and emoji in one query, in the
+// order the emoji were first used.
+const summarySQL = `
+	SELECT %[1]s AS target_id, emoji, COUNT(*) AS count, BOOL_OR(user_id = $2) AS reacted
+	FROM reactions
+	WHERE %[1]s = ANY($1)
+	GROUP BY %[1]s, emoji
+	ORDER BY %[1]s, MIN(created_at), emoji`
+
+type summaryRow struct {
+	TargetID int64  `boil:"target_id"`
+	Emoji    string `boil:"emoji"`
+	Count    int    `boil:"count"`
+	Reacted  bool   `boil:"reacted"`
+}
+
+// Summaries returns the reaction counts of every given target keyed by target
+// id, and whether the user is among the reacting users. Targets without
+// reactions get an empty list.
+func Summaries(ctx context.Context, exec boil.ContextExecutor, target Target, userID int64, ids []int64) (map[int64][]dto.ReactionDTO, error) {
+	summaries := make(map[int64][]dto.ReactionDTO, len(ids))
+	for _, id := range ids {
+		summaries[id] = []dto.ReactionDTO{}
+	}
+	if len(ids) == 0 {
+		return summaries, nil
+	}
+
+	var rows []summaryRow
+	err := queries.Raw(fmt.Sprintf(summarySQL, target), pq.Array(ids), userID).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		summaries[row.TargetID] = append(summaries[row.TargetID], dto.ReactionDTO{
+			Emoji:   row.Emoji,
+			Count:   row.Count,
+			Reacted: row.Reacted,
+		})
+	}
+
+	return summaries, nil
+}
+
+// Set adds or removes the reaction of the user to the target. Only emoji of
+// the reaction set of the tenant can be added, removing is always allowed.
+func Set(ctx context.Context, exec boil.ContextExecutor, tenantID int64, userID int64, target Target, id int64, emoji string, reacted bool) error {
+	if !reacted {
+		_, err := queries.Raw(
+			fmt.Sprintf(`DELETE FROM reactions WHERE %s = $1 AND user_id = $2 AND emoji = $3`, target),
+			id, userID, emoji,
+		).ExecContext(ctx, exec)
+		return err
+	}
+
+	tenant, err := models.FindTenant(ctx, exec, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(tenant.ReactionEmojis, emoji) {
+		return httperrors.ErrReactionNotAllowed
+	}
+
+	// Reacting twice keeps the first reaction.
+	_, err = queries.Raw(
+		fmt.Sprintf(`INSERT INTO reactions (user_id, %s, emoji, tenant_id) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`, target),
+		userID, id, emoji, tenantID,
+	).ExecContext(ctx, exec)
+	return err
+}
+
+// RemoveDisallowed deletes the reactions of the tenant using emoji that are no
+// longer part of its reaction set.
+func RemoveDisallowed(ctx context.Context, exec boil.ContextExecutor, tenantID int64, emojis []string) error {
+	_, err := models.Reactions(
+		models.ReactionWhere.TenantID.EQ(tenantID),
+		models.ReactionWhere.Emoji.NIN(emojis),
+	).DeleteAll(ctx, exec)
+	return err
+}
@@ -0,0 +1,184 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"cuhara.qua.go/internal/config"
+	"cuhara.qua.go/internal/models"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+const (
+	upsertUserSQL = `
+	INSERT INTO user_token_revocations (user_id, revoked_at) VALUES ($1, $2)
+	ON CONFLICT (user_id) DO UPDATE SET revoked_at = EXCLUDED.revoked_at`
+
+	revokeRefreshTokensSQL = `
+	UPDATE refresh_tokens SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL`
+)
+
+// Store answers whether an access token was revoked. Revocations are kept in
+// Postgres so every instance sees them, and mirrored in memory so the check
+// done on every request needs no query. The mirror is reloaded once it is
+// older than the sync interval of the config, which bounds how long a
+// revocation made by another instance goes unnoticed.
+type Store struct {
+	db     *sql.DB
+	config config.Server
+	// syncMu serializes reloads with revocations, so a reload cannot replace
+	// the mirror with a snapshot that misses a revocation made meanwhile.
+	syncMu   sync.Mutex
+	mu       sync.RWMutex
+	tokens   map[string]time.Time
+	users    map[int64]time.Time
+	syncedAt time.Time
+}
+
+func NewStore(config config.Server, db *sql.DB) *Store {
+	return &Store{
+		db:     db,
+		config: config,
+		tokens: make(map[string]time.Time),
+		users:  make(map[int64]time.Time),
+	}
+}
+
+// Revoked reports whether the token with the jti, issued to the user at
+// issuedAt, was revoked on its own or together with all tokens of the user.
+func (s *Store) Revoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	if err := s.sync(ctx); err != nil {
+		return false, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if jti != "" {
+		if _, ok := s.tokens[jti]; ok {
+			return true, nil
+		}
+	}
+
+	revokedAt, ok := s.users[userID]
+	return ok && issuedAt.Before(revokedAt), nil
+}
+
+// RevokeToken revokes a single access token until it expires.
+func (s *Store) RevokeToken(ctx context.Context, jti string, userID int64, expiresAt time.Time) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	token := models.RevokedToken{
+		Jti:       jti,
+		UserID:    userID,
+		ExpiresAt: expiresAt.UTC(),
+	}
+	if err := token.Upsert(ctx, s.db, false, []string{models.RevokedTokenColumns.Jti}, boil.None(), boil.Infer()); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.tokens[jti] = token.ExpiresAt
+	s.mu.Unlock()
+
+	return nil
+}
+
+// RevokeUser revokes every access token issued to the user so far, together
+// with the refresh tokens of the user. Tokens issued after it stay valid, so
+// a user can log in again right away; it is kept to the microsecond, like
+// the issue time of tokens. The revocation is written through exec, which
+// may be a transaction; the returned func mirrors it in memory and must only
+// be run once exec committed.
+func (s *Store) RevokeUser(ctx context.Context, exec boil.ContextExecutor, userID int64) (func(), error) {
+	now := time.Now().UTC()
+	revokedAt := now.Truncate(time.Microsecond)
+
+	if _, err := queries.Raw(upsertUserSQL, userID, revokedAt).ExecContext(ctx, exec); err != nil {
+		return nil, err
+	}
+
+	if _, err := queries.Raw(revokeRefreshTokensSQL, userID, now).ExecContext(ctx, exec); err != nil {
+		return nil, err
+	}
+
+	return func() { s.mirrorUser(userID, revokedAt) }, nil
+}
+
+// mirrorUser adds a committed user revocation to the mirror.
+func (s *Store) mirrorUser(userID int64, revokedAt time.Time) {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if revokedAt.After(s.users[userID]) {
+		s.users[userID] = revokedAt
+	}
+}
+
+// sync reloads the mirror when it is stale and prunes revocations of tokens
+// that expired in the meantime.
+func (s *Store) sync(ctx context.Context) error {
+	s.mu.RLock()
+	fresh := time.Since(s.syncedAt) < s.config.Auth.RevocationSyncInterval
+	s.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	// Another request may have synced while this one waited.
+	s.mu.RLock()
+	fresh = time.Since(s.syncedAt) < s.config.Auth.RevocationSyncInterval
+	s.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	// Access tokens issued before this cutoff have expired, so user wide
+	// revocations older than that no longer matter.
+	cutoff := now.Add(-s.config.Auth.JWTTTLMinutes)
+
+	if _, err := models.RevokedTokens(models.RevokedTokenWhere.ExpiresAt.LT(now)).DeleteAll(ctx, s.db); err != nil {
+		return err
+	}
+	if _, err := models.UserTokenRevocations(models.UserTokenRevocationWhere.RevokedAt.LT(cutoff)).DeleteAll(ctx, s.db); err != nil {
+		return err
+	}
+
+	revokedTokens, err := models.RevokedTokens().All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	revokedUsers, err := models.UserTokenRevocations().All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	tokens := make(map[string]time.Time, len(revokedTokens))
+	for _, token := range revokedTokens {
+		tokens[token.Jti] = token.ExpiresAt
+	}
+	users := make(map[int64]time.Time, len(revokedUsers))
+	for _, user := range revokedUsers {
+		users[user.UserID] = user.RevokedAt
+	}
+
+	s.mu.Lock()
+	s.tokens = tokens
+	s.users = users
+	s.syncedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"cuhara.qua.go/internal/config"
+)
+
+func TestRevoked(t *testing.T) {
+	var cfg config.Server
+	cfg.Auth.RevocationSyncInterval = time.Hour
+
+	revokedAt := time.Date(2026, 1, 17, 10, 0, 0, 500000000, time.UTC)
+	store := NewStore(cfg, nil)
+	// A fresh mirror is used without querying the database.
+	store.syncedAt = time.Now()
+	store.tokens["revoked"] = revokedAt.Add(time.Hour)
+	store.users[2] = revokedAt
+
+	tests := []struct {
+		name     string
+		jti      string
+		userID   int64
+		issuedAt time.Time
+		want     bool
+	}{
+		{"revoked token", "revoked", 1, revokedAt, true},
+		{"other token", "other", 1, revokedAt, false},
+		{"token without id", "", 1, revokedAt, false},
+		{"issued before user revocation", "other", 2, revokedAt.Add(-time.Second), true},
+		{"issued earlier in the second of the user revocation", "other", 2, revokedAt.Add(-300 * time.Millisecond), true},
+		{"issued later in the second of the user revocation", "other", 2, revokedAt.Add(300 * time.Millisecond), false},
+		{"issued after user revocation", "", 2, revokedAt.Add(time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revoked, err := store.Revoked(context.Background(), tt.jti, tt.userID, tt.issuedAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if revoked != tt.want {
+				t.Errorf("got revoked %v, want %v", revoked, tt.want)
+			}
+		})
+	}
+}
+
+// execRecorder stands in for a transaction and records the statements run.
+type execRecorder struct {
+	statements int
+}
+
+func (e *execRecorder) Exec(query string, args ...any) (sql.Result, error) {
+	return e.ExecContext(context.Background(), query, args...)
+}
+func (e *execRecorder) Query(string, ...any) (*sql.Rows, error) {
+	return nil, errors.New("not supported")
+}
+func (e *execRecorder) QueryRow(string, ...any) *sql.Row { return nil }
+
+func (e *execRecorder) ExecContext(context.Context, string, ...any) (sql.Result, error) {
+	e.statements++
+	return driver.RowsAffected(1), nil
+}
+
+func (e *execRecorder) QueryContext(context.Context, string, ...any) (*sql.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func (e *execRecorder) QueryRowContext(context.Context, string, ...any) *sql.Row { return nil }
+
+func TestRevokeUserMirrorsOnlyAfterCommit(t *testing.T) {
+	var cfg config.Server
+	cfg.Auth.RevocationSyncInterval = time.Hour
+
+	store := NewStore(cfg, nil)
+	store.syncedAt = time.Now()
+	// Issued in the same second as the revocation, just before it.
+	issuedAt := time.Now().Add(-time.Millisecond)
+
+	exec := &execRecorder{}
+	mirror, err := store.RevokeUser(context.Background(), exec, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.statements != 2 {
+		t.Errorf("RevokeUser ran %d statements, want 2", exec.statements)
+	}
+
+	// Until the caller committed, a rollback must leave the tokens working.
+	if revoked, _ := store.Revoked(context.Background(), "", 3, issuedAt); revoked {
+		t.Fatal("revocation was mirrored before the commit")
+	}
+
+	mirror()
+
+	if revoked, _ := store.Revoked(context.Background(), "", 3, issuedAt); !revoked {
+		t.Error("revocation was not mirrored after the commit")
+	}
+}
@@ -0,0 +1,253 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	gosaml "github.com/crewjam/saml"
+)
+
+// Endpoints of the service provider. The metadata of each tenant is told
+// apart by its tenant id, which makes its URL the entity id of the tenant.
+const (
+	MetadataPath = "/api/v1/auth/sso/saml/metadata"
+	ACSPath      = "/api/v1/auth/sso/saml/acs"
+)
+
+// EmailAddressFormat is the format of a NameID that is an email address.
+const EmailAddressFormat = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+
+// ErrInvalidResponse is returned when a response is malformed, not signed
+// by the identity provider, meant for another service provider or request,
+// or expired.
+var ErrInvalidResponse = errors.New("invalid saml response")
+
+// ErrInvalidCertificate is returned when the certificate of an identity
+// provider cannot be read.
+var ErrInvalidCertificate = errors.New("invalid certificate")
+
+// Config is the identity provider of a tenant and the endpoints of the
+// service provider it talks to. EntityID is the URL of the metadata of the
+// service provider.
+type Config struct {
+	EntityID       string
+	ACSURL         string
+	IDPEntityID    string
+	IDPSSOURL      string
+	IDPCertificate string
+}
+
+// Assertion is the verified subject of a response, the format of its NameID
+// and its attributes, by name and by friendly name.
+type Assertion struct {
+	NameID       string
+	NameIDFormat string
+	Attributes   map[string][]string
+}
+
+// RoleMapping gives the role to users whose role attribute contains the
+// value.
+type RoleMapping struct {
+	Value  string `json:"value"`
+	RoleID int64  `json:"roleId"`
+}
+
+// EntityID returns the entity id of the service provider of the tenant on
+// the server of the base URL.
+func EntityID(baseURL string, tenantID int64) string {
+	return strings.TrimSuffix(baseURL, "/") + MetadataPath + "?tenantId=" + strconv.FormatInt(tenantID, 10)
+}
+
+// ACSURL returns the assertion consumer service of the server of the base
+// URL, shared by all tenants.
+func ACSURL(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + ACSPath
+}
+
+// ParseCertificate reads a certificate in PEM, or as the bare base64 found
+// in the metadata of identity providers.
+func ParseCertificate(certificate string) (*x509.Certificate, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		der = block.Bytes
+	} else {
+		raw, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), ""))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+		}
+		der = raw
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	return cert, nil
+}
+
+// Metadata returns the metadata of the service provider to register with
+// the identity provider.
+func Metadata(config Config) ([]byte, error) {
+	sp, err := serviceProvider(config)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), metadata...), nil
+}
+
+// AuthnRequestURL returns the URL of the identity provider to send the user
+// to and the id of the authentication request, which the response has to
+// answer. The relay state comes back with the response.
+func AuthnRequestURL(config Config, relayState string) (string, string, error) {
+	sp, err := serviceProvider(config)
+	if err != nil {
+		return "", "", err
+	}
+
+	request, err := sp.MakeAuthenticationRequest(config.IDPSSOURL, gosaml.HTTPRedirectBinding, gosaml.HTTPPostBinding)
+	if err != nil {
+		return "", "", err
+	}
+
+	redirect, err := request.Redirect(url.QueryEscape(relayState), sp)
+	if err != nil {
+		return "", "", err
+	}
+
+	return redirect.String(), request.ID, nil
+}
+
+// ParseResponse verifies the base64 encoded response the identity provider
+// posted to the assertion consumer service and returns its assertion. The
+// response must answer the request of the id and be signed by the
+// certificate of the identity provider.
+func ParseResponse(config Config, response string, requestID string) (Assertion, error) {
+	sp, err := serviceProvider(config)
+	if err != nil {
+		return Assertion{}, err
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		return Assertion{}, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	assertion, err := sp.ParseXMLResponse(raw, []string{requestID}, sp.AcsURL)
+	if err != nil {
+		var invalid *gosaml.InvalidResponseError
+		if errors.As(err, &invalid) && invalid.PrivateErr != nil {
+			err = invalid.PrivateErr
+		}
+		return Assertion{}, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return Assertion{}, fmt.Errorf("%w: no subject", ErrInvalidResponse)
+	}
+
+	attributes := map[string][]string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			values := make([]string, len(attribute.Values))
+			for i, value := range attribute.Values {
+				values[i] = value.Value
+			}
+
+			attributes[attribute.Name] = values
+			if _, ok := attributes[attribute.FriendlyName]; attribute.FriendlyName != "" && !ok {
+				attributes[attribute.FriendlyName] = values
+			}
+		}
+	}
+
+	return Assertion{
+		NameID:       assertion.Subject.NameID.Value,
+		NameIDFormat: assertion.Subject.NameID.Format,
+		Attributes:   attributes,
+	}, nil
+}
+
+// First returns the first value of the attribute, or "" without one.
+func (a Assertion) First(attribute string) string {
+	if values := a.Attributes[attribute]; attribute != "" && len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// MapRole returns the role of the first mapping whose value is in the
+// attribute.
+func MapRole(attributes map[string][]string, attribute string, mappings []RoleMapping) (int64, bool) {
+	if attribute == "" {
+		return 0, false
+	}
+
+	for _, mapping := range mappings {
+		for _, value := range attributes[attribute] {
+			if value == mapping.Value {
+				return mapping.RoleID, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func serviceProvider(config Config) (*gosaml.ServiceProvider, error) {
+	cert, err := ParseCertificate(config.IDPCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataURL, err := url.Parse(config.EntityID)
+	if err != nil {
+		return nil, err
+	}
+
+	acsURL, err := url.Parse(config.ACSURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gosaml.ServiceProvider{
+		EntityID:          config.EntityID,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		AuthnNameIDFormat: gosaml.UnspecifiedNameIDFormat,
+		IDPMetadata: &gosaml.EntityDescriptor{
+			EntityID: config.IDPEntityID,
+			IDPSSODescriptors: []gosaml.IDPSSODescriptor{{
+				SSODescriptor: gosaml.SSODescriptor{
+					RoleDescriptor: gosaml.RoleDescriptor{
+						KeyDescriptors: []gosaml.KeyDescriptor{{
+							Use: "signing",
+							KeyInfo: gosaml.KeyInfo{
+								X509Data: gosaml.X509Data{
+									X509Certificates: []gosaml.X509Certificate{{
+										Data: base64.StdEncoding.EncodeToString(cert.Raw),
+									}},
+								},
+							},
+						}},
+					},
+				},
+				SingleSignOnServices: []gosaml.Endpoint{{
+					Binding:  gosaml.HTTPRedirectBinding,
+					Location: config.IDPSSOURL,
+				}},
+			}},
+		},
+	}, nil
+}
@@ -0,0 +1,333 @@
+package saml
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	gosaml "github.com/crewjam/saml"
+)
+
+const (
+	testEntityID = "https://api.example.com/api/v1/auth/sso/saml/metadata?tenantId=1"
+	testACSURL   = "https://api.example.com/api/v1/auth/sso/saml/acs"
+)
+
+// testIDP is an identity provider signing its responses with a local key.
+type testIDP struct {
+	idp         *gosaml.IdentityProvider
+	certificate string
+}
+
+func newTestIDP(t *testing.T) *testIDP {
+	t.Helper()
+
+	key, certificate := newKeyPair(t)
+
+	idp := &gosaml.IdentityProvider{
+		Key:         key,
+		Certificate: certificate,
+		MetadataURL: mustParseURL(t, "https://idp.example.com/metadata"),
+		SSOURL:      mustParseURL(t, "https://idp.example.com/sso"),
+	}
+
+	return &testIDP{
+		idp:         idp,
+		certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})),
+	}
+}
+
+func newKeyPair(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, certificate
+}
+
+func mustParseURL(t *testing.T, raw string) url.URL {
+	t.Helper()
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *u
+}
+
+func (p *testIDP) config() Config {
+	return Config{
+		EntityID:       testEntityID,
+		ACSURL:         testACSURL,
+		IDPEntityID:    p.idp.MetadataURL.String(),
+		IDPSSOURL:      p.idp.SSOURL.String(),
+		IDPCertificate: p.certificate,
+	}
+}
+
+// serviceProviders hands the metadata of the service provider to the
+// identity provider, the way it is registered there.
+type serviceProviders struct {
+	metadata *gosaml.EntityDescriptor
+}
+
+func (s serviceProviders) GetServiceProvider(_ *http.Request, id string) (*gosaml.EntityDescriptor, error) {
+	if id != s.metadata.EntityID {
+		return nil, errors.New("unknown service provider")
+	}
+	return s.metadata, nil
+}
+
+// respond plays the login of the user at the identity provider and returns
+// the response and relay state it posts back.
+func (p *testIDP) respond(t *testing.T, config Config, authURL string, session *gosaml.Session) (string, string) {
+	t.Helper()
+
+	raw, err := Metadata(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var metadata gosaml.EntityDescriptor
+	if err := xml.Unmarshal(raw, &metadata); err != nil {
+		t.Fatal(err)
+	}
+	p.idp.ServiceProviderProvider = serviceProviders{metadata: &metadata}
+
+	request, err := gosaml.NewIdpAuthnRequest(p.idp, httptest.NewRequest(http.MethodGet, authURL, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := request.Validate(); err != nil {
+		t.Fatalf("identity provider rejected the request: %v", err)
+	}
+	if err := (gosaml.DefaultAssertionMaker{}).MakeAssertion(request, session); err != nil {
+		t.Fatal(err)
+	}
+	if err := request.MakeResponse(); err != nil {
+		t.Fatal(err)
+	}
+
+	form, err := request.PostBinding()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form.SAMLResponse, form.RelayState
+}
+
+var testSession = &gosaml.Session{
+	ID:             "session-1",
+	CreateTime:     time.Now(),
+	ExpireTime:     time.Now().Add(time.Hour),
+	NameID:         "ada@example.com",
+	NameIDFormat:   EmailAddressFormat,
+	UserEmail:      "ada@example.com",
+	UserCommonName: "Ada",
+	Groups:         []string{"staff", "admins"},
+}
+
+// login runs the flow up to the response and returns it with the id of the
+// request.
+func login(t *testing.T, p *testIDP) (string, string) {
+	t.Helper()
+
+	authURL, requestID, err := AuthnRequestURL(p.config(), "relay-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, relayState := p.respond(t, p.config(), authURL, testSession)
+	if relayState != "relay-1" {
+		t.Fatalf("relay state %q, want relay-1", relayState)
+	}
+	return response, requestID
+}
+
+func TestLogin(t *testing.T) {
+	p := newTestIDP(t)
+	response, requestID := login(t, p)
+
+	assertion, err := ParseResponse(p.config(), response, requestID)
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	if assertion.NameID != "ada@example.com" || assertion.First("mail") != "ada@example.com" || assertion.First("cn") != "Ada" {
+		t.Errorf("unexpected assertion %+v", assertion)
+	}
+	if assertion.NameIDFormat != EmailAddressFormat {
+		t.Errorf("NameID format %q, want %q", assertion.NameIDFormat, EmailAddressFormat)
+	}
+	if role, ok := MapRole(assertion.Attributes, "eduPersonAffiliation", []RoleMapping{{Value: "admins", RoleID: 3}}); !ok || role != 3 {
+		t.Errorf("role %d %v, want 3 true", role, ok)
+	}
+}
+
+func TestParseResponseRejectsOtherRequest(t *testing.T) {
+	p := newTestIDP(t)
+	response, _ := login(t, p)
+
+	if _, err := ParseResponse(p.config(), response, "id-other"); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestParseResponseRejectsOtherIdentityProvider(t *testing.T) {
+	p := newTestIDP(t)
+	response, requestID := login(t, p)
+
+	// The response is signed with the key of another identity provider than
+	// the one configured for the tenant.
+	config := p.config()
+	config.IDPCertificate = newTestIDP(t).certificate
+
+	if _, err := ParseResponse(config, response, requestID); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestParseResponseRejectsOtherServiceProvider(t *testing.T) {
+	p := newTestIDP(t)
+	response, requestID := login(t, p)
+
+	config := p.config()
+	config.ACSURL = "https://other.example.com/api/v1/auth/sso/saml/acs"
+
+	if _, err := ParseResponse(config, response, requestID); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestParseResponseRejectsTamperedResponse(t *testing.T) {
+	p := newTestIDP(t)
+	response, requestID := login(t, p)
+
+	raw, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := strings.ReplaceAll(string(raw), "ada@example.com", "eve@example.com")
+
+	if _, err := ParseResponse(p.config(), base64.StdEncoding.EncodeToString([]byte(tampered)), requestID); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestParseResponseRejectsUnsignedResponse(t *testing.T) {
+	p := newTestIDP(t)
+	response, requestID := login(t, p)
+
+	raw, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		t.Fatal(err)
+	}
+	for _, signature := range doc.FindElements("//Signature") {
+		signature.Parent().RemoveChild(signature)
+	}
+	unsigned, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ParseResponse(p.config(), base64.StdEncoding.EncodeToString(unsigned), requestID); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	_, certificate := newKeyPair(t)
+
+	encoded := base64.StdEncoding.EncodeToString(certificate.Raw)
+	inputs := map[string]string{
+		"pem":         string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})),
+		"bare base64": encoded[:40] + "\n  " + encoded[40:],
+	}
+	for name, input := range inputs {
+		if _, err := ParseCertificate(input); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	if _, err := ParseCertificate("not a certificate"); !errors.Is(err, ErrInvalidCertificate) {
+		t.Errorf("expected ErrInvalidCertificate, got %v", err)
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	p := newTestIDP(t)
+
+	raw, err := Metadata(p.config())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var metadata gosaml.EntityDescriptor
+	if err := xml.Unmarshal(raw, &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if metadata.EntityID != testEntityID {
+		t.Errorf("entity id %q, want %q", metadata.EntityID, testEntityID)
+	}
+	if acs := metadata.SPSSODescriptors[0].AssertionConsumerServices[0]; acs.Location != testACSURL || acs.Binding != gosaml.HTTPPostBinding {
+		t.Errorf("unexpected assertion consumer service %+v", acs)
+	}
+}
+
+func TestMapRole(t *testing.T) {
+	mappings := []RoleMapping{{Value: "admins", RoleID: 1}, {Value: "staff", RoleID: 2}}
+
+	tests := []struct {
+		name       string
+		attributes map[string][]string
+		attribute  string
+		want       int64
+		ok         bool
+	}{
+		{"first mapping wins", map[string][]string{"groups": {"staff", "admins"}}, "groups", 1, true},
+		{"no match", map[string][]string{"groups": {"guests"}}, "groups", 0, false},
+		{"missing attribute", map[string][]string{}, "groups", 0, false},
+		{"no role attribute configured", map[string][]string{"groups": {"admins"}}, "", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := MapRole(tt.attributes, tt.attribute, mappings)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: got %d %v, want %d %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
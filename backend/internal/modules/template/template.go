@@ -0,0 +1,184 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Types of the structured fields of a question template. Text fields bound
+// their length by min and max, number fields their value.
+const (
+	FieldTypeText    = "TEXT"
+	FieldTypeNumber  = "NUMBER"
+	FieldTypeBoolean = "BOOLEAN"
+	FieldTypeSelect  = "SELECT"
+)
+
+var fieldTypes = []string{FieldTypeText, FieldTypeNumber, FieldTypeBoolean, FieldTypeSelect}
+
+var fieldNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// Fields returns the structured fields of the question template of the sub
+// topic in order.
+func Fields(ctx context.Context, exec boil.ContextExecutor, subTopicID int64) (models.QuestionTemplateFieldSlice, error) {
+	return models.QuestionTemplateFields(
+		models.QuestionTemplateFieldWhere.SubTopicID.EQ(subTopicID),
+		qm.OrderBy(models.QuestionTemplateFieldColumns.Position+" ASC, "+models.QuestionTemplateFieldColumns.ID+" ASC"),
+	).All(ctx, exec)
+}
+
+// ValidateDefinition checks the fields of a question template before it is
+// stored.
+func ValidateDefinition(fields []dto.QuestionTemplateFieldDTO) []types.HttpValidationErrorDetail {
+	var details []types.HttpValidationErrorDetail
+	invalid := func(i int, attribute string, message string) {
+		details = append(details, types.HttpValidationErrorDetail{
+			Key:   fmt.Sprintf("fields[%d].%s", i, attribute),
+			In:    "body",
+			Error: message,
+		})
+	}
+
+	names := make(map[string]bool, len(fields))
+	for i, field := range fields {
+		switch {
+		case !fieldNamePattern.MatchString(field.Name):
+			invalid(i, "name", "must start with a letter and contain only letters, digits and underscores")
+		case names[field.Name]:
+			invalid(i, "name", "is used by another field")
+		}
+		names[field.Name] = true
+
+		if !slices.Contains(fieldTypes, field.Type) {
+			invalid(i, "type", "must be one of "+strings.Join(fieldTypes, ", "))
+		}
+
+		if field.Pattern != nil {
+			if field.Type != FieldTypeText {
+				invalid(i, "pattern", "is only allowed for text fields")
+			} else if _, err := regexp.Compile(*field.Pattern); err != nil {
+				invalid(i, "pattern", "is not a valid regular expression")
+			}
+		}
+
+		if field.Type == FieldTypeSelect && len(field.Options) == 0 {
+			invalid(i, "options", "are required for select fields")
+		}
+		if field.Type != FieldTypeSelect && len(field.Options) > 0 {
+			invalid(i, "options", "are only allowed for select fields")
+		}
+
+		if (field.Min != nil || field.Max != nil) && field.Type != FieldTypeText && field.Type != FieldTypeNumber {
+			invalid(i, "min", "is only allowed for text and number fields")
+		}
+		if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+			invalid(i, "max", "must not be less than min")
+		}
+	}
+
+	return details
+}
+
+// Validate checks the submitted values of a post against the fields of the
+// question template of its sub topic. Every value must belong to a field and
+// every required field must be filled in.
+func Validate(fields models.QuestionTemplateFieldSlice, values map[string]any) []types.HttpValidationErrorDetail {
+	var details []types.HttpValidationErrorDetail
+	invalid := func(name string, message string) {
+		details = append(details, types.HttpValidationErrorDetail{
+			Key:   "fields." + name,
+			In:    "body",
+			Error: message,
+		})
+	}
+
+	known := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		known[field.Name] = true
+
+		value, ok := values[field.Name]
+		if !ok || value == nil {
+			if field.Required {
+				invalid(field.Name, field.Label+" is required")
+			}
+			continue
+		}
+
+		if message := validateValue(field, value); message != "" {
+			invalid(field.Name, field.Label+" "+message)
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		invalid(name, "is not a field of the question template")
+	}
+
+	return details
+}
+
+func validateValue(field *models.QuestionTemplateField, value any) string {
+	switch field.Type {
+	case FieldTypeText:
+		text, ok := value.(string)
+		if !ok {
+			return "must be a text"
+		}
+		if field.Required && strings.TrimSpace(text) == "" {
+			return "is required"
+		}
+
+		length := float64(utf8.RuneCountInString(text))
+		if field.MinValue.Valid && length < field.MinValue.Float64 {
+			return fmt.Sprintf("must be at least %g characters long", field.MinValue.Float64)
+		}
+		if field.MaxValue.Valid && length > field.MaxValue.Float64 {
+			return fmt.Sprintf("must be at most %g characters long", field.MaxValue.Float64)
+		}
+
+		if field.Pattern.Valid {
+			matched, err := regexp.MatchString(field.Pattern.String, text)
+			if err != nil || !matched {
+				return "does not match the expected format"
+			}
+		}
+	case FieldTypeNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return "must be a number"
+		}
+		if field.MinValue.Valid && number < field.MinValue.Float64 {
+			return fmt.Sprintf("must be at least %g", field.MinValue.Float64)
+		}
+		if field.MaxValue.Valid && number > field.MaxValue.Float64 {
+			return fmt.Sprintf("must be at most %g", field.MaxValue.Float64)
+		}
+	case FieldTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return "must be true or false"
+		}
+	case FieldTypeSelect:
+		option, ok := value.(string)
+		if !ok || !slices.Contains(field.Options, option) {
+			return "must be one of " + strings.Join(field.Options, ", ")
+		}
+	}
+
+	return ""
+}
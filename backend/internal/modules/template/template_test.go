@@ -0,0 +1,98 @@
+package template
+
+import (
+	"testing"
+
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/types"
+	"github.com/aarondl/null/v8"
+)
+
+func templateFields() models.QuestionTemplateFieldSlice {
+	return models.QuestionTemplateFieldSlice{
+		{Name: "environment", Label: "Environment", Type: FieldTypeSelect, Required: true, Options: []string{"dev", "prod"}},
+		{Name: "version", Label: "Version", Type: FieldTypeText, Pattern: null.StringFrom(`^\d+\.\d+$`)},
+		{Name: "steps", Label: "Steps to reproduce", Type: FieldTypeText, Required: true, MinValue: null.Float64From(10)},
+		{Name: "instances", Label: "Instances", Type: FieldTypeNumber, MaxValue: null.Float64From(3)},
+		{Name: "blocking", Label: "Blocking", Type: FieldTypeBoolean},
+	}
+}
+
+func keys(details []types.HttpValidationErrorDetail) map[string]bool {
+	found := make(map[string]bool, len(details))
+	for _, detail := range details {
+		found[detail.Key] = true
+	}
+
+	return found
+}
+
+func TestValidateAcceptsMatchingValues(t *testing.T) {
+	details := Validate(templateFields(), map[string]any{
+		"environment": "prod",
+		"version":     "1.2",
+		"steps":       "open the page and click save",
+		"instances":   float64(2),
+		"blocking":    true,
+	})
+
+	if len(details) != 0 {
+		t.Errorf("valid values rejected: %v", details)
+	}
+}
+
+func TestValidateReportsEveryInvalidField(t *testing.T) {
+	details := Validate(templateFields(), map[string]any{
+		"environment": "staging",
+		"version":     "latest",
+		"steps":       "click",
+		"instances":   float64(4),
+		"blocking":    "yes",
+		"priority":    "high",
+	})
+
+	found := keys(details)
+	for _, key := range []string{"fields.environment", "fields.version", "fields.steps", "fields.instances", "fields.blocking", "fields.priority"} {
+		if !found[key] {
+			t.Errorf("no validation error for %s in %v", key, details)
+		}
+	}
+
+	for _, detail := range details {
+		if detail.In != "body" {
+			t.Errorf("validation error for %s is not in the body: %s", detail.Key, detail.In)
+		}
+	}
+}
+
+func TestValidateRequiresRequiredFields(t *testing.T) {
+	details := Validate(templateFields(), map[string]any{"steps": "   "})
+
+	found := keys(details)
+	if !found["fields.environment"] || !found["fields.steps"] {
+		t.Errorf("missing required fields not reported: %v", details)
+	}
+	if found["fields.version"] || found["fields.instances"] {
+		t.Errorf("optional fields reported as missing: %v", details)
+	}
+}
+
+func TestValidateDefinition(t *testing.T) {
+	pattern := "("
+	low, high := float64(5), float64(1)
+
+	details := ValidateDefinition([]dto.QuestionTemplateFieldDTO{
+		{Name: "environment", Label: "Environment", Type: FieldTypeSelect},
+		{Name: "environment", Label: "Again", Type: FieldTypeText},
+		{Name: "1st", Label: "First", Type: "DATE"},
+		{Name: "version", Label: "Version", Type: FieldTypeText, Pattern: &pattern, Min: &low, Max: &high},
+	})
+
+	found := keys(details)
+	for _, key := range []string{"fields[0].options", "fields[1].name", "fields[2].name", "fields[2].type", "fields[3].pattern", "fields[3].max"} {
+		if !found[key] {
+			t.Errorf("no definition error for %s in %v", key, details)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// GetOIDCProvider returns the single sign-on provider of the tenant. Only
+// users of the tenant holding the manage SSO claim can see it.
+func (s *Service) GetOIDCProvider(ctx context.Context, request dto.GetOIDCProviderRequest) (dto.OIDCProviderDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetOIDCProvider").Logger()
+
+	if err := s.authorizeSSO(ctx, request.TenantID); err != nil {
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	provider, err := models.FindTenantOidcProvider(ctx, s.db, request.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.OIDCProviderDTO{}, httperrors.ErrSSONotConfigured
+		}
+
+		log.Error().Err(err).Msg("Failed to fetch OIDC provider")
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	result, err := oidcProviderDTO(provider)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read role mappings")
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	log.Debug().Msg("GetOIDCProvider service successfully executed")
+
+	return result, nil
+}
+
+// SetOIDCProvider creates or replaces the single sign-on provider of the
+// tenant. The client secret is kept when the request leaves it out.
+func (s *Service) SetOIDCProvider(ctx context.Context, request dto.SetOIDCProviderRequest) (dto.OIDCProviderDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetOIDCProvider").Logger()
+
+	if err := s.authorizeSSO(ctx, request.TenantID); err != nil {
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	if !validIssuer(request.Issuer) {
+		return dto.OIDCProviderDTO{}, ssoValidationError("issuer", "must be an https URL")
+	}
+
+	if err := s.checkSSORoles(ctx, request.TenantID, request.RoleMappings, request.DefaultRoleID); err != nil {
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	provider, err := models.FindTenantOidcProvider(ctx, s.db, request.TenantID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Error().Err(err).Msg("Failed to fetch OIDC provider")
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	exists := provider != nil
+	if !exists {
+		if request.ClientSecret == nil {
+			return dto.OIDCProviderDTO{}, ssoValidationError("clientSecret", "is required")
+		}
+
+		provider = &models.TenantOidcProvider{
+			TenantID:  request.TenantID,
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	rawMappings, err := json.Marshal(roleMappings(request.RoleMappings))
+	if err != nil {
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	scopes := slices.Clone(request.Scopes)
+	if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	provider.Enabled = request.Enabled
+	provider.Issuer = request.Issuer
+	provider.ClientID = request.ClientID
+	if request.ClientSecret != nil {
+		provider.ClientSecret = *request.ClientSecret
+	}
+	provider.Scopes = scopes
+	provider.RoleClaim = request.RoleClaim
+	provider.RoleMappings = rawMappings
+	provider.DefaultRoleID = null.Int64FromPtr(request.DefaultRoleID)
+	provider.UpdatedAt = time.Now().UTC()
+
+	if exists {
+		_, err = provider.Update(ctx, s.db, boil.Infer())
+	} else {
+		err = provider.Insert(ctx, s.db, boil.Infer())
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save OIDC provider")
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	result, err := oidcProviderDTO(provider)
+	if err != nil {
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	log.Debug().Msg("SetOIDCProvider service successfully executed")
+
+	return result, nil
+}
+
+// validIssuer accepts secure URLs without query, the discovery document is
+// read below them.
+func validIssuer(issuer string) bool {
+	u, ok := secureURL(issuer)
+	return ok && u.RawQuery == "" && u.Fragment == ""
+}
+
+func oidcProviderDTO(provider *models.TenantOidcProvider) (dto.OIDCProviderDTO, error) {
+	var mappings []dto.SSORoleMapping
+	if err := json.Unmarshal(provider.RoleMappings, &mappings); err != nil {
+		return dto.OIDCProviderDTO{}, err
+	}
+
+	return dto.OIDCProviderDTO{
+		TenantID:        provider.TenantID,
+		Enabled:         provider.Enabled,
+		Issuer:          provider.Issuer,
+		ClientID:        provider.ClientID,
+		ClientSecretSet: provider.ClientSecret != "",
+		Scopes:          provider.Scopes,
+		RoleClaim:       provider.RoleClaim,
+		RoleMappings:    roleMappings(mappings),
+		DefaultRoleID:   provider.DefaultRoleID.Ptr(),
+	}, nil
+}
@@ -0,0 +1,144 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/saml"
+	"cuhara.qua.go/internal/util"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// GetSAMLProvider returns the SAML identity provider of the tenant. Only
+// users of the tenant holding the manage SSO claim can see it.
+func (s *Service) GetSAMLProvider(ctx context.Context, request dto.GetSAMLProviderRequest) (dto.SAMLProviderDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetSAMLProvider").Logger()
+
+	if err := s.authorizeSSO(ctx, request.TenantID); err != nil {
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	provider, err := models.FindTenantSamlProvider(ctx, s.db, request.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.SAMLProviderDTO{}, httperrors.ErrSSONotConfigured
+		}
+
+		log.Error().Err(err).Msg("Failed to fetch SAML provider")
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	result, err := s.samlProviderDTO(provider)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read role mappings")
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	log.Debug().Msg("GetSAMLProvider service successfully executed")
+
+	return result, nil
+}
+
+// SetSAMLProvider creates or replaces the SAML identity provider of the
+// tenant.
+func (s *Service) SetSAMLProvider(ctx context.Context, request dto.SetSAMLProviderRequest) (dto.SAMLProviderDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetSAMLProvider").Logger()
+
+	if err := s.authorizeSSO(ctx, request.TenantID); err != nil {
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	if _, ok := secureURL(request.IDPSSOURL); !ok {
+		return dto.SAMLProviderDTO{}, ssoValidationError("idpSsoUrl", "must be an https URL")
+	}
+
+	if _, err := saml.ParseCertificate(request.IDPCertificate); err != nil {
+		return dto.SAMLProviderDTO{}, ssoValidationError("idpCertificate", "must be an X.509 certificate")
+	}
+
+	if err := s.checkSSORoles(ctx, request.TenantID, request.RoleMappings, request.DefaultRoleID); err != nil {
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	provider, err := models.FindTenantSamlProvider(ctx, s.db, request.TenantID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Error().Err(err).Msg("Failed to fetch SAML provider")
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	exists := provider != nil
+	if !exists {
+		provider = &models.TenantSamlProvider{
+			TenantID:  request.TenantID,
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	rawMappings, err := json.Marshal(roleMappings(request.RoleMappings))
+	if err != nil {
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	provider.Enabled = request.Enabled
+	provider.IdpEntityID = request.IDPEntityID
+	provider.IdpSsoURL = request.IDPSSOURL
+	provider.IdpCertificate = request.IDPCertificate
+	provider.EmailAttribute = request.EmailAttribute
+	provider.NameAttribute = request.NameAttribute
+	provider.UsernameAttribute = request.UsernameAttribute
+	provider.RoleAttribute = request.RoleAttribute
+	provider.RoleMappings = rawMappings
+	provider.DefaultRoleID = null.Int64FromPtr(request.DefaultRoleID)
+	provider.LinkExistingUsers = request.LinkExistingUsers
+	provider.UpdatedAt = time.Now().UTC()
+
+	if exists {
+		_, err = provider.Update(ctx, s.db, boil.Infer())
+	} else {
+		err = provider.Insert(ctx, s.db, boil.Infer())
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save SAML provider")
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	result, err := s.samlProviderDTO(provider)
+	if err != nil {
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	log.Debug().Msg("SetSAMLProvider service successfully executed")
+
+	return result, nil
+}
+
+func (s *Service) samlProviderDTO(provider *models.TenantSamlProvider) (dto.SAMLProviderDTO, error) {
+	var mappings []dto.SSORoleMapping
+	if err := json.Unmarshal(provider.RoleMappings, &mappings); err != nil {
+		return dto.SAMLProviderDTO{}, err
+	}
+
+	return dto.SAMLProviderDTO{
+		TenantID:          provider.TenantID,
+		Enabled:           provider.Enabled,
+		IDPEntityID:       provider.IdpEntityID,
+		IDPSSOURL:         provider.IdpSsoURL,
+		IDPCertificate:    provider.IdpCertificate,
+		EmailAttribute:    provider.EmailAttribute,
+		NameAttribute:     provider.NameAttribute,
+		UsernameAttribute: provider.UsernameAttribute,
+		RoleAttribute:     provider.RoleAttribute,
+		RoleMappings:      roleMappings(mappings),
+		DefaultRoleID:     provider.DefaultRoleID.Ptr(),
+		LinkExistingUsers: provider.LinkExistingUsers,
+		EntityID:          saml.EntityID(s.config.Echo.BaseURL, provider.TenantID),
+		ACSURL:            saml.ACSURL(s.config.Echo.BaseURL),
+	}, nil
+}
@@ -4,18 +4,29 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"net/http"
+	"slices"
 	"time"
 
 	"cuhara.qua.go/internal/api/httperrors"
 	"cuhara.qua.go/internal/config"
 	"cuhara.qua.go/internal/data/dto"
 	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/reaction"
+	"cuhara.qua.go/internal/types"
 	"cuhara.qua.go/internal/util"
 	"cuhara.qua.go/internal/util/db"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 )
 
+// What users who have not verified their email yet can do in the tenant.
+const (
+	UnverifiedAccessFull     = "FULL"
+	UnverifiedAccessReadOnly = "READ_ONLY"
+	UnverifiedAccessNone     = "NONE"
+)
+
 type Service struct {
 	db     *sql.DB
 	config config.Server
@@ -40,8 +51,13 @@ func (s *Service) GetAll(ctx context.Context) ([]dto.TenantDTO, error) {
 	tenantDTOs := make([]dto.TenantDTO, len(tenants))
 	for i, tenant := range tenants {
 		tenantDTOs[i] = dto.TenantDTO{
-			ID:   tenant.ID,
-			Name: tenant.Name,
+			ID:                    tenant.ID,
+			Name:                  tenant.Name,
+			AllowAnonymousPosts:   tenant.AllowAnonymousPosts,
+			ReactionEmojis:        tenant.ReactionEmojis,
+			WikiEditMinReputation: tenant.WikiEditMinReputation,
+			UnverifiedUserAccess:  tenant.UnverifiedUserAccess,
+			MFARequiredRoleIDs:    tenant.MfaRequiredRoleIds,
 		}
 	}
 
@@ -112,6 +128,7 @@ func (s *Service) Update(ctx context.Context, request dto.UpdateTenantRequest) (
 	}
 
 	changed := false
+	whitelist := []string{models.TenantColumns.UpdatedAt}
 	if request.Name != nil && t.Name != *request.Name {
 		log.Debug().Str("name", *request.Name).Msg("Updating name")
 
@@ -131,18 +148,107 @@ func (s *Service) Update(ctx context.Context, request dto.UpdateTenantRequest) (
 		}
 
 		t.Name = *request.Name
+		whitelist = append(whitelist, models.TenantColumns.Name)
+		changed = true
+	}
+
+	if request.AllowAnonymousPosts != nil && t.AllowAnonymousPosts != *request.AllowAnonymousPosts {
+		log.Debug().Bool("allowAnonymousPosts", *request.AllowAnonymousPosts).Msg("Updating anonymous posting")
+
+		t.AllowAnonymousPosts = *request.AllowAnonymousPosts
+		whitelist = append(whitelist, models.TenantColumns.AllowAnonymousPosts)
+		changed = true
+	}
+
+	if request.WikiEditMinReputation != nil && t.WikiEditMinReputation != *request.WikiEditMinReputation {
+		log.Debug().Int("wikiEditMinReputation", *request.WikiEditMinReputation).Msg("Updating community wiki reputation threshold")
+
+		t.WikiEditMinReputation = *request.WikiEditMinReputation
+		whitelist = append(whitelist, models.TenantColumns.WikiEditMinReputation)
+		changed = true
+	}
+
+	if request.UnverifiedUserAccess != nil && t.UnverifiedUserAccess != *request.UnverifiedUserAccess {
+		switch *request.UnverifiedUserAccess {
+		case UnverifiedAccessFull, UnverifiedAccessReadOnly, UnverifiedAccessNone:
+		default:
+			return dto.UpdateTenantResponse{}, httperrors.NewHTTPValidationError(
+				http.StatusBadRequest,
+				httperrors.HTTPErrorTypeGeneric,
+				"Tenant validation failed",
+				[]types.HttpValidationErrorDetail{{
+					Key:   "unverifiedUserAccess",
+					In:    "body",
+					Error: "must be FULL, READ_ONLY or NONE",
+				}},
+			)
+		}
+
+		log.Debug().Str("unverifiedUserAccess", *request.UnverifiedUserAccess).Msg("Updating access of unverified users")
+
+		t.UnverifiedUserAccess = *request.UnverifiedUserAccess
+		whitelist = append(whitelist, models.TenantColumns.UnverifiedUserAccess)
+		changed = true
+	}
+
+	if request.MFARequiredRoleIDs != nil && !slices.Equal(t.MfaRequiredRoleIds, *request.MFARequiredRoleIDs) {
+		roleIDs := slices.Compact(slices.Sorted(slices.Values(*request.MFARequiredRoleIDs)))
+
+		count, err := models.Roles(
+			models.RoleWhere.ID.IN(roleIDs),
+			models.RoleWhere.TenantID.EQ(t.ID),
+		).Count(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check roles")
+			return dto.UpdateTenantResponse{}, err
+		}
+
+		if count != int64(len(roleIDs)) {
+			return dto.UpdateTenantResponse{}, httperrors.NewHTTPValidationError(
+				http.StatusBadRequest,
+				httperrors.HTTPErrorTypeGeneric,
+				"Tenant validation failed",
+				[]types.HttpValidationErrorDetail{{
+					Key:   "mfaRequiredRoleIds",
+					In:    "body",
+					Error: "must only contain roles of the tenant",
+				}},
+			)
+		}
+
+		log.Debug().Ints64("mfaRequiredRoleIds", roleIDs).Msg("Updating roles requiring two factor authentication")
+
+		t.MfaRequiredRoleIds = roleIDs
+		whitelist = append(whitelist, models.TenantColumns.MfaRequiredRoleIds)
 		changed = true
 	}
 
+	emojisChanged := false
+	if request.ReactionEmojis != nil && !slices.Equal(t.ReactionEmojis, *request.ReactionEmojis) {
+		log.Debug().Strs("reactionEmojis", *request.ReactionEmojis).Msg("Updating reaction emojis")
+
+		t.ReactionEmojis = *request.ReactionEmojis
+		whitelist = append(whitelist, models.TenantColumns.ReactionEmojis)
+		changed = true
+		emojisChanged = true
+	}
+
 	if !changed {
 		return dto.UpdateTenantResponse{ID: t.ID}, nil
 	}
 
 	t.UpdatedAt = null.TimeFrom(time.Now().UTC())
-	_, err = t.Update(ctx, s.db, boil.Whitelist(
-		models.TenantColumns.Name,
-		models.TenantColumns.UpdatedAt,
-	))
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := t.Update(ctx, ce, boil.Whitelist(whitelist...)); err != nil {
+			return err
+		}
+
+		// Reactions with emoji dropped from the set disappear with them.
+		if emojisChanged {
+			return reaction.RemoveDisallowed(ctx, ce, t.ID, t.ReactionEmojis)
+		}
+		return nil
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update tenant")
 		return dto.UpdateTenantResponse{}, err
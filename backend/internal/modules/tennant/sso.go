@@ -0,0 +1,126 @@
+package tenant
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"cuhara.qua.go/internal/api/httperrors"
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/permission"
+	"cuhara.qua.go/internal/types"
+	"cuhara.qua.go/internal/util"
+)
+
+// authorizeSSO checks that the user manages the single sign-on of the tenant
+// the request is about.
+func (s *Service) authorizeSSO(ctx context.Context, tenantID int64) error {
+	log := util.LogFromContext(ctx).With().Str("function", "authorizeSSO").Logger()
+
+	currentTenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return err
+	}
+
+	if currentTenantID != tenantID {
+		log.Debug().Int64("tenantId", tenantID).Msg("User may not manage SSO of other tenants")
+		return httperrors.ErrForbidden
+	}
+
+	allowed, err := permission.HasClaim(ctx, s.db, tenantID, userID, permission.ClaimManageSSO)
+	if err != nil {
+		log.Err(err).Msg("Failed to check manage SSO claim")
+		return err
+	}
+
+	if !allowed {
+		log.Debug().Int64("userId", userID).Msg("User may not manage SSO")
+		return httperrors.ErrForbidden
+	}
+
+	return nil
+}
+
+// checkSSORoles checks that the mapped roles and the default role belong to
+// the tenant.
+func (s *Service) checkSSORoles(ctx context.Context, tenantID int64, mappings []dto.SSORoleMapping, defaultRoleID *int64) error {
+	roleIDs := make([]int64, 0, len(mappings)+1)
+	for _, mapping := range mappings {
+		roleIDs = append(roleIDs, mapping.RoleID)
+	}
+	if defaultRoleID != nil {
+		roleIDs = append(roleIDs, *defaultRoleID)
+	}
+	roleIDs = slices.Compact(slices.Sorted(slices.Values(roleIDs)))
+
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	count, err := models.Roles(
+		models.RoleWhere.ID.IN(roleIDs),
+		models.RoleWhere.TenantID.EQ(tenantID),
+	).Count(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	if count != int64(len(roleIDs)) {
+		return ssoValidationError("roleMappings", "must only contain roles of the tenant")
+	}
+
+	return nil
+}
+
+// roleMappings returns the mappings as a list, also when there are none.
+func roleMappings(mappings []dto.SSORoleMapping) []dto.SSORoleMapping {
+	if mappings == nil {
+		return []dto.SSORoleMapping{}
+	}
+	return mappings
+}
+
+// secureURL accepts https URLs, and http ones on the local machine for
+// providers run in development.
+func secureURL(raw string) (*url.URL, bool) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.User != nil {
+		return nil, false
+	}
+
+	switch u.Scheme {
+	case "https":
+		return u, true
+	case "http":
+		host := u.Hostname()
+		if host == "localhost" {
+			return u, true
+		}
+		ip := net.ParseIP(host)
+		return u, ip != nil && ip.IsLoopback()
+	}
+	return nil, false
+}
+
+func ssoValidationError(key string, message string) error {
+	return httperrors.NewHTTPValidationError(
+		http.StatusBadRequest,
+		httperrors.HTTPErrorTypeGeneric,
+		"SSO provider validation failed",
+		[]types.HttpValidationErrorDetail{{
+			Key:   key,
+			In:    "body",
+			Error: message,
+		}},
+	)
+}
@@ -4,13 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"net/http"
 	"time"
 
 	"cuhara.qua.go/internal/api/httperrors"
 	"cuhara.qua.go/internal/config"
 	"cuhara.qua.go/internal/data/dto"
 	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/modules/category"
+	"cuhara.qua.go/internal/modules/permission"
+	"cuhara.qua.go/internal/modules/post"
+	"cuhara.qua.go/internal/modules/template"
 	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -37,20 +44,35 @@ func (s *Service) GetAll(ctx context.Context) ([]dto.TopicDTO, error) {
 		return nil, err
 	}
 
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
 	topics, err := models.Topics(
 		models.TopicWhere.TenantID.EQ(tenantID),
+		filter.Topics(),
+		qm.InnerJoin("categories c ON c.topic_id = topics.id"),
+		qm.OrderBy("c.position ASC"),
+		qm.Load(models.TopicRels.Category),
 	).All(ctx, s.db)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get topics")
 		return nil, err
 	}
 
+	stats, err := topicStats(ctx, s.db, tenantID, filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get topic stats")
+		return nil, err
+	}
+
 	topicDTOs := make([]dto.TopicDTO, len(topics))
 	for i, topic := range topics {
-		topicDTOs[i] = dto.TopicDTO{
-			ID:   topic.ID,
-			Name: topic.Name,
-		}
+		topicDTOs[i] = topicToDTO(topic)
+		topicDTOs[i].Position = topic.R.Category.Position
+		topicDTOs[i].Stats = statsOrEmpty(stats, topic.ID)
 	}
 
 	log.Debug().Msg("Topics fetched successfully")
@@ -83,10 +105,21 @@ func (s *Service) Create(ctx context.Context, request dto.CreateTopicRequest) (d
 
 	topic := models.Topic{
 		Name:     request.Name,
+		Icon:     optionalString(request.Icon),
+		Color:    optionalString(request.Color),
 		TenantID: tenantID,
 	}
+	if request.Description != nil {
+		topic.Description = *request.Description
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if err := topic.Insert(ctx, ce, boil.Infer()); err != nil {
+			return err
+		}
 
-	err = topic.Insert(ctx, s.db, boil.Infer())
+		return category.AddTopic(ctx, ce, &topic)
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to create topic")
 		return dto.CreateTopicResponse{}, err
@@ -141,15 +174,46 @@ func (s *Service) Update(ctx context.Context, request dto.UpdateTopicRequest) (d
 		changed = true
 	}
 
+	if request.Description != nil && topic.Description != *request.Description {
+		topic.Description = *request.Description
+		changed = true
+	}
+
+	if request.Icon != nil && topic.Icon != optionalString(request.Icon) {
+		topic.Icon = optionalString(request.Icon)
+		changed = true
+	}
+
+	if request.Color != nil && topic.Color != optionalString(request.Color) {
+		topic.Color = optionalString(request.Color)
+		changed = true
+	}
+
+	if request.Archived != nil && topic.Archived != *request.Archived {
+		topic.Archived = *request.Archived
+		changed = true
+	}
+
 	if !changed {
 		return dto.UpdateTopicResponse{ID: topic.ID}, nil
 	}
 
 	topic.UpdatedAt = null.TimeFrom(time.Now().UTC())
-	_, err = topic.Update(ctx, s.db, boil.Whitelist(
-		models.TopicColumns.Name,
-		models.TopicColumns.UpdatedAt,
-	))
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		_, err := topic.Update(ctx, ce, boil.Whitelist(
+			models.TopicColumns.Name,
+			models.TopicColumns.Description,
+			models.TopicColumns.Icon,
+			models.TopicColumns.Color,
+			models.TopicColumns.Archived,
+			models.TopicColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		return category.Rename(ctx, ce, models.CategoryWhere.TopicID.EQ(null.Int64From(topic.ID)), topic.Name)
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update topic")
 		return dto.UpdateTopicResponse{}, err
@@ -194,6 +258,139 @@ func (s *Service) Delete(ctx context.Context, request dto.DeleteTopicRequest) (d
 	return dto.DeleteTopicResponse{ID: topic.ID}, nil
 }
 
+// ReorderTopics sets the display order of the topics to the order of the
+// given topic ids.
+func (s *Service) ReorderTopics(ctx context.Context, request dto.ReorderTopicsRequest) (dto.ReorderTopicsResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ReorderTopics").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.ReorderTopicsResponse{}, err
+	}
+
+	roots, err := models.Categories(
+		models.CategoryWhere.TenantID.EQ(tenantID),
+		models.CategoryWhere.ParentID.IsNull(),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get topic categories")
+		return dto.ReorderTopicsResponse{}, err
+	}
+
+	byTopicID := make(map[int64]int64, len(roots))
+	for _, root := range roots {
+		byTopicID[root.TopicID.Int64] = root.ID
+	}
+
+	categoryIDs, ok := mapIDs(byTopicID, request.IDs)
+	if !ok {
+		log.Debug().Ints64("ids", request.IDs).Msg("Ordered ids do not match the topics")
+		return dto.ReorderTopicsResponse{}, httperrors.ErrInvalidTopicOrder
+	}
+
+	if err := s.setPositions(ctx, roots, categoryIDs); err != nil {
+		if errors.Is(err, errInvalidOrder) {
+			log.Debug().Ints64("ids", request.IDs).Msg("Ordered ids do not match the topics")
+			return dto.ReorderTopicsResponse{}, httperrors.ErrInvalidTopicOrder
+		}
+
+		log.Error().Err(err).Msg("Failed to reorder topics")
+		return dto.ReorderTopicsResponse{}, err
+	}
+
+	log.Debug().Msg("Topics reordered successfully")
+
+	return dto.ReorderTopicsResponse{IDs: request.IDs}, nil
+}
+
+func (s *Service) GetAccess(ctx context.Context, request dto.GetTopicAccessRequest) (dto.TopicAccessDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetAccess").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	topic, err := models.Topics(
+		models.TopicWhere.ID.EQ(request.ID),
+		models.TopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Topic not found")
+			return dto.TopicAccessDTO{}, httperrors.ErrTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find topic")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	roles, err := topic.Roles().All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get topic roles")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	claims, err := topic.Claims().All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get topic claims")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	log.Debug().Msg("Topic access list fetched successfully")
+
+	return accessToDTO(roles, claims), nil
+}
+
+// SetAccess replaces the roles and claims allowed to see the topic. Its sub
+// topics inherit the restriction.
+func (s *Service) SetAccess(ctx context.Context, request dto.SetTopicAccessRequest) (dto.TopicAccessDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetAccess").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	topic, err := models.Topics(
+		models.TopicWhere.ID.EQ(request.ID),
+		models.TopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Topic not found")
+			return dto.TopicAccessDTO{}, httperrors.ErrTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find topic")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	roles, claims, err := s.findAccessList(ctx, tenantID, request.RoleIDs, request.ClaimIDs)
+	if err != nil {
+		return dto.TopicAccessDTO{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if err := topic.SetRoles(ctx, ce, false, roles...); err != nil {
+			return err
+		}
+
+		return topic.SetClaims(ctx, ce, false, claims...)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to set topic access list")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	log.Debug().Msg("Topic access list updated successfully")
+
+	return accessToDTO(roles, claims), nil
+}
+
 func (s *Service) GetSubTopics(ctx context.Context, request dto.GetSubTopicsRequest) ([]dto.SubTopicDTO, error) {
 	log := util.LogFromContext(ctx).With().Str("function", "GetSubTopics").Logger()
 
@@ -203,25 +400,46 @@ func (s *Service) GetSubTopics(ctx context.Context, request dto.GetSubTopicsRequ
 		return nil, err
 	}
 
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	// Sub topics of every depth below the topic are listed in tree order.
 	subTopics, err := models.SubTopics(
 		models.SubTopicWhere.TopicID.EQ(request.TopicID),
 		models.SubTopicWhere.TenantID.EQ(tenantID),
+		filter.SubTopics(),
+		qm.InnerJoin("categories c ON c.sub_topic_id = sub_topics.id"),
+		qm.OrderBy("c.depth ASC, c.position ASC"),
 		qm.Load(models.SubTopicRels.Topic),
+		qm.Load(models.SubTopicRels.Category),
 	).All(ctx, s.db)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get sub topics")
 		return nil, err
 	}
 
+	stats, err := subTopicStats(ctx, s.db, tenantID, filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic stats")
+		return nil, err
+	}
+
 	subTopicDTOs := make([]dto.SubTopicDTO, len(subTopics))
 	for i, subTopic := range subTopics {
 		subTopicDTOs[i] = dto.SubTopicDTO{
-			ID:   subTopic.ID,
-			Name: subTopic.Name,
-			Topic: dto.TopicDTO{
-				ID:   subTopic.TopicID,
-				Name: subTopic.R.Topic.Name,
-			},
+			ID:                      subTopic.ID,
+			Name:                    subTopic.Name,
+			Description:             subTopic.Description,
+			Icon:                    subTopic.Icon.Ptr(),
+			Color:                   subTopic.Color.Ptr(),
+			Archived:                subTopic.Archived,
+			Position:                subTopic.R.Category.Position,
+			FirstReplyTargetMinutes: subTopic.FirstReplyTargetMinutes.Ptr(),
+			Stats:                   statsOrEmpty(stats, subTopic.ID),
+			Topic:                   topicToDTO(subTopic.R.Topic),
 		}
 	}
 
@@ -239,6 +457,25 @@ func (s *Service) CreateSubTopic(ctx context.Context, request dto.CreateSubTopic
 		return dto.CreateSubTopicResponse{}, err
 	}
 
+	topic, err := models.Topics(
+		models.TopicWhere.ID.EQ(request.TopicID),
+		models.TopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Topic not found")
+			return dto.CreateSubTopicResponse{}, httperrors.ErrTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find topic")
+		return dto.CreateSubTopicResponse{}, err
+	}
+
+	if topic.Archived {
+		log.Debug().Int64("topicId", topic.ID).Msg("Topic is archived")
+		return dto.CreateSubTopicResponse{}, httperrors.ErrTopicArchived
+	}
+
 	exists, err := models.SubTopics(
 		models.SubTopicWhere.Name.EQ(request.Name),
 		models.SubTopicWhere.TopicID.EQ(request.TopicID),
@@ -255,12 +492,24 @@ func (s *Service) CreateSubTopic(ctx context.Context, request dto.CreateSubTopic
 	}
 
 	subTopic := models.SubTopic{
-		Name:     request.Name,
-		TopicID:  request.TopicID,
-		TenantID: tenantID,
+		Name:                    request.Name,
+		TopicID:                 request.TopicID,
+		TenantID:                tenantID,
+		Icon:                    optionalString(request.Icon),
+		Color:                   optionalString(request.Color),
+		FirstReplyTargetMinutes: null.IntFromPtr(request.FirstReplyTargetMinutes),
+	}
+	if request.Description != nil {
+		subTopic.Description = *request.Description
 	}
 
-	err = subTopic.Insert(ctx, s.db, boil.Infer())
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if err := subTopic.Insert(ctx, ce, boil.Infer()); err != nil {
+			return err
+		}
+
+		return category.AddSubTopic(ctx, ce, &subTopic)
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to create sub topic")
 		return dto.CreateSubTopicResponse{}, err
@@ -318,15 +567,52 @@ func (s *Service) UpdateSubTopic(ctx context.Context, request dto.UpdateSubTopic
 		changed = true
 	}
 
+	if request.FirstReplyTargetMinutes != nil && subTopic.FirstReplyTargetMinutes != null.IntFrom(*request.FirstReplyTargetMinutes) {
+		subTopic.FirstReplyTargetMinutes = null.IntFrom(*request.FirstReplyTargetMinutes)
+		changed = true
+	}
+
+	if request.Description != nil && subTopic.Description != *request.Description {
+		subTopic.Description = *request.Description
+		changed = true
+	}
+
+	if request.Icon != nil && subTopic.Icon != optionalString(request.Icon) {
+		subTopic.Icon = optionalString(request.Icon)
+		changed = true
+	}
+
+	if request.Color != nil && subTopic.Color != optionalString(request.Color) {
+		subTopic.Color = optionalString(request.Color)
+		changed = true
+	}
+
+	if request.Archived != nil && subTopic.Archived != *request.Archived {
+		subTopic.Archived = *request.Archived
+		changed = true
+	}
+
 	if !changed {
 		return dto.UpdateSubTopicResponse{ID: subTopic.ID}, nil
 	}
 
 	subTopic.UpdatedAt = null.TimeFrom(time.Now().UTC())
-	_, err = subTopic.Update(ctx, s.db, boil.Whitelist(
-		models.SubTopicColumns.Name,
-		models.SubTopicColumns.UpdatedAt,
-	))
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		_, err := subTopic.Update(ctx, ce, boil.Whitelist(
+			models.SubTopicColumns.Name,
+			models.SubTopicColumns.FirstReplyTargetMinutes,
+			models.SubTopicColumns.Description,
+			models.SubTopicColumns.Icon,
+			models.SubTopicColumns.Color,
+			models.SubTopicColumns.Archived,
+			models.SubTopicColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		return category.Rename(ctx, ce, models.CategoryWhere.SubTopicID.EQ(null.Int64From(subTopic.ID)), subTopic.Name)
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update sub topic")
 		return dto.UpdateSubTopicResponse{}, err
@@ -361,7 +647,70 @@ func (s *Service) DeleteSubTopic(ctx context.Context, request dto.DeleteSubTopic
 		return dto.DeleteSubTopicResponse{}, err
 	}
 
-	_, err = subTopic.Delete(ctx, s.db)
+	hasChildren, err := category.HasChildren(ctx, s.db, subTopic.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether sub topic has child categories")
+		return dto.DeleteSubTopicResponse{}, err
+	}
+
+	if hasChildren {
+		log.Debug().Msg("Sub topic still has child categories")
+		return dto.DeleteSubTopicResponse{}, httperrors.ErrCategoryHasChildren
+	}
+
+	posts, err := subTopic.SubtopicPosts().All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic posts")
+		return dto.DeleteSubTopicResponse{}, err
+	}
+
+	var userID int64
+	if len(posts) > 0 {
+		if request.ReassignTo == nil {
+			log.Debug().Int("postCount", len(posts)).Msg("Sub topic still has posts")
+			return dto.DeleteSubTopicResponse{}, httperrors.ErrSubTopicHasPosts
+		}
+
+		if *request.ReassignTo == subTopic.ID {
+			log.Debug().Msg("Posts cannot be reassigned to the deleted sub topic")
+			return dto.DeleteSubTopicResponse{}, httperrors.ErrInvalidSubTopicReassign
+		}
+
+		exists, err := models.SubTopics(
+			models.SubTopicWhere.ID.EQ(*request.ReassignTo),
+			models.SubTopicWhere.TenantID.EQ(tenantID),
+		).Exists(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check whether sub topic exists")
+			return dto.DeleteSubTopicResponse{}, err
+		}
+
+		if !exists {
+			log.Debug().Int64("reassignTo", *request.ReassignTo).Msg("Sub topic to reassign posts to not found")
+			return dto.DeleteSubTopicResponse{}, httperrors.ErrSubTopicNotFound
+		}
+
+		userID, err = util.UserIDFromContext(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get user id from context")
+			return dto.DeleteSubTopicResponse{}, err
+		}
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if len(posts) > 0 {
+			if _, err := post.MovePosts(ctx, ce, tenantID, userID, posts, *request.ReassignTo); err != nil {
+				return err
+			}
+		}
+
+		if err := subTopic.SetUsers(ctx, ce, false); err != nil {
+			return err
+		}
+
+		_, err := subTopic.Delete(ctx, ce)
+		return err
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to delete sub topic")
 		return dto.DeleteSubTopicResponse{}, err
@@ -371,3 +720,775 @@ func (s *Service) DeleteSubTopic(ctx context.Context, request dto.DeleteSubTopic
 
 	return dto.DeleteSubTopicResponse{ID: subTopic.ID}, nil
 }
+
+// ReorderSubTopics sets the display order of the direct sub topics of a topic
+// to the order of the given sub topic ids. Deeper sub topics are ordered
+// through the category tree.
+func (s *Service) ReorderSubTopics(ctx context.Context, request dto.ReorderSubTopicsRequest) (dto.ReorderSubTopicsResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "ReorderSubTopics").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.ReorderSubTopicsResponse{}, err
+	}
+
+	root, err := models.Categories(
+		models.CategoryWhere.TopicID.EQ(null.Int64From(request.TopicID)),
+		models.CategoryWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Topic not found")
+			return dto.ReorderSubTopicsResponse{}, httperrors.ErrTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find topic category")
+		return dto.ReorderSubTopicsResponse{}, err
+	}
+
+	children, err := models.Categories(
+		models.CategoryWhere.ParentID.EQ(null.Int64From(root.ID)),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic categories")
+		return dto.ReorderSubTopicsResponse{}, err
+	}
+
+	bySubTopicID := make(map[int64]int64, len(children))
+	for _, child := range children {
+		bySubTopicID[child.SubTopicID.Int64] = child.ID
+	}
+
+	categoryIDs, ok := mapIDs(bySubTopicID, request.IDs)
+	if !ok {
+		log.Debug().Ints64("ids", request.IDs).Msg("Ordered ids do not match the sub topics")
+		return dto.ReorderSubTopicsResponse{}, httperrors.ErrInvalidSubTopicOrder
+	}
+
+	if err := s.setPositions(ctx, children, categoryIDs); err != nil {
+		if errors.Is(err, errInvalidOrder) {
+			log.Debug().Ints64("ids", request.IDs).Msg("Ordered ids do not match the sub topics")
+			return dto.ReorderSubTopicsResponse{}, httperrors.ErrInvalidSubTopicOrder
+		}
+
+		log.Error().Err(err).Msg("Failed to reorder sub topics")
+		return dto.ReorderSubTopicsResponse{}, err
+	}
+
+	log.Debug().Msg("Sub topics reordered successfully")
+
+	return dto.ReorderSubTopicsResponse{TopicID: request.TopicID, IDs: request.IDs}, nil
+}
+
+func (s *Service) GetSubTopicResponders(ctx context.Context, request dto.GetSubTopicRespondersRequest) ([]dto.UserDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetSubTopicResponders").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	subTopic, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Sub topic not found")
+			return nil, httperrors.ErrSubTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find sub topic")
+		return nil, err
+	}
+
+	users, err := subTopic.Users(
+		qm.Load(models.UserRels.Role),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic responders")
+		return nil, err
+	}
+
+	userDTOs := make([]dto.UserDTO, len(users))
+	for i, user := range users {
+		userDTOs[i] = dto.UserDTO{
+			ID:         user.ID,
+			Name:       user.Name,
+			Email:      user.Email,
+			VscAccount: user.VSCAccount,
+			RoleDTO: dto.RoleDTO{
+				ID:   user.R.Role.ID,
+				Name: user.R.Role.Name,
+			},
+		}
+	}
+
+	log.Debug().Msg("Sub topic responders fetched successfully")
+
+	return userDTOs, nil
+}
+
+func (s *Service) SetSubTopicResponders(ctx context.Context, request dto.SetSubTopicRespondersRequest) (dto.UpdateSubTopicResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetSubTopicResponders").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UpdateSubTopicResponse{}, err
+	}
+
+	subTopic, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Sub topic not found")
+			return dto.UpdateSubTopicResponse{}, httperrors.ErrSubTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find sub topic")
+		return dto.UpdateSubTopicResponse{}, err
+	}
+
+	var users models.UserSlice
+	if len(request.UserIDs) > 0 {
+		users, err = models.Users(
+			models.UserWhere.ID.IN(request.UserIDs),
+			models.UserWhere.TenantID.EQ(tenantID),
+		).All(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get responder users")
+			return dto.UpdateSubTopicResponse{}, err
+		}
+	}
+
+	if len(users) != len(request.UserIDs) {
+		log.Debug().Ints64("userIds", request.UserIDs).Msg("Some responders were not found")
+		return dto.UpdateSubTopicResponse{}, httperrors.ErrUserNotFound
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		return subTopic.SetUsers(ctx, ce, false, users...)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to set sub topic responders")
+		return dto.UpdateSubTopicResponse{}, err
+	}
+
+	log.Debug().Msg("Sub topic responders updated successfully")
+
+	return dto.UpdateSubTopicResponse{ID: subTopic.ID}, nil
+}
+
+func (s *Service) GetSubTopicAccess(ctx context.Context, request dto.GetSubTopicAccessRequest) (dto.TopicAccessDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetSubTopicAccess").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	subTopic, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Sub topic not found")
+			return dto.TopicAccessDTO{}, httperrors.ErrSubTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find sub topic")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	roles, err := subTopic.Roles().All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic roles")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	claims, err := subTopic.Claims().All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic claims")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	log.Debug().Msg("Sub topic access list fetched successfully")
+
+	return accessToDTO(roles, claims), nil
+}
+
+// SetSubTopicAccess replaces the roles and claims allowed to see the sub
+// topic. Users must also be allowed to see the topic and every sub topic above
+// it.
+func (s *Service) SetSubTopicAccess(ctx context.Context, request dto.SetSubTopicAccessRequest) (dto.TopicAccessDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetSubTopicAccess").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	subTopic, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Sub topic not found")
+			return dto.TopicAccessDTO{}, httperrors.ErrSubTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find sub topic")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	roles, claims, err := s.findAccessList(ctx, tenantID, request.RoleIDs, request.ClaimIDs)
+	if err != nil {
+		return dto.TopicAccessDTO{}, err
+	}
+
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if err := subTopic.SetRoles(ctx, ce, false, roles...); err != nil {
+			return err
+		}
+
+		return subTopic.SetClaims(ctx, ce, false, claims...)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to set sub topic access list")
+		return dto.TopicAccessDTO{}, err
+	}
+
+	log.Debug().Msg("Sub topic access list updated successfully")
+
+	return accessToDTO(roles, claims), nil
+}
+
+func (s *Service) GetModerators(ctx context.Context, request dto.GetTopicModeratorsRequest) ([]dto.UserDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetModerators").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	exists, err := models.Topics(
+		models.TopicWhere.ID.EQ(request.ID),
+		models.TopicWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether topic exists")
+		return nil, err
+	}
+
+	if !exists {
+		log.Debug().Int64("id", request.ID).Msg("Topic not found")
+		return nil, httperrors.ErrTopicNotFound
+	}
+
+	users, err := moderatorUsers(ctx, s.db, models.TopicModeratorWhere.TopicID.EQ(null.Int64From(request.ID)))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get topic moderators")
+		return nil, err
+	}
+
+	log.Debug().Msg("Topic moderators fetched successfully")
+
+	return usersToDTO(users), nil
+}
+
+// SetModerators replaces the moderators of the topic. Topic moderators may
+// moderate the posts of every sub topic of the topic.
+func (s *Service) SetModerators(ctx context.Context, request dto.SetTopicModeratorsRequest) ([]dto.UserDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetModerators").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	if err := s.requireManageModerators(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	exists, err := models.Topics(
+		models.TopicWhere.ID.EQ(request.ID),
+		models.TopicWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether topic exists")
+		return nil, err
+	}
+
+	if !exists {
+		log.Debug().Int64("id", request.ID).Msg("Topic not found")
+		return nil, httperrors.ErrTopicNotFound
+	}
+
+	users, err := s.replaceModerators(ctx, tenantID, request.UserIDs, models.TopicModeratorWhere.TopicID.EQ(null.Int64From(request.ID)), func(moderator *models.TopicModerator) {
+		moderator.TopicID = null.Int64From(request.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Msg("Topic moderators updated successfully")
+
+	return usersToDTO(users), nil
+}
+
+func (s *Service) GetSubTopicModerators(ctx context.Context, request dto.GetSubTopicModeratorsRequest) ([]dto.UserDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetSubTopicModerators").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	exists, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether sub topic exists")
+		return nil, err
+	}
+
+	if !exists {
+		log.Debug().Int64("id", request.ID).Msg("Sub topic not found")
+		return nil, httperrors.ErrSubTopicNotFound
+	}
+
+	users, err := moderatorUsers(ctx, s.db, models.TopicModeratorWhere.SubTopicID.EQ(null.Int64From(request.ID)))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sub topic moderators")
+		return nil, err
+	}
+
+	log.Debug().Msg("Sub topic moderators fetched successfully")
+
+	return usersToDTO(users), nil
+}
+
+// SetSubTopicModerators replaces the moderators of the sub topic. Sub topic
+// moderators may also moderate the posts of the sub topics below it.
+func (s *Service) SetSubTopicModerators(ctx context.Context, request dto.SetSubTopicModeratorsRequest) ([]dto.UserDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetSubTopicModerators").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	if err := s.requireManageModerators(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	exists, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether sub topic exists")
+		return nil, err
+	}
+
+	if !exists {
+		log.Debug().Int64("id", request.ID).Msg("Sub topic not found")
+		return nil, httperrors.ErrSubTopicNotFound
+	}
+
+	users, err := s.replaceModerators(ctx, tenantID, request.UserIDs, models.TopicModeratorWhere.SubTopicID.EQ(null.Int64From(request.ID)), func(moderator *models.TopicModerator) {
+		moderator.SubTopicID = null.Int64From(request.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Msg("Sub topic moderators updated successfully")
+
+	return usersToDTO(users), nil
+}
+
+// GetTemplate returns the question template of a sub topic visible to the
+// user.
+func (s *Service) GetTemplate(ctx context.Context, request dto.GetQuestionTemplateRequest) (dto.QuestionTemplateDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetTemplate").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	subTopic, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+		filter.SubTopics(),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Sub topic not found")
+			return dto.QuestionTemplateDTO{}, httperrors.ErrSubTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find sub topic")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	fields, err := template.Fields(ctx, s.db, subTopic.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get question template fields")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	log.Debug().Msg("Question template fetched successfully")
+
+	return templateToDTO(subTopic, fields), nil
+}
+
+// SetTemplate replaces the question template of a sub topic. Existing posts
+// keep the values they were created with.
+func (s *Service) SetTemplate(ctx context.Context, request dto.SetQuestionTemplateRequest) (dto.QuestionTemplateDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "SetTemplate").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	subTopic, err := models.SubTopics(
+		models.SubTopicWhere.ID.EQ(request.ID),
+		models.SubTopicWhere.TopicID.EQ(request.TopicID),
+		models.SubTopicWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Sub topic not found")
+			return dto.QuestionTemplateDTO{}, httperrors.ErrSubTopicNotFound
+		}
+
+		log.Error().Err(err).Msg("Failed to find sub topic")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	if details := template.ValidateDefinition(request.Fields); len(details) > 0 {
+		log.Debug().Int("invalidFields", len(details)).Msg("Question template is invalid")
+		return dto.QuestionTemplateDTO{}, httperrors.NewHTTPValidationError(
+			http.StatusBadRequest,
+			httperrors.HTTPErrorTypeGeneric,
+			"Question template validation failed",
+			details,
+		)
+	}
+
+	fields := make(models.QuestionTemplateFieldSlice, len(request.Fields))
+	for i, field := range request.Fields {
+		fields[i] = &models.QuestionTemplateField{
+			SubTopicID: subTopic.ID,
+			Name:       field.Name,
+			Label:      field.Label,
+			Type:       field.Type,
+			Required:   field.Required,
+			Pattern:    null.StringFromPtr(field.Pattern),
+			MinValue:   null.Float64FromPtr(field.Min),
+			MaxValue:   null.Float64FromPtr(field.Max),
+			Options:    field.Options,
+			Position:   i,
+			TenantID:   tenantID,
+		}
+	}
+
+	subTopic.TemplateBody = request.Body
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := subTopic.Update(ctx, ce, boil.Whitelist(models.SubTopicColumns.TemplateBody)); err != nil {
+			return err
+		}
+
+		if _, err := subTopic.QuestionTemplateFields().DeleteAll(ctx, ce); err != nil {
+			return err
+		}
+
+		for _, field := range fields {
+			if err := field.Insert(ctx, ce, boil.Infer()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to set question template")
+		return dto.QuestionTemplateDTO{}, err
+	}
+
+	log.Debug().Msg("Question template updated successfully")
+
+	return templateToDTO(subTopic, fields), nil
+}
+
+var errInvalidOrder = errors.New("ordered ids do not match the siblings")
+
+func (s *Service) setPositions(ctx context.Context, siblings models.CategorySlice, categoryIDs []int64) error {
+	return db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		ordered, err := category.SetPositions(ctx, ce, siblings, categoryIDs)
+		if err != nil {
+			return err
+		}
+
+		if !ordered {
+			return errInvalidOrder
+		}
+
+		return nil
+	})
+}
+
+// mapIDs translates topic or sub topic ids to the ids of their categories.
+func mapIDs(categoryIDs map[int64]int64, ids []int64) ([]int64, bool) {
+	mapped := make([]int64, len(ids))
+	for i, id := range ids {
+		categoryID, ok := categoryIDs[id]
+		if !ok {
+			return nil, false
+		}
+		mapped[i] = categoryID
+	}
+
+	return mapped, true
+}
+
+func topicToDTO(topic *models.Topic) dto.TopicDTO {
+	return dto.TopicDTO{
+		ID:          topic.ID,
+		Name:        topic.Name,
+		Description: topic.Description,
+		Icon:        topic.Icon.Ptr(),
+		Color:       topic.Color.Ptr(),
+		Archived:    topic.Archived,
+	}
+}
+
+// optionalString treats an empty string as clearing the value.
+func optionalString(value *string) null.String {
+	if value == nil || *value == "" {
+		return null.String{}
+	}
+
+	return null.StringFrom(*value)
+}
+
+// findAccessList loads the roles and claims of an access list, all of which
+// must belong to the tenant.
+func (s *Service) findAccessList(ctx context.Context, tenantID int64, roleIDs []int64, claimIDs []int64) (models.RoleSlice, models.ClaimSlice, error) {
+	log := util.LogFromContext(ctx)
+
+	var roles models.RoleSlice
+	if len(roleIDs) > 0 {
+		var err error
+		roles, err = models.Roles(
+			models.RoleWhere.ID.IN(roleIDs),
+			models.RoleWhere.TenantID.EQ(tenantID),
+		).All(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get access list roles")
+			return nil, nil, err
+		}
+	}
+
+	if len(roles) != len(roleIDs) {
+		log.Debug().Ints64("roleIds", roleIDs).Msg("Some roles were not found")
+		return nil, nil, httperrors.ErrRoleNotFound
+	}
+
+	var claims models.ClaimSlice
+	if len(claimIDs) > 0 {
+		var err error
+		claims, err = models.Claims(
+			models.ClaimWhere.ID.IN(claimIDs),
+			models.ClaimWhere.TenantID.EQ(tenantID),
+		).All(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get access list claims")
+			return nil, nil, err
+		}
+	}
+
+	if len(claims) != len(claimIDs) {
+		log.Debug().Ints64("claimIds", claimIDs).Msg("Some claims were not found")
+		return nil, nil, httperrors.ErrClaimNotFound
+	}
+
+	return roles, claims, nil
+}
+
+func accessToDTO(roles models.RoleSlice, claims models.ClaimSlice) dto.TopicAccessDTO {
+	list := dto.TopicAccessDTO{
+		RoleIDs:  make([]int64, len(roles)),
+		ClaimIDs: make([]int64, len(claims)),
+	}
+	for i, role := range roles {
+		list.RoleIDs[i] = role.ID
+	}
+	for i, claim := range claims {
+		list.ClaimIDs[i] = claim.ID
+	}
+
+	return list
+}
+
+// requireManageModerators checks that the user of the request may assign
+// moderators.
+func (s *Service) requireManageModerators(ctx context.Context, tenantID int64) error {
+	log := util.LogFromContext(ctx)
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return err
+	}
+
+	allowed, err := permission.HasClaim(ctx, s.db, tenantID, userID, permission.ClaimManageModerators)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check moderator management claim")
+		return err
+	}
+
+	if !allowed {
+		log.Debug().Int64("userId", userID).Msg("User may not manage moderators")
+		return httperrors.ErrForbidden
+	}
+
+	return nil
+}
+
+// replaceModerators replaces the moderators matching scope with the given
+// users, scoping each new row through setScope.
+func (s *Service) replaceModerators(ctx context.Context, tenantID int64, userIDs []int64, scope qm.QueryMod, setScope func(*models.TopicModerator)) (models.UserSlice, error) {
+	log := util.LogFromContext(ctx)
+
+	var users models.UserSlice
+	if len(userIDs) > 0 {
+		var err error
+		users, err = models.Users(
+			models.UserWhere.ID.IN(userIDs),
+			models.UserWhere.TenantID.EQ(tenantID),
+			qm.Load(models.UserRels.Role),
+		).All(ctx, s.db)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get moderator users")
+			return nil, err
+		}
+	}
+
+	if len(users) != len(userIDs) {
+		log.Debug().Ints64("userIds", userIDs).Msg("Some moderators were not found")
+		return nil, httperrors.ErrUserNotFound
+	}
+
+	err := db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := models.TopicModerators(scope).DeleteAll(ctx, ce); err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			moderator := models.TopicModerator{
+				UserID:   user.ID,
+				TenantID: tenantID,
+			}
+			setScope(&moderator)
+
+			if err := moderator.Insert(ctx, ce, boil.Infer()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to replace moderators")
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// moderatorUsers returns the users of the moderator rows matching scope.
+func moderatorUsers(ctx context.Context, exec boil.ContextExecutor, scope qm.QueryMod) (models.UserSlice, error) {
+	return models.Users(
+		qm.InnerJoin("topic_moderators ON topic_moderators.user_id = users.id"),
+		scope,
+		qm.Load(models.UserRels.Role),
+		qm.OrderBy(models.UserTableColumns.Name),
+	).All(ctx, exec)
+}
+
+func usersToDTO(users models.UserSlice) []dto.UserDTO {
+	userDTOs := make([]dto.UserDTO, len(users))
+	for i, user := range users {
+		userDTOs[i] = dto.UserDTO{
+			ID:         user.ID,
+			Name:       user.Name,
+			Email:      user.Email,
+			VscAccount: user.VSCAccount,
+			RoleDTO: dto.RoleDTO{
+				ID:   user.R.Role.ID,
+				Name: user.R.Role.Name,
+			},
+		}
+	}
+
+	return userDTOs
+}
+
+func templateToDTO(subTopic *models.SubTopic, fields models.QuestionTemplateFieldSlice) dto.QuestionTemplateDTO {
+	fieldDTOs := make([]dto.QuestionTemplateFieldDTO, len(fields))
+	for i, field := range fields {
+		options := []string(field.Options)
+		if options == nil {
+			options = []string{}
+		}
+
+		fieldDTOs[i] = dto.QuestionTemplateFieldDTO{
+			Name:     field.Name,
+			Label:    field.Label,
+			Type:     field.Type,
+			Required: field.Required,
+			Pattern:  field.Pattern.Ptr(),
+			Min:      field.MinValue.Ptr(),
+			Max:      field.MaxValue.Ptr(),
+			Options:  options,
+		}
+	}
+
+	return dto.QuestionTemplateDTO{
+		Body:   subTopic.TemplateBody,
+		Fields: fieldDTOs,
+	}
+}
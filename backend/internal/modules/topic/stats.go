@@ -0,0 +1,81 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	"cuhara.qua.go/internal/data/dto"
+	"cuhara.qua.go/internal/modules/access"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/lib/pq"
+)
+
+type statsRow struct {
+	ID               int64     `boil:"id"`
+	PostCount        int       `boil:"post_count"`
+	UnansweredCount  int       `boil:"unanswered_count"`
+	LatestActivityAt null.Time `boil:"latest_activity_at"`
+}
+
+// postStatsSQL counts the posts of a tenant grouped by either the topic or
+// the sub topic column, leaving out the posts of hidden sub topics. A post is unanswered until it has an answer and its
+// latest activity is its last edit or its newest answer.
+const postStatsSQL = `
+	SELECT %s AS id,
+		COUNT(*) AS post_count,
+		COUNT(*) FILTER (WHERE p.last_answer_at IS NULL) AS unanswered_count,
+		MAX(GREATEST(p.created_at, p.updated_at, p.last_answer_at)) AS latest_activity_at
+	FROM (
+		SELECT posts.subtopic_id, posts.created_at, posts.updated_at,
+			(SELECT MAX(a.created_at) FROM answers a WHERE a.post_id = posts.id) AS last_answer_at
+		FROM posts
+		WHERE posts.tenant_id = $1 AND posts.merged_into_id IS NULL AND NOT posts.subtopic_id = ANY($2)
+	) p
+	JOIN sub_topics st ON st.id = p.subtopic_id
+	GROUP BY %s`
+
+// topicStats returns the post statistics of every topic of the tenant that has
+// visible posts, keyed by topic id.
+func topicStats(ctx context.Context, exec boil.ContextExecutor, tenantID int64, filter *access.Filter) (map[int64]*dto.TopicStatsDTO, error) {
+	return postStats(ctx, exec, tenantID, filter, "st.topic_id")
+}
+
+// subTopicStats returns the post statistics of every sub topic of the tenant
+// that has visible posts, keyed by sub topic id.
+func subTopicStats(ctx context.Context, exec boil.ContextExecutor, tenantID int64, filter *access.Filter) (map[int64]*dto.TopicStatsDTO, error) {
+	return postStats(ctx, exec, tenantID, filter, "st.id")
+}
+
+func postStats(ctx context.Context, exec boil.ContextExecutor, tenantID int64, filter *access.Filter, groupBy string) (map[int64]*dto.TopicStatsDTO, error) {
+	var rows []statsRow
+	err := queries.Raw(
+		fmt.Sprintf(postStatsSQL, groupBy, groupBy),
+		tenantID, pq.Array(filter.HiddenSubTopicIDs()),
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[int64]*dto.TopicStatsDTO, len(rows))
+	for _, row := range rows {
+		stats[row.ID] = &dto.TopicStatsDTO{
+			PostCount:        row.PostCount,
+			UnansweredCount:  row.UnansweredCount,
+			LatestActivityAt: row.LatestActivityAt.Ptr(),
+		}
+	}
+
+	return stats, nil
+}
+
+// statsOrEmpty returns the statistics of id, or zero counts when it has no
+// posts yet.
+func statsOrEmpty(stats map[int64]*dto.TopicStatsDTO, id int64) *dto.TopicStatsDTO {
+	if s, ok := stats[id]; ok {
+		return s
+	}
+
+	return &dto.TopicStatsDTO{}
+}
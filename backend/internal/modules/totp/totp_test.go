@@ -0,0 +1,65 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 key of the RFC 6238 test vectors, base32 encoded.
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeMatchesRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+	}
+
+	key := []byte("12345678901234567890")
+	for _, tt := range tests {
+		if got := code(key, Step(time.Unix(tt.unix, 0)), 8); got != tt.want {
+			t.Errorf("code at %d is %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+
+	code, err := Code(rfcSecret, current-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step, ok := Validate(rfcSecret, code, now, 0)
+	if !ok || step != current-1 {
+		t.Fatalf("code of the previous step is rejected: step %d, ok %v", step, ok)
+	}
+	if _, ok := Validate(rfcSecret, code, now, step); ok {
+		t.Error("code of a used step is accepted again")
+	}
+	if _, ok := Validate(rfcSecret, code, now.Add(3*Period), 0); ok {
+		t.Error("code outside the skew is accepted")
+	}
+	if _, ok := Validate(rfcSecret, "12345", now, 0); ok {
+		t.Error("code of the wrong length is accepted")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("cuhara", "user@example.com", "ABC")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/cuhara:user@example.com?") {
+		t.Errorf("unexpected uri label: %s", uri)
+	}
+	if !strings.Contains(uri, "secret=ABC") || !strings.Contains(uri, "issuer=cuhara") {
+		t.Errorf("uri misses secret or issuer: %s", uri)
+	}
+}
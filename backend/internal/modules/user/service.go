@@ -9,20 +9,29 @@ import (
 	"cuhara.qua.go/internal/config"
 	"cuhara.qua.go/internal/data/dto"
 	"cuhara.qua.go/internal/models"
+	"cuhara.qua.go/internal/modules/access"
+	"cuhara.qua.go/internal/modules/expertise"
+	"cuhara.qua.go/internal/modules/lockout"
+	"cuhara.qua.go/internal/modules/permission"
+	"cuhara.qua.go/internal/modules/revocation"
 	"cuhara.qua.go/internal/util"
+	"cuhara.qua.go/internal/util/db"
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 )
 
 type Service struct {
-	db     *sql.DB
-	config config.Server
+	db          *sql.DB
+	config      config.Server
+	revocations *revocation.Store
 }
 
-func NewService(config config.Server, db *sql.DB) *Service {
+func NewService(config config.Server, db *sql.DB, revocations *revocation.Store) *Service {
 	return &Service{
-		config: config,
-		db:     db,
+		config:      config,
+		db:          db,
+		revocations: revocations,
 	}
 }
 
@@ -47,10 +56,11 @@ func (s *Service) GetAll(ctx context.Context) ([]dto.UserDTO, error) {
 	userDTOs := make([]dto.UserDTO, len(users))
 	for i, user := range users {
 		userDTOs[i] = dto.UserDTO{
-			ID:         user.ID,
-			Name:       user.Name,
-			Email:      user.Email,
-			VscAccount: user.VSCAccount,
+			ID:              user.ID,
+			Name:            user.Name,
+			Email:           user.Email,
+			VscAccount:      user.VSCAccount,
+			EmailVerifiedAt: user.EmailVerifiedAt.Ptr(),
 			RoleDTO: dto.RoleDTO{
 				ID:   user.R.Role.ID,
 				Name: user.R.Role.Name,
@@ -111,6 +121,8 @@ func (s *Service) Update(ctx context.Context, request dto.UpdateUserRequest) (dt
 
 		log.Debug().Str("email", *request.Email).Msg("Updating email")
 		user.Email = *request.Email
+		// The new email has not been verified by anyone yet.
+		user.EmailVerifiedAt = null.Time{}
 		changed = true
 	}
 
@@ -162,6 +174,7 @@ func (s *Service) Update(ctx context.Context, request dto.UpdateUserRequest) (dt
 	_, err = user.Update(ctx, s.db, boil.Whitelist(
 		models.UserColumns.Name,
 		models.UserColumns.Email,
+		models.UserColumns.EmailVerifiedAt,
 		models.UserColumns.VSCAccount,
 		models.UserColumns.RoleID,
 	))
@@ -183,7 +196,7 @@ func (s *Service) Delete(ctx context.Context, request dto.DeleteUserRequest) (dt
 		log.Error().Err(err).Msg("Failed to get tenant id from context")
 		return dto.DeleteUserResponse{}, err
 	}
-	
+
 	user, err := models.Users(
 		models.UserWhere.ID.EQ(request.ID),
 		models.UserWhere.TenantID.EQ(tenantID),
@@ -198,13 +211,243 @@ func (s *Service) Delete(ctx context.Context, request dto.DeleteUserRequest) (dt
 		return dto.DeleteUserResponse{}, err
 	}
 
-	_, err = user.Delete(ctx, s.db)
+	// Tokens of the deleted user must stop working right away.
+	var mirrorRevocation func()
+	err = db.WithTransaction(ctx, s.db, func(ce boil.ContextExecutor) error {
+		if _, err := user.Delete(ctx, ce); err != nil {
+			return err
+		}
+
+		mirrorRevocation, err = s.revocations.RevokeUser(ctx, ce, user.ID)
+		return err
+	})
 	if err != nil {
 		log.Err(err).Msg("Failed to delete user")
 		return dto.DeleteUserResponse{}, err
 	}
+	mirrorRevocation()
 
 	log.Debug().Msg("User deleted successfully")
 
 	return dto.DeleteUserResponse(request), nil
 }
+
+func (s *Service) GetExpertise(ctx context.Context, request dto.GetUserExpertiseRequest) ([]dto.TagExpertiseDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetExpertise").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	exists, err := models.Users(
+		models.UserWhere.ID.EQ(request.ID),
+		models.UserWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether user exists")
+		return nil, err
+	}
+
+	if !exists {
+		log.Debug().Int64("id", request.ID).Msg("User not found")
+		return nil, httperrors.ErrUserNotFound
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	tagExpertise, err := expertise.ForUser(ctx, s.db, tenantID, request.ID, filter.HiddenSubTopicIDs())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to compute user expertise")
+		return nil, err
+	}
+
+	log.Debug().Msg("User expertise fetched successfully")
+
+	return tagExpertise, nil
+}
+
+// GetModeratedScopes returns the topics and sub topics the user moderates,
+// leaving out the ones hidden from the user of the request.
+func (s *Service) GetModeratedScopes(ctx context.Context, request dto.GetModeratedScopesRequest) ([]dto.ModeratedScopeDTO, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "GetModeratedScopes").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return nil, err
+	}
+
+	exists, err := models.Users(
+		models.UserWhere.ID.EQ(request.ID),
+		models.UserWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check whether user exists")
+		return nil, err
+	}
+
+	if !exists {
+		log.Debug().Int64("id", request.ID).Msg("User not found")
+		return nil, httperrors.ErrUserNotFound
+	}
+
+	filter, err := access.FromContext(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve topic access")
+		return nil, err
+	}
+
+	moderators, err := models.TopicModerators(
+		models.TopicModeratorWhere.UserID.EQ(request.ID),
+		models.TopicModeratorWhere.TenantID.EQ(tenantID),
+		qm.Load(models.TopicModeratorRels.Topic),
+		qm.Load(models.TopicModeratorRels.SubTopic),
+		qm.OrderBy(models.TopicModeratorColumns.CreatedAt+" ASC, "+models.TopicModeratorColumns.ID+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get moderated scopes")
+		return nil, err
+	}
+
+	scopes := make([]dto.ModeratedScopeDTO, 0, len(moderators))
+	for _, moderator := range moderators {
+		scope := dto.ModeratedScopeDTO{
+			ID:         moderator.ID,
+			TopicID:    moderator.TopicID.Ptr(),
+			SubTopicID: moderator.SubTopicID.Ptr(),
+		}
+
+		if moderator.TopicID.Valid {
+			if !filter.TopicVisible(moderator.TopicID.Int64) {
+				continue
+			}
+			scope.Name = moderator.R.Topic.Name
+		} else {
+			if !filter.SubTopicVisible(moderator.SubTopicID.Int64) {
+				continue
+			}
+			scope.Name = moderator.R.SubTopic.Name
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	log.Debug().Msg("Moderated scopes fetched successfully")
+
+	return scopes, nil
+}
+
+// RevokeSessions revokes every access and refresh token of a user. Users may
+// end their own sessions, ending those of others needs the revoke sessions
+// claim.
+func (s *Service) RevokeSessions(ctx context.Context, request dto.RevokeSessionsRequest) (dto.RevokeSessionsResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "RevokeSessions").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.RevokeSessionsResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.RevokeSessionsResponse{}, err
+	}
+
+	if request.ID != userID {
+		allowed, err := permission.HasClaim(ctx, s.db, tenantID, userID, permission.ClaimRevokeSessions)
+		if err != nil {
+			log.Err(err).Msg("Failed to check revoke sessions claim")
+			return dto.RevokeSessionsResponse{}, err
+		}
+
+		if !allowed {
+			log.Debug().Int64("userId", userID).Msg("User may not revoke sessions of other users")
+			return dto.RevokeSessionsResponse{}, httperrors.ErrForbidden
+		}
+	}
+
+	exists, err := models.Users(
+		models.UserWhere.ID.EQ(request.ID),
+		models.UserWhere.TenantID.EQ(tenantID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		log.Err(err).Msg("Failed to check whether user exists")
+		return dto.RevokeSessionsResponse{}, err
+	}
+
+	if !exists {
+		log.Debug().Msg("User not found")
+		return dto.RevokeSessionsResponse{}, httperrors.ErrUserNotFound
+	}
+
+	mirrorRevocation, err := s.revocations.RevokeUser(ctx, s.db, request.ID)
+	if err != nil {
+		log.Err(err).Msg("Failed to revoke sessions")
+		return dto.RevokeSessionsResponse{}, err
+	}
+	mirrorRevocation()
+
+	log.Debug().Msg("Sessions revoked successfully")
+
+	return dto.RevokeSessionsResponse{ID: request.ID}, nil
+}
+
+// UnlockUser lifts the login lockout of the user and forgets its failed
+// logins. Locks of client IPs stay.
+func (s *Service) UnlockUser(ctx context.Context, request dto.UnlockUserRequest) (dto.UnlockUserResponse, error) {
+	log := util.LogFromContext(ctx).With().Str("function", "UnlockUser").Logger()
+
+	tenantID, err := util.TenantIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get tenant id from context")
+		return dto.UnlockUserResponse{}, err
+	}
+
+	userID, err := util.UserIDFromContext(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get user id from context")
+		return dto.UnlockUserResponse{}, err
+	}
+
+	allowed, err := permission.HasClaim(ctx, s.db, tenantID, userID, permission.ClaimUnlockUsers)
+	if err != nil {
+		log.Err(err).Msg("Failed to check unlock users claim")
+		return dto.UnlockUserResponse{}, err
+	}
+
+	if !allowed {
+		log.Debug().Int64("userId", userID).Msg("User may not unlock users")
+		return dto.UnlockUserResponse{}, httperrors.ErrForbidden
+	}
+
+	user, err := models.Users(
+		models.UserWhere.ID.EQ(request.ID),
+		models.UserWhere.TenantID.EQ(tenantID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("User not found")
+			return dto.UnlockUserResponse{}, httperrors.ErrUserNotFound
+		}
+
+		log.Err(err).Msg("Failed to load user")
+		return dto.UnlockUserResponse{}, err
+	}
+
+	if err := lockout.Reset(ctx, s.db, lockout.Account(user.Email)); err != nil {
+		log.Err(err).Msg("Failed to unlock user")
+		return dto.UnlockUserResponse{}, err
+	}
+
+	log.Debug().Msg("User unlocked successfully")
+
+	return dto.UnlockUserResponse{ID: request.ID}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -21,6 +22,52 @@ const (
 	TenantAuthScopes = "TenantAuth.Scopes"
 )
 
+// AnswerResponse defines model for answerResponse.
+type AnswerResponse struct {
+	Accepted  *bool               `json:"accepted,omitempty"`
+	Body      *string             `json:"body,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	CreatorId *int64              `json:"creatorId,omitempty"`
+	Id        *int64              `json:"id,omitempty"`
+	PostId    *int64              `json:"postId,omitempty"`
+	Reactions *[]ReactionResponse `json:"reactions,omitempty"`
+	UpdatedAt *time.Time          `json:"updatedAt,omitempty"`
+	Version   *int                `json:"version,omitempty"`
+	Wiki      *bool               `json:"wiki,omitempty"`
+}
+
+// AssignPostRequest Exactly one of userId and roleId must be given
+type AssignPostRequest struct {
+	RoleId *int64 `json:"roleId,omitempty"`
+	UserId *int64 `json:"userId,omitempty"`
+}
+
+// AssignPostResponse defines model for assignPostResponse.
+type AssignPostResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// CategoryResponse defines model for categoryResponse.
+type CategoryResponse struct {
+	Children *[]CategoryResponse `json:"children,omitempty"`
+	Depth    *int                `json:"depth,omitempty"`
+	Id       *int64              `json:"id,omitempty"`
+	Name     *string             `json:"name,omitempty"`
+	ParentId *int64              `json:"parentId,omitempty"`
+
+	// Path Ids from the root down to the category joined by "/"
+	Path       *string `json:"path,omitempty"`
+	Position   *int    `json:"position,omitempty"`
+	SubTopicId *int64  `json:"subTopicId,omitempty"`
+	TopicId    *int64  `json:"topicId,omitempty"`
+}
+
+// ChangePasswordRequest defines model for changePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
 // ClaimResponse defines model for claimResponse.
 type ClaimResponse struct {
 	Description *string `json:"description,omitempty"`
@@ -28,6 +75,33 @@ type ClaimResponse struct {
 	Name        *string `json:"name,omitempty"`
 }
 
+// ClosePostRequest defines model for closePostRequest.
+type ClosePostRequest struct {
+	Closed bool    `json:"closed"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ClosePostResponse defines model for closePostResponse.
+type ClosePostResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// ConfirmTotpRequest defines model for confirmTotpRequest.
+type ConfirmTotpRequest struct {
+	Code string `json:"code"`
+}
+
+// CreateCategoryRequest defines model for createCategoryRequest.
+type CreateCategoryRequest struct {
+	Name     string `json:"name"`
+	ParentId *int64 `json:"parentId,omitempty"`
+}
+
+// CreateCategoryResponse defines model for createCategoryResponse.
+type CreateCategoryResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
 // CreateClaimRequest defines model for createClaimRequest.
 type CreateClaimRequest struct {
 	Description string `json:"description"`
@@ -39,6 +113,61 @@ type CreateClaimResponse struct {
 	Id *int64 `json:"id,omitempty"`
 }
 
+// CreateCustomFieldRequest defines model for createCustomFieldRequest.
+type CreateCustomFieldRequest struct {
+	Label string `json:"label"`
+	Name  string `json:"name"`
+
+	// Options Allowed values of enum fields
+	Options *[]string `json:"options,omitempty"`
+
+	// Position Appended when omitted
+	Position *int  `json:"position,omitempty"`
+	Required *bool `json:"required,omitempty"`
+
+	// Type One of STRING, NUMBER, ENUM, DATE and USER
+	Type string `json:"type"`
+}
+
+// CreateCustomFieldResponse defines model for createCustomFieldResponse.
+type CreateCustomFieldResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// CreatePollRequest defines model for createPollRequest.
+type CreatePollRequest struct {
+	ClosesAt       *time.Time `json:"closesAt,omitempty"`
+	MultipleChoice *bool      `json:"multipleChoice,omitempty"`
+	Options        []string   `json:"options"`
+	Question       string     `json:"question"`
+
+	// ResultsVisibility ALWAYS (default), AFTER_VOTE or AFTER_CLOSE
+	ResultsVisibility *string `json:"resultsVisibility,omitempty"`
+}
+
+// CreatePostRequest defines model for createPostRequest.
+type CreatePostRequest struct {
+	// Anonymous Hide the creator, only allowed when the tenant allows anonymous posts
+	Anonymous *bool  `json:"anonymous,omitempty"`
+	Body      string `json:"body"`
+
+	// CustomFields Values of the custom fields of the tenant
+	CustomFields *map[string]interface{} `json:"customFields,omitempty"`
+
+	// Fields Values of the question template fields of the sub topic
+	Fields     *map[string]interface{} `json:"fields,omitempty"`
+	Poll       *CreatePollRequest      `json:"poll,omitempty"`
+	SubTopicId int64                   `json:"subTopicId"`
+	Tags       *[]string               `json:"tags,omitempty"`
+	Title      string                  `json:"title"`
+}
+
+// CreatePostResponse defines model for createPostResponse.
+type CreatePostResponse struct {
+	Id               *int64            `json:"id,omitempty"`
+	SuggestedExperts *[]ExpertResponse `json:"suggestedExperts,omitempty"`
+}
+
 // CreateRoleRequest defines model for createRoleRequest.
 type CreateRoleRequest struct {
 	Name string `json:"name"`
@@ -51,7 +180,13 @@ type CreateRoleResponse struct {
 
 // CreateSubTopicRequest defines model for createSubTopicRequest.
 type CreateSubTopicRequest struct {
-	Name string `json:"name"`
+	Color *string `json:"color,omitempty"`
+
+	// Description Markdown description
+	Description             *string `json:"description,omitempty"`
+	FirstReplyTargetMinutes *int    `json:"firstReplyTargetMinutes,omitempty"`
+	Icon                    *string `json:"icon,omitempty"`
+	Name                    string  `json:"name"`
 }
 
 // CreateSubTopicResponse defines model for createSubTopicResponse.
@@ -71,7 +206,12 @@ type CreateTenantResponse struct {
 
 // CreateTopicRequest defines model for createTopicRequest.
 type CreateTopicRequest struct {
-	Name string `json:"name"`
+	Color *string `json:"color,omitempty"`
+
+	// Description Markdown description
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	Name        string  `json:"name"`
 }
 
 // CreateTopicResponse defines model for createTopicResponse.
@@ -79,11 +219,44 @@ type CreateTopicResponse struct {
 	Id *int64 `json:"id,omitempty"`
 }
 
+// CustomFieldResponse defines model for customFieldResponse.
+type CustomFieldResponse struct {
+	Id       *int64    `json:"id,omitempty"`
+	Label    *string   `json:"label,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+
+	// Type One of STRING, NUMBER, ENUM, DATE and USER
+	Type *string `json:"type,omitempty"`
+}
+
+// DeleteCategoryResponse defines model for deleteCategoryResponse.
+type DeleteCategoryResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
 // DeleteClaimResponse defines model for deleteClaimResponse.
 type DeleteClaimResponse struct {
 	Id *int64 `json:"id,omitempty" validate:"gte=0"`
 }
 
+// DeleteCustomFieldResponse defines model for deleteCustomFieldResponse.
+type DeleteCustomFieldResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// DeletePasskeyResponse defines model for deletePasskeyResponse.
+type DeletePasskeyResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// DeletePostResponse defines model for deletePostResponse.
+type DeletePostResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
 // DeleteRoleResponse defines model for deleteRoleResponse.
 type DeleteRoleResponse struct {
 	Id *int64 `json:"id,omitempty"`
@@ -112,6 +285,42 @@ type DeleteUserResponse struct {
 	Id *int64 `json:"id,omitempty"`
 }
 
+// DiffLineResponse defines model for diffLineResponse.
+type DiffLineResponse struct {
+	// Op EQUAL, INSERT or DELETE
+	Op   *string `json:"op,omitempty"`
+	Text *string `json:"text,omitempty"`
+}
+
+// DisableTotpRequest defines model for disableTotpRequest.
+type DisableTotpRequest struct {
+	Code         *string `json:"code,omitempty"`
+	Password     string  `json:"password"`
+	RecoveryCode *string `json:"recoveryCode,omitempty"`
+}
+
+// DisableTotpResponse defines model for disableTotpResponse.
+type DisableTotpResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// ExpertResponse defines model for expertResponse.
+type ExpertResponse struct {
+	Name   *string `json:"name,omitempty"`
+	Score  *int64  `json:"score,omitempty"`
+	UserId *int64  `json:"userId,omitempty"`
+}
+
+// ForgotPasswordRequest defines model for forgotPasswordRequest.
+type ForgotPasswordRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// ForgotPasswordResponse defines model for forgotPasswordResponse.
+type ForgotPasswordResponse struct {
+	Email *string `json:"email,omitempty"`
+}
+
 // HttpValidationErrorDetail defines model for httpValidationErrorDetail.
 type HttpValidationErrorDetail struct {
 	// Error Error describing field validation failure
@@ -124,6 +333,44 @@ type HttpValidationErrorDetail struct {
 	Key string `json:"key"`
 }
 
+// JsonWebKey defines model for jsonWebKey.
+type JsonWebKey struct {
+	// Alg RS256 or EdDSA
+	Alg string `json:"alg"`
+
+	// Crv Curve of OKP keys, Ed25519
+	Crv *string `json:"crv,omitempty"`
+
+	// E Exponent of RSA keys
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+
+	// Kty RSA or OKP
+	Kty string `json:"kty"`
+
+	// N Modulus of RSA keys
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+
+	// X Public key of OKP keys
+	X *string `json:"x,omitempty"`
+}
+
+// JwksResponse defines model for jwksResponse.
+type JwksResponse struct {
+	Keys []JsonWebKey `json:"keys"`
+}
+
+// LockPostRequest defines model for lockPostRequest.
+type LockPostRequest struct {
+	Locked bool `json:"locked"`
+}
+
+// LockPostResponse defines model for lockPostResponse.
+type LockPostResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
 // LoginRequest defines model for loginRequest.
 type LoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -132,9 +379,217 @@ type LoginRequest struct {
 
 // LoginResponse defines model for loginResponse.
 type LoginResponse struct {
+	// ExpiresIn Lifetime of the access token in seconds
+	ExpiresIn *int64 `json:"expiresIn,omitempty"`
+
+	// MfaRequired The password was correct but the login must be completed with a second factor
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken Token to complete the login with a second factor, valid for five minutes
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// RefreshToken Opaque refresh token, valid for a single refresh
+	RefreshToken *string `json:"refreshToken,omitempty"`
+
+	// Token Access token
 	Token *string `json:"token,omitempty"`
 }
 
+// LogoutRequest defines model for logoutRequest.
+type LogoutRequest struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// LogoutResponse defines model for logoutResponse.
+type LogoutResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// MergePostRequest defines model for mergePostRequest.
+type MergePostRequest struct {
+	TargetPostId int64 `json:"targetPostId"`
+}
+
+// MergePostResponse defines model for mergePostResponse.
+type MergePostResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// ModeratedScopeResponse defines model for moderatedScopeResponse.
+type ModeratedScopeResponse struct {
+	Id   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// SubTopicId Set when the user moderates a sub topic
+	SubTopicId *int64 `json:"subTopicId,omitempty"`
+
+	// TopicId Set when the user moderates a whole topic
+	TopicId *int64 `json:"topicId,omitempty"`
+}
+
+// MoveCategoryRequest defines model for moveCategoryRequest.
+type MoveCategoryRequest struct {
+	ParentId int64 `json:"parentId"`
+
+	// Position Position among the new siblings, appended when omitted
+	Position *int `json:"position,omitempty"`
+}
+
+// MoveCategoryResponse defines model for moveCategoryResponse.
+type MoveCategoryResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// MovePostRequest defines model for movePostRequest.
+type MovePostRequest struct {
+	// SubTopicId Destination sub topic
+	SubTopicId int64 `json:"subTopicId"`
+}
+
+// MovePostResponse defines model for movePostResponse.
+type MovePostResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// MovePostsRequest At least one of postIds, fromSubTopicId, fromTopicId and tag must be given. Given filters are combined
+type MovePostsRequest struct {
+	FromSubTopicId *int64   `json:"fromSubTopicId,omitempty"`
+	FromTopicId    *int64   `json:"fromTopicId,omitempty"`
+	PostIds        *[]int64 `json:"postIds,omitempty"`
+
+	// SubTopicId Destination sub topic
+	SubTopicId int64   `json:"subTopicId"`
+	Tag        *string `json:"tag,omitempty"`
+}
+
+// MovePostsResponse defines model for movePostsResponse.
+type MovePostsResponse struct {
+	MovedCount *int64 `json:"movedCount,omitempty"`
+}
+
+// NotificationResponse defines model for notificationResponse.
+type NotificationResponse struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Id        *int64     `json:"id,omitempty"`
+	Message   *string    `json:"message,omitempty"`
+	PostId    *int64     `json:"postId,omitempty"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+	Type      *string    `json:"type,omitempty"`
+}
+
+// OidcCallbackRequest defines model for oidcCallbackRequest.
+type OidcCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// OidcProviderResponse defines model for oidcProviderResponse.
+type OidcProviderResponse struct {
+	ClientId        *string           `json:"clientId,omitempty"`
+	ClientSecretSet *bool             `json:"clientSecretSet,omitempty"`
+	DefaultRoleId   *int64            `json:"defaultRoleId,omitempty"`
+	Enabled         *bool             `json:"enabled,omitempty"`
+	Issuer          *string           `json:"issuer,omitempty"`
+	RoleClaim       *string           `json:"roleClaim,omitempty"`
+	RoleMappings    *[]SsoRoleMapping `json:"roleMappings,omitempty"`
+	Scopes          *[]string         `json:"scopes,omitempty"`
+	TenantId        *int64            `json:"tenantId,omitempty"`
+}
+
+// PasskeyLoginRequest defines model for passkeyLoginRequest.
+type PasskeyLoginRequest struct {
+	CeremonyId string `json:"ceremonyId"`
+
+	// Credential The PublicKeyCredential returned by navigator.credentials.get, serialized to JSON
+	Credential map[string]interface{} `json:"credential"`
+}
+
+// PasskeyOptionsResponse defines model for passkeyOptionsResponse.
+type PasskeyOptionsResponse struct {
+	CeremonyId *string `json:"ceremonyId,omitempty"`
+
+	// Options Options to pass to the WebAuthn API of the browser
+	Options *map[string]interface{} `json:"options,omitempty"`
+}
+
+// PasskeyResponse defines model for passkeyResponse.
+type PasskeyResponse struct {
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	Id         *int64     `json:"id,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+}
+
+// PollOptionResponse defines model for pollOptionResponse.
+type PollOptionResponse struct {
+	Id    *int64  `json:"id,omitempty"`
+	Label *string `json:"label,omitempty"`
+	Voted *bool   `json:"voted,omitempty"`
+
+	// Votes Unset while the results are hidden from the user
+	Votes *int `json:"votes,omitempty"`
+}
+
+// PollResponse defines model for pollResponse.
+type PollResponse struct {
+	Closed            *bool                 `json:"closed,omitempty"`
+	ClosesAt          *time.Time            `json:"closesAt,omitempty"`
+	Id                *int64                `json:"id,omitempty"`
+	MultipleChoice    *bool                 `json:"multipleChoice,omitempty"`
+	Options           *[]PollOptionResponse `json:"options,omitempty"`
+	Question          *string               `json:"question,omitempty"`
+	ResultsVisibility *string               `json:"resultsVisibility,omitempty"`
+	ResultsVisible    *bool                 `json:"resultsVisible,omitempty"`
+	Voted             *bool                 `json:"voted,omitempty"`
+
+	// VoterCount Unset while the results are hidden from the user
+	VoterCount *int `json:"voterCount,omitempty"`
+}
+
+// PostHistoryResponse defines model for postHistoryResponse.
+type PostHistoryResponse struct {
+	Action *string `json:"action,omitempty"`
+
+	// ActorId Unset when the actor is the hidden author of an anonymous post
+	ActorId   *int64                  `json:"actorId,omitempty"`
+	CreatedAt *time.Time              `json:"createdAt,omitempty"`
+	Data      *map[string]interface{} `json:"data,omitempty"`
+	Id        *int64                  `json:"id,omitempty"`
+	PostId    *int64                  `json:"postId,omitempty"`
+}
+
+// PostResponse defines model for postResponse.
+type PostResponse struct {
+	Anonymous      *bool      `json:"anonymous,omitempty"`
+	AssignedAt     *time.Time `json:"assignedAt,omitempty"`
+	AssigneeRoleId *int64     `json:"assigneeRoleId,omitempty"`
+	AssigneeUserId *int64     `json:"assigneeUserId,omitempty"`
+	Body           *string    `json:"body,omitempty"`
+	ClosedAt       *time.Time `json:"closedAt,omitempty"`
+	CreatedAt      *time.Time `json:"createdAt,omitempty"`
+
+	// CreatorId Unset for anonymous posts
+	CreatorId *int64 `json:"creatorId,omitempty"`
+
+	// CustomFields Values of the custom fields of the tenant
+	CustomFields *map[string]interface{} `json:"customFields,omitempty"`
+
+	// Fields Values of the question template fields of the sub topic
+	Fields   *map[string]interface{} `json:"fields,omitempty"`
+	Id       *int64                  `json:"id,omitempty"`
+	LockedAt *time.Time              `json:"lockedAt,omitempty"`
+
+	// MergedIntoId Post this post was merged into
+	MergedIntoId *int64              `json:"mergedIntoId,omitempty"`
+	Poll         *PollResponse       `json:"poll,omitempty"`
+	Reactions    *[]ReactionResponse `json:"reactions,omitempty"`
+	SubTopic     *SubTopicResponse   `json:"subTopic,omitempty"`
+	Tags         *[]string           `json:"tags,omitempty"`
+	Title        *string             `json:"title,omitempty"`
+	Version      *int                `json:"version,omitempty"`
+	Wiki         *bool               `json:"wiki,omitempty"`
+}
+
 // PublicHttpError defines model for publicHttpError.
 type PublicHttpError struct {
 	// Detail More detailed, human-readable, optional explanation of the error
@@ -168,6 +623,75 @@ type PublicHttpValidationError struct {
 	ValidationErrors []HttpValidationErrorDetail `json:"validationErrors"`
 }
 
+// QuestionTemplateField defines model for questionTemplateField.
+type QuestionTemplateField struct {
+	Label string `json:"label"`
+
+	// Max Maximum length of text values or maximum number
+	Max *float64 `json:"max,omitempty"`
+
+	// Min Minimum length of text values or minimum number
+	Min  *float64 `json:"min,omitempty"`
+	Name string   `json:"name"`
+
+	// Options Allowed values of select fields
+	Options *[]string `json:"options,omitempty"`
+
+	// Pattern Regular expression text values must match
+	Pattern  *string `json:"pattern,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+
+	// Type One of TEXT, NUMBER, BOOLEAN or SELECT
+	Type string `json:"type"`
+}
+
+// QuestionTemplateResponse defines model for questionTemplateResponse.
+type QuestionTemplateResponse struct {
+	Body   *string                  `json:"body,omitempty"`
+	Fields *[]QuestionTemplateField `json:"fields,omitempty"`
+}
+
+// ReactRequest defines model for reactRequest.
+type ReactRequest struct {
+	Emoji string `json:"emoji"`
+
+	// Reacted Add the reaction when true, remove it when false
+	Reacted bool `json:"reacted"`
+}
+
+// ReactionResponse defines model for reactionResponse.
+type ReactionResponse struct {
+	Count *int    `json:"count,omitempty"`
+	Emoji *string `json:"emoji,omitempty"`
+
+	// Reacted Whether the current user reacted with the emoji
+	Reacted *bool `json:"reacted,omitempty"`
+}
+
+// ReadNotificationResponse defines model for readNotificationResponse.
+type ReadNotificationResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// RecoveryCodesResponse defines model for recoveryCodesResponse.
+type RecoveryCodesResponse struct {
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+}
+
+// RefreshRequest defines model for refreshRequest.
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RegisterPasskeyRequest defines model for registerPasskeyRequest.
+type RegisterPasskeyRequest struct {
+	CeremonyId string `json:"ceremonyId"`
+
+	// Credential The PublicKeyCredential returned by navigator.credentials.create, serialized to JSON
+	Credential map[string]interface{} `json:"credential"`
+	Name       string                 `json:"name"`
+}
+
 // RegisterRequest defines model for registerRequest.
 type RegisterRequest struct {
 	Email    string `json:"email"`
@@ -184,31 +708,374 @@ type RegisterResponse struct {
 	Id *int64 `json:"id,omitempty"`
 }
 
+// ReorderCategoriesRequest defines model for reorderCategoriesRequest.
+type ReorderCategoriesRequest struct {
+	Ids      []int64 `json:"ids"`
+	ParentId *int64  `json:"parentId,omitempty"`
+}
+
+// ReorderCategoriesResponse defines model for reorderCategoriesResponse.
+type ReorderCategoriesResponse struct {
+	ParentId *int64 `json:"parentId,omitempty"`
+}
+
+// ReorderSubTopicsRequest defines model for reorderSubTopicsRequest.
+type ReorderSubTopicsRequest struct {
+	Ids []int64 `json:"ids"`
+}
+
+// ReorderSubTopicsResponse defines model for reorderSubTopicsResponse.
+type ReorderSubTopicsResponse struct {
+	Ids     *[]int64 `json:"ids,omitempty"`
+	TopicId *int64   `json:"topicId,omitempty"`
+}
+
+// ReorderTopicsRequest defines model for reorderTopicsRequest.
+type ReorderTopicsRequest struct {
+	Ids []int64 `json:"ids"`
+}
+
+// ReorderTopicsResponse defines model for reorderTopicsResponse.
+type ReorderTopicsResponse struct {
+	Ids *[]int64 `json:"ids,omitempty"`
+}
+
+// ResendVerificationResponse defines model for resendVerificationResponse.
+type ResendVerificationResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// ResetPasswordRequest defines model for resetPasswordRequest.
+type ResetPasswordRequest struct {
+	NewPassword string `json:"newPassword"`
+	Token       string `json:"token"`
+}
+
+// ResetPasswordResponse defines model for resetPasswordResponse.
+type ResetPasswordResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// ReviewSuggestedEditRequest defines model for reviewSuggestedEditRequest.
+type ReviewSuggestedEditRequest struct {
+	Approved bool `json:"approved"`
+
+	// Body Improved body applied instead of the suggested one
+	Body    *string `json:"body,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+
+	// Title Improved title applied instead of the suggested one
+	Title *string `json:"title,omitempty"`
+}
+
+// RevokeSessionsResponse defines model for revokeSessionsResponse.
+type RevokeSessionsResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
 // RoleResponse defines model for roleResponse.
 type RoleResponse struct {
 	Id   *int64  `json:"id,omitempty"`
 	Name *string `json:"name,omitempty"`
 }
 
+// SamlAssertionRequest defines model for samlAssertionRequest.
+type SamlAssertionRequest struct {
+	RelayState   string `json:"RelayState"`
+	SAMLResponse string `json:"SAMLResponse"`
+}
+
+// SamlProviderResponse defines model for samlProviderResponse.
+type SamlProviderResponse struct {
+	// AcsUrl Assertion consumer service URL of the service provider
+	AcsUrl         *string `json:"acsUrl,omitempty"`
+	DefaultRoleId  *int64  `json:"defaultRoleId,omitempty"`
+	EmailAttribute *string `json:"emailAttribute,omitempty"`
+	Enabled        *bool   `json:"enabled,omitempty"`
+
+	// EntityId Entity ID of the service provider, the URL of its metadata
+	EntityId          *string           `json:"entityId,omitempty"`
+	IdpCertificate    *string           `json:"idpCertificate,omitempty"`
+	IdpEntityId       *string           `json:"idpEntityId,omitempty"`
+	IdpSsoUrl         *string           `json:"idpSsoUrl,omitempty"`
+	LinkExistingUsers *bool             `json:"linkExistingUsers,omitempty"`
+	NameAttribute     *string           `json:"nameAttribute,omitempty"`
+	RoleAttribute     *string           `json:"roleAttribute,omitempty"`
+	RoleMappings      *[]SsoRoleMapping `json:"roleMappings,omitempty"`
+	TenantId          *int64            `json:"tenantId,omitempty"`
+	UsernameAttribute *string           `json:"usernameAttribute,omitempty"`
+}
+
+// SetModeratorsRequest defines model for setModeratorsRequest.
+type SetModeratorsRequest struct {
+	UserIds []int64 `json:"userIds"`
+}
+
+// SetOidcProviderRequest defines model for setOidcProviderRequest.
+type SetOidcProviderRequest struct {
+	ClientId string `json:"clientId"`
+
+	// ClientSecret Required when the provider is created, the stored secret is kept when left out
+	ClientSecret *string `json:"clientSecret,omitempty"`
+
+	// DefaultRoleId Role of users no mapping matches, users without a role cannot log in
+	DefaultRoleId *int64 `json:"defaultRoleId,omitempty"`
+	Enabled       bool   `json:"enabled"`
+
+	// Issuer Issuer URL of the provider, its discovery document is read from it
+	Issuer string `json:"issuer"`
+
+	// RoleClaim Claim of the ID token holding the roles or groups of the user
+	RoleClaim *string `json:"roleClaim,omitempty"`
+
+	// RoleMappings Roles given to users by the value of the role claim, the first match wins
+	RoleMappings *[]SsoRoleMapping `json:"roleMappings,omitempty"`
+
+	// Scopes Scopes to request, openid is always added
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
+// SetQuestionTemplateRequest defines model for setQuestionTemplateRequest.
+type SetQuestionTemplateRequest struct {
+	// Body Markdown new posts are prefilled with
+	Body   *string                 `json:"body,omitempty"`
+	Fields []QuestionTemplateField `json:"fields"`
+}
+
+// SetSamlProviderRequest defines model for setSamlProviderRequest.
+type SetSamlProviderRequest struct {
+	// DefaultRoleId Role of users no mapping matches, users without a role cannot log in
+	DefaultRoleId *int64 `json:"defaultRoleId,omitempty"`
+
+	// EmailAttribute Attribute holding the email of the user. When empty, the NameID is used if its format is emailAddress
+	EmailAttribute *string `json:"emailAttribute,omitempty"`
+	Enabled        bool    `json:"enabled"`
+
+	// IdpCertificate Certificate the identity provider signs with, in PEM or as base64 from its metadata
+	IdpCertificate string `json:"idpCertificate"`
+
+	// IdpEntityId Entity ID of the identity provider, the issuer of its responses
+	IdpEntityId string `json:"idpEntityId"`
+
+	// IdpSsoUrl Single sign-on URL of the identity provider for the HTTP-Redirect binding
+	IdpSsoUrl string `json:"idpSsoUrl"`
+
+	// LinkExistingUsers Link users of the tenant with the email the identity provider asserts on their first login. Only set this when the identity provider verifies the addresses of its users, otherwise existing users are turned away
+	LinkExistingUsers *bool   `json:"linkExistingUsers,omitempty"`
+	NameAttribute     *string `json:"nameAttribute,omitempty"`
+
+	// RoleAttribute Attribute holding the roles or groups of the user
+	RoleAttribute *string `json:"roleAttribute,omitempty"`
+
+	// RoleMappings Roles given to users by the values of the role attribute, the first match wins
+	RoleMappings      *[]SsoRoleMapping `json:"roleMappings,omitempty"`
+	UsernameAttribute *string           `json:"usernameAttribute,omitempty"`
+}
+
+// SetSubTopicRespondersRequest defines model for setSubTopicRespondersRequest.
+type SetSubTopicRespondersRequest struct {
+	UserIds []int64 `json:"userIds"`
+}
+
+// SetTopicAccessRequest defines model for setTopicAccessRequest.
+type SetTopicAccessRequest struct {
+	ClaimIds []int64 `json:"claimIds"`
+	RoleIds  []int64 `json:"roleIds"`
+}
+
+// SetWikiRequest defines model for setWikiRequest.
+type SetWikiRequest struct {
+	Wiki bool `json:"wiki"`
+}
+
+// SetWikiResponse defines model for setWikiResponse.
+type SetWikiResponse struct {
+	Id   *int64 `json:"id,omitempty"`
+	Wiki *bool  `json:"wiki,omitempty"`
+}
+
+// SsoAuthorizationResponse defines model for ssoAuthorizationResponse.
+type SsoAuthorizationResponse struct {
+	AuthorizationUrl *string `json:"authorizationUrl,omitempty"`
+}
+
+// SsoLoginCodeRequest defines model for ssoLoginCodeRequest.
+type SsoLoginCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// SsoRoleMapping defines model for ssoRoleMapping.
+type SsoRoleMapping struct {
+	RoleId int64 `json:"roleId"`
+
+	// Value Value of the role claim or attribute
+	Value string `json:"value"`
+}
+
+// StartSsoLoginRequest defines model for startSsoLoginRequest.
+type StartSsoLoginRequest struct {
+	TenantId int64 `json:"tenantId"`
+}
+
 // SubTopicResponse defines model for subTopicResponse.
 type SubTopicResponse struct {
-	Id    *int64         `json:"id,omitempty"`
-	Name  *string        `json:"name,omitempty"`
-	Topic *TopicResponse `json:"topic,omitempty"`
+	Archived                *bool               `json:"archived,omitempty"`
+	Color                   *string             `json:"color,omitempty"`
+	Description             *string             `json:"description,omitempty"`
+	FirstReplyTargetMinutes *int                `json:"firstReplyTargetMinutes,omitempty"`
+	Icon                    *string             `json:"icon,omitempty"`
+	Id                      *int64              `json:"id,omitempty"`
+	Name                    *string             `json:"name,omitempty"`
+	Position                *int                `json:"position,omitempty"`
+	Stats                   *TopicStatsResponse `json:"stats,omitempty"`
+	Topic                   *TopicResponse      `json:"topic,omitempty"`
+}
+
+// SuggestEditRequest defines model for suggestEditRequest.
+type SuggestEditRequest struct {
+	Body string `json:"body"`
+
+	// Comment Why the edit is proposed
+	Comment *string `json:"comment,omitempty"`
+
+	// Title Proposed title, only for posts
+	Title *string `json:"title,omitempty"`
+}
+
+// SuggestedEditDiffResponse defines model for suggestedEditDiffResponse.
+type SuggestedEditDiffResponse struct {
+	BaseVersion    *int                `json:"baseVersion,omitempty"`
+	Body           *[]DiffLineResponse `json:"body,omitempty"`
+	CurrentVersion *int                `json:"currentVersion,omitempty"`
+	Id             *int64              `json:"id,omitempty"`
+
+	// Outdated Set when the content changed since the suggestion was made
+	Outdated *bool               `json:"outdated,omitempty"`
+	Title    *[]DiffLineResponse `json:"title,omitempty"`
+}
+
+// SuggestedEditResponse defines model for suggestedEditResponse.
+type SuggestedEditResponse struct {
+	AnswerId      *int64     `json:"answerId,omitempty"`
+	BaseVersion   *int       `json:"baseVersion,omitempty"`
+	Body          *string    `json:"body,omitempty"`
+	Comment       *string    `json:"comment,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	Id            *int64     `json:"id,omitempty"`
+	PostId        *int64     `json:"postId,omitempty"`
+	ReviewComment *string    `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
+	ReviewerId    *int64     `json:"reviewerId,omitempty"`
+
+	// Status PENDING, APPROVED or REJECTED
+	Status      *string `json:"status,omitempty"`
+	SuggesterId *int64  `json:"suggesterId,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
+// TagExpertiseResponse defines model for tagExpertiseResponse.
+type TagExpertiseResponse struct {
+	AcceptedAnswers *int64  `json:"acceptedAnswers,omitempty"`
+	Score           *int64  `json:"score,omitempty"`
+	TagId           *int64  `json:"tagId,omitempty"`
+	TagName         *string `json:"tagName,omitempty"`
+	Upvotes         *int64  `json:"upvotes,omitempty"`
 }
 
 // TenantResponse defines model for tenantResponse.
 type TenantResponse struct {
-	Id   *int64  `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
+	AllowAnonymousPosts   *bool     `json:"allowAnonymousPosts,omitempty"`
+	Id                    *int64    `json:"id,omitempty"`
+	MfaRequiredRoleIds    *[]int64  `json:"mfaRequiredRoleIds,omitempty"`
+	Name                  *string   `json:"name,omitempty"`
+	ReactionEmojis        *[]string `json:"reactionEmojis,omitempty"`
+	UnverifiedUserAccess  *string   `json:"unverifiedUserAccess,omitempty"`
+	WikiEditMinReputation *int      `json:"wikiEditMinReputation,omitempty"`
+}
+
+// TopicAccessResponse defines model for topicAccessResponse.
+type TopicAccessResponse struct {
+	ClaimIds *[]int64 `json:"claimIds,omitempty"`
+	RoleIds  *[]int64 `json:"roleIds,omitempty"`
 }
 
 // TopicResponse defines model for topicResponse.
 type TopicResponse struct {
-	Id   *int64  `json:"id,omitempty"`
+	Archived    *bool               `json:"archived,omitempty"`
+	Color       *string             `json:"color,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Icon        *string             `json:"icon,omitempty"`
+	Id          *int64              `json:"id,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+	Position    *int                `json:"position,omitempty"`
+	Stats       *TopicStatsResponse `json:"stats,omitempty"`
+}
+
+// TopicStatsResponse defines model for topicStatsResponse.
+type TopicStatsResponse struct {
+	LatestActivityAt *time.Time `json:"latestActivityAt,omitempty"`
+	PostCount        *int       `json:"postCount,omitempty"`
+	UnansweredCount  *int       `json:"unansweredCount,omitempty"`
+}
+
+// TotpEnrollmentResponse defines model for totpEnrollmentResponse.
+type TotpEnrollmentResponse struct {
+	// ProvisioningUri otpauth URI to show as QR code
+	ProvisioningUri *string `json:"provisioningUri,omitempty"`
+	Secret          *string `json:"secret,omitempty"`
+}
+
+// UnansweredPostResponse defines model for unansweredPostResponse.
+type UnansweredPostResponse struct {
+	Anonymous   *bool      `json:"anonymous,omitempty"`
+	AnswerCount *int       `json:"answerCount,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+
+	// CreatorId Unset for anonymous posts
+	CreatorId    *int64     `json:"creatorId,omitempty"`
+	FirstReplyAt *time.Time `json:"firstReplyAt,omitempty"`
+	Id           *int64     `json:"id,omitempty"`
+
+	// Overdue Whether the first reply target was missed
+	Overdue *bool `json:"overdue,omitempty"`
+
+	// ResponseMinutes Minutes between the post and its first reply
+	ResponseMinutes *int              `json:"responseMinutes,omitempty"`
+	SubTopic        *SubTopicResponse `json:"subTopic,omitempty"`
+
+	// TargetMinutes First reply target of the sub topic
+	TargetMinutes *int `json:"targetMinutes,omitempty"`
+}
+
+// UnlockUserResponse defines model for unlockUserResponse.
+type UnlockUserResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// UpdateAnswerRequest defines model for updateAnswerRequest.
+type UpdateAnswerRequest struct {
+	Body string `json:"body"`
+
+	// Version Version the edit is based on, required for community wiki answers
+	Version *int `json:"version,omitempty"`
+}
+
+// UpdateAnswerResponse defines model for updateAnswerResponse.
+type UpdateAnswerResponse struct {
+	Id      *int64 `json:"id,omitempty"`
+	Version *int   `json:"version,omitempty"`
+}
+
+// UpdateCategoryRequest defines model for updateCategoryRequest.
+type UpdateCategoryRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// UpdateCategoryResponse defines model for updateCategoryResponse.
+type UpdateCategoryResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
 // UpdateClaimRequest defines model for updateClaimRequest.
 type UpdateClaimRequest struct {
 	Description *string `json:"description,omitempty"`
@@ -220,6 +1087,46 @@ type UpdateClaimResponse struct {
 	Id *int64 `json:"id,omitempty"`
 }
 
+// UpdateCustomFieldRequest defines model for updateCustomFieldRequest.
+type UpdateCustomFieldRequest struct {
+	Label    *string   `json:"label,omitempty"`
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+}
+
+// UpdateCustomFieldResponse defines model for updateCustomFieldResponse.
+type UpdateCustomFieldResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// UpdatePasskeyRequest defines model for updatePasskeyRequest.
+type UpdatePasskeyRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdatePostRequest defines model for updatePostRequest.
+type UpdatePostRequest struct {
+	Body *string `json:"body,omitempty"`
+
+	// CustomFields Values of the custom fields of the tenant
+	CustomFields *map[string]interface{} `json:"customFields,omitempty"`
+
+	// Fields Values of the question template fields of the sub topic
+	Fields *map[string]interface{} `json:"fields,omitempty"`
+	Tags   *[]string               `json:"tags,omitempty"`
+	Title  *string                 `json:"title,omitempty"`
+
+	// Version Version the edit is based on, required for community wiki posts
+	Version *int `json:"version,omitempty"`
+}
+
+// UpdatePostResponse defines model for updatePostResponse.
+type UpdatePostResponse struct {
+	Id      *int64 `json:"id,omitempty"`
+	Version *int   `json:"version,omitempty"`
+}
+
 // UpdateRoleRequest defines model for updateRoleRequest.
 type UpdateRoleRequest struct {
 	Name *string `json:"name,omitempty"`
@@ -232,8 +1139,15 @@ type UpdateRoleResponse struct {
 
 // UpdateSubTopicRequest defines model for updateSubTopicRequest.
 type UpdateSubTopicRequest struct {
-	Name  *string `json:"name,omitempty"`
-	Topic *struct {
+	Archived *bool   `json:"archived,omitempty"`
+	Color    *string `json:"color,omitempty"`
+
+	// Description Markdown description
+	Description             *string `json:"description,omitempty"`
+	FirstReplyTargetMinutes *int    `json:"firstReplyTargetMinutes,omitempty"`
+	Icon                    *string `json:"icon,omitempty"`
+	Name                    *string `json:"name,omitempty"`
+	Topic                   *struct {
 		Id *int64 `json:"id,omitempty"`
 	} `json:"topic,omitempty"`
 }
@@ -245,7 +1159,20 @@ type UpdateSubTopicResponse struct {
 
 // UpdateTenantRequest defines model for updateTenantRequest.
 type UpdateTenantRequest struct {
-	Name *string `json:"name,omitempty"`
+	AllowAnonymousPosts *bool `json:"allowAnonymousPosts,omitempty"`
+
+	// MfaRequiredRoleIds Roles whose users must enable two factor authentication
+	MfaRequiredRoleIds *[]int64 `json:"mfaRequiredRoleIds,omitempty"`
+	Name               *string  `json:"name,omitempty"`
+
+	// ReactionEmojis Emoji allowed as reactions, in display order
+	ReactionEmojis *[]string `json:"reactionEmojis,omitempty"`
+
+	// UnverifiedUserAccess What users can do before verifying their email, FULL, READ_ONLY or NONE
+	UnverifiedUserAccess *string `json:"unverifiedUserAccess,omitempty"`
+
+	// WikiEditMinReputation Expertise a user needs to edit community wiki posts and answers
+	WikiEditMinReputation *int `json:"wikiEditMinReputation,omitempty"`
 }
 
 // UpdateTenantResponse defines model for updateTenantResponse.
@@ -255,7 +1182,13 @@ type UpdateTenantResponse struct {
 
 // UpdateTopicRequest defines model for updateTopicRequest.
 type UpdateTopicRequest struct {
-	Name *string `json:"name,omitempty"`
+	Archived *bool   `json:"archived,omitempty"`
+	Color    *string `json:"color,omitempty"`
+
+	// Description Markdown description
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	Name        *string `json:"name,omitempty"`
 }
 
 // UpdateTopicResponse defines model for updateTopicResponse.
@@ -280,11 +1213,37 @@ type UpdateUserResponse struct {
 
 // UserResponse defines model for userResponse.
 type UserResponse struct {
-	Email      *string       `json:"email,omitempty"`
-	Id         *int64        `json:"id,omitempty"`
-	Name       *string       `json:"name,omitempty"`
-	Role       *RoleResponse `json:"role,omitempty"`
-	VscAccount *string       `json:"vscAccount,omitempty"`
+	Email           *string       `json:"email,omitempty"`
+	EmailVerifiedAt *time.Time    `json:"emailVerifiedAt,omitempty"`
+	Id              *int64        `json:"id,omitempty"`
+	Name            *string       `json:"name,omitempty"`
+	Role            *RoleResponse `json:"role,omitempty"`
+	VscAccount      *string       `json:"vscAccount,omitempty"`
+}
+
+// VerifyEmailRequest defines model for verifyEmailRequest.
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyEmailResponse defines model for verifyEmailResponse.
+type VerifyEmailResponse struct {
+	Id *int64 `json:"id,omitempty"`
+}
+
+// VerifyMfaRequest defines model for verifyMfaRequest.
+type VerifyMfaRequest struct {
+	// Code TOTP code of the authenticator app
+	Code     *string `json:"code,omitempty"`
+	MfaToken string  `json:"mfaToken"`
+
+	// RecoveryCode Single use recovery code, used when no code is given
+	RecoveryCode *string `json:"recoveryCode,omitempty"`
+}
+
+// VotePollRequest defines model for votePollRequest.
+type VotePollRequest struct {
+	OptionIds []int64 `json:"optionIds"`
 }
 
 // IDPathParam defines model for IDPathParam.
@@ -293,18 +1252,216 @@ type IDPathParam = int64
 // SubIDPathParam defines model for SubIDPathParam.
 type SubIDPathParam = int64
 
+// GetApiV1AuthSsoSamlMetadataParams defines parameters for GetApiV1AuthSsoSamlMetadata.
+type GetApiV1AuthSsoSamlMetadataParams struct {
+	// TenantId Tenant ID
+	TenantId int64 `form:"tenantId" json:"tenantId"`
+}
+
+// GetApiV1PostsParams defines parameters for GetApiV1Posts.
+type GetApiV1PostsParams struct {
+	// SubTopicId Only posts of this sub topic
+	SubTopicId *int64 `form:"subTopicId,omitempty" json:"subTopicId,omitempty"`
+
+	// Field Question template field value as name:value, may be repeated
+	Field *[]string `form:"field,omitempty" json:"field,omitempty"`
+
+	// CustomField Custom field value as name:value, may be repeated
+	CustomField *[]string `form:"customField,omitempty" json:"customField,omitempty"`
+
+	// Sort Sort by createdAt or by a custom field as custom.name, descending when prefixed with a minus. Defaults to -createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of posts returned
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1PostsExportParams defines parameters for GetApiV1PostsExport.
+type GetApiV1PostsExportParams struct {
+	// SubTopicId Only posts of this sub topic
+	SubTopicId *int64 `form:"subTopicId,omitempty" json:"subTopicId,omitempty"`
+
+	// Field Question template field value as name:value, may be repeated
+	Field *[]string `form:"field,omitempty" json:"field,omitempty"`
+
+	// CustomField Custom field value as name:value, may be repeated
+	CustomField *[]string `form:"customField,omitempty" json:"customField,omitempty"`
+
+	// Sort Sort by createdAt or by a custom field as custom.name, descending when prefixed with a minus. Defaults to -createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of posts exported
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1PostsSearchParams defines parameters for GetApiV1PostsSearch.
+type GetApiV1PostsSearchParams struct {
+	// Q Text to search for
+	Q string `form:"q" json:"q"`
+
+	// Field Question template field value as name:value, may be repeated
+	Field *[]string `form:"field,omitempty" json:"field,omitempty"`
+
+	// Limit Maximum number of posts returned
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1PostsSuggestedEditsParams defines parameters for GetApiV1PostsSuggestedEdits.
+type GetApiV1PostsSuggestedEditsParams struct {
+	// PostId Only list suggestions for this post
+	PostId *int64 `form:"postId,omitempty" json:"postId,omitempty"`
+}
+
+// GetApiV1PostsUnansweredParams defines parameters for GetApiV1PostsUnanswered.
+type GetApiV1PostsUnansweredParams struct {
+	// AcceptanceDays Days after which a post without an accepted answer is listed
+	AcceptanceDays *int `form:"acceptanceDays,omitempty" json:"acceptanceDays,omitempty"`
+}
+
+// GetApiV1PostsIdExpertsParams defines parameters for GetApiV1PostsIdExperts.
+type GetApiV1PostsIdExpertsParams struct {
+	// Limit Maximum number of experts
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeleteApiV1TopicsIdSubTopicsSubIdParams defines parameters for DeleteApiV1TopicsIdSubTopicsSubId.
+type DeleteApiV1TopicsIdSubTopicsSubIdParams struct {
+	// ReassignTo Sub topic the posts of the deleted sub topic are moved to
+	ReassignTo *int64 `form:"reassignTo,omitempty" json:"reassignTo,omitempty"`
+}
+
+// PostApiV1AuthEmailVerifyJSONRequestBody defines body for PostApiV1AuthEmailVerify for application/json ContentType.
+type PostApiV1AuthEmailVerifyJSONRequestBody = VerifyEmailRequest
+
 // PostApiV1AuthLoginJSONRequestBody defines body for PostApiV1AuthLogin for application/json ContentType.
 type PostApiV1AuthLoginJSONRequestBody = LoginRequest
 
+// PostApiV1AuthLogoutJSONRequestBody defines body for PostApiV1AuthLogout for application/json ContentType.
+type PostApiV1AuthLogoutJSONRequestBody = LogoutRequest
+
+// PostApiV1AuthMfaTotpConfirmJSONRequestBody defines body for PostApiV1AuthMfaTotpConfirm for application/json ContentType.
+type PostApiV1AuthMfaTotpConfirmJSONRequestBody = ConfirmTotpRequest
+
+// PostApiV1AuthMfaTotpDisableJSONRequestBody defines body for PostApiV1AuthMfaTotpDisable for application/json ContentType.
+type PostApiV1AuthMfaTotpDisableJSONRequestBody = DisableTotpRequest
+
+// PostApiV1AuthMfaVerifyJSONRequestBody defines body for PostApiV1AuthMfaVerify for application/json ContentType.
+type PostApiV1AuthMfaVerifyJSONRequestBody = VerifyMfaRequest
+
+// PostApiV1AuthPasskeysJSONRequestBody defines body for PostApiV1AuthPasskeys for application/json ContentType.
+type PostApiV1AuthPasskeysJSONRequestBody = RegisterPasskeyRequest
+
+// PostApiV1AuthPasskeysLoginJSONRequestBody defines body for PostApiV1AuthPasskeysLogin for application/json ContentType.
+type PostApiV1AuthPasskeysLoginJSONRequestBody = PasskeyLoginRequest
+
+// PatchApiV1AuthPasskeysIdJSONRequestBody defines body for PatchApiV1AuthPasskeysId for application/json ContentType.
+type PatchApiV1AuthPasskeysIdJSONRequestBody = UpdatePasskeyRequest
+
+// PostApiV1AuthPasswordJSONRequestBody defines body for PostApiV1AuthPassword for application/json ContentType.
+type PostApiV1AuthPasswordJSONRequestBody = ChangePasswordRequest
+
+// PostApiV1AuthPasswordForgotJSONRequestBody defines body for PostApiV1AuthPasswordForgot for application/json ContentType.
+type PostApiV1AuthPasswordForgotJSONRequestBody = ForgotPasswordRequest
+
+// PostApiV1AuthPasswordResetJSONRequestBody defines body for PostApiV1AuthPasswordReset for application/json ContentType.
+type PostApiV1AuthPasswordResetJSONRequestBody = ResetPasswordRequest
+
+// PostApiV1AuthRefreshJSONRequestBody defines body for PostApiV1AuthRefresh for application/json ContentType.
+type PostApiV1AuthRefreshJSONRequestBody = RefreshRequest
+
 // PostApiV1AuthRegisterJSONRequestBody defines body for PostApiV1AuthRegister for application/json ContentType.
 type PostApiV1AuthRegisterJSONRequestBody = RegisterRequest
 
+// PostApiV1AuthSsoOidcAuthorizeJSONRequestBody defines body for PostApiV1AuthSsoOidcAuthorize for application/json ContentType.
+type PostApiV1AuthSsoOidcAuthorizeJSONRequestBody = StartSsoLoginRequest
+
+// PostApiV1AuthSsoOidcCallbackJSONRequestBody defines body for PostApiV1AuthSsoOidcCallback for application/json ContentType.
+type PostApiV1AuthSsoOidcCallbackJSONRequestBody = OidcCallbackRequest
+
+// PostApiV1AuthSsoSamlAuthorizeJSONRequestBody defines body for PostApiV1AuthSsoSamlAuthorize for application/json ContentType.
+type PostApiV1AuthSsoSamlAuthorizeJSONRequestBody = StartSsoLoginRequest
+
+// PostApiV1AuthSsoSamlTokenJSONRequestBody defines body for PostApiV1AuthSsoSamlToken for application/json ContentType.
+type PostApiV1AuthSsoSamlTokenJSONRequestBody = SsoLoginCodeRequest
+
+// PostApiV1CategoriesJSONRequestBody defines body for PostApiV1Categories for application/json ContentType.
+type PostApiV1CategoriesJSONRequestBody = CreateCategoryRequest
+
+// PostApiV1CategoriesReorderJSONRequestBody defines body for PostApiV1CategoriesReorder for application/json ContentType.
+type PostApiV1CategoriesReorderJSONRequestBody = ReorderCategoriesRequest
+
+// PatchApiV1CategoriesIdJSONRequestBody defines body for PatchApiV1CategoriesId for application/json ContentType.
+type PatchApiV1CategoriesIdJSONRequestBody = UpdateCategoryRequest
+
+// PostApiV1CategoriesIdMoveJSONRequestBody defines body for PostApiV1CategoriesIdMove for application/json ContentType.
+type PostApiV1CategoriesIdMoveJSONRequestBody = MoveCategoryRequest
+
 // PostApiV1ClaimsJSONRequestBody defines body for PostApiV1Claims for application/json ContentType.
 type PostApiV1ClaimsJSONRequestBody = CreateClaimRequest
 
 // PatchApiV1ClaimsIdJSONRequestBody defines body for PatchApiV1ClaimsId for application/json ContentType.
 type PatchApiV1ClaimsIdJSONRequestBody = UpdateClaimRequest
 
+// PostApiV1CustomFieldsJSONRequestBody defines body for PostApiV1CustomFields for application/json ContentType.
+type PostApiV1CustomFieldsJSONRequestBody = CreateCustomFieldRequest
+
+// PatchApiV1CustomFieldsIdJSONRequestBody defines body for PatchApiV1CustomFieldsId for application/json ContentType.
+type PatchApiV1CustomFieldsIdJSONRequestBody = UpdateCustomFieldRequest
+
+// PostApiV1PostsJSONRequestBody defines body for PostApiV1Posts for application/json ContentType.
+type PostApiV1PostsJSONRequestBody = CreatePostRequest
+
+// PostApiV1PostsMoveJSONRequestBody defines body for PostApiV1PostsMove for application/json ContentType.
+type PostApiV1PostsMoveJSONRequestBody = MovePostsRequest
+
+// PostApiV1PostsSuggestedEditsIdReviewJSONRequestBody defines body for PostApiV1PostsSuggestedEditsIdReview for application/json ContentType.
+type PostApiV1PostsSuggestedEditsIdReviewJSONRequestBody = ReviewSuggestedEditRequest
+
+// PatchApiV1PostsIdJSONRequestBody defines body for PatchApiV1PostsId for application/json ContentType.
+type PatchApiV1PostsIdJSONRequestBody = UpdatePostRequest
+
+// PatchApiV1PostsIdAnswersAnswerIDJSONRequestBody defines body for PatchApiV1PostsIdAnswersAnswerID for application/json ContentType.
+type PatchApiV1PostsIdAnswersAnswerIDJSONRequestBody = UpdateAnswerRequest
+
+// PostApiV1PostsIdAnswersAnswerIDReactionsJSONRequestBody defines body for PostApiV1PostsIdAnswersAnswerIDReactions for application/json ContentType.
+type PostApiV1PostsIdAnswersAnswerIDReactionsJSONRequestBody = ReactRequest
+
+// PostApiV1PostsIdAnswersAnswerIDSuggestedEditsJSONRequestBody defines body for PostApiV1PostsIdAnswersAnswerIDSuggestedEdits for application/json ContentType.
+type PostApiV1PostsIdAnswersAnswerIDSuggestedEditsJSONRequestBody = SuggestEditRequest
+
+// PostApiV1PostsIdAnswersAnswerIDWikiJSONRequestBody defines body for PostApiV1PostsIdAnswersAnswerIDWiki for application/json ContentType.
+type PostApiV1PostsIdAnswersAnswerIDWikiJSONRequestBody = SetWikiRequest
+
+// PostApiV1PostsIdAssignJSONRequestBody defines body for PostApiV1PostsIdAssign for application/json ContentType.
+type PostApiV1PostsIdAssignJSONRequestBody = AssignPostRequest
+
+// PostApiV1PostsIdCloseJSONRequestBody defines body for PostApiV1PostsIdClose for application/json ContentType.
+type PostApiV1PostsIdCloseJSONRequestBody = ClosePostRequest
+
+// PostApiV1PostsIdCommentsCommentIDReactionsJSONRequestBody defines body for PostApiV1PostsIdCommentsCommentIDReactions for application/json ContentType.
+type PostApiV1PostsIdCommentsCommentIDReactionsJSONRequestBody = ReactRequest
+
+// PostApiV1PostsIdLockJSONRequestBody defines body for PostApiV1PostsIdLock for application/json ContentType.
+type PostApiV1PostsIdLockJSONRequestBody = LockPostRequest
+
+// PostApiV1PostsIdMergeJSONRequestBody defines body for PostApiV1PostsIdMerge for application/json ContentType.
+type PostApiV1PostsIdMergeJSONRequestBody = MergePostRequest
+
+// PostApiV1PostsIdMoveJSONRequestBody defines body for PostApiV1PostsIdMove for application/json ContentType.
+type PostApiV1PostsIdMoveJSONRequestBody = MovePostRequest
+
+// PutApiV1PostsIdPollVoteJSONRequestBody defines body for PutApiV1PostsIdPollVote for application/json ContentType.
+type PutApiV1PostsIdPollVoteJSONRequestBody = VotePollRequest
+
+// PostApiV1PostsIdReactionsJSONRequestBody defines body for PostApiV1PostsIdReactions for application/json ContentType.
+type PostApiV1PostsIdReactionsJSONRequestBody = ReactRequest
+
+// PostApiV1PostsIdSuggestedEditsJSONRequestBody defines body for PostApiV1PostsIdSuggestedEdits for application/json ContentType.
+type PostApiV1PostsIdSuggestedEditsJSONRequestBody = SuggestEditRequest
+
+// PostApiV1PostsIdWikiJSONRequestBody defines body for PostApiV1PostsIdWiki for application/json ContentType.
+type PostApiV1PostsIdWikiJSONRequestBody = SetWikiRequest
+
 // PostApiV1RolesJSONRequestBody defines body for PostApiV1Roles for application/json ContentType.
 type PostApiV1RolesJSONRequestBody = CreateRoleRequest
 
@@ -317,66 +1474,281 @@ type PostApiV1TenantsJSONRequestBody = CreateTenantRequest
 // PatchApiV1TenantsIdJSONRequestBody defines body for PatchApiV1TenantsId for application/json ContentType.
 type PatchApiV1TenantsIdJSONRequestBody = UpdateTenantRequest
 
+// PutApiV1TenantsIdOidcJSONRequestBody defines body for PutApiV1TenantsIdOidc for application/json ContentType.
+type PutApiV1TenantsIdOidcJSONRequestBody = SetOidcProviderRequest
+
+// PutApiV1TenantsIdSamlJSONRequestBody defines body for PutApiV1TenantsIdSaml for application/json ContentType.
+type PutApiV1TenantsIdSamlJSONRequestBody = SetSamlProviderRequest
+
 // PostApiV1TopicsJSONRequestBody defines body for PostApiV1Topics for application/json ContentType.
 type PostApiV1TopicsJSONRequestBody = CreateTopicRequest
 
+// PostApiV1TopicsReorderJSONRequestBody defines body for PostApiV1TopicsReorder for application/json ContentType.
+type PostApiV1TopicsReorderJSONRequestBody = ReorderTopicsRequest
+
 // PatchApiV1TopicsIdJSONRequestBody defines body for PatchApiV1TopicsId for application/json ContentType.
 type PatchApiV1TopicsIdJSONRequestBody = UpdateTopicRequest
 
+// PutApiV1TopicsIdAccessJSONRequestBody defines body for PutApiV1TopicsIdAccess for application/json ContentType.
+type PutApiV1TopicsIdAccessJSONRequestBody = SetTopicAccessRequest
+
+// PutApiV1TopicsIdModeratorsJSONRequestBody defines body for PutApiV1TopicsIdModerators for application/json ContentType.
+type PutApiV1TopicsIdModeratorsJSONRequestBody = SetModeratorsRequest
+
 // PostApiV1TopicsIdSubTopicsJSONRequestBody defines body for PostApiV1TopicsIdSubTopics for application/json ContentType.
 type PostApiV1TopicsIdSubTopicsJSONRequestBody = CreateSubTopicRequest
 
+// PostApiV1TopicsIdSubTopicsReorderJSONRequestBody defines body for PostApiV1TopicsIdSubTopicsReorder for application/json ContentType.
+type PostApiV1TopicsIdSubTopicsReorderJSONRequestBody = ReorderSubTopicsRequest
+
 // PatchApiV1TopicsIdSubTopicsSubIdJSONRequestBody defines body for PatchApiV1TopicsIdSubTopicsSubId for application/json ContentType.
 type PatchApiV1TopicsIdSubTopicsSubIdJSONRequestBody = UpdateSubTopicRequest
 
+// PutApiV1TopicsIdSubTopicsSubIdAccessJSONRequestBody defines body for PutApiV1TopicsIdSubTopicsSubIdAccess for application/json ContentType.
+type PutApiV1TopicsIdSubTopicsSubIdAccessJSONRequestBody = SetTopicAccessRequest
+
+// PutApiV1TopicsIdSubTopicsSubIdModeratorsJSONRequestBody defines body for PutApiV1TopicsIdSubTopicsSubIdModerators for application/json ContentType.
+type PutApiV1TopicsIdSubTopicsSubIdModeratorsJSONRequestBody = SetModeratorsRequest
+
+// PutApiV1TopicsIdSubTopicsSubIdRespondersJSONRequestBody defines body for PutApiV1TopicsIdSubTopicsSubIdResponders for application/json ContentType.
+type PutApiV1TopicsIdSubTopicsSubIdRespondersJSONRequestBody = SetSubTopicRespondersRequest
+
+// PutApiV1TopicsIdSubTopicsSubIdTemplateJSONRequestBody defines body for PutApiV1TopicsIdSubTopicsSubIdTemplate for application/json ContentType.
+type PutApiV1TopicsIdSubTopicsSubIdTemplateJSONRequestBody = SetQuestionTemplateRequest
+
 // PatchApiV1UsersIdJSONRequestBody defines body for PatchApiV1UsersId for application/json ContentType.
 type PatchApiV1UsersIdJSONRequestBody = UpdateUserRequest
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Rc33LjtvV+FQx+v0vK0iZpZ6KZXmx2t4mbbuKxtWlnHF1A5JGELEkwAGhb69Gr9Da3",
-	"zTNk+14dACTFPwAFyaK1s70UCR4cfN/B+c4BaT/ikCUZSyGVAk8fcUY4SUAC178uX18Rub5S19TPCETI",
-	"aSYpS/EUvxPA0eVrHGCqfmZErnGAU5IAnmIa4QBz+DWnHCI8lTyHAItwDQlRlpaMJ0Sqcan881c4wHKT",
-	"gfkJK+B4uw3wTb7onf8mXyDJMho6nRD54vKpfmzL4RqQMCY0uQaRsVSAxouzDLikIDoOPpbGhOQ0XeFt",
-	"oFDxmbRcQMfCthrLFr9AKNXQkAOR8Mo49msOQh7oV4AfRsA546MEhCAr88gOM/zyj98+/v4+JglBHxjP",
-	"0ziPckOR3c29Bj/+W9CkaWtbZ+m24XAxzXzf2l2keILuxvaaxeCEdjAI9izaODXYmm/yxUztrU9u3TvH",
-	"Blv7DFKSyk9u5aVbw637kyR8OLYjiOFE2UPBwEhGRyGLYAXpCB4kJyNJVtrIHYlpRKR6YiXhLxMDgsOh",
-	"wXa2MT/oBjJTDBipxQQDL0CVNrWN4CHY9ecGcGstZfaTCSLK0jdqw70GSWjcnUXvxm61pJ9B5tqCpiu0",
-	"pBBH6K4yipaExjmHnUe1uiXtGrxMIxoSCQKt2T2Sa0A01dYKy/dEoIyzOxpBZLP5HjZdo9/DBrFlYUE5",
-	"pDzd+di108olZvHaYTODLa/EbEVTZ56DpIC1IspcCbwyX/XQG/UQMj8//o5W8MdvwAX90ChHi1Gtqioj",
-	"QtwzHh2Rbf/zL7rk0Jtuy9VUs/RA5Ipkyd5D6lmfZvkipuF3UmZvyshsF6dlIDdj4S3jgMxNiAK0zhOS",
-	"jjiQiCxiCBDT40iM4CGLSWpimC11JJZhAA8kyWIomxUqUEzC9yqmMuAJFUI9IxkiYQhCILmmAnEQLOeh",
-	"dR8ISWTerajxd7PZFTI3kVIAxEHmPIVIBYDdo68mXwbdXJCQB5rkCZ7+6euvA5zQ1Px6MZlYJWfFRkWr",
-	"84pFoDyUVMZg6ZXWjMs2hqg2xo3cXxlf0CiC1AaIudCebbbJQBnUxiosAiTWLI8jtACUiwKbMKaQypGg",
-	"UTE3WpM0ik2w75xYQQqchnv3f0FQiUMxfN4blq28qpZD4vjHJZ7ePuL/57DEU/x/412bPC76wXE7srdB",
-	"O7TvmqYtkfN3KmQFlQq+EOgdROh+TWOoMp8KWLKJGYkQWRGaComKHjbAVEKiDfe56taP3ZYlnJNNB9HO",
-	"ErpgzvUjKypkQzUdabUTQ45ety8PqglZfFqJvRPhyzBkeSrtic2WQ7XrNUcLtxrGbMG3Q2uAWoGfon48",
-	"6AhCnKqk7AkGfdKzL8xlwwurr/I0telB+MhhwbFNmWfR006FnjDdADFt7B9xELPX2mDOHnmCUg/1E3nk",
-	"4+NgMBx1mOJhbziHjyFtr7nB3G21qs/by2z7MvZTFbouuNTepuzT7F7MhqCk17C7BHq6PJZg96ljozA4",
-	"HD0l9BDmnMrNjbJoFvUNEA78ZS7X3eL2b/+YIXWHcfrB9GdrIBFwlAtV0qp+wzxuqlm4QG9MxT9FP+PG",
-	"g9Ny4KNuPbc/4/KNj7G4e+fTeAwXL2/UDWNgh6oqiRUKJqHYF2DuocvXpeOqZUnyWNKRqSMQyeUaUklD",
-	"Pd8FepsLqRqc8uQDkZilK3RP5bpcgl6BaQy1jZHIIKRLGiIVLNqOuHAt75+j2ZsfXv4wG+l3XmXlntHv",
-	"YWNafZouWXchP6bxBuku1TRZCYsgVu2GJDQ15zOmcTStrDkseqsHqWpW7XZtZ3Lx4mKiUGMZpCSjeIq/",
-	"vJhcvNBFsFzriBiTjI7vXowVNGN9kKC3AjMJqtX+qNuqA1exIDZCQoK1cYPDZYSn+IoJ+TKjP71QHOkH",
-	"ijMUEPIbFulTpJClEkwckyyLCzrGvwhT2uxe+PXtj8a5UOvcRPIc9AWzffRCv5hMTj13VbnqI8UuUiLX",
-	"JxXLPG7sRzy9nasyPEkI35SDFan6/PkWKypUAn2ozqcL/EYLFm3KMwTDlbLboLBsVtwsXhcjEEEp3Kvm",
-	"nvezWD4wEJHtZvSZuex0dxY6K8jcjD42UtPtfBs0s+3tfNsgvQbqYbxXBNep12+6NTQrsFD+LUhE4hgV",
-	"w9psfwuG7Ffl7cHAbr6RtyBtXEBLkOEaohresTns2AGo1lStp4RQX8DzbeCI/Vf6JVUR+WawM/RraJw+",
-	"6C3fATxz3NvexrsIQWZwLx8FtCWoLUb2RXXNG0tgjx9ptDVsxiAtZ5iv9XVEHJya2zVW9Ycm9a9nbk/5",
-	"vUyn+JwPyKPtvaiTRzO4l8cCSgeP+qBPhpbq650u1p0UXKmnzszA6bex5eDmmbex7SzHSb8Z3Et/QeOR",
-	"27jmTWMbq2ZmvzyZUS51ui7uDlcJNDouSxWgHPCVpnItJYC65fMUpuJY2qFLOxyGkqX6yd1ZVOnag4kD",
-	"NKnAs0mFnyKpmbqR7K9HViprcqTJ/OzUyIs/fy2y8uehRPZ9VAnR2bAfSofOuG8th/Qu3v1F6Kh9u/Ok",
-	"sW/N6c1+DSrHuVRoVt0fDMvWSy8LjoWTPVpUb0z72lC17N2SS6TNFV+5KkY7BasO2VCS1XxxcRbRmu2l",
-	"rTigdAuXL2sF/BXwbd78tM24Y9sl/vrm4L6mcAX7n53GedPtr3NOPj20zrUJK7U7Iw9D6d1ZN731Bac7",
-	"Ctyq57vpC6qP3vR1h/G+ies5gWU09BBOM8ypm+Xt4WSz9TlJlwntgm8DV62nwlm/6PfVRD3YLYk7NAZT",
-	"xPqb8fMIohchB/RxJagtRjzVTg/edgL7AK2zclqXOm3w81M6Px4P0Dk7jz4qZ99WO5E7GwODadwZt7Ht",
-	"mxgn/f5t3ZHbuOaNaxuPRb4YeeqVKP8gdZ9mXUblh1diX1jNev/A9VPa2Z2PQC28Vn+y662ZDUyP0s3K",
-	"wj7tPC8rQ4l2+zPEs+j2zSGxcYB817k9SsJLxzy2//hR/zm5n7C7g84i7lXY3RR/r/6MsRcM8Vf1Z6go",
-	"Dosw/8KiJ8I8ioue3NMpMP5HomCoqubMec7xPXVvFPrXN0/Ic03HGnkuF8W/+eitaswoV0Hzrrg7HLCi",
-	"/0Mq7YBvNVGupcTQ/J53QPHv4KzfutVyvHbvs+vf3nmQckCSLUBss7I/v9q/NKxS69nAHyrJ1T/2P0uC",
-	"8yLeP6/ZifdLaWqq4v/z9Bw7Bp2vKOfqAX5XhkLOYzzFY7zV0zJOVzQl8Ujck9UK+Gj36fMXFxO8/W8A",
-	"AAD//0jBlO4vSQAA",
+	"H4sIAAAAAAAC/+y93XLkNpIo/CqI2r3Y3a8ktT22I9wbX5woS+UZjVs/o5LaO+vRcUBFVBVGLIIGQKlr",
+	"fPpVzrnc27PPsLPvdSLxQ4IsgARLoqq73VfdKpJAIjORSOTvr6M5W+csI5kUo9e/jnLM8ZpIwtVfpyeX",
+	"WK4u4Tf4MyFizmkuKctGr0c3gnB0ejIajyj8mWO5Go1HGV6T0esRTUbjESe/FJSTZPRa8oKMR2K+ImsM",
+	"Iy0YX2MJ72Xym69G45Hc5ET/SZaEj96/H49mxV3r/LPiDkmW03kQCFHcnT4Vjvf2dYUQnIlHwq+IyFkm",
+	"iEIYZznhkhL9fD4nuYSZfrVD3TGWEpyN3o9HdyzZOE+E5DRbwoM5J1iSZCJrMCVYkgNJ12Q0DnzC+GkS",
+	"tYzxiMa+mDMho0flBM+BHGrxVJK1+s8/crIYvR79w1HFW0cGiUf2ixKH78txMed4A38XedIXHQ+EC8UW",
+	"v3qgfKT31EeRamp291cyl/AuFoIus0sm5BX5pSBCbjPe9B2ey3SDWEYQW6BCEH6aIJwliLOUnCZoXQiJ",
+	"7gha0geSjcYNHtEvRSJYDx67ZVpXE+JZuvvwcyzJkvFNePD5iqYJJ1k0f2wN6eGPhORy5Sd1NJtrEeHZ",
+	"iznmJIvfAUribLHIaSLQgrM1kiuCOGMSJewxQ5KpH+wa0V8ZzUiC7jboL6Ojv4x8jJ0zQWWQs0Vxdw0i",
+	"MBpc2eNtL8VXOFuSSyzEI+OJs0UaZC84YNG+Bz+tafaGZEvA1heedWbkMfrt965I/2lrsvpgt75VpJiu",
+	"w0xbo6WHRZ7KZF7EpkyQhthp4BTeCJwsnGDhBbaJKj3GbTsAQ0gKli0oX18zmYcXyBI16Rq/s7T/Zuxy",
+	"wjednAAjeBenDtjjUrYEIPDTazx6d0A4Z/xgTYTAS/1uNe/o7/9X0DX6G+NFlhZJoVHQS4401qHgiFnH",
+	"EJTSU+gdEsBT6wbpRNfkv/7j7/95n+I1buLs+fDfQKgL8LgTve3S4em4LYRk6+8pScMCNMV3JG1shi+/",
+	"/nrcKhg7ETWV9J7IENbdfffVuEtgs7xU/Opn3yRN2SNJ0ANOCyJAQyJZsUYLWK8YjSs1oGOC2qk/HhUZ",
+	"/aUgp/pjyQvSOBwbQOQ5yRKSoMcVyRBbUykJnAxrmtF1sR69fuVXZy2qfEJW/9Kc6UJrgbPrq9Pz34/R",
+	"+c3Zd9OrMZqe35yN0cnkeqo0w5vZ9GrUJb4UIcaG9ubl2zg2GoxZL1math9Joo+evi5SSfOUHK8YnRM/",
+	"nh3OiuWVNX5nOOPLV4rI9q9t5VEtxfBMx6iciCKV4i0V9I6mVG48fPbmx8mfZ+ifErLARSr/eYwm319P",
+	"r35+e3E9RYybv47fXMymneQvIatQcNtClxZVAWcs26xZ4dmdf6AJ0UqovkCOEcvSDcJm06rtAo8lyXAm",
+	"9e8ClQOinAkpRmMP2fy32wjh/V//QTi9b0qlecXeek1JovY6Ti+dteoLfX2Jb0vBo9apxjHix/6oVzfy",
+	"YHfx5AktGZEk6zzFkjTmFtZu4Zs+Z2naeUXa2pg73QTwsrHFepw0ceJZUpkS39A9WeQ7/N//J/37f963",
+	"HvEOAuzMhie7NtETZSfgfrkkQpJk+g4+jzeGEPV++KobFstXLCUvoMb200s1UIMdRTND4ZYLRMq4+hlL",
+	"SXg2ej36n//w06uDb/HBYnLw/e2v37z/R9+h1FBna3+OzjC/V5f3ug65NciCcmCmPN1cY74k8oxmhST2",
+	"8NJKxxdey9ycZY1N8s1X5YvVBPuiaoX2wSh7rcTxB8fPFqzh1v3hsvOHzpUDsuQz6tXj6iIXibmA/tt+",
+	"/HbaCfdwsdnCa0JSMrDtwkzxHPd34GCGc3oANqUlyQ7IO8nxgdWaHnBK4aIzej1aSvL/v9L8GwJo4Jua",
+	"ngUsnvdkSMQOZh3Uww+mPujhBz3H9BQDHhhmgoEXAD5d5zyKkG/ud0OARReLNzRr4QuWezx0f7qZvBmj",
+	"0/PZ9OoaruEn0zfT66nvnJPknYw00SdU4LuURNmwPW6laFcIJ3P2QPjm2D9W45zM2xwdNZAHIE/jAhOp",
+	"uSmfOuPkZXygC8aXTHa6rMga07Q2if6ly2qj37qNmDiEpHLmCB5cSZm/1WcPZdmUc8ZPiDTfN4aFh569",
+	"AT8b7e+OZkttnUAP5aBogWlacK/5jno0ytMsoXMsiUAr9qgsHDRTo5mRH7FAOWcPNCGJb8x74rGr/UA2",
+	"oHzoEQAggLSCsZsqavEKYD2Dj0B/FSz7kdz9QDbb2MPpchuqq9mXX38D8mSanMwm/rCMh+3Pjgv+oHSp",
+	"ix8u0T3ZiDGaJl9+/fUX3/qGIL6IA205gDGuZhM1hheVNPHutnuf6RIGYhxg8g3luzqwpEgL0QVEIfxb",
+	"/t32iJfFXUrnMJKLnU7iwnL0PHrJY0UtL4Uf70V446nJYm01DrP47DQ1+GBcHzgpm9+3Gm7hBb+W3pjC",
+	"vNg+yQACP2VLmj1Zhvqvh+VHU/gI6T///p9oScBCLOjfaqFc5q0tr2t1zPa8kv73/6YLTlrvpHY1rWeu",
+	"QVFQ2r/LKSfi1LPB3tAFkXRNrKUYosmEQJLdkwzRDAkyZ5nyoUWcmusFvnJuffWZrlcE2UUoAT1nnJO5",
+	"RHeFVFOrRZSBTLAdUiLBP0DlCmEDCVrguWS8mt65U64X+Brg9sytliNZOaozoW/4MTKnCeNoQR8IWhvL",
+	"mld1WnAiVoGZL3L8S0GQeUnj1R0dI0GzZVq+4ZtB+oeeOKSKuw6nbMmKsCRorqQrICY4/gBCYE34st0B",
+	"JZUJ9DI+lLCx0Wrf37aDMMQCWUI4liSZzVlOnsEKFNaDa26bRoArkZU/rhCEIwuXQLjmRuoX+NVnlscV",
+	"S0n8PH5kPnRH3vSNugs6/i/NE4TXLFuqRWXkEQl6ByqkGCO8Q2TA1pXLAHvbudxBmPOhffO18dQJEZJm",
+	"Ws/vxUFhb9ttK4wDIkAEI3QnEqUEC2lDdHVMsxirmMxZCbr+2/yhrJkSL+vRu4fo9/APWtBUEi4Q5uo0",
+	"vKOZYpr6muqjR7KyA0KvCO265hrxWYTDdhDOUa7mbjtGJEe1qPPwSnLMikzuzFoZk3QBt1k3Qn1roh3C",
+	"9aNPCqOc+m1IPUPze0FonQARqgWjyfwYp+kdnt93R3O2G7uExLL7vSavqI/G4XhPgPBS2xxa7JLzlJaH",
+	"zvZNXj2ckTknckak33Niwn6u+oT0kwxMcgFXDBWiINwLEGepdm0En57hPIdDLvpOKwS7qr7zuZUEKEA9",
+	"XVE6uOYJFrtcezLetN4154STNcs2pzEm1TknCckkxWm/yB64J2kjxQ9kc1wOgjiRBTeh+xl+oEssGT+s",
+	"ZhGHSyLHSBBOcUr/RhK47/xxdnG+HfPTDGGullUD+zaMpwsFbYtgrKOqzd8YjxkzKawLwLCpDT+Su0kh",
+	"VxmaXJ7aa+wdZ4+CcO/SQ2vaj/BNsZA3ot/gPWL8IbhL421Qx/IDC2agwSNPUOBNJtQtgKb6Mm4CH5W2",
+	"s6JJQrIqm6WokbJ9H6sQtbD0Decz9A8sjT9gd4xAbZOjHsK+b488jYs1bX0rJWEat5CflxrSC/GAkH+g",
+	"QrbehnRKoHfFygR0moThNTdX9R6iQv1h4MWFXDEOYghnjRDWOMV1B1mTYIm7JOkWkoZIzgwRo4UKbtTw",
+	"NvPoXMJ+2DDfkF4Kkv3oJt7v15Zbq8RM79TaJ2Tj+vhUmRe3gqhjWPA3GQYdfwoqP0ivDATClyQ5zSTz",
+	"UQuumEiuqKaRMo3rDxDNJIsjWUwMd+1oHCyR2t6pu8YSzdAYX3x4t95vw72Hy87OlS7+BynzqfVuNzPT",
+	"rDO86bvkBOmHJBmjVbHG2QFckeEuNkb6wMcpIu/yFBsrh2FU60om7zD4K2zlAypQiuf34JfOCV9TASsE",
+	"Ndh6bYCJOBGs4HMvIwqJpTdD4/r6EumHCC641WUDhIgXoq9e/c7DmWv8Tls0v/72W8e++cWrV95otyU7",
+	"0LrsSAWeuARtWIxXjMsmDt140jDmvmf8Tp3NbRaIxhVskysbnhqsxMUYiRUr0gQMdYUwuNFX9gNBEzM3",
+	"WuEsSbX3rwJiSTLC6XwbBI+VoRBONkEwIatiy0Zsho4kSC8Wo9c/dQiEBme/HzdZ+6E+tPD5D4UsUQXM",
+	"Nyf0gSRGqbPfA8PiTcpwgvAS00xIpIFwc/PaQA3HoHQ5xreWsI3MW0dRvjYnhzr9nilHUieL+eKi1WZB",
+	"qfpQcTB5J8skRo7MbkJZsb5TO6Y6blhxlzpb3LzxXgHimYlmHTOZF/rMFMjhdCLHf8IHf5sc/Put+ffV",
+	"wbc/3/6LN368R2anICl4jbdzO7vjlS1kzUmuyLJIMQdJzIkRqg5+lHV+jeV8NRq7q/XkFT0t7vl6+m/X",
+	"VdTzdxcXb6aTcyDPbPpmenz9bMmcTWYP6+dBFbdS1qL2r397RSUfKaWjJfyD/ZU2uPB3X4674x3xXPqi",
+	"FCZJYq6iWtUxtz1QPREnYO5H1FwBFzgVxBOIsBXAARBWU96G1thu/7c3aI9x16IgfpU/rohcEW60dM5J",
+	"JrUf1nyhgyLgqYU+RlOCc/k8ypvxBE+cG6naYn+svdZHp/TPqQIjni1wwmWP2rd+3lhSIQkvY+0/TuO0",
+	"vuHG2ae9Z4vSIftgtmbWNqKxw7ptcd0dbRafWhMODzMulGfdHg9iPpk3hUVrGHGJm7wqZqPAqg3Wjq1B",
+	"9jnjCeEmrIESEaQJHcQv/ZRaKgDRbdyaQpjrO31oLhsb8LLo64cPB8YwIz0NyGcoQ2WA/eCxOTgq/cgR",
+	"JEveEj704S9Id1pFn7peTnRln7NFf9Rd9asB8CAoeaDkcVaWA0hoS42OHJIiukpFNlIt1vobBI8hki2l",
+	"yjIpJMFJZVg10yOWkVGn9j1n6zXxnlBBA1AJhnr+LHA0SFoix0/HB3ZPZvpyKIYh5HOkIvbyDQu8TidC",
+	"EK73a4BprkiKN7OokJnxaDY5e+Muog8Bat+O3XlvA8B3h9vgubjhHttsuWw0Z5ko1oQjQfgDnRN0c/Wm",
+	"ZCfzk0kl4v7M9x3CcUDxmkjJ6V0h/Vpja8QOySSVG58vYaqeoNOT0BLG6lezRirB1SCxciB6Hdz5MaBJ",
+	"yXQSqEuYTx1ofM9nghkabD1NaXY/fUcFWAjBwh1wAwJPt6MLNk/3GwMEK/UKPNJ5hV2r8W5VIs90iDTj",
+	"YeVDZy0OroDYaW79gF7UAuGCZbyqOLiu08KJivPZ7jRglVvesjq4S4xfVXO9kAxeFGokeHpPcmPLSclC",
+	"IlbI7rNra783wGFpWaFXoIyhteYdbT8kYmyegIGFFRJhVcAXzXGWMQkZKUilDz5fTF/jGFW/uzKuEgwg",
+	"DRIqtN0EJWxewCGNlEsJJzoSg0ZgqBY02MhKhJ/tzKcnJs1oxdKEmsB5+FhZpJecFXnpua1Hf4R39TYx",
+	"hA6qBmODxvzdRo2obLt2eE0DAE5ziiqso0mGHmkmRuPnkhdVcGPDwaV+Byi53jLgICQZTQD/OH3EG4Fw",
+	"otNZe5iyatd9wzAld4yrXRjYyn/aMhQHtrNfdywLv0A6hApAUKE9OScLmqbGzDgav6B12cWHmSSw9FlN",
+	"vwgWJf1QhMGWRtHMTjCPaptNfeVusUP0I0hDss7lRu+Ec7wmpyfAhMrlSbXSoCGCX/XEScKJEDHekXap",
+	"taVvNMRH9VABRxOtCVUiX9BlpvE5huzFy+kZyBIs0B0W5JuvrBCrqT0d4qyh4XToW1sgaTTqHWd1Lm70",
+	"VR/GuqGp9KmGCNGJhICCA5a5Mn4bT9atD47/gyuSUJ2JSbNEe65rdtcvuyuzehW5pqc4uzf8XgsFcn0N",
+	"wI5+gLFS2AVi6oyn3IholcJ5iC6gnqQgJoym1AS2h3lQFgqiQ/awZlsiLF0UdGPE5IrwRyoIImZJBm4Q",
+	"XsbOjR+VrhShq3Y7DJu6a8zWbT8no+Z8wrkpagcnthAOfXh6teeOtYYPQWdfu/tqSwyFDohaAFNCPni9",
+	"XEGrk4Zb1HJM18MkeukeFIMjwU4zrtYSwMeP9J4GEdESIebOpl5rHf/JBp0+sWpCsIkKQKZ/6zDCYvc1",
+	"//U8MIFKkQG36tPSsGLL6DekwtZkvVqbKOG1Le/e+u8CSnUoRU0/a6KeyXK9f2USczkzCA0is29mkwtE",
+	"+a13+s7aZJjPVzRoLi5LRXYVg+xTtjRcqvTZO2J09jmRWHaeWcqpBEZKUYtjjQmAlfXoV+9u0+bsVpu+",
+	"vXnFm9yboSD6XCcJVQo9jK6SZMbRtvlL84m2zZvq3qBh2pjzPhsnWD5ZuC6OE7pYtEQtYUHetsX/WqRF",
+	"6SVb9eA8mokJommdNJphWSFVM6qOwglzlkmSSaT74yRI0GxOXDeICl+CaHKcEK+uWtLzmfDwvotsbYkg",
+	"4rFP6kUshXv5nobM8+uZSA1OveMWUPUb/WA138RjORQufjk9P1EFWieXl1cXb6cncFJeTf84Pb6envhm",
+	"tkwQP3UosN/HYhIvdRV0Kkh3n7yJ4rRY9bNP0UCJl31K4Z+HzqUiL3Mmd/Ikyo6SoKrBwsRmBqkKByF7",
+	"TORqnMJPV8+g5rcd2jZUcgqBiT2zRYrM3P8TsFHoq5D3Q9C5QV6dgWKWFxKH1AQv+t17VjgT9RkuWs90",
+	"qwqv4uV1w49P3Qsir/6eJ5VAEiEnc0kfqNz0keNwlByH44GLTB+mJAm+5IdZ5tOMszSFQycMt7KlwcEL",
+	"pj5Ot08HJnO4XaKbq1OwHgko0YkF+tOVSi3yHg+lby9C2lfLu3xSaqkapAWNH1RKZnVlGkQ5AZdfUpD2",
+	"QHFt1+MABNLF0rR2SUXtulBrEaiJ41zwtvJS4AG6I/KRWPctE1IVRlKOhmrOQBuUp2QbNi6fddi+315u",
+	"OI20dWcVGaSMDlavWreunZg2wU+7JjpZkw0TiX5QuyqCEg6RVmNkL3A6JY6t10UGZnc4RhE2+ta4tSdJ",
+	"7C2wvtgnG9daskTDqN6xp2PUiIPxxxO6K/aLKqtNN9xyhmpouFszuJ6NA3dvCRiJkcHw3pWF8hyJG8E2",
+	"LAaEtkKEseaw32SJg332X3v5g8exPrYdO61ctp8DZodOa52jDSYROlukRV4Vf0MN1FwXwTNRI4Y+g7FA",
+	"Ry+1aHuT34zki014XDFBTGCCSs/Wfn0kH5kp4a0qMZFMmnQUNwhhWHNUI0AIfi9bnWJRJhYLFaCUUJGn",
+	"eINU5o4LY7905lAv2i+iZHbILNa8CmJpMD7HGUoYuiMLxomOqdmYwBTKdRjPGH1/8+bNGF1NJyc/X5y/",
+	"+TOYiM8vzr0NcYLmtq2WFNrIi7ACBGWEJCpmUh0MPvGvLpKeG8irXdh7uP3zscrPJ4rBTowMhvFG06mX",
+	"bSnxvlWgPDEjuJ4p6FWju3KEW3E2BElaBw6nXKsnb43oGsQu1kml1mpZrt61C9q1YFVsFOTVJ2Qv3nZN",
+	"OQCp9fhnCxxckA0eatQduLi+1JWpbJ+S6nSHsz7PfXR2G4L0bIDmDa0tBEH2RQXNWEdFK5d8xjSA1ERO",
+	"dlZrKaHzEoJ19MHXRorncP24MFWj3vpUTEHmBadyMwMW13B8RzAnHKLOttH2xx+vUS0eDa0ITghHhbBx",
+	"rPpzXQ2KHKKprpj1Gv1lVPvwtX3xV8W77/8y0o22Xo/0iLaKwev6Z6PxSI8MHb3VABVKVlLmgA99uvsX",
+	"oJ9BeLcBHK6ZqprrgW1WX9MyD9GZqeRvu48hnEJPCBXjbJagVqBG0mMciJzMIdgUAX3VOOIwtLx/O7ie",
+	"nk/Orw9OHUc7zqnqEfVetUtbMF+BoXSjgr1NkbI1S0gqECcSm7YC5uKuS8Hphm1n6qWRc4kdvTr84vCV",
+	"tpGRDOd09Hr0u8NXh1+o8hFypTji6PCRpOnBfcYesyPohnUIfazgydKXwFU15BK17kM63NpqpiamX5k4",
+	"6BJ8T/CF9g8comv9AeDIKKHwkGqrwT1NDPn+Vc8CxVkRZ1rNRELiDUqpyhouMmki0A0IckU2SNcgRSv8",
+	"QJBupQQIK4kF7p7R74n8kaTpD7DoPz7eiz8KxX1VpP/rX0dfvnqlJZyK2zEJ2alhnSOLJH18dLYGc7uM",
+	"KcJvNbMTaEEgzSRBolB4XRRpuqnt49Hrn27HI1Gs15hvYMPOLs6hnjaCXngzIkfWZvSTChgd3cLHRzin",
+	"Rw9fHMEvR+oMPnpwEv+VqGK+FhlnoA9hlQzkfoAgecAW83arER0i+ELzgVxxJiXkDOVKfhC+RQK4WE5y",
+	"+vYL2MvTSjcoL4CDEaOl+oGHNO57SOmIAiB4Px599epb3w1SoS3lBCebcj+o17/81msv84yOJGNwdJFM",
+	"brNAXYj/dPu+xhNXanE1ivVii00bQ/D7eiKSudhhUd/41ZbUL61VTc1tLorliY1p60aE/M4YjJ+FETwa",
+	"W6Ohmw2GH4oVfQqchwc1U5U4jhYQGnuovA/VuADuQ7Z1tcHuAdjjbY1PB7bRFseoTJ4wq6ggaSskxEZI",
+	"sj5E32s2UJ/aPjlFJo2QwFrZViYA+FsnO6LTy39FOQYfM1qQR9t5VCCC5yvVvAectcI2w1MjMI5OL/WJ",
+	"/UjnBNhTnepYv5ZiIcdqGthla5xt1BiIGoe/LoZ5iG4ydSZq7GnTxCNnqjamLhAiFDQIp/SetLOyQsdA",
+	"TFzrt/jC7FtvZOhhXM0HFb9qsfmFr0WsbrJnRAsvkRyUnJOK2iWrIF3sGeGFJLwi78JlvNYNYwnVb6eo",
+	"gb17hBUyvEmuVJWS7T6ONrNBz2SSPHG9KWF5bxkjou439ldRkKQqwy9AxVLgIZUYDhMquybofJ08qzPs",
+	"B2Jap7Xhy3Ot2/fQz7aQ4OvybZ8zuERdbzaCz7b4aL3AR5LJPMxJx5xgCXZWdfE2FRNs0mhdRzvVecA6",
+	"rw4xiEGfs2xB+brq3WkCv1qY4wwuwzIfUksLBLh5qOUu2oSB9aOXnkYhr1tXstQ4MmgLU2VqnBygMakZ",
+	"SFIjkMG2DpoCnB+iK1WYUZj975guxBiOpflKHZsqYwMC9TKdQQtUjKLXsQF5mE1tEOJ2v3/hne2vNhpi",
+	"GbMJ+vGKQaGfWbp2uIOh8DY/Mi35w4x1QkW7+6zSwssWvsqzotlPFUl3eOsQnTMJDhjVMqbKWOGmHkLZ",
+	"9tMQUui6It3sZuAciN0MmvbIbjUIOpjNvNuT2yyld+I2Bzw/t3Vd+I5t+2VsdIjqyqcZrJ3/DLtpOzBH",
+	"uMl111Y/02o1/Ci0Kq5UF7fDs6uZd7LeC9wZHZP4h69yd18Qt5p173BRPFvgbS4zvdpE0Jz4eyJLOWWt",
+	"fU2lxWe9K+l9aWd4Is7jung1Ws9t2+e3yGHhi7LutQsDwFVeLbehp4wDm/h7mlEBm9F8inQdYN44KCzy",
+	"/B4bc5OvfWrjLlu3Y408z78bA9W2X3hPbrFFkA2QBbjvQXBlvrNU7L1JG5gKb9UI0w6ifRnHHB99OGZI",
+	"m4mvfehHLcf1y4omuzKIi5Mu7jhyYq79XGLlunlRnd/BNqjAJanDVthZRCynXJS8NfQ2bzZTDdLOIqmN",
+	"cN8ReNOK5tRrfgoTw5XHz0ELfXvWZe2MvKnODZ89IY5AVy6YHwSBrnwHWS+BXCccr68wln6/0uS9pldK",
+	"fMWkTohRv+08MdqR/miLCKpuUo45XhNJuFAL9J9Rylet3NngJK6c2TQZNSXk2KHMVkDJ7ZBXL7XIy/iD",
+	"V3/Q9/ql8R8SqbpJ0XzlM+0q53Y/wl3CWB8K3Z7/0PVmpHzImhqgr7eapui+6xlcQ5H/ELZl8gM3dlVc",
+	"pG758bDeIZqqe7gw7bNcI4/rJ8gSEwCgvQs5pua5LqvXLf1tg5IhrI1qqc3uAh+aFmfhq8q+bF3/KtOi",
+	"Jp7T2KWndbGGkTD7HC0YXzLZGfkhqjC6kpk4EUTWQkAcc5ANDThE1+7FIGFEoIxJJEmagnlR5SgbQ9IK",
+	"C/dDQ0ftHuagjKR0Ta2P2DqyI7jue73GYXhPI3DPvNcEok3bKYlmQjHowov/oLO1NNVp+nNLJasTkjJK",
+	"IKToanrsztz11bYwt4IwzNtQk0nLtJKnzZ3DoEavUAm8ODEZx4+KBoNZQTx9Vl7c9eJrndImETWio2NZ",
+	"NBPvzD81+LbZxzjXW5x577R43XLEa8s0MFTNiQ9nZ+1FkG06kLY5guYjEenIb2e3K7OOoRit1lnvQzts",
+	"zeJjjSYVrvqykv7Ow0T60twW8lFeq4Fjuq/Q9oOB7ad7ExqNlnTBqzrhrdEYblT4T7fvx3HG1J2NqNuk",
+	"F4IdMZrMj2yl1hb37UxiLkufGtbGmYucZKcn6JhlGZnLqgR2LUldK1b2/DGRoup52dER6oiD7JmzNYHU",
+	"63kVq4vTVP2d42VprV1wRcdEjywkluWjMmwI5C4AmiFoPq1i0+eM3VMyRoK5oUpamYBMQw2bOlgd+1I7",
+	"q88Eg14kkxKBw7C8t47rC/N9sPCvh/9rL/pageiYuq86K8xTrYSrGIRlwZ1u8Zq5YJyvX33pLRiqeRE+",
+	"5xB4qbz6baJVs7ioAdB7tylKAUsEbNLlnrOcHe+00Iyu7rc19CpftYtcxE21fWipBpsHhnA3i2F5XT9d",
+	"bTO1NdSmKSMp9FaC8ktqTSQ5RDdliXoTsuStlR+1Y47t8ofZMMyZ4uOKM33lK+ylg3oZt7khmo7BwNTL",
+	"ihH+qtmgJKj+5nehHADYLWXI9t2mzlZggmc6wsbuQqUMtF6htCf3ibvKJad/T0GvtiM8F21qzJzQB+L1",
+	"/211cbDWAEicf9qeua5dvsp9ac43aEjXfsjZm55j2NCz6ORA5toxVEqVexJ2bkVoQTOZx3u63x08Pj4e",
+	"QArgQcFTkgEQSY8zxNcOMGJf/s7HsWVPEclqGGsX8oBwHGzN1+0HqZhtR6VJQbDdNmRXnam2Q3VBghqH",
+	"m+/CS95m9f3pUoodP+tSz6VLKU7r1KA6VSI1znPoRUDeFr1Ibauya1JX9JV9MdSGstECyPUPK76kUgT2",
+	"YmvsluHSs6q5U6ufq0zvtW6uXwrCN5Wfq+yf0Obt6s6D7+e2BERb9P1/79ZpnXGbKeVbHDlrYrqk2YCc",
+	"qHgwOHG82C6rGfhF9jXHCXHEny0IgDWv6B8V3DCOeDadGHjq2nR8HkTqebqqfEoKcUWtGHX0WSSag9Ga",
+	"PJuXvfc7ZZh5dYMkJ2Srtp9fCFWt/V8kfHTerBsaET96XFtWOIi0FiVaw4VDDft7S7homUpk3z1EV4yV",
+	"Q1JS36BKScnpXIxRQkgOR0UGr4iqtKGN6aUccRjJ1jsMbOIGTQZwHCvQm1VhX3gDN4EI7+SSASzK2zzI",
+	"mnglmX2U7/Qi1yAL7MYjTnQNtJYronpBb80VTRNus8EtKOrCJVWeSZ2/1BUwx1z1OoWjjq2plG2Otopn",
+	"zLSDmcvV6O50e7Kbb8HRyUCAWfNdOxNZys3djdifj7ZgDLFSdBBcKdVsA9aKr6rmRcFYuAqQ7oiqcs99",
+	"PKFwvUSJ/qSVCwzWW0VJOP7tRgUyOTRrCXbbP2GGinXb8xkTKFbexhj6k1bGMKR90hlTh6xNMBzdcYKT",
+	"OS/Wd50aIM7mREjGRf2cqZzo6qBRhRJLl5h5hUpB0kWEnniafFcB9LFJkeGV1IpavVXVOxevHlnTwiMA",
+	"DMkSnMnua4LzboNNsEDYKszdbHDizPmZD7YPmArLfRkhqWG2Hyes2UOLDfmMPWzpEcp25YJ7R1LogJOp",
+	"ttJGDY3RPE8TGP5TOcAAkXs+vuogRBxe8EErmyn6P+ngcmGqH1vQQKNd+uA0Rea1oICxj4e7d9Yaffiw",
+	"qUCI3rQW4BKV8EOMeQHCn/TL4b1VYWMwK4DbZmU/JoAogvS5/BukNigSee1XL28zdo9Lmpem7j1MDdit",
+	"6oMx+GO6f8XRscfNy0/HmDuXf1tVF669UWCwi9Yet7GvgVKQ/D3uV7ttYwea+jZWnW8Oqo427bb0cJ8c",
+	"8F5bE1vgEHOb9byIpupppRSjrNYWGXvguR+55KlgiDKuO8OUrjsdcdDuuagOxyaWBzsit1t37eeg9FG5",
+	"nap9Tk3nsxBZI49Q55PgDuxxnrrrsX0WH0yTqczkDChuaztzHWaJMLK5U35EJ/AODNLjOI5gkJiz2RkG",
+	"ivGZKthAWEAZ9GoBx/4dsQmDbef4B0LVwU71vUuecKu+LsbqcdA/g+TZgrMmeTImy7rL7Wd/7c1epZjO",
+	"a3O8xKnvwtrn2K9BGnvsZ43lWSq5v9ctU7UvtHGKE5x0VNfGtZl8FDBNqVqcobUFniZXBHfKBveTj0Li",
+	"AwbOvQzQTnC0xvy+7OzV4QbFSY0aUWTPbbu09mpn8BaCvtyqjGOVSD0GuwgR0sQ7FVlKhECCcRUMJVeE",
+	"P1IRNlBfGidoK7FV0Kmja1JRa0Ppi6yz/ZjVKdMnlm7cnPxP/oaYWqMBssCMr9VfY7TGGzgJOcmVGhcA",
+	"zkpOj/xwmmz9hA/+Njn491vz76uDb3++/ZfXo662mZ4l1OT8E+B2JfzLQT9jXEI2QtkvHZznd5stTVOY",
+	"vw8B2rG1kEP6qg7P4GRB31Ulg9c0K8QhOiELXKRSRS4flFOEuIpxWVu5s+CD//FP5ef/S0Pyl78cetHw",
+	"z//o692zLV7fQS85lBXrOx3QqreAU8vCB6OqPlADcq0HqhrlhruVvoifJnc7n8YUJFTLjjz3bFSFFXzw",
+	"d6x1t+wML4rlEmQaUQ0BFW/oHn+tdWStLBvuZut2Jt7LlbbWtDZAqh53WHMLrNMq7tIKM422zrEjLHQz",
+	"ndYDTe8j+6qvMwxyKkg6NYWjFEvFBhMLx4e4oSxwKO+zs3Dtq+0ttkUL8i5nXAYpMVWP27ULkOrHs7da",
+	"ZrOMoDlLi3Wm223UryEtxNAzfdYyPmsZn7WMWC1Db94dtYxn0DMkeSeP5uKhZ3bKZQ34NpFmxE+sMIuI",
+	"06gkmcoTtR0IVdMTRBPdkGdBU0m4USl0xEYpZXQxKVWvHj48uziZXk2upz9fXsyuZ9q/gkxvPRB1Zny8",
+	"WOis0nIc0aGjmLCPoaIw1Bx7DMEw87drKSIy+MLPHzFRF2oWj4oiCDRcDh6LM/VYUVa1TBRjdMcSlcWQ",
+	"JQjAKFMAQ6dm+2moJ+hOXXun0uY0tODxCUiCX1ptLB1dYz/BY/Dzta22yzT/tG80w/KxotjczkhyQBIa",
+	"Y7syB3T5nWrnLiolExiJkwdKHseIpUlpzerYR3a4qYIiRrtMqZAWjLKwsdI0zU3IxxnwqLeC+SIcIVwM",
+	"9GGNWYMSkTeQBgH7s4oJvqWLRZBpoIMI5gThxmwopRkBhVD9W1ZdsDdC08HWFc2qg4iyGvTho9PkBMDr",
+	"4KY6Aj8KA3iNWWCNbSd0Y31Asd2YRH26K6NomRDW+iY55CITxGytlS2uOUT6HZwqIUPX+gNgkUoM6Ko+",
+	"gFjVqEiHdZsmtoqp5hzGqiwVdg7eoec1OetKr2ePvDVEghusaVaXRPsp6+CXhp3MrVfQleEG7zSYq7dW",
+	"6sGVRz8tMi21ooxoNrFNfyLKkjT6N1VgMpckMc9tm8vyVlQpSQneiHY5eVPB1cHBJ3gjzFSmBZ0WyC2A",
+	"UWFadAcOYf0+zubkRANaU3H3rJlVFLvsqaNVOO1nCywa30VI2PjOAZZUKnDIMJaSkSsqpM7v3uGa7Nry",
+	"gmFHitEi6tcz8cyCcdimAzFW+/jAIq/V/v24JY9AfXKIzghflpymymXbekbqyBOyuEM5o5mmGas0KdUl",
+	"H9gNrfUQNJOsXVp8QkTMY8jXwz3m9Y75o8DgiKhMEcoSsVG+EWOGsJTVZYqEUnKI+YZyBImLmtpFJmmq",
+	"6QiE112Px3aPmgzI2jatxoIq3t54wSqwbG8kH6zpxP48fS4AHUwXHzO2k6evgmTkP0+OzOkQkWir3qtY",
+	"ts9F/zSZmGk+JokSpTlovPTyHRpMxjoNS8zF6AeWnke/6v+cnrw3drqgcAJdTr1bCaGGACpV0zlbr4sM",
+	"an090ntqf0dznIEZ0Vyx7jbKJiQQvmNlvci8kFibJVeciBVLk0aKgVuIcXpyev3z8cXZ2c356fWff/7x",
+	"9IfTzgSXOp9NzOJflN+2DJkaiuD4uALyQxGaE8PNexSbk8aGCm2gHqKztCHtIjz1bKPo7XbECZ53dMqb",
+	"JIk2eax1drL9xBv8qfxc9pblWMc6TBdbe+GqhOvzpogPOZ0PqUREnTCWOfqcMZbWyN9uww141QVgd9wi",
+	"CrbeO8TjfvDvk0vOciZgA+sjiS2crVBzPmRMWmslJ3OZbnRZVl1kVneiLjVlYy8SiPbeQ/08Fp83UsOy",
+	"9zHYFuG5YyZsc7rplyw/7mRQNBNpWgbMiaFtBDpYSyXOgmfObqEZ7PKmBgcROnh+f4gmrkaHCOaZ0MYj",
+	"vYEyZiI5fZHoHXvmRwDz806J5VMiAWH72iV29paUpzoHdRwws/IG02C9/juFmF2i+Cm0S1SEZYvipZ5b",
+	"E6lkiFC3MZ06JzhLyRMNpP+KGDir9TXoccXUDUmQKsBJmXBwFeHasaH0qj4B04xe8h5NMy4AHaYZS542",
+	"9jb8tJNtpgIlyM1K+HaaZnQ3xhVDj5yV9v8xotk8LRLLmyuaJCQrFSJQpFi2WbNCR06IBsdPbuAOPjm/",
+	"OP/z2cXNzGX8TlOPhvpTsR0Ddrt5RaO1hwnZfBJr1pmnTLRETx7DY32lZDnJSqvy87p5mnJJzfopiCWF",
+	"3X2mhlTzd2WGwJvtiSGKFXbLC7FghAQSHOEK8F/N/4aydyAzPmLZjsaPYwPqsQX0AzF/GHiCE5SI/WwA",
+	"eVEDiMH7bhYQQ9TQtjFJcOGAZXORtIe5qEzha1Ym0VFBENV9kurOw64DeWqm3yvfbwf1khKsntG8X38Q",
+	"wbwa/L7hvHbVvVSFClNRuoIJ8ujUG8178Yz0BzPwJ+fEg8WbxfUmqMViH4KuSkRGERR8/W19Duf3cLoW",
+	"GbxXqn5vVICA+kN76NSVtOamq2IHhtYUAZhPQVEEDO9RT6ym71ATU038Fl5UXLOTlmiBCJ12KsCoJdEL",
+	"HiOMkkJjwRhEaObkc+1+eemfynWaKIg+Be5UmN8jezrzd/CnCUJrSx1TbLITg5ZgBDk0pmK0tRDuLccw",
+	"rrj0R8GXJpdvzxmN3VwZnc+4czpjiCVzlqYRJYTS1I0ZfMskQXNWZCYYVJ3wZURo2VWVE1Gk5pXeuY6n",
+	"ySWA9ulEgqZpOx+kaS9VLtfYidLj4N2jB6Zjt/PC20EpT/Fc+wngRWvy0N6JzMsFkwyRdS5NbhzcHBOO",
+	"H0U5xraYKbbo+1a/99FLGljvpSLxXiRNF3cBmlvZSu1olrWwVpegsRgICZpBrHWaEVWCJlmzv1L7Jnet",
+	"MPV4PxMziJMkwgG2H9vdZ5vaE21qO52UCrK2o/JJ0UNlNsTwoUN7jBX6HMXTP4pHMcZTYniAgm0RPDHh",
+	"Ou5dOByrY6K0QYSCrreVKmK+h6/GWil0skTKr6hU43Xy8YvH73yOrImNrFHc8vS4GqDeVlQNZynpbm6j",
+	"3wrdIK7M08GwCtO3oVQBEKvP27VY/MHfsYUP1bvBnVThYai6hlcKEXusa3gVQYkedQ0NPuukiKtrCDNt",
+	"c3J89qyXlE6GqyLmJ9fLJop+8RmuXvpF1Mr376Myy2dvuB8q4WaP+9YFoIPu8ak2O+3bCpLavtU3xe4z",
+	"yL4XOoWuy+eD4VKD0IZHA2TLWVS13P919B3BnHBQ9KAD//vb5lFVLdliWv8Se1x1dZpxUTbUkXVtcLbH",
+	"Q+u6k2zXxloRPLhiqWbQXyK+Sbe4s02D49sl8edbgPbOCWeo/8mdcdHkjj/ngvSMOOtCm7A87fZIh6HO",
+	"u71u+joInVwQPvViN70h9c6b3gV41DVxQCYcMZrMO11LgmbLFP5ZZgcsQ1BkiyZVyKUeUBvG5iklmUSC",
+	"zDmRiAplQHNqM7YewqfJBYDTWTpTEeBjkCyA3UuDrVbHksWoXwEYj7569ZUnMrBOFoPsOcsWdFlwUvWR",
+	"k9XB0EuRuMhJdnqCjlmWkbks6R4QaEVYr1C20cp5FcVPQc/U3pllEOvTRY1V9iIBe3OrwA9PkoCzXjwW",
+	"YatykRjUg44EXne702eTszeIJiSTYDzzs6guGbwiiGSJKqxUldkh/IHOSfWdZIiTJRWqaJouvtUtDWcA",
+	"6KcjDQHvA0pDRbBnl4Fq2GeTfDFc1S349sUXgwi+WY0r9mN+78uYzyH4uhgrQty5qKuLOx1C1mkb8Uea",
+	"lXLIPh4M8QqAVmVbgRBroy/XU+ISfog2e7QHKzvYGMzoodGxT5tHFEF6mOotUhsUiTRoqJe3GfuIE91x",
+	"OugwnZlTPKEiT/FGN6hWPv6S7W2Um66UWr5AE9HFA1dm9qEiQNTodqq9MEMDhs79ad7vKrKrkRzapd2x",
+	"Hw5QPq6IN3J11SnVc3x6Jq643d3DwOXf3THmLb+wraxbe6PAYMatPQr3GgRd5I/35+wo3B1oQtv4CKu5",
+	"O29pynurrmEqtF6AfGePupS7Lamhhh07YbACrfE9QVSivLhL6bxDATpNJhqYLo1bYe9jkAYKI3pR3eyg",
+	"KaEQ10sPcz/0i4iuGOdo4oZvTPsm4CA3pmuXfHuRJzszUIRsmcUxUMT1yMFTUM5UsW9RlVREM3PHaWgY",
+	"YsamNDmrpvyoJEpcrXzRr96tXmJFhX4iZu2icgcJs10f25vENTmf/H76s8nlurgKlbq5LD4gQg8ieaoV",
+	"7TtE/cl81ksStfFZrCByuCEkjERxdxBpumlJFGzKm5npovoJKTC2MWx7GxiLofjWRndPNCFF5Lx/CFQZ",
+	"yn41K8myRxPWrA9v9LBkubTdyZplAYvY/k8wcOkfOZm7/KyzW/SKdzZ7OTxbWcA+ftY1qHbWtk+TmwNG",
+	"lGjrZXlrE3CR1rcSvhgu/lUUd6eRNrmo/kFbjDiDCV6WDbdbW5fCJDSFMFA+zyxb147KXmdfwZyYfHXJ",
+	"AiWUONElJq/ZC7e+7DZT9pPg8dbKFgkeYbFsOdu3rJafPn8Oairdsx7RBCKGC+ONpk/QI+qA9ZDAz2lO",
+	"LeF/kkm1vkP2YZ97wX2yXytcta4dTLnC9/Fw5twWIVv8hhnot21G9jNwpAEnkoFjom0Mx3VYlD3S91mN",
+	"zOEt0i5j92WN/Djk7CBGx2ql/Q3cwvPtcxm5g9XKdjR0/+YY7bNxvZvPe8vnJxrZLRP2srOXIlojNSHR",
+	"Ijpjki6oraTmXA913YU1FYLoLjmqBaaKAt4giTmM3U9yX1WwfZbcL83RFWP0l9zcJdwOkjshYKFRu8gB",
+	"Q9lyd1WUfwO8NEyUes0akJBBhfRzGycc5uktldt4uIdUdviuh1SWZJ2nWNuNW2XyGeb3CdQ4Ai+cX11W",
+	"RtGckwVNU5LoJBybvyMkL+ZS54tQkiZCt9KGVxHNeorrawv0Z2Hdi7MV/1CWWfy18fafzLvIckiscP6l",
+	"+eFuknlrGI9q/T1wEgIU6uqq19N/ux6j85uz76ZXY/TdxcWb6eRcseBs+mZ6fN1Tkn/ybDaIHP/TFpPt",
+	"RYo/jdcjhXgEr0dI8CbGavJb6cKdwSr6rZAUvTFP99akSwEQK0DsWiwi9d+3W0iJz0bwlll2nJ4KvE8u",
+	"F+Emgig9fHsGiU2qdLv1vNivPHp7Q/5QvjWN9z2qrlGEj3en+Qkf50mDqUb+nXtUdbTt0j3LN/XNDMZA",
+	"OeHQh2kM9RfzAlay4GytTNA5/AWnfpE/MP1/1RG3XT7aRk20u6feByUIom7nEi/L1fW5pauVVujvIcFr",
+	"DYujRLk14pPkQMxZTrrtRCZCB0jtRGIZBrGjdZL9zE4707N+csRf1xbYh/wlapCmSCwDrBvfRbMAJw/s",
+	"nhwIIkR7kfQr9SIiD4RvrN8J+ICTBSdihSS7J1klLg6RVkHmOEN6Dqdqr51trB9pnxDT0kY1HxGIu0b8",
+	"q+nbix+mP8+ms9npxXnQhG9j+wybaYhndmWfiq7Ba8tqtZaYdwwBOuLpFI1Eha04/tFNuFqaddGFFh0p",
+	"W9JM9WlihXSOFeChBeNLIrVlGVMwZai3m126bs7fXBz/8PPNbHoVywM3GrxPhfYa23HqRubtiQWVRn7n",
+	"6QFEhbCuWQ+aG2qKGjqkn3ZVjRj/agp51OpIEP5gaVPwdPR6dDR6r1QexumSZjg9EI94uST8AN7TQH95",
+	"+Gr0/v8NAKmUjfq7nwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -17,6 +17,8 @@ const (
 	CTXKeyRequestID     contextKey = "request_id"
 	CTXKeyDisableLogger contextKey = "disable_logger"
 	CTXKeyTenant        contextKey = "tenant_id"
+	CTXKeyTokenID       contextKey = "token_id"
+	CTXKeyTokenExpiry   contextKey = "token_expiry"
 )
 
 type detachedContext struct {
@@ -96,3 +98,38 @@ func TenantIDFromContext(ctx context.Context) (int64, error) {
 
 	return tenantID, nil
 }
+
+func UserIDFromContext(ctx context.Context) (int64, error) {
+	valStr, err := GetContextValue(ctx, CTXKeyUser)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, err := strconv.ParseInt(valStr, 10, 64)
+	if err != nil {
+		return 0, errors.New("user id in context is not a valid number")
+	}
+
+	return userID, nil
+}
+
+// TokenIDFromContext returns the jti of the access token of the request.
+// Tokens issued before tokens had an id have none.
+func TokenIDFromContext(ctx context.Context) (string, error) {
+	return GetContextValue(ctx, CTXKeyTokenID)
+}
+
+// TokenExpiryFromContext returns when the access token of the request expires.
+func TokenExpiryFromContext(ctx context.Context) (time.Time, error) {
+	valStr, err := GetContextValue(ctx, CTXKeyTokenExpiry)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	exp, err := strconv.ParseInt(valStr, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.New("token expiry in context is not a valid number")
+	}
+
+	return time.Unix(exp, 0).UTC(), nil
+}
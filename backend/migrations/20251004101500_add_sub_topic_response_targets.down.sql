@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS sub_topic_responders;
+
+ALTER TABLE posts DROP COLUMN overdue_notified_at;
+ALTER TABLE sub_topics DROP COLUMN first_reply_target_minutes;
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- +migrate Up
+
+ALTER TABLE sub_topics ADD COLUMN first_reply_target_minutes INT;
+ALTER TABLE posts ADD COLUMN overdue_notified_at TIMESTAMP;
+
+-- SubTopicResponders join table
+CREATE TABLE sub_topic_responders (
+    sub_topic_id BIGINT NOT NULL REFERENCES sub_topics(id),
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    PRIMARY KEY(sub_topic_id, user_id)
+);
+
+-- Notification table
+CREATE TABLE notifications (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    type VARCHAR(64) NOT NULL,
+    message TEXT NOT NULL,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    post_id BIGINT REFERENCES posts(id),
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now()
+);
\ No newline at end of file
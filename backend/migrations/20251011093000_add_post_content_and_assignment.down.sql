@@ -0,0 +1,13 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS post_tags_tag_id_idx;
+DROP INDEX IF EXISTS answers_creator_id_idx;
+
+ALTER TABLE tags DROP CONSTRAINT tags_tenant_id_name_key;
+ALTER TABLE tags ADD CONSTRAINT tags_name_key UNIQUE (name);
+
+ALTER TABLE posts DROP COLUMN assigned_at;
+ALTER TABLE posts DROP COLUMN assignee_role_id;
+ALTER TABLE posts DROP COLUMN assignee_user_id;
+ALTER TABLE posts DROP COLUMN body;
+ALTER TABLE posts DROP COLUMN title;
\ No newline at end of file
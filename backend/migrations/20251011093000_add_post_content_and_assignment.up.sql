@@ -0,0 +1,14 @@
+-- +migrate Up
+
+ALTER TABLE posts ADD COLUMN title VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN body TEXT NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN assignee_user_id BIGINT REFERENCES users(id);
+ALTER TABLE posts ADD COLUMN assignee_role_id BIGINT REFERENCES roles(id);
+ALTER TABLE posts ADD COLUMN assigned_at TIMESTAMP;
+
+-- Tag names are unique per tenant
+ALTER TABLE tags DROP CONSTRAINT tags_name_key;
+ALTER TABLE tags ADD CONSTRAINT tags_tenant_id_name_key UNIQUE (tenant_id, name);
+
+CREATE INDEX answers_creator_id_idx ON answers(creator_id);
+CREATE INDEX post_tags_tag_id_idx ON post_tags(tag_id);
\ No newline at end of file
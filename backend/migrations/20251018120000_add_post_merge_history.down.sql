@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS post_histories;
+
+ALTER TABLE posts DROP COLUMN merged_into_id;
\ No newline at end of file
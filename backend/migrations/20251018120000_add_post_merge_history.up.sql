@@ -0,0 +1,16 @@
+-- +migrate Up
+
+ALTER TABLE posts ADD COLUMN merged_into_id BIGINT REFERENCES posts(id);
+
+-- PostHistory table
+CREATE TABLE post_histories (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    post_id BIGINT NOT NULL REFERENCES posts(id),
+    actor_id BIGINT NOT NULL REFERENCES users(id),
+    action VARCHAR(64) NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX post_histories_post_id_idx ON post_histories(post_id);
\ No newline at end of file
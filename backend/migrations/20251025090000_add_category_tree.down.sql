@@ -0,0 +1,3 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS categories;
\ No newline at end of file
@@ -0,0 +1,38 @@
+-- +migrate Up
+
+-- Category table
+-- Root categories are backed by a topic, every deeper category by a sub topic
+-- of its root topic. path holds the ids from the root down to the category
+-- joined by "/".
+CREATE TABLE categories (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(255) NOT NULL,
+    parent_id BIGINT REFERENCES categories(id),
+    path TEXT NOT NULL DEFAULT '',
+    depth INT NOT NULL DEFAULT 0,
+    position INT NOT NULL DEFAULT 0,
+    topic_id BIGINT UNIQUE REFERENCES topics(id) ON DELETE CASCADE,
+    sub_topic_id BIGINT UNIQUE REFERENCES sub_topics(id) ON DELETE CASCADE,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CHECK ((topic_id IS NULL) <> (sub_topic_id IS NULL))
+);
+
+CREATE INDEX categories_parent_id_idx ON categories(parent_id);
+CREATE INDEX categories_path_idx ON categories(path text_pattern_ops);
+
+INSERT INTO categories (name, topic_id, tenant_id, position, created_at, updated_at)
+SELECT t.name, t.id, t.tenant_id, (ROW_NUMBER() OVER (PARTITION BY t.tenant_id ORDER BY t.id)) - 1, t.created_at, t.updated_at
+FROM topics t;
+
+UPDATE categories SET path = id::TEXT WHERE topic_id IS NOT NULL;
+
+INSERT INTO categories (name, parent_id, depth, sub_topic_id, tenant_id, position, created_at, updated_at)
+SELECT st.name, c.id, 1, st.id, st.tenant_id, (ROW_NUMBER() OVER (PARTITION BY st.topic_id ORDER BY st.id)) - 1, st.created_at, st.updated_at
+FROM sub_topics st
+JOIN categories c ON c.topic_id = st.topic_id;
+
+UPDATE categories c SET path = p.path || '/' || c.id::TEXT
+FROM categories p
+WHERE c.parent_id = p.id AND c.sub_topic_id IS NOT NULL;
\ No newline at end of file
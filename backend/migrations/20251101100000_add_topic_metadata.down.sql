@@ -0,0 +1,11 @@
+-- +migrate Down
+
+ALTER TABLE sub_topics DROP COLUMN archived;
+ALTER TABLE sub_topics DROP COLUMN color;
+ALTER TABLE sub_topics DROP COLUMN icon;
+ALTER TABLE sub_topics DROP COLUMN description;
+
+ALTER TABLE topics DROP COLUMN archived;
+ALTER TABLE topics DROP COLUMN color;
+ALTER TABLE topics DROP COLUMN icon;
+ALTER TABLE topics DROP COLUMN description;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE topics ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE topics ADD COLUMN icon VARCHAR(64);
+ALTER TABLE topics ADD COLUMN color VARCHAR(16);
+ALTER TABLE topics ADD COLUMN archived BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE sub_topics ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE sub_topics ADD COLUMN icon VARCHAR(64);
+ALTER TABLE sub_topics ADD COLUMN color VARCHAR(16);
+ALTER TABLE sub_topics ADD COLUMN archived BOOLEAN NOT NULL DEFAULT false;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sub_topic_claims;
+DROP TABLE IF EXISTS sub_topic_roles;
+DROP TABLE IF EXISTS topic_claims;
+DROP TABLE IF EXISTS topic_roles;
\ No newline at end of file
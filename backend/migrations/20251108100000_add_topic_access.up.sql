@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- TopicRoles join table
+CREATE TABLE topic_roles (
+    topic_id BIGINT NOT NULL REFERENCES topics(id) ON DELETE CASCADE,
+    role_id BIGINT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    PRIMARY KEY(topic_id, role_id)
+);
+
+-- TopicClaims join table
+CREATE TABLE topic_claims (
+    topic_id BIGINT NOT NULL REFERENCES topics(id) ON DELETE CASCADE,
+    claim_id BIGINT NOT NULL REFERENCES claims(id) ON DELETE CASCADE,
+    PRIMARY KEY(topic_id, claim_id)
+);
+
+-- SubTopicRoles join table
+CREATE TABLE sub_topic_roles (
+    sub_topic_id BIGINT NOT NULL REFERENCES sub_topics(id) ON DELETE CASCADE,
+    role_id BIGINT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    PRIMARY KEY(sub_topic_id, role_id)
+);
+
+-- SubTopicClaims join table
+CREATE TABLE sub_topic_claims (
+    sub_topic_id BIGINT NOT NULL REFERENCES sub_topics(id) ON DELETE CASCADE,
+    claim_id BIGINT NOT NULL REFERENCES claims(id) ON DELETE CASCADE,
+    PRIMARY KEY(sub_topic_id, claim_id)
+);
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- +migrate Down
+
+ALTER TABLE posts DROP CONSTRAINT posts_merged_into_id_fkey;
+ALTER TABLE posts ADD CONSTRAINT posts_merged_into_id_fkey FOREIGN KEY (merged_into_id) REFERENCES posts(id);
+ALTER TABLE notifications DROP CONSTRAINT notifications_post_id_fkey;
+ALTER TABLE notifications ADD CONSTRAINT notifications_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id);
+ALTER TABLE post_histories DROP CONSTRAINT post_histories_post_id_fkey;
+ALTER TABLE post_histories ADD CONSTRAINT post_histories_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id);
+ALTER TABLE post_tags DROP CONSTRAINT post_tags_post_id_fkey;
+ALTER TABLE post_tags ADD CONSTRAINT post_tags_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id);
+ALTER TABLE comments DROP CONSTRAINT comments_answer_id_fkey;
+ALTER TABLE comments ADD CONSTRAINT comments_answer_id_fkey FOREIGN KEY (answer_id) REFERENCES answers(id);
+ALTER TABLE votes DROP CONSTRAINT votes_answer_id_fkey;
+ALTER TABLE votes ADD CONSTRAINT votes_answer_id_fkey FOREIGN KEY (answer_id) REFERENCES answers(id);
+ALTER TABLE answers DROP CONSTRAINT answers_post_id_fkey;
+ALTER TABLE answers ADD CONSTRAINT answers_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id);
+
+ALTER TABLE posts DROP COLUMN locked_at;
+ALTER TABLE posts DROP COLUMN closed_at;
+
+DROP TABLE IF EXISTS topic_moderators;
\ No newline at end of file
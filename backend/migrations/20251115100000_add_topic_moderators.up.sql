@@ -0,0 +1,39 @@
+-- +migrate Up
+
+-- TopicModerator table
+-- A moderator is scoped to exactly one topic or sub topic and everything
+-- below it in the category tree.
+CREATE TABLE topic_moderators (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    topic_id BIGINT REFERENCES topics(id) ON DELETE CASCADE,
+    sub_topic_id BIGINT REFERENCES sub_topics(id) ON DELETE CASCADE,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK ((topic_id IS NULL) <> (sub_topic_id IS NULL)),
+    UNIQUE(user_id, topic_id),
+    UNIQUE(user_id, sub_topic_id)
+);
+
+CREATE INDEX topic_moderators_topic_id_idx ON topic_moderators(topic_id);
+CREATE INDEX topic_moderators_sub_topic_id_idx ON topic_moderators(sub_topic_id);
+
+ALTER TABLE posts ADD COLUMN closed_at TIMESTAMP;
+ALTER TABLE posts ADD COLUMN locked_at TIMESTAMP;
+
+-- Deleting a post removes everything hanging off it. Posts merged into it
+-- are kept with their history and stop redirecting.
+ALTER TABLE answers DROP CONSTRAINT answers_post_id_fkey;
+ALTER TABLE answers ADD CONSTRAINT answers_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE;
+ALTER TABLE votes DROP CONSTRAINT votes_answer_id_fkey;
+ALTER TABLE votes ADD CONSTRAINT votes_answer_id_fkey FOREIGN KEY (answer_id) REFERENCES answers(id) ON DELETE CASCADE;
+ALTER TABLE comments DROP CONSTRAINT comments_answer_id_fkey;
+ALTER TABLE comments ADD CONSTRAINT comments_answer_id_fkey FOREIGN KEY (answer_id) REFERENCES answers(id) ON DELETE CASCADE;
+ALTER TABLE post_tags DROP CONSTRAINT post_tags_post_id_fkey;
+ALTER TABLE post_tags ADD CONSTRAINT post_tags_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE;
+ALTER TABLE post_histories DROP CONSTRAINT post_histories_post_id_fkey;
+ALTER TABLE post_histories ADD CONSTRAINT post_histories_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE;
+ALTER TABLE notifications DROP CONSTRAINT notifications_post_id_fkey;
+ALTER TABLE notifications ADD CONSTRAINT notifications_post_id_fkey FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE;
+ALTER TABLE posts DROP CONSTRAINT posts_merged_into_id_fkey;
+ALTER TABLE posts ADD CONSTRAINT posts_merged_into_id_fkey FOREIGN KEY (merged_into_id) REFERENCES posts(id) ON DELETE SET NULL;
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- +migrate Down
+
+-- Anonymous posts get their authors back before the table keeping them is
+-- dropped, creator_id cannot be NULL afterwards.
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS posts_anonymous_creator_check;
+UPDATE posts p SET creator_id = a.user_id, anonymous = FALSE
+FROM anonymous_post_authors a
+WHERE a.post_id = p.id;
+
+DROP TABLE IF EXISTS anonymous_post_authors;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS anonymous;
+ALTER TABLE posts ALTER COLUMN creator_id SET NOT NULL;
+
+ALTER TABLE tenants DROP COLUMN IF EXISTS allow_anonymous_posts;
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- +migrate Up
+
+ALTER TABLE tenants ADD COLUMN allow_anonymous_posts BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Anonymous posts have no creator, their author is kept in
+-- anonymous_post_authors which is only read for auditors.
+ALTER TABLE posts ALTER COLUMN creator_id DROP NOT NULL;
+ALTER TABLE posts ADD COLUMN anonymous BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE posts ADD CONSTRAINT posts_anonymous_creator_check CHECK (anonymous = (creator_id IS NULL));
+
+-- AnonymousPostAuthor table
+CREATE TABLE anonymous_post_authors (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    post_id BIGINT NOT NULL UNIQUE REFERENCES posts(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX anonymous_post_authors_user_id_idx ON anonymous_post_authors(user_id);
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS posts_fields_idx;
+ALTER TABLE posts DROP COLUMN IF EXISTS fields;
+
+DROP TABLE IF EXISTS question_template_fields;
+
+ALTER TABLE sub_topics DROP COLUMN IF EXISTS template_body;
\ No newline at end of file
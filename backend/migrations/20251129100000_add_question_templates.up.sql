@@ -0,0 +1,27 @@
+-- +migrate Up
+
+ALTER TABLE sub_topics ADD COLUMN template_body TEXT NOT NULL DEFAULT '';
+
+-- QuestionTemplateField table
+-- A structured field of the question template of a sub topic. Posts store the
+-- submitted values keyed by name in posts.fields.
+CREATE TABLE question_template_fields (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    sub_topic_id BIGINT NOT NULL REFERENCES sub_topics(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    label VARCHAR(255) NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    pattern VARCHAR(255),
+    min_value DOUBLE PRECISION,
+    max_value DOUBLE PRECISION,
+    options TEXT[],
+    position INT NOT NULL DEFAULT 0,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE(sub_topic_id, name)
+);
+
+ALTER TABLE posts ADD COLUMN fields JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX posts_fields_idx ON posts USING GIN (fields);
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS posts_tenant_id_created_at_idx;
+DROP INDEX IF EXISTS posts_custom_fields_idx;
+ALTER TABLE posts DROP COLUMN IF EXISTS custom_fields;
+
+DROP TABLE IF EXISTS custom_fields;
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- CustomField table
+-- A typed field tenants attach to every post. Posts store the values keyed
+-- by name in posts.custom_fields.
+CREATE TABLE custom_fields (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(64) NOT NULL,
+    label VARCHAR(255) NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options TEXT[],
+    position INT NOT NULL DEFAULT 0,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP,
+    UNIQUE(tenant_id, name)
+);
+
+ALTER TABLE posts ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}';
+
+-- Filters are containment queries, jsonb_path_ops keeps the index small.
+CREATE INDEX posts_custom_fields_idx ON posts USING GIN (custom_fields jsonb_path_ops);
+CREATE INDEX posts_tenant_id_created_at_idx ON posts(tenant_id, created_at DESC);
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS reactions;
+ALTER TABLE tenants DROP COLUMN IF EXISTS reaction_emojis;
\ No newline at end of file
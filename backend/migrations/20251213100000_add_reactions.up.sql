@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- Emoji a tenant allows as reactions, in display order.
+ALTER TABLE tenants ADD COLUMN reaction_emojis TEXT[] NOT NULL DEFAULT '{👍,❤️,🎉,😄,🤔,👀}';
+
+-- Reaction table
+-- A reaction of a user to exactly one post, answer or comment. Reactions are
+-- kept apart from votes and never count towards reputation or ranking.
+CREATE TABLE reactions (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    post_id BIGINT REFERENCES posts(id) ON DELETE CASCADE,
+    answer_id BIGINT REFERENCES answers(id) ON DELETE CASCADE,
+    comment_id BIGINT REFERENCES comments(id) ON DELETE CASCADE,
+    emoji VARCHAR(32) NOT NULL,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK (num_nonnulls(post_id, answer_id, comment_id) = 1)
+);
+
+-- One reaction per user, target and emoji. The indexes also serve the
+-- aggregated counts of list endpoints.
+CREATE UNIQUE INDEX reactions_post_id_idx ON reactions(post_id, emoji, user_id) WHERE post_id IS NOT NULL;
+CREATE UNIQUE INDEX reactions_answer_id_idx ON reactions(answer_id, emoji, user_id) WHERE answer_id IS NOT NULL;
+CREATE UNIQUE INDEX reactions_comment_id_idx ON reactions(comment_id, emoji, user_id) WHERE comment_id IS NOT NULL;
\ No newline at end of file
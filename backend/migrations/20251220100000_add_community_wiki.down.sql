@@ -0,0 +1,9 @@
+-- +migrate Down
+
+ALTER TABLE answers DROP COLUMN IF EXISTS version;
+ALTER TABLE answers DROP COLUMN IF EXISTS wiki;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS version;
+ALTER TABLE posts DROP COLUMN IF EXISTS wiki;
+
+ALTER TABLE tenants DROP COLUMN IF EXISTS wiki_edit_min_reputation;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- +migrate Up
+
+-- Community wiki posts and answers can be edited by every user above the
+-- reputation threshold of the tenant. Edits carry the version they were
+-- based on so concurrent edits are detected instead of overwritten.
+ALTER TABLE tenants ADD COLUMN wiki_edit_min_reputation INT NOT NULL DEFAULT 100;
+
+ALTER TABLE posts ADD COLUMN wiki BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE posts ADD COLUMN version INT NOT NULL DEFAULT 1;
+
+ALTER TABLE answers ADD COLUMN wiki BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE answers ADD COLUMN version INT NOT NULL DEFAULT 1;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS suggested_edits;
\ No newline at end of file
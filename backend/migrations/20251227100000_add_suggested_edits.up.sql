@@ -0,0 +1,28 @@
+-- +migrate Up
+
+-- Suggested edit table
+-- An edit of a post, or of one of its answers when answer_id is set, proposed
+-- by a user without edit rights. Suggestions wait for the author or a
+-- moderator of the post and keep the version of the content they are based on.
+CREATE TABLE suggested_edits (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    post_id BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    answer_id BIGINT REFERENCES answers(id) ON DELETE CASCADE,
+    suggester_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT,
+    body TEXT NOT NULL,
+    comment TEXT,
+    base_version INT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    reviewer_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    review_comment TEXT,
+    reviewed_at TIMESTAMP,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    CHECK (answer_id IS NULL OR title IS NULL)
+);
+
+-- The review queue lists the pending suggestions of a tenant oldest first.
+CREATE INDEX suggested_edits_pending_idx ON suggested_edits(tenant_id, created_at) WHERE status = 'PENDING';
+CREATE INDEX suggested_edits_post_id_idx ON suggested_edits(post_id);
\ No newline at end of file
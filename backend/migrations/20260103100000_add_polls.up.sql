@@ -0,0 +1,42 @@
+-- +migrate Up
+
+-- Poll table
+-- A poll embedded in a post. Results are shown ALWAYS, AFTER_VOTE to users
+-- who voted or AFTER_CLOSE once closes_at has passed, and always after the
+-- poll closed.
+CREATE TABLE polls (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    post_id BIGINT NOT NULL UNIQUE REFERENCES posts(id) ON DELETE CASCADE,
+    question TEXT NOT NULL,
+    multiple_choice BOOLEAN NOT NULL DEFAULT false,
+    results_visibility VARCHAR(16) NOT NULL DEFAULT 'ALWAYS',
+    closes_at TIMESTAMP,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK (results_visibility IN ('ALWAYS', 'AFTER_VOTE', 'AFTER_CLOSE'))
+);
+
+-- Poll option table
+CREATE TABLE poll_options (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    poll_id BIGINT NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    position INT NOT NULL,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id)
+);
+
+CREATE INDEX poll_options_poll_id_idx ON poll_options(poll_id, position);
+
+-- Poll vote table
+-- The options a user chose. Single choice polls keep at most one row per
+-- user, which the service enforces when replacing a vote.
+CREATE TABLE poll_votes (
+    poll_id BIGINT NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+    option_id BIGINT NOT NULL REFERENCES poll_options(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (option_id, user_id)
+);
+
+CREATE INDEX poll_votes_poll_id_idx ON poll_votes(poll_id, user_id);
\ No newline at end of file
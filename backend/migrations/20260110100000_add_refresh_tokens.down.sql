@@ -0,0 +1,3 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS refresh_tokens;
\ No newline at end of file
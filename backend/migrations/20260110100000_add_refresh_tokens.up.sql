@@ -0,0 +1,19 @@
+-- +migrate Up
+
+-- Refresh token table
+-- Opaque refresh tokens, stored as SHA-256 hashes. Every refresh rotates the
+-- token within its family; presenting a rotated token again revokes the
+-- whole family.
+CREATE TABLE refresh_tokens (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id VARCHAR(64) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    rotated_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens(family_id);
+CREATE INDEX refresh_tokens_user_id_idx ON refresh_tokens(user_id);
\ No newline at end of file
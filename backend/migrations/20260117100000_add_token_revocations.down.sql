@@ -0,0 +1,4 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS user_token_revocations;
+DROP TABLE IF EXISTS revoked_tokens;
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- Revoked token table
+-- Access tokens revoked before they expire, by their jti. Rows are pruned once
+-- the token would have expired anyway.
+CREATE TABLE revoked_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX revoked_tokens_expires_at_idx ON revoked_tokens(expires_at);
+
+-- User token revocation table
+-- Every access token of the user issued before revoked_at is revoked. The
+-- user id has no foreign key so the revocation outlives a deleted user.
+CREATE TABLE user_token_revocations (
+    user_id BIGINT PRIMARY KEY,
+    revoked_at TIMESTAMP NOT NULL
+);
\ No newline at end of file
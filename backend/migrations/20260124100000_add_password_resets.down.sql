@@ -0,0 +1,4 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS password_reset_requests;
+DROP TABLE IF EXISTS password_reset_tokens;
\ No newline at end of file
@@ -0,0 +1,27 @@
+-- +migrate Up
+
+-- Password reset token table
+-- Single use tokens mailed to users who forgot their password, stored as
+-- SHA-256 hashes.
+CREATE TABLE password_reset_tokens (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX password_reset_tokens_user_id_idx ON password_reset_tokens(user_id);
+
+-- Password reset request table
+-- Every reset request by the lower cased email, whether a user has the email
+-- or not, so requests can be rate limited without revealing which emails
+-- exist.
+CREATE TABLE password_reset_requests (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX password_reset_requests_email_created_at_idx ON password_reset_requests(email, created_at);
\ No newline at end of file
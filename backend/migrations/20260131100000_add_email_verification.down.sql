@@ -0,0 +1,5 @@
+-- +migrate Down
+
+ALTER TABLE tenants DROP COLUMN IF EXISTS unverified_user_access;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verification_sent_at;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
\ No newline at end of file
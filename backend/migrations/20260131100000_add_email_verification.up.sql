@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- Email verification
+-- Accounts that existed before verification was introduced count as verified.
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN email_verification_sent_at TIMESTAMP;
+UPDATE users SET email_verified_at = COALESCE(created_at, now());
+
+-- What users of the tenant can do before verifying their email: FULL,
+-- READ_ONLY or NONE, which keeps them from logging in at all.
+ALTER TABLE tenants ADD COLUMN unverified_user_access VARCHAR(16) NOT NULL DEFAULT 'READ_ONLY';
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- +migrate Down
+
+ALTER TABLE tenants DROP COLUMN IF EXISTS mfa_required_role_ids;
+DROP TABLE IF EXISTS recovery_codes;
+DROP TABLE IF EXISTS user_totps;
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- +migrate Up
+
+-- User TOTP table
+-- The TOTP secret of a user. Two-factor authentication is enabled once the
+-- enrollment is confirmed with a first code. last_used_step keeps codes from
+-- being replayed.
+CREATE TABLE user_totps (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMP,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Recovery code table
+-- Single use codes to log in without the authenticator, stored as SHA-256
+-- hashes.
+CREATE TABLE recovery_codes (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX recovery_codes_user_id_idx ON recovery_codes(user_id);
+
+-- Roles of the tenant whose users must enable two-factor authentication.
+ALTER TABLE tenants ADD COLUMN mfa_required_role_ids BIGINT[] NOT NULL DEFAULT '{}';
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS webauthn_ceremonies;
+DROP TABLE IF EXISTS webauthn_credentials;
+ALTER TABLE users DROP COLUMN IF EXISTS webauthn_handle;
\ No newline at end of file
@@ -0,0 +1,41 @@
+-- +migrate Up
+
+-- Random user handle passkeys are registered with, so authenticators never
+-- learn the user id. Set on the first passkey registration.
+ALTER TABLE users ADD COLUMN webauthn_handle BYTEA UNIQUE;
+
+-- WebAuthn credential table
+-- The passkeys of a user. Credentials belong to the tenant of the user and
+-- their ids are unique per tenant.
+CREATE TABLE webauthn_credentials (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    credential_id BYTEA NOT NULL,
+    public_key BYTEA NOT NULL,
+    attestation_type VARCHAR(32) NOT NULL,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    aaguid BYTEA NOT NULL,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+    backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (tenant_id, credential_id)
+);
+
+CREATE INDEX webauthn_credentials_user_id_idx ON webauthn_credentials(user_id);
+
+-- WebAuthn ceremony table
+-- The challenge of a registration or login between its two requests. Each
+-- ceremony can be finished once.
+CREATE TABLE webauthn_ceremonies (
+    id VARCHAR(64) PRIMARY KEY,
+    user_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL,
+    session JSONB NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX webauthn_ceremonies_expires_at_idx ON webauthn_ceremonies(expires_at);
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS oidc_login_states;
+DROP TABLE IF EXISTS tenant_oidc_providers;
\ No newline at end of file
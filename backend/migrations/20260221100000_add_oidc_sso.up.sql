@@ -0,0 +1,48 @@
+-- +migrate Up
+
+-- Tenant OIDC provider table
+-- The OpenID Connect identity provider users of a tenant can log in with.
+-- role_mappings is an ordered list of {"value": ..., "roleId": ...}; the
+-- first value found in the role_claim of the ID token decides the role, and
+-- users matching none get default_role_id or are refused without it.
+CREATE TABLE tenant_oidc_providers (
+    tenant_id BIGINT PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    issuer VARCHAR(255) NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    client_secret VARCHAR(255) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{openid,email,profile}',
+    role_claim VARCHAR(100) NOT NULL DEFAULT '',
+    role_mappings JSONB NOT NULL DEFAULT '[]',
+    default_role_id BIGINT REFERENCES roles(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- OIDC login state table
+-- A started login until the provider redirects back. Each state can be used
+-- once and carries the nonce and PKCE verifier of the login.
+CREATE TABLE oidc_login_states (
+    state VARCHAR(64) PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX oidc_login_states_expires_at_idx ON oidc_login_states(expires_at);
+
+-- User identity table
+-- Links the subject of an identity provider to the user it was provisioned
+-- as, so users are found again even after their email changed.
+CREATE TABLE user_identities (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (tenant_id, issuer, subject)
+);
+
+CREATE INDEX user_identities_user_id_idx ON user_identities(user_id);
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sso_login_codes;
+DROP TABLE IF EXISTS saml_login_requests;
+DROP TABLE IF EXISTS tenant_saml_providers;
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- +migrate Up
+
+-- Tenant SAML provider table
+-- The SAML identity provider users of a tenant can log in with. Responses
+-- must be signed with idp_certificate. The user attributes are read from the
+-- named attributes of the assertion; without email_attribute the NameID is
+-- taken as email. role_mappings works like the one of the OIDC providers.
+CREATE TABLE tenant_saml_providers (
+    tenant_id BIGINT PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    idp_entity_id VARCHAR(255) NOT NULL,
+    idp_sso_url VARCHAR(1024) NOT NULL,
+    idp_certificate TEXT NOT NULL,
+    email_attribute VARCHAR(255) NOT NULL DEFAULT '',
+    name_attribute VARCHAR(255) NOT NULL DEFAULT '',
+    username_attribute VARCHAR(255) NOT NULL DEFAULT '',
+    role_attribute VARCHAR(255) NOT NULL DEFAULT '',
+    role_mappings JSONB NOT NULL DEFAULT '[]',
+    default_role_id BIGINT REFERENCES roles(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- SAML login request table
+-- An authentication request sent to the identity provider, found again by
+-- the hash of its relay state when the response comes back. Each can be
+-- answered once.
+CREATE TABLE saml_login_requests (
+    relay_state VARCHAR(64) PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    request_id VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX saml_login_requests_expires_at_idx ON saml_login_requests(expires_at);
+
+-- SSO login code table
+-- Hands a finished SAML login over to the frontend, which trades the code
+-- for tokens once.
+CREATE TABLE sso_login_codes (
+    code_hash VARCHAR(64) PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX sso_login_codes_expires_at_idx ON sso_login_codes(expires_at);
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- +migrate Up
+
+-- Login throttle table
+-- Failed logins in a row per account, keyed by the lower case email, and per
+-- client IP. Emails without an account are counted too, so a lockout does
+-- not tell which accounts exist. Past a few failures every further one locks
+-- the key for a growing time, up to locked_until.
+CREATE TABLE login_throttles (
+    scope VARCHAR(16) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (scope, subject)
+);
+
+CREATE INDEX login_throttles_updated_at_idx ON login_throttles(updated_at);
@@ -0,0 +1,14 @@
+-- +migrate Up
+
+-- JWT signing key table
+-- The keys access tokens are signed with when they are kept in the database,
+-- as PKCS #8 PEM. The newest key without retired_at signs; retired keys keep
+-- verifying until the tokens they signed have expired. Tokens name their key
+-- by kid.
+CREATE TABLE jwt_signing_keys (
+    kid VARCHAR(64) PRIMARY KEY,
+    algorithm VARCHAR(16) NOT NULL,
+    private_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    retired_at TIMESTAMP
+);
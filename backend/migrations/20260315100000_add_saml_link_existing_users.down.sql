@@ -0,0 +1,3 @@
+-- +migrate Down
+
+ALTER TABLE tenant_saml_providers DROP COLUMN IF EXISTS link_existing_users;
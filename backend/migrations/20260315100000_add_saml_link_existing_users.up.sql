@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- Users of a tenant with the email an identity provider asserts are only
+-- linked to the provider when link_existing_users is set, as SAML providers
+-- do not say whether they verified the address.
+ALTER TABLE tenant_saml_providers
+    ADD COLUMN link_existing_users BOOLEAN NOT NULL DEFAULT FALSE;